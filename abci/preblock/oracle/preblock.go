@@ -41,6 +41,8 @@ type PreBlockHandler struct { //golint:ignore
 
 // NewOraclePreBlockHandler returns a new PreBlockHandler. The handler
 // is responsible for writing oracle data included in vote extensions to state.
+// The given PriceApplierOptions (e.g. a PriceGuard) configure how the aggregated
+// prices are written to state.
 func NewOraclePreBlockHandler(
 	logger log.Logger,
 	aggregateFn aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int],
@@ -49,6 +51,7 @@ func NewOraclePreBlockHandler(
 	strategy currencypair.CurrencyPairStrategy,
 	veCodec codec.VoteExtensionCodec,
 	ecCodec codec.ExtendedCommitCodec,
+	paOpts ...abciaggregator.PriceApplierOption,
 ) *PreBlockHandler {
 	va := abciaggregator.NewDefaultVoteAggregator(
 		logger,
@@ -61,6 +64,7 @@ func NewOraclePreBlockHandler(
 		veCodec,
 		ecCodec,
 		logger,
+		paOpts...,
 	)
 
 	return &PreBlockHandler{
@@ -143,6 +147,15 @@ func (h *PreBlockHandler) WrappedPreBlocker(mm *module.Manager) sdk.PreBlocker {
 			return response, err
 		}
 
+		// record each validator's oracle report for the block in state, the prices are written regardless
+		if perfErr := h.recordValidatorPerformance(ctx, req.DecidedLastCommit, prices); perfErr != nil {
+			h.logger.Error(
+				"failed to record validator performance",
+				"height", req.Height,
+				"error", perfErr,
+			)
+		}
+
 		return response, nil
 	}
 }
@@ -215,6 +228,15 @@ func (h *PreBlockHandler) PreBlocker() sdk.PreBlocker {
 			return &sdk.ResponsePreBlock{}, err
 		}
 
+		// record each validator's oracle report for the block in state, the prices are written regardless
+		if perfErr := h.recordValidatorPerformance(ctx, req.DecidedLastCommit, prices); perfErr != nil {
+			h.logger.Error(
+				"failed to record validator performance",
+				"height", req.Height,
+				"error", perfErr,
+			)
+		}
+
 		return &sdk.ResponsePreBlock{}, nil
 	}
 }
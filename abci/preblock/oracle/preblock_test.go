@@ -2,6 +2,7 @@ package oracle_test
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -97,6 +98,7 @@ func (s *PreBlockTestSuite) SetupTest() {
 	s.genesis = oracletypes.GenesisState{
 		CurrencyPairGenesis: genesisCPs,
 		NextId:              3,
+		Params:              oracletypes.DefaultParams(),
 	}
 
 	s.veCodec = compression.NewCompressionVoteExtensionCodec(
@@ -561,6 +563,7 @@ func (s *PreBlockTestSuite) TestPreBlockStatus() {
 		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
 		s.ctx = s.ctx.WithBlockHeight(4)
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return(nil)
+		mockOracleKeeper.On("RecordValidatorReports", mock.Anything, mock.Anything).Return(nil)
 
 		// run preblocker
 		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, &cometabci.RequestFinalizeBlock{
@@ -676,6 +679,7 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 
 		// mock oracle keeper calls
 		mockOracleKeeper.On("GetAllCurrencyPairs", s.ctx).Return([]slinkytypes.CurrencyPair{btcUsd, mogUsd}, nil)
+		mockOracleKeeper.On("RecordValidatorReports", mock.Anything, mock.Anything).Return(nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil)
 		mockOracleKeeper.On("SetPriceForCurrencyPair", s.ctx, mogUsd, mock.Anything).Return(nil)
 
@@ -749,3 +753,124 @@ func (s *PreBlockTestSuite) TestValidatorReports() {
 		s.Require().NoError(err)
 	})
 }
+
+func (s *PreBlockTestSuite) TestValidatorPerformance() {
+	val1 := sdk.ConsAddress("val1")
+	val2 := sdk.ConsAddress("val2")
+	val3 := sdk.ConsAddress("val3")
+
+	btcUsd := slinkytypes.NewCurrencyPair("BTC", "USD")
+	mogUsd := slinkytypes.NewCurrencyPair("MOG", "USD")
+
+	setup := func(k *slinkyabcimocks.OracleKeeper) (*preblock.PreBlockHandler, *cometabci.RequestFinalizeBlock) {
+		metrics := metricmock.NewMetrics(s.T())
+		metrics.On("ObserveABCIMethodLatency", servicemetrics.PreBlock, mock.Anything).Return().Maybe()
+		metrics.On("AddABCIRequest", servicemetrics.PreBlock, mock.Anything).Return().Maybe()
+		metrics.On("ObservePriceForTicker", mock.Anything, mock.Anything).Return().Maybe()
+		metrics.On("AddValidatorReportForTicker", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+		metrics.On("AddValidatorPriceForTicker", mock.Anything, mock.Anything, mock.Anything).Return().Maybe()
+
+		currencyPairStrategyMock := currencypairmock.NewCurrencyPairStrategy(s.T())
+		handler := preblock.NewOraclePreBlockHandler(
+			log.NewTestLogger(s.T()),
+			func(_ sdk.Context) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+				return func(_ aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
+					return map[slinkytypes.CurrencyPair]*big.Int{
+						btcUsd: big.NewInt(100),
+					}
+				}
+			},
+			k,
+			metrics,
+			currencyPairStrategyMock,
+			compression.NewDefaultVoteExtensionCodec(),
+			compression.NewDefaultExtendedCommitCodec(),
+		)
+
+		// enable ves + set exec mode
+		s.ctx = testutils.UpdateContextWithVEHeight(s.ctx, 2)
+		s.ctx = s.ctx.WithBlockHeight(4)
+		s.ctx = s.ctx.WithExecMode(sdk.ExecModeFinalize)
+
+		// mock currency-pair strategy calls
+		currencyPairStrategyMock.On("FromID", s.ctx, uint64(0)).Return(btcUsd, nil)
+		currencyPairStrategyMock.On("GetDecodedPrice", s.ctx, btcUsd, big.NewInt(100).Bytes()).Return(big.NewInt(100), nil)
+		currencyPairStrategyMock.On("GetDecodedPrice", s.ctx, btcUsd, big.NewInt(110).Bytes()).Return(big.NewInt(110), nil)
+
+		// mock oracle keeper calls
+		k.On("GetAllCurrencyPairs", s.ctx).Return([]slinkytypes.CurrencyPair{btcUsd, mogUsd}, nil)
+		k.On("SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything).Return(nil).Once()
+
+		// create extended commit info
+		val1Vote, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{
+			0: big.NewInt(100).Bytes(),
+		}, compression.NewDefaultVoteExtensionCodec())
+		s.Require().NoError(err)
+
+		val2Vote, err := testutils.CreateExtendedVoteInfo(val2, map[uint64][]byte{
+			0: big.NewInt(110).Bytes(),
+		}, compression.NewDefaultVoteExtensionCodec())
+		s.Require().NoError(err)
+
+		_, extCommitBz, err := testutils.CreateExtendedCommitInfo([]cometabci.ExtendedVoteInfo{val1Vote, val2Vote}, compression.NewDefaultExtendedCommitCodec())
+		s.Require().NoError(err)
+
+		return handler, &cometabci.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitBz},
+			DecidedLastCommit: cometabci.CommitInfo{
+				Votes: []cometabci.VoteInfo{
+					{
+						Validator:   cometabci.Validator{Address: val1},
+						BlockIdFlag: cometproto.BlockIDFlagCommit,
+					},
+					{
+						Validator:   cometabci.Validator{Address: val2},
+						BlockIdFlag: cometproto.BlockIDFlagCommit,
+					},
+					{
+						Validator:   cometabci.Validator{Address: val3},
+						BlockIdFlag: cometproto.BlockIDFlagAbsent,
+					},
+				},
+			},
+		}
+	}
+
+	s.Run("validator reports are recorded in state", func() {
+		k := slinkyabcimocks.NewOracleKeeper(s.T())
+		handler, req := setup(k)
+
+		val1Report := oracletypes.NewValidatorReport(val1, 4)
+		val1Report.MissingPrices = 1
+		val1Report.ReportedPrices = 1
+
+		val2Report := oracletypes.NewValidatorReport(val2, 4)
+		val2Report.MissingPrices = 1
+		val2Report.ReportedPrices = 1
+		val2Report.Deviation = math.LegacyNewDecWithPrec(1, 1)
+
+		val3Report := oracletypes.NewValidatorReport(val3, 4)
+		val3Report.Absent = true
+
+		k.On(
+			"RecordValidatorReports",
+			mock.Anything,
+			[]oracletypes.ValidatorReport{val1Report, val2Report, val3Report},
+		).Return(nil).Once()
+
+		_, err := handler.WrappedPreBlocker(s.mm)(s.ctx, req)
+		s.Require().NoError(err)
+	})
+
+	s.Run("errors recording validator reports do not fail the block", func() {
+		k := slinkyabcimocks.NewOracleKeeper(s.T())
+		handler, req := setup(k)
+
+		k.On("RecordValidatorReports", mock.Anything, mock.Anything).Return(fmt.Errorf("fail")).Once()
+
+		// the prices are still written to state
+		_, err := handler.PreBlocker()(s.ctx, req)
+		s.Require().NoError(err)
+		k.AssertCalled(s.T(), "SetPriceForCurrencyPair", s.ctx, btcUsd, mock.Anything)
+	})
+}
@@ -3,12 +3,14 @@ package oracle
 import (
 	"math/big"
 
+	"cosmossdk.io/math"
 	cometabci "github.com/cometbft/cometbft/abci/types"
 	cometproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
 	servicemetrics "github.com/1119-Labs/slinky/service/metrics"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
 )
 
 // recordPrice records all the given prices per ticker, and reports them as a float64.
@@ -56,3 +58,73 @@ func (h *PreBlockHandler) recordValidatorReports(ctx sdk.Context, decidedCommit
 		}
 	}
 }
+
+// recordValidatorPerformance takes the commit decided for this block and the final prices written to state, and
+// records for each validator in the commit whether their vote was included in the commit, how many prices they
+// did / did not report, and how far their reported prices deviated from the final prices. The reports are recorded
+// in a cache context, so no state changes are written if an error is returned.
+func (h *PreBlockHandler) recordValidatorPerformance(
+	ctx sdk.Context,
+	decidedCommit cometabci.CommitInfo,
+	prices map[slinkytypes.CurrencyPair]*big.Int,
+) error {
+	pricesToReport := h.keeper.GetAllCurrencyPairs(ctx)
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+
+	reports := make([]oracletypes.ValidatorReport, 0, len(decidedCommit.Votes))
+	for _, vote := range decidedCommit.Votes {
+		validator := sdk.ConsAddress(vote.Validator.Address)
+		report := oracletypes.NewValidatorReport(validator, height)
+
+		// if the validator voted nil, none of their prices are counted
+		if vote.BlockIdFlag != cometproto.BlockIDFlagCommit {
+			report.Absent = true
+			reports = append(reports, report)
+			continue
+		}
+
+		validatorPrices := h.pa.GetPricesForValidator(validator)
+		for _, cp := range pricesToReport {
+			price, ok := validatorPrices[cp]
+			if !ok || price == nil {
+				report.MissingPrices++
+				continue
+			}
+
+			report.ReportedPrices++
+
+			// the deviation is only measured against prices that were written to state
+			finalPrice, ok := prices[cp]
+			if !ok || finalPrice == nil || finalPrice.Sign() <= 0 {
+				continue
+			}
+
+			report.Deviation = report.Deviation.Add(priceDeviation(price, finalPrice))
+		}
+
+		reports = append(reports, report)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := h.keeper.RecordValidatorReports(cacheCtx, reports); err != nil {
+		return err
+	}
+	write()
+
+	return nil
+}
+
+// priceDeviation returns the absolute fractional deviation of the given price from the final price, capped at 1 so
+// that a single outlandish price cannot overflow a validator's accumulated deviation.
+func priceDeviation(price, finalPrice *big.Int) math.LegacyDec {
+	diff := new(big.Int).Sub(price, finalPrice)
+	diff.Abs(diff)
+	if diff.Cmp(finalPrice) >= 0 {
+		return math.LegacyOneDec()
+	}
+
+	// scale the difference to LegacyDec precision before dividing, as the prices may be too large to be
+	// represented as LegacyDecs themselves
+	scaled := new(big.Int).Mul(diff, math.LegacyOneDec().BigInt())
+	return math.LegacyNewDecFromBigIntWithPrec(scaled.Quo(scaled, finalPrice), math.LegacyPrecision)
+}
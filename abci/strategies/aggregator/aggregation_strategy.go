@@ -0,0 +1,92 @@
+package aggregator
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/aggregator"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+var (
+	// DefaultTrimFraction is the fraction of the total stake weight trimmed from each end of the sorted
+	// prices by the default trimmed mean strategy, i.e. the interquartile mean.
+	DefaultTrimFraction = math.LegacyNewDecWithPrec(25, 2)
+
+	// DefaultMADMultiplier is the number of median absolute deviations from the median beyond which prices
+	// are rejected by the default MAD mean strategy.
+	DefaultMADMultiplier int64 = 3
+)
+
+// StrategyFromContext returns the name of the aggregation strategy to use for a given currency pair, e.g.
+// as read from the x/oracle module's params and the currency pair's market.
+type StrategyFromContext func(ctx sdk.Context, cp slinkytypes.CurrencyPair) (string, error)
+
+// DefaultAggregationStrategies returns the registry of the supported aggregation strategies, keyed by the
+// names used in the x/oracle module's params and the ticker metadata of markets.
+func DefaultAggregationStrategies() map[string]voteweighted.AggregationStrategy {
+	return map[string]voteweighted.AggregationStrategy{
+		oracletypes.AggregationStrategyMedian:      voteweighted.ComputeMedian,
+		oracletypes.AggregationStrategyTrimmedMean: voteweighted.TrimmedMean(DefaultTrimFraction),
+		oracletypes.AggregationStrategyMADMean:     voteweighted.MADMean(DefaultMADMultiplier),
+	}
+}
+
+// AggregateFromThresholdContext returns a new aggregate function that is parametrized by the latest
+// state of the application, including the power threshold and the aggregation strategy of each currency
+// pair. The strategy for each currency pair is looked up by name in the given registry. If the threshold
+// cannot be retrieved, the DefaultPowerThreshold is used, and if the strategy cannot be retrieved or is
+// not in the registry, the stake-weighted median is used.
+func AggregateFromThresholdContext(
+	logger log.Logger,
+	validatorStore voteweighted.ValidatorStore,
+	thresholdFn voteweighted.ThresholdFromContext,
+	strategyFn StrategyFromContext,
+	strategies map[string]voteweighted.AggregationStrategy,
+) aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+		threshold, err := thresholdFn(ctx)
+		if err != nil {
+			logger.Error(
+				"failed to retrieve power threshold; using default",
+				"default_threshold", voteweighted.DefaultPowerThreshold.String(),
+				"err", err,
+			)
+
+			threshold = voteweighted.DefaultPowerThreshold
+		}
+
+		strategyFor := func(cp slinkytypes.CurrencyPair) (string, voteweighted.AggregationStrategy) {
+			name, err := strategyFn(ctx, cp)
+			if err != nil {
+				logger.Error(
+					"failed to retrieve aggregation strategy; using median",
+					"currency_pair", cp.String(),
+					"err", err,
+				)
+
+				return oracletypes.AggregationStrategyMedian, voteweighted.ComputeMedian
+			}
+
+			strategy, ok := strategies[name]
+			if !ok {
+				logger.Error(
+					"unknown aggregation strategy; using median",
+					"currency_pair", cp.String(),
+					"strategy", name,
+				)
+
+				return oracletypes.AggregationStrategyMedian, voteweighted.ComputeMedian
+			}
+
+			return name, strategy
+		}
+
+		return voteweighted.Aggregate(ctx, logger, validatorStore, threshold, strategyFor)
+	}
+}
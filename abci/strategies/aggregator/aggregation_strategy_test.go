@@ -0,0 +1,94 @@
+package aggregator_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/abci/strategies/aggregator"
+	aggregatorpkg "github.com/1119-Labs/slinky/aggregator"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted/mocks"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func TestAggregateFromThresholdContext(t *testing.T) {
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+	sol := slinkytypes.NewCurrencyPair("SOL", "USD")
+	atom := slinkytypes.NewCurrencyPair("ATOM", "USD")
+
+	validators := []sdk.ConsAddress{val1, val2, sdk.ConsAddress("val3")}
+
+	// every validator has the same stake
+	validatorStore := mocks.NewValidatorStore(t)
+	validatorStore.On("TotalBondedTokens", mock.Anything).Return(math.NewInt(30), nil)
+	for _, val := range validators {
+		validatorStore.On("ValidatorByConsAddr", mock.Anything, val).Return(
+			stakingtypes.Validator{
+				Tokens: math.NewInt(10),
+				Status: stakingtypes.Bonded,
+			},
+			nil,
+		)
+	}
+
+	// every market has the same prices, so that the price only depends on the strategy of the market
+	prices := func(price int64) map[slinkytypes.CurrencyPair]*big.Int {
+		return map[slinkytypes.CurrencyPair]*big.Int{
+			btc:  big.NewInt(price),
+			eth:  big.NewInt(price),
+			sol:  big.NewInt(price),
+			atom: big.NewInt(price),
+		}
+	}
+	providerPrices := aggregatorpkg.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validators[0].String(): prices(10),
+		validators[1].String(): prices(20),
+		validators[2].String(): prices(90),
+	}
+
+	strategyFn := func(_ sdk.Context, cp slinkytypes.CurrencyPair) (string, error) {
+		switch cp {
+		case btc:
+			return oracletypes.AggregationStrategyTrimmedMean, nil
+		case eth:
+			return oracletypes.AggregationStrategyMADMean, nil
+		case sol:
+			return "", fmt.Errorf("no market")
+		default:
+			return "mode", nil
+		}
+	}
+	thresholdFn := func(sdk.Context) (math.LegacyDec, error) {
+		return voteweighted.DefaultPowerThreshold, nil
+	}
+
+	aggregateFn := aggregator.AggregateFromThresholdContext(
+		log.NewTestLogger(t),
+		validatorStore,
+		thresholdFn,
+		strategyFn,
+		aggregator.DefaultAggregationStrategies(),
+	)
+
+	result := aggregateFn(ctx)(providerPrices)
+	require.Equal(t, map[slinkytypes.CurrencyPair]*big.Int{
+		btc:  big.NewInt(31),
+		eth:  big.NewInt(15),
+		sol:  big.NewInt(20),
+		atom: big.NewInt(20),
+	}, result)
+}
@@ -0,0 +1,197 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	math "cosmossdk.io/math"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+	mock "github.com/stretchr/testify/mock"
+
+	pkgtypes "github.com/1119-Labs/slinky/pkg/types"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CircuitBreakerKeeper is an autogenerated mock type for the CircuitBreakerKeeper type
+type CircuitBreakerKeeper struct {
+	mock.Mock
+}
+
+// GetMaxPriceChange provides a mock function with given fields: ctx, cp
+func (_m *CircuitBreakerKeeper) GetMaxPriceChange(ctx types.Context, cp pkgtypes.CurrencyPair) (math.LegacyDec, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMaxPriceChange")
+	}
+
+	var r0 math.LegacyDec
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) (math.LegacyDec, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) math.LegacyDec); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetParams provides a mock function with given fields: ctx
+func (_m *CircuitBreakerKeeper) GetParams(ctx types.Context) (oracletypes.Params, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParams")
+	}
+
+	var r0 oracletypes.Params
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context) (oracletypes.Params, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) oracletypes.Params); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(oracletypes.Params)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceForCurrencyPair provides a mock function with given fields: ctx, cp
+func (_m *CircuitBreakerKeeper) GetPriceForCurrencyPair(ctx types.Context, cp pkgtypes.CurrencyPair) (oracletypes.QuotePrice, error) {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceForCurrencyPair")
+	}
+
+	var r0 oracletypes.QuotePrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) (oracletypes.QuotePrice, error)); ok {
+		return rf(ctx, cp)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) oracletypes.QuotePrice); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Get(0).(oracletypes.QuotePrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair) error); ok {
+		r1 = rf(ctx, cp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTWAPByBlocks provides a mock function with given fields: ctx, cp, blocks
+func (_m *CircuitBreakerKeeper) GetTWAPByBlocks(ctx types.Context, cp pkgtypes.CurrencyPair, blocks uint64) (math.Int, uint64, error) {
+	ret := _m.Called(ctx, cp, blocks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTWAPByBlocks")
+	}
+
+	var r0 math.Int
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, uint64) (math.Int, uint64, error)); ok {
+		return rf(ctx, cp, blocks)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, uint64) math.Int); ok {
+		r0 = rf(ctx, cp, blocks)
+	} else {
+		r0 = ret.Get(0).(math.Int)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair, uint64) uint64); ok {
+		r1 = rf(ctx, cp, blocks)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(types.Context, pkgtypes.CurrencyPair, uint64) error); ok {
+		r2 = rf(ctx, cp, blocks)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RecordCircuitBreakerTrip provides a mock function with given fields: ctx, cp, upward
+func (_m *CircuitBreakerKeeper) RecordCircuitBreakerTrip(ctx types.Context, cp pkgtypes.CurrencyPair, upward bool) (uint64, error) {
+	ret := _m.Called(ctx, cp, upward)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordCircuitBreakerTrip")
+	}
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, bool) (uint64, error)); ok {
+		return rf(ctx, cp, upward)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, bool) uint64); ok {
+		r0 = rf(ctx, cp, upward)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair, bool) error); ok {
+		r1 = rf(ctx, cp, upward)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetCircuitBreakerTrips provides a mock function with given fields: ctx, cp
+func (_m *CircuitBreakerKeeper) ResetCircuitBreakerTrips(ctx types.Context, cp pkgtypes.CurrencyPair) error {
+	ret := _m.Called(ctx, cp)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetCircuitBreakerTrips")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair) error); ok {
+		r0 = rf(ctx, cp)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCircuitBreakerKeeper creates a new instance of CircuitBreakerKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCircuitBreakerKeeper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CircuitBreakerKeeper {
+	mock := &CircuitBreakerKeeper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,50 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pkgtypes "github.com/1119-Labs/slinky/pkg/types"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceDispersionKeeper is an autogenerated mock type for the PriceDispersionKeeper type
+type PriceDispersionKeeper struct {
+	mock.Mock
+}
+
+// SetPriceDispersion provides a mock function with given fields: ctx, cp, dispersion
+func (_m *PriceDispersionKeeper) SetPriceDispersion(ctx types.Context, cp pkgtypes.CurrencyPair, dispersion oracletypes.PriceDispersion) error {
+	ret := _m.Called(ctx, cp, dispersion)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPriceDispersion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, oracletypes.PriceDispersion) error); ok {
+		r0 = rf(ctx, cp, dispersion)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPriceDispersionKeeper creates a new instance of PriceDispersionKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceDispersionKeeper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceDispersionKeeper {
+	mock := &PriceDispersionKeeper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
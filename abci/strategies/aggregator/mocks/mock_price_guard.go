@@ -0,0 +1,62 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	big "math/big"
+
+	pkgtypes "github.com/1119-Labs/slinky/pkg/types"
+
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceGuard is an autogenerated mock type for the PriceGuard type
+type PriceGuard struct {
+	mock.Mock
+}
+
+// GuardPrice provides a mock function with given fields: ctx, cp, price
+func (_m *PriceGuard) GuardPrice(ctx types.Context, cp pkgtypes.CurrencyPair, price *big.Int) (*big.Int, error) {
+	ret := _m.Called(ctx, cp, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GuardPrice")
+	}
+
+	var r0 *big.Int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, *big.Int) (*big.Int, error)); ok {
+		return rf(ctx, cp, price)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, pkgtypes.CurrencyPair, *big.Int) *big.Int); ok {
+		r0 = rf(ctx, cp, price)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, pkgtypes.CurrencyPair, *big.Int) error); ok {
+		r1 = rf(ctx, cp, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPriceGuard creates a new instance of PriceGuard. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceGuard(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceGuard {
+	mock := &PriceGuard{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
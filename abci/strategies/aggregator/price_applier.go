@@ -10,6 +10,7 @@ import (
 
 	"github.com/1119-Labs/slinky/abci/strategies/codec"
 	slinkyabcitypes "github.com/1119-Labs/slinky/abci/types"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
 	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
 
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
@@ -22,8 +23,8 @@ import (
 type PriceApplier interface {
 	// ApplyPricesFromVoteExtensions derives the aggregate prices per asset in accordance with the given
 	// vote extensions + VoteAggregator. If a price exists for an asset, it is written to state. The
-	// prices aggregated from vote-extensions are returned if no errors are encountered in execution,
-	// otherwise an error is returned + nil prices.
+	// prices aggregated from vote-extensions (as modified by the PriceGuard, if one is configured) are
+	// returned if no errors are encountered in execution, otherwise an error is returned + nil prices.
 	ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error)
 
 	// GetPriceForValidator gets the prices reported by a given validator. This method depends
@@ -45,6 +46,25 @@ type oraclePriceApplier struct {
 	// codecs
 	voteExtensionCodec  codec.VoteExtensionCodec
 	extendedCommitCodec codec.ExtendedCommitCodec
+
+	// guard is an optional PriceGuard that checks aggregated prices before they are written to state.
+	guard PriceGuard
+
+	// dispersionFn is an optional function used to compute the dispersion of the validator prices, which
+	// is written to state with dk alongside each price.
+	dispersionFn voteweighted.DispersionFn
+	dk           PriceDispersionKeeper
+}
+
+// PriceApplierOption is a function that enables optional configuration of the oraclePriceApplier.
+type PriceApplierOption func(*oraclePriceApplier)
+
+// WithPriceGuard returns a PriceApplierOption that configures the oraclePriceApplier to check every
+// aggregated price with the given PriceGuard before writing it to state.
+func WithPriceGuard(guard PriceGuard) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		opa.guard = guard
+	}
 }
 
 // NewOraclePriceApplier returns a new oraclePriceApplier.
@@ -54,14 +74,21 @@ func NewOraclePriceApplier(
 	voteExtensionCodec codec.VoteExtensionCodec,
 	extendedCommitCodec codec.ExtendedCommitCodec,
 	logger log.Logger,
+	opts ...PriceApplierOption,
 ) PriceApplier {
-	return &oraclePriceApplier{
+	opa := &oraclePriceApplier{
 		va:                  va,
 		ok:                  ok,
 		logger:              logger,
 		voteExtensionCodec:  voteExtensionCodec,
 		extendedCommitCodec: extendedCommitCodec,
 	}
+
+	for _, opt := range opts {
+		opt(opa)
+	}
+
+	return opa
 }
 
 func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, req *cometabci.RequestFinalizeBlock) (map[slinkytypes.CurrencyPair]*big.Int, error) {
@@ -100,6 +127,15 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 		return nil, err
 	}
 
+	// the prices returned are those written to state, so that guarded prices are reflected, notice the
+	// aggregated prices are copied as they are retained by the VoteAggregator.
+	applied := make(map[slinkytypes.CurrencyPair]*big.Int, len(prices))
+	for cp, price := range prices {
+		applied[cp] = price
+	}
+
+	dispersions := opa.getPriceDispersions(ctx, votes)
+
 	currencyPairs := opa.ok.GetAllCurrencyPairs(ctx)
 	for _, cp := range currencyPairs {
 		price, ok := prices[cp]
@@ -122,6 +158,36 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			continue
 		}
 
+		if opa.guard != nil {
+			guarded, err := opa.guard.GuardPrice(ctx, cp, price)
+			if err != nil {
+				// fail closed, i.e. do not write a price that could not be checked
+				opa.logger.Error(
+					"failed to guard price for currency pair",
+					"currency_pair", cp.String(),
+					"price", price.String(),
+					"err", err,
+				)
+
+				delete(applied, cp)
+				continue
+			}
+
+			if guarded == nil {
+				opa.logger.Info(
+					"price rejected by price guard",
+					"currency_pair", cp.String(),
+					"price", price.String(),
+				)
+
+				delete(applied, cp)
+				continue
+			}
+
+			price = guarded
+			applied[cp] = price
+		}
+
 		// Convert the price to a quote price and write it to state.
 		quotePrice := oracletypes.QuotePrice{
 			Price:          math.NewIntFromBigInt(price),
@@ -145,9 +211,24 @@ func (opa *oraclePriceApplier) ApplyPricesFromVoteExtensions(ctx sdk.Context, re
 			"currency_pair", cp.String(),
 			"quote_price", quotePrice.Price.String(),
 		)
+
+		dispersion, ok := dispersions[cp]
+		if !ok {
+			continue
+		}
+
+		if err := opa.dk.SetPriceDispersion(ctx, cp, toPriceDispersion(dispersion, quotePrice.BlockHeight)); err != nil {
+			opa.logger.Error(
+				"failed to set price dispersion for currency pair",
+				"currency_pair", cp.String(),
+				"err", err,
+			)
+
+			return nil, err
+		}
 	}
 
-	return prices, nil
+	return applied, nil
 }
 
 func (opa *oraclePriceApplier) GetPricesForValidator(validator sdk.ConsAddress) map[slinkytypes.CurrencyPair]*big.Int {
@@ -16,11 +16,14 @@ import (
 	abcimocks "github.com/1119-Labs/slinky/abci/types/mocks"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	vetypes "github.com/1119-Labs/slinky/abci/ve/types"
+	aggregatorpkg "github.com/1119-Labs/slinky/aggregator"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
 	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
 )
@@ -230,3 +233,190 @@ func TestPriceApplier(t *testing.T) {
 		require.Equal(t, expPrices, valPrices)
 	})
 }
+
+func TestPriceApplierWithPriceGuard(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	extCommitcodec := codec.NewDefaultExtendedCommitCodec()
+
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+	sol := slinkytypes.NewCurrencyPair("SOL", "USD")
+
+	prices := map[uint64][]byte{
+		1: big.NewInt(100).Bytes(),
+	}
+	ca := sdk.ConsAddress("val1")
+
+	vote, err := testutils.CreateExtendedVoteInfo(ca, prices, veCodec)
+	require.NoError(t, err)
+
+	_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+		[]abcitypes.ExtendedVoteInfo{vote},
+		extCommitcodec,
+	)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
+		Time: time.Now(),
+	}).WithBlockHeight(1)
+
+	va := mocks.NewVoteAggregator(t)
+	ok := abcimocks.NewOracleKeeper(t)
+	guard := mocks.NewPriceGuard(t)
+
+	pa := aggregator.NewOraclePriceApplier(
+		va,
+		ok,
+		veCodec,
+		extCommitcodec,
+		log.NewNopLogger(),
+		aggregator.WithPriceGuard(guard),
+	)
+
+	va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+		eth: big.NewInt(200),
+		sol: big.NewInt(300),
+	}, nil)
+	ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{btc, eth, sol})
+
+	// btc is unchanged, eth is clamped, sol is rejected
+	guard.On("GuardPrice", ctx, btc, big.NewInt(100)).Return(big.NewInt(100), nil)
+	guard.On("GuardPrice", ctx, eth, big.NewInt(200)).Return(big.NewInt(150), nil)
+	guard.On("GuardPrice", ctx, sol, big.NewInt(300)).Return(nil, nil)
+
+	ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		qp := args.Get(2).(oracletypes.QuotePrice)
+		require.Equal(t, big.NewInt(100), qp.Price.BigInt())
+	}).Once()
+	ok.On("SetPriceForCurrencyPair", ctx, eth, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		qp := args.Get(2).(oracletypes.QuotePrice)
+		require.Equal(t, big.NewInt(150), qp.Price.BigInt())
+	}).Once()
+
+	applied, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{extCommitInfoBz},
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[slinkytypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+		eth: big.NewInt(150),
+	}, applied)
+
+	t.Run("prices that fail to be guarded are not written", func(t *testing.T) {
+		guard := mocks.NewPriceGuard(t)
+		pa := aggregator.NewOraclePriceApplier(
+			va,
+			ok,
+			veCodec,
+			extCommitcodec,
+			log.NewNopLogger(),
+			aggregator.WithPriceGuard(guard),
+		)
+
+		guard.On("GuardPrice", ctx, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("fail"))
+
+		applied, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.NoError(t, err)
+		require.Empty(t, applied)
+	})
+}
+
+func TestPriceApplierWithPriceDispersion(t *testing.T) {
+	veCodec := codec.NewDefaultVoteExtensionCodec()
+	extCommitcodec := codec.NewDefaultExtendedCommitCodec()
+
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	val1 := sdk.ConsAddress("val1")
+	val2 := sdk.ConsAddress("val2")
+
+	vote1, err := testutils.CreateExtendedVoteInfo(val1, map[uint64][]byte{1: big.NewInt(100).Bytes()}, veCodec)
+	require.NoError(t, err)
+	vote2, err := testutils.CreateExtendedVoteInfo(val2, map[uint64][]byte{1: big.NewInt(110).Bytes()}, veCodec)
+	require.NoError(t, err)
+
+	_, extCommitInfoBz, err := testutils.CreateExtendedCommitInfo(
+		[]abcitypes.ExtendedVoteInfo{vote1, vote2},
+		extCommitcodec,
+	)
+	require.NoError(t, err)
+
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{
+		Time: time.Now(),
+	}).WithBlockHeight(5)
+
+	va := mocks.NewVoteAggregator(t)
+	ok := abcimocks.NewOracleKeeper(t)
+	dk := mocks.NewPriceDispersionKeeper(t)
+
+	dispersion := voteweighted.Dispersion{
+		Min:           big.NewInt(100),
+		Max:           big.NewInt(110),
+		LowerQuartile: big.NewInt(100),
+		UpperQuartile: big.NewInt(110),
+		NumValidators: 2,
+		Power:         math.NewInt(20),
+		TotalPower:    math.NewInt(30),
+	}
+
+	var providers aggregatorpkg.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]
+	dispersionFn := func(_ sdk.Context, p aggregatorpkg.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]voteweighted.Dispersion {
+		providers = p
+		// a dispersion is computed for eth, but no price is aggregated for it
+		return map[slinkytypes.CurrencyPair]voteweighted.Dispersion{
+			btc: dispersion,
+			eth: dispersion,
+		}
+	}
+
+	pa := aggregator.NewOraclePriceApplier(
+		va,
+		ok,
+		veCodec,
+		extCommitcodec,
+		log.NewNopLogger(),
+		aggregator.WithPriceDispersion(dispersionFn, dk),
+	)
+
+	va.On("AggregateOracleVotes", ctx, mock.Anything).Return(map[slinkytypes.CurrencyPair]*big.Int{
+		btc: big.NewInt(100),
+	}, nil)
+	va.On("GetPriceForValidator", val1).Return(map[slinkytypes.CurrencyPair]*big.Int{btc: big.NewInt(100)})
+	va.On("GetPriceForValidator", val2).Return(map[slinkytypes.CurrencyPair]*big.Int{btc: big.NewInt(110)})
+	ok.On("GetAllCurrencyPairs", ctx).Return([]slinkytypes.CurrencyPair{btc, eth})
+	ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Once()
+
+	dk.On("SetPriceDispersion", ctx, btc, oracletypes.PriceDispersion{
+		Min:           math.NewInt(100),
+		Max:           math.NewInt(110),
+		LowerQuartile: math.NewInt(100),
+		UpperQuartile: math.NewInt(110),
+		NumValidators: 2,
+		Power:         math.NewInt(20),
+		TotalPower:    math.NewInt(30),
+		BlockHeight:   5,
+	}).Return(nil).Once()
+
+	_, err = pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+		Txs: [][]byte{extCommitInfoBz},
+	})
+	require.NoError(t, err)
+	require.Equal(t, aggregatorpkg.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		val1.String(): {btc: big.NewInt(100)},
+		val2.String(): {btc: big.NewInt(110)},
+	}, providers)
+
+	t.Run("errors setting the dispersion are returned", func(t *testing.T) {
+		ok.On("SetPriceForCurrencyPair", ctx, btc, mock.Anything).Return(nil).Once()
+		dk.On("SetPriceDispersion", ctx, btc, mock.Anything).Return(fmt.Errorf("fail")).Once()
+
+		_, err := pa.ApplyPricesFromVoteExtensions(ctx, &abcitypes.RequestFinalizeBlock{
+			Txs: [][]byte{extCommitInfoBz},
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,66 @@
+package aggregator
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/aggregator"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// PriceDispersionKeeper defines the interface that must be fulfilled by the oracle keeper in
+// order to store the dispersion of the validator prices alongside each aggregated price.
+//
+//go:generate mockery --name PriceDispersionKeeper --filename mock_price_dispersion_keeper.go
+type PriceDispersionKeeper interface {
+	SetPriceDispersion(ctx sdk.Context, cp slinkytypes.CurrencyPair, dispersion oracletypes.PriceDispersion) error
+}
+
+// WithPriceDispersion returns a PriceApplierOption that configures the oraclePriceApplier to compute
+// the dispersion of the validator prices with the given DispersionFn, and to store it with the given
+// keeper for every price written to state.
+func WithPriceDispersion(dispersionFn voteweighted.DispersionFn, keeper PriceDispersionKeeper) PriceApplierOption {
+	return func(opa *oraclePriceApplier) {
+		opa.dispersionFn = dispersionFn
+		opa.dk = keeper
+	}
+}
+
+// getPriceDispersions computes the dispersion of the prices reported in the given votes, as decoded by the
+// VoteAggregator. Nil is returned if no DispersionFn is configured.
+func (opa *oraclePriceApplier) getPriceDispersions(ctx sdk.Context, votes []Vote) map[slinkytypes.CurrencyPair]voteweighted.Dispersion {
+	if opa.dispersionFn == nil || opa.dk == nil {
+		return nil
+	}
+
+	providers := make(aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int], len(votes))
+	for _, vote := range votes {
+		prices := opa.va.GetPriceForValidator(vote.ConsAddress)
+		if len(prices) == 0 {
+			continue
+		}
+
+		providers[vote.ConsAddress.String()] = prices
+	}
+
+	return opa.dispersionFn(ctx, providers)
+}
+
+// toPriceDispersion converts the given dispersion of the prices reported at the given height into its
+// on-chain representation.
+func toPriceDispersion(d voteweighted.Dispersion, height uint64) oracletypes.PriceDispersion {
+	return oracletypes.PriceDispersion{
+		Min:           math.NewIntFromBigInt(d.Min),
+		Max:           math.NewIntFromBigInt(d.Max),
+		LowerQuartile: math.NewIntFromBigInt(d.LowerQuartile),
+		UpperQuartile: math.NewIntFromBigInt(d.UpperQuartile),
+		NumValidators: d.NumValidators,
+		Power:         d.Power,
+		TotalPower:    d.TotalPower,
+		BlockHeight:   height,
+	}
+}
@@ -0,0 +1,178 @@
+package aggregator
+
+import (
+	"errors"
+	"math/big"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	servicemetrics "github.com/1119-Labs/slinky/service/metrics"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// PriceGuard is an interface used by the PriceApplier to check newly aggregated prices before
+// they are written to state.
+//
+//go:generate mockery --name PriceGuard --filename mock_price_guard.go
+type PriceGuard interface {
+	// GuardPrice checks the newly aggregated price for the given currency pair. It returns the
+	// price that should be written to state (which may differ from the given price), or nil if
+	// the price should not be written to state.
+	GuardPrice(ctx sdk.Context, cp slinkytypes.CurrencyPair, price *big.Int) (*big.Int, error)
+}
+
+// CircuitBreakerKeeper defines the interface that must be fulfilled by the oracle keeper for
+// the circuit breaker PriceGuard.
+//
+//go:generate mockery --name CircuitBreakerKeeper --filename mock_circuit_breaker_keeper.go
+type CircuitBreakerKeeper interface {
+	GetParams(ctx sdk.Context) (oracletypes.Params, error)
+	GetMaxPriceChange(ctx sdk.Context, cp slinkytypes.CurrencyPair) (math.LegacyDec, error)
+	GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (oracletypes.QuotePrice, error)
+	GetTWAPByBlocks(ctx sdk.Context, cp slinkytypes.CurrencyPair, blocks uint64) (math.Int, uint64, error)
+	RecordCircuitBreakerTrip(ctx sdk.Context, cp slinkytypes.CurrencyPair, upward bool) (uint64, error)
+	ResetCircuitBreakerTrips(ctx sdk.Context, cp slinkytypes.CurrencyPair) error
+}
+
+// circuitBreaker is a PriceGuard that compares newly aggregated prices against a reference
+// price derived from the stored price and price history, and clamps or rejects prices that
+// move further than the market's maximum allowed change.
+type circuitBreaker struct {
+	keeper  CircuitBreakerKeeper
+	metrics servicemetrics.Metrics
+	logger  log.Logger
+}
+
+// NewCircuitBreakerPriceGuard returns a new circuit breaker PriceGuard. The maximum allowed price
+// change per market, whether prices are clamped or rejected, and the reference price window are
+// read from the x/oracle module's params (and the market's ticker metadata) via the given keeper.
+func NewCircuitBreakerPriceGuard(
+	keeper CircuitBreakerKeeper,
+	metrics servicemetrics.Metrics,
+	logger log.Logger,
+) PriceGuard {
+	return &circuitBreaker{
+		keeper:  keeper,
+		metrics: metrics,
+		logger:  logger,
+	}
+}
+
+// GuardPrice returns the given price if it is within the maximum allowed change of the currency
+// pair's reference price. Otherwise, the circuit breaker trips: an event is emitted, a metric is
+// recorded, and either the clamped price or nil is returned depending on the module's params.
+// Since rejected prices are not written to state, the reference price does not follow a sustained
+// move. So once more than MaxConsecutiveRejections prices moving in the same direction have been
+// rejected, prices continuing the move are accepted until the reference price catches up with them.
+func (cb *circuitBreaker) GuardPrice(ctx sdk.Context, cp slinkytypes.CurrencyPair, price *big.Int) (*big.Int, error) {
+	maxPriceChange, err := cb.keeper.GetMaxPriceChange(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	// the circuit breaker is disabled for this market
+	if maxPriceChange.IsZero() {
+		return price, nil
+	}
+
+	params, err := cb.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, ok, err := cb.referencePrice(ctx, cp, params.PriceChangeWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	// there is nothing to compare the first price for a market against
+	if !ok {
+		return price, nil
+	}
+
+	refDec := math.LegacyNewDecFromBigInt(reference.BigInt())
+	upper := refDec.Mul(math.LegacyOneDec().Add(maxPriceChange)).TruncateInt().BigInt()
+	lower := big.NewInt(0)
+	if maxPriceChange.LT(math.LegacyOneDec()) {
+		lower = refDec.Mul(math.LegacyOneDec().Sub(maxPriceChange)).Ceil().TruncateInt().BigInt()
+	}
+
+	var bound *big.Int
+	switch {
+	case price.Cmp(upper) > 0:
+		bound = upper
+	case price.Cmp(lower) < 0:
+		bound = lower
+	default:
+		return price, cb.keeper.ResetCircuitBreakerTrips(ctx, cp)
+	}
+
+	var guarded *big.Int
+	action := servicemetrics.Rejected
+	if params.ClampPriceChanges {
+		action = servicemetrics.Clamped
+		guarded = bound
+	} else {
+		trips, err := cb.keeper.RecordCircuitBreakerTrip(ctx, cp, bound == upper)
+		if err != nil {
+			return nil, err
+		}
+
+		if params.MaxConsecutiveRejections > 0 && trips > params.MaxConsecutiveRejections {
+			action = servicemetrics.Accepted
+			guarded = price
+		}
+	}
+
+	cb.logger.Info(
+		"circuit breaker tripped",
+		"currency_pair", cp.String(),
+		"price", price.String(),
+		"reference_price", reference.String(),
+		"max_price_change", maxPriceChange.String(),
+		"action", action.String(),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		oracletypes.EventTypeCircuitBreakerTripped,
+		sdk.NewAttribute(oracletypes.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(oracletypes.AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(oracletypes.AttributeKeyReferencePrice, reference.String()),
+		sdk.NewAttribute(oracletypes.AttributeKeyAction, action.String()),
+	))
+	cb.metrics.AddCircuitBreakerTrip(cp, action)
+
+	return guarded, nil
+}
+
+// referencePrice returns the price that newly aggregated prices are compared against. This is the
+// time-weighted average price over the last window blocks if the window is non-zero and price history
+// exists, otherwise the latest stored price. If the currency pair has no stored price, false is returned.
+func (cb *circuitBreaker) referencePrice(ctx sdk.Context, cp slinkytypes.CurrencyPair, window uint64) (math.Int, bool, error) {
+	if window > 0 {
+		twap, _, err := cb.keeper.GetTWAPByBlocks(ctx, cp, window)
+		if err == nil {
+			return twap, true, nil
+		}
+
+		var priceHistoryNotExistError oracletypes.PriceHistoryNotExistError
+		if !errors.As(err, &priceHistoryNotExistError) {
+			return math.Int{}, false, err
+		}
+	}
+
+	qp, err := cb.keeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		var quotePriceNotExistError oracletypes.QuotePriceNotExistError
+		if errors.As(err, &quotePriceNotExistError) {
+			return math.Int{}, false, nil
+		}
+
+		return math.Int{}, false, err
+	}
+
+	return qp.Price, true, nil
+}
@@ -0,0 +1,267 @@
+package aggregator_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/abci/strategies/aggregator"
+	"github.com/1119-Labs/slinky/abci/strategies/aggregator/mocks"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	servicemetrics "github.com/1119-Labs/slinky/service/metrics"
+	metricsmocks "github.com/1119-Labs/slinky/service/metrics/mocks"
+	oraclekeeper "github.com/1119-Labs/slinky/x/oracle/keeper"
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func TestCircuitBreakerPriceGuard(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ctx := testutil.DefaultContext(storetypes.NewKVStoreKey("test"), storetypes.NewTransientStoreKey("transient_test"))
+
+	params := func(clamp bool, window uint64) oracletypes.Params {
+		return oracletypes.NewParams(
+			oracletypes.DefaultVotePowerThreshold, 10, 10, math.LegacyNewDecWithPrec(1, 1), clamp, window, 0, oracletypes.DefaultAggregationStrategy, 2,
+		)
+	}
+	storedPrice := oracletypes.QuotePrice{Price: math.NewInt(1000)}
+
+	cases := []struct {
+		name          string
+		price         *big.Int
+		setup         func(k *mocks.CircuitBreakerKeeper, m *metricsmocks.Metrics)
+		expectedPrice *big.Int
+		expectErr     bool
+		expectTrip    bool
+	}{
+		{
+			name:  "circuit breaker disabled for the market",
+			price: big.NewInt(5000),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyZeroDec(), nil)
+			},
+			expectedPrice: big.NewInt(5000),
+		},
+		{
+			name:  "error getting the max price change",
+			price: big.NewInt(1000),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyDec{}, fmt.Errorf("fail"))
+			},
+			expectErr: true,
+		},
+		{
+			name:  "no stored price to compare against",
+			price: big.NewInt(5000),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(false, 0), nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(oracletypes.QuotePrice{}, oracletypes.NewQuotePriceNotExistError(cp))
+			},
+			expectedPrice: big.NewInt(5000),
+		},
+		{
+			name:  "price within the bound of the stored price",
+			price: big.NewInt(1100),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(false, 0), nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(storedPrice, nil)
+				k.On("ResetCircuitBreakerTrips", mock.Anything, cp).Return(nil).Once()
+			},
+			expectedPrice: big.NewInt(1100),
+		},
+		{
+			name:  "price above the bound is rejected",
+			price: big.NewInt(1101),
+			setup: func(k *mocks.CircuitBreakerKeeper, m *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(false, 0), nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(storedPrice, nil)
+				k.On("RecordCircuitBreakerTrip", mock.Anything, cp, true).Return(uint64(1), nil).Once()
+				m.On("AddCircuitBreakerTrip", cp, servicemetrics.Rejected).Once()
+			},
+			expectedPrice: nil,
+			expectTrip:    true,
+		},
+		{
+			name:  "price below the bound is accepted after too many consecutive rejections",
+			price: big.NewInt(500),
+			setup: func(k *mocks.CircuitBreakerKeeper, m *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(false, 0), nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(storedPrice, nil)
+				k.On("RecordCircuitBreakerTrip", mock.Anything, cp, false).Return(uint64(3), nil).Once()
+				m.On("AddCircuitBreakerTrip", cp, servicemetrics.Accepted).Once()
+			},
+			expectedPrice: big.NewInt(500),
+			expectTrip:    true,
+		},
+		{
+			name:  "error recording the rejection",
+			price: big.NewInt(1101),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(false, 0), nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(storedPrice, nil)
+				k.On("RecordCircuitBreakerTrip", mock.Anything, cp, true).Return(uint64(0), fmt.Errorf("fail")).Once()
+			},
+			expectErr: true,
+		},
+		{
+			name:  "price below the bound is clamped",
+			price: big.NewInt(500),
+			setup: func(k *mocks.CircuitBreakerKeeper, m *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(true, 0), nil)
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(storedPrice, nil)
+				m.On("AddCircuitBreakerTrip", cp, servicemetrics.Clamped).Once()
+			},
+			expectedPrice: big.NewInt(900),
+			expectTrip:    true,
+		},
+		{
+			name:  "price is compared against the TWAP of the price history",
+			price: big.NewInt(1500),
+			setup: func(k *mocks.CircuitBreakerKeeper, m *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(2, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(true, 5), nil)
+				k.On("GetTWAPByBlocks", mock.Anything, cp, uint64(5)).Return(math.NewInt(1000), uint64(3), nil)
+				m.On("AddCircuitBreakerTrip", cp, servicemetrics.Clamped).Once()
+			},
+			expectedPrice: big.NewInt(1200),
+			expectTrip:    true,
+		},
+		{
+			name:  "stored price is used if there is no price history",
+			price: big.NewInt(1050),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(true, 5), nil)
+				k.On("GetTWAPByBlocks", mock.Anything, cp, uint64(5)).Return(math.Int{}, uint64(0), oracletypes.NewPriceHistoryNotExistError(cp))
+				k.On("GetPriceForCurrencyPair", mock.Anything, cp).Return(storedPrice, nil)
+				k.On("ResetCircuitBreakerTrips", mock.Anything, cp).Return(nil).Once()
+			},
+			expectedPrice: big.NewInt(1050),
+		},
+		{
+			name:  "error getting the TWAP",
+			price: big.NewInt(1050),
+			setup: func(k *mocks.CircuitBreakerKeeper, _ *metricsmocks.Metrics) {
+				k.On("GetMaxPriceChange", mock.Anything, cp).Return(math.LegacyNewDecWithPrec(1, 1), nil)
+				k.On("GetParams", mock.Anything).Return(params(true, 5), nil)
+				k.On("GetTWAPByBlocks", mock.Anything, cp, uint64(5)).Return(math.Int{}, uint64(0), fmt.Errorf("fail"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			k := mocks.NewCircuitBreakerKeeper(t)
+			m := metricsmocks.NewMetrics(t)
+			tc.setup(k, m)
+
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			guard := aggregator.NewCircuitBreakerPriceGuard(k, m, log.NewNopLogger())
+
+			price, err := guard.GuardPrice(ctx, cp, tc.price)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPrice, price)
+
+			events := ctx.EventManager().Events()
+			if !tc.expectTrip {
+				require.Empty(t, events)
+				return
+			}
+
+			require.Len(t, events, 1)
+			require.Equal(t, oracletypes.EventTypeCircuitBreakerTripped, events[0].Type)
+			attr, ok := events[0].GetAttribute(oracletypes.AttributeKeyPrice)
+			require.True(t, ok)
+			require.Equal(t, tc.price.String(), attr.Value)
+		})
+	}
+}
+
+func TestCircuitBreakerPriceGuardSustainedPriceChange(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	key := storetypes.NewKVStoreKey(oracletypes.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	k := oraclekeeper.NewKeeper(runtime.NewKVStoreService(key), moduletestutil.MakeTestEncodingConfig().Codec, nil, sdk.AccAddress("authority"))
+	params := oracletypes.NewParams(
+		oracletypes.DefaultVotePowerThreshold, 10, 10, math.LegacyNewDecWithPrec(1, 1), false, 5, 0, oracletypes.DefaultAggregationStrategy, 3,
+	)
+	k.InitGenesis(ctx, oracletypes.GenesisState{Params: params})
+	require.NoError(t, k.CreateCurrencyPair(ctx, cp))
+
+	m := metricsmocks.NewMetrics(t)
+	guard := aggregator.NewCircuitBreakerPriceGuard(&k, m, log.NewNopLogger())
+
+	// guardBlock guards the given price at the given height, and writes the guarded price to state
+	// as the PriceApplier would.
+	guardBlock := func(height int64, price int64) *big.Int {
+		ctx := ctx.WithBlockHeight(height)
+		guarded, err := guard.GuardPrice(ctx, cp, big.NewInt(price))
+		require.NoError(t, err)
+
+		if guarded != nil {
+			require.NoError(t, k.SetPriceForCurrencyPair(ctx, cp, oracletypes.QuotePrice{
+				Price:       math.NewIntFromBigInt(guarded),
+				BlockHeight: uint64(height), //nolint:gosec
+			}))
+		}
+
+		return guarded
+	}
+
+	for h := int64(1); h <= 5; h++ {
+		require.Equal(t, big.NewInt(1000), guardBlock(h, 1000))
+	}
+
+	// a single outlier is rejected, and the next price within the bound restarts the count
+	m.On("AddCircuitBreakerTrip", cp, servicemetrics.Rejected).Times(2)
+	require.Nil(t, guardBlock(6, 2000))
+	require.Equal(t, big.NewInt(1000), guardBlock(7, 1000))
+
+	// a rejection in the other direction restarts the count
+	require.Nil(t, guardBlock(8, 2000))
+	m.On("AddCircuitBreakerTrip", cp, servicemetrics.Rejected).Times(1)
+	require.Nil(t, guardBlock(9, 500))
+
+	// the price steps up, and is rejected until the maximum number of consecutive rejections is exceeded
+	m.On("AddCircuitBreakerTrip", cp, servicemetrics.Rejected).Times(3)
+	for h := int64(10); h <= 12; h++ {
+		require.Nil(t, guardBlock(h, 2000))
+	}
+
+	// prices continuing the move are accepted until the reference price, i.e. the TWAP, catches up
+	m.On("AddCircuitBreakerTrip", cp, servicemetrics.Accepted)
+	for h := int64(13); h <= 20; h++ {
+		require.Equal(t, big.NewInt(2000), guardBlock(h, 2000))
+	}
+
+	twap, _, err := k.GetTWAPByBlocks(ctx.WithBlockHeight(20), cp, 5)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(2000), twap)
+	m.AssertNumberOfCalls(t, "AddCircuitBreakerTrip", 6+4)
+
+	// the market trips again on the next sustained move
+	m.On("AddCircuitBreakerTrip", cp, servicemetrics.Rejected).Once()
+	require.Nil(t, guardBlock(21, 1000))
+}
@@ -13,12 +13,13 @@ import (
 
 // OracleKeeper defines the interface that must be fulfilled by the oracle keeper. This
 // interface is utilized by the PreBlock handler to write oracle data to state for the
-// supported assets.
+// supported assets, and to record each validator's oracle report for the block in state.
 //
 //go:generate mockery --name OracleKeeper --filename mock_oracle_keeper.go
 type OracleKeeper interface { //golint:ignore
 	GetAllCurrencyPairs(ctx sdk.Context) []slinkytypes.CurrencyPair
 	SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp oracletypes.QuotePrice) error
+	RecordValidatorReports(ctx sdk.Context, reports []oracletypes.ValidatorReport) error
 }
 
 // OracleClient defines the interface that must be fulfilled by the slinky client.
@@ -3,9 +3,8 @@
 package mocks
 
 import (
-	mock "github.com/stretchr/testify/mock"
-
 	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+	mock "github.com/stretchr/testify/mock"
 
 	pkgtypes "github.com/1119-Labs/slinky/pkg/types"
 
@@ -37,6 +36,24 @@ func (_m *OracleKeeper) GetAllCurrencyPairs(ctx types.Context) []pkgtypes.Curren
 	return r0
 }
 
+// RecordValidatorReports provides a mock function with given fields: ctx, reports
+func (_m *OracleKeeper) RecordValidatorReports(ctx types.Context, reports []oracletypes.ValidatorReport) error {
+	ret := _m.Called(ctx, reports)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordValidatorReports")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, []oracletypes.ValidatorReport) error); ok {
+		r0 = rf(ctx, reports)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetPriceForCurrencyPair provides a mock function with given fields: ctx, cp, qp
 func (_m *OracleKeeper) SetPriceForCurrencyPair(ctx types.Context, cp pkgtypes.CurrencyPair, qp oracletypes.QuotePrice) error {
 	ret := _m.Called(ctx, cp, qp)
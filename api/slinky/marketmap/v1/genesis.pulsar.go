@@ -13,11 +13,117 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ScheduledMarketUpdate
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMarketUpdate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ScheduledMarketUpdate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ScheduledMarketUpdate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ScheduledMarketUpdate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*MarketRevision
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketRevision)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketRevision)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(MarketRevision)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(MarketRevision)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState              protoreflect.MessageDescriptor
-	fd_GenesisState_market_map   protoreflect.FieldDescriptor
-	fd_GenesisState_last_updated protoreflect.FieldDescriptor
-	fd_GenesisState_params       protoreflect.FieldDescriptor
+	md_GenesisState                                 protoreflect.MessageDescriptor
+	fd_GenesisState_market_map                      protoreflect.FieldDescriptor
+	fd_GenesisState_last_updated                    protoreflect.FieldDescriptor
+	fd_GenesisState_params                          protoreflect.FieldDescriptor
+	fd_GenesisState_scheduled_market_updates        protoreflect.FieldDescriptor
+	fd_GenesisState_next_scheduled_market_update_id protoreflect.FieldDescriptor
+	fd_GenesisState_market_revisions                protoreflect.FieldDescriptor
+	fd_GenesisState_next_market_revision_id         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -26,6 +132,10 @@ func init() {
 	fd_GenesisState_market_map = md_GenesisState.Fields().ByName("market_map")
 	fd_GenesisState_last_updated = md_GenesisState.Fields().ByName("last_updated")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_scheduled_market_updates = md_GenesisState.Fields().ByName("scheduled_market_updates")
+	fd_GenesisState_next_scheduled_market_update_id = md_GenesisState.Fields().ByName("next_scheduled_market_update_id")
+	fd_GenesisState_market_revisions = md_GenesisState.Fields().ByName("market_revisions")
+	fd_GenesisState_next_market_revision_id = md_GenesisState.Fields().ByName("next_market_revision_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -111,6 +221,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.ScheduledMarketUpdates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ScheduledMarketUpdates})
+		if !f(fd_GenesisState_scheduled_market_updates, value) {
+			return
+		}
+	}
+	if x.NextScheduledMarketUpdateId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextScheduledMarketUpdateId)
+		if !f(fd_GenesisState_next_scheduled_market_update_id, value) {
+			return
+		}
+	}
+	if len(x.MarketRevisions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.MarketRevisions})
+		if !f(fd_GenesisState_market_revisions, value) {
+			return
+		}
+	}
+	if x.NextMarketRevisionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextMarketRevisionId)
+		if !f(fd_GenesisState_next_market_revision_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -132,6 +266,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.LastUpdated != uint64(0)
 	case "slinky.marketmap.v1.GenesisState.params":
 		return x.Params != nil
+	case "slinky.marketmap.v1.GenesisState.scheduled_market_updates":
+		return len(x.ScheduledMarketUpdates) != 0
+	case "slinky.marketmap.v1.GenesisState.next_scheduled_market_update_id":
+		return x.NextScheduledMarketUpdateId != uint64(0)
+	case "slinky.marketmap.v1.GenesisState.market_revisions":
+		return len(x.MarketRevisions) != 0
+	case "slinky.marketmap.v1.GenesisState.next_market_revision_id":
+		return x.NextMarketRevisionId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.GenesisState"))
@@ -154,6 +296,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastUpdated = uint64(0)
 	case "slinky.marketmap.v1.GenesisState.params":
 		x.Params = nil
+	case "slinky.marketmap.v1.GenesisState.scheduled_market_updates":
+		x.ScheduledMarketUpdates = nil
+	case "slinky.marketmap.v1.GenesisState.next_scheduled_market_update_id":
+		x.NextScheduledMarketUpdateId = uint64(0)
+	case "slinky.marketmap.v1.GenesisState.market_revisions":
+		x.MarketRevisions = nil
+	case "slinky.marketmap.v1.GenesisState.next_market_revision_id":
+		x.NextMarketRevisionId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.GenesisState"))
@@ -179,6 +329,24 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "slinky.marketmap.v1.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.marketmap.v1.GenesisState.scheduled_market_updates":
+		if len(x.ScheduledMarketUpdates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ScheduledMarketUpdates}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.GenesisState.next_scheduled_market_update_id":
+		value := x.NextScheduledMarketUpdateId
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.GenesisState.market_revisions":
+		if len(x.MarketRevisions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.MarketRevisions}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.GenesisState.next_market_revision_id":
+		value := x.NextMarketRevisionId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.GenesisState"))
@@ -205,6 +373,18 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.LastUpdated = value.Uint()
 	case "slinky.marketmap.v1.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "slinky.marketmap.v1.GenesisState.scheduled_market_updates":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ScheduledMarketUpdates = *clv.list
+	case "slinky.marketmap.v1.GenesisState.next_scheduled_market_update_id":
+		x.NextScheduledMarketUpdateId = value.Uint()
+	case "slinky.marketmap.v1.GenesisState.market_revisions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.MarketRevisions = *clv.list
+	case "slinky.marketmap.v1.GenesisState.next_market_revision_id":
+		x.NextMarketRevisionId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.GenesisState"))
@@ -235,8 +415,24 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "slinky.marketmap.v1.GenesisState.scheduled_market_updates":
+		if x.ScheduledMarketUpdates == nil {
+			x.ScheduledMarketUpdates = []*ScheduledMarketUpdate{}
+		}
+		value := &_GenesisState_4_list{list: &x.ScheduledMarketUpdates}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.GenesisState.market_revisions":
+		if x.MarketRevisions == nil {
+			x.MarketRevisions = []*MarketRevision{}
+		}
+		value := &_GenesisState_6_list{list: &x.MarketRevisions}
+		return protoreflect.ValueOfList(value)
 	case "slinky.marketmap.v1.GenesisState.last_updated":
 		panic(fmt.Errorf("field last_updated of message slinky.marketmap.v1.GenesisState is not mutable"))
+	case "slinky.marketmap.v1.GenesisState.next_scheduled_market_update_id":
+		panic(fmt.Errorf("field next_scheduled_market_update_id of message slinky.marketmap.v1.GenesisState is not mutable"))
+	case "slinky.marketmap.v1.GenesisState.next_market_revision_id":
+		panic(fmt.Errorf("field next_market_revision_id of message slinky.marketmap.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.GenesisState"))
@@ -258,6 +454,16 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "slinky.marketmap.v1.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.marketmap.v1.GenesisState.scheduled_market_updates":
+		list := []*ScheduledMarketUpdate{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "slinky.marketmap.v1.GenesisState.next_scheduled_market_update_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.GenesisState.market_revisions":
+		list := []*MarketRevision{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "slinky.marketmap.v1.GenesisState.next_market_revision_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.GenesisState"))
@@ -338,6 +544,24 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ScheduledMarketUpdates) > 0 {
+			for _, e := range x.ScheduledMarketUpdates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextScheduledMarketUpdateId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextScheduledMarketUpdateId))
+		}
+		if len(x.MarketRevisions) > 0 {
+			for _, e := range x.MarketRevisions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextMarketRevisionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextMarketRevisionId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -367,6 +591,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NextMarketRevisionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextMarketRevisionId))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.MarketRevisions) > 0 {
+			for iNdEx := len(x.MarketRevisions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MarketRevisions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.NextScheduledMarketUpdateId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextScheduledMarketUpdateId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.ScheduledMarketUpdates) > 0 {
+			for iNdEx := len(x.ScheduledMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ScheduledMarketUpdates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -540,6 +806,112 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledMarketUpdates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScheduledMarketUpdates = append(x.ScheduledMarketUpdates, &ScheduledMarketUpdate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScheduledMarketUpdates[len(x.ScheduledMarketUpdates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMarketUpdateId", wireType)
+				}
+				x.NextScheduledMarketUpdateId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextScheduledMarketUpdateId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketRevisions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketRevisions = append(x.MarketRevisions, &MarketRevision{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketRevisions[len(x.MarketRevisions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextMarketRevisionId", wireType)
+				}
+				x.NextMarketRevisionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextMarketRevisionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -603,6 +975,16 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// ScheduledMarketUpdates is the queue of market updates that are pending
+	// activation at a future block height.
+	ScheduledMarketUpdates []*ScheduledMarketUpdate `protobuf:"bytes,4,rep,name=scheduled_market_updates,json=scheduledMarketUpdates,proto3" json:"scheduled_market_updates,omitempty"`
+	// NextScheduledMarketUpdateId is the identifier of the next scheduled market
+	// update.
+	NextScheduledMarketUpdateId uint64 `protobuf:"varint,5,opt,name=next_scheduled_market_update_id,json=nextScheduledMarketUpdateId,proto3" json:"next_scheduled_market_update_id,omitempty"`
+	// MarketRevisions is the history of changes made to the markets.
+	MarketRevisions []*MarketRevision `protobuf:"bytes,6,rep,name=market_revisions,json=marketRevisions,proto3" json:"market_revisions,omitempty"`
+	// NextMarketRevisionId is the identifier of the next market revision.
+	NextMarketRevisionId uint64 `protobuf:"varint,7,opt,name=next_market_revision_id,json=nextMarketRevisionId,proto3" json:"next_market_revision_id,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -646,6 +1028,34 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetScheduledMarketUpdates() []*ScheduledMarketUpdate {
+	if x != nil {
+		return x.ScheduledMarketUpdates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextScheduledMarketUpdateId() uint64 {
+	if x != nil {
+		return x.NextScheduledMarketUpdateId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetMarketRevisions() []*MarketRevision {
+	if x != nil {
+		return x.MarketRevisions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextMarketRevisionId() uint64 {
+	if x != nil {
+		return x.NextMarketRevisionId
+	}
+	return 0
+}
+
 var File_slinky_marketmap_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_slinky_marketmap_v1_genesis_proto_rawDesc = []byte{
@@ -658,7 +1068,7 @@ var file_slinky_marketmap_v1_genesis_proto_rawDesc = []byte{
 	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
 	0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xb1, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x74, 0x6f, 0x22, 0xf0, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
 	0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x6d, 0x61,
 	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
 	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
@@ -669,20 +1079,40 @@ var file_slinky_marketmap_v1_genesis_proto_rawDesc = []byte{
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c,
 	0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76,
 	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
-	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c,
-	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6a, 0x0a, 0x18, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x6e, 0x65, 0x78,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x10, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x35,
+	0x0a, 0x17, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x6e, 0x65, 0x78, 0x74, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x42, 0xc7, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e,
+	0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -699,18 +1129,22 @@ func file_slinky_marketmap_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_slinky_marketmap_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_slinky_marketmap_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: slinky.marketmap.v1.GenesisState
-	(*MarketMap)(nil),    // 1: slinky.marketmap.v1.MarketMap
-	(*Params)(nil),       // 2: slinky.marketmap.v1.Params
+	(*GenesisState)(nil),          // 0: slinky.marketmap.v1.GenesisState
+	(*MarketMap)(nil),             // 1: slinky.marketmap.v1.MarketMap
+	(*Params)(nil),                // 2: slinky.marketmap.v1.Params
+	(*ScheduledMarketUpdate)(nil), // 3: slinky.marketmap.v1.ScheduledMarketUpdate
+	(*MarketRevision)(nil),        // 4: slinky.marketmap.v1.MarketRevision
 }
 var file_slinky_marketmap_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: slinky.marketmap.v1.GenesisState.market_map:type_name -> slinky.marketmap.v1.MarketMap
 	2, // 1: slinky.marketmap.v1.GenesisState.params:type_name -> slinky.marketmap.v1.Params
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: slinky.marketmap.v1.GenesisState.scheduled_market_updates:type_name -> slinky.marketmap.v1.ScheduledMarketUpdate
+	4, // 3: slinky.marketmap.v1.GenesisState.market_revisions:type_name -> slinky.marketmap.v1.MarketRevision
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_slinky_marketmap_v1_genesis_proto_init() }
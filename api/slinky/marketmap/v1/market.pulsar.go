@@ -595,6 +595,7 @@ var (
 	fd_Ticker_min_provider_count protoreflect.FieldDescriptor
 	fd_Ticker_enabled            protoreflect.FieldDescriptor
 	fd_Ticker_metadata_JSON      protoreflect.FieldDescriptor
+	fd_Ticker_state              protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -605,6 +606,7 @@ func init() {
 	fd_Ticker_min_provider_count = md_Ticker.Fields().ByName("min_provider_count")
 	fd_Ticker_enabled = md_Ticker.Fields().ByName("enabled")
 	fd_Ticker_metadata_JSON = md_Ticker.Fields().ByName("metadata_JSON")
+	fd_Ticker_state = md_Ticker.Fields().ByName("state")
 }
 
 var _ protoreflect.Message = (*fastReflection_Ticker)(nil)
@@ -702,6 +704,12 @@ func (x *fastReflection_Ticker) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.State != "" {
+		value := protoreflect.ValueOfString(x.State)
+		if !f(fd_Ticker_state, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -727,6 +735,8 @@ func (x *fastReflection_Ticker) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Enabled != false
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
 		return x.Metadata_JSON != ""
+	case "slinky.marketmap.v1.Ticker.state":
+		return x.State != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Ticker"))
@@ -753,6 +763,8 @@ func (x *fastReflection_Ticker) Clear(fd protoreflect.FieldDescriptor) {
 		x.Enabled = false
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
 		x.Metadata_JSON = ""
+	case "slinky.marketmap.v1.Ticker.state":
+		x.State = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Ticker"))
@@ -784,6 +796,9 @@ func (x *fastReflection_Ticker) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
 		value := x.Metadata_JSON
 		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.Ticker.state":
+		value := x.State
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Ticker"))
@@ -814,6 +829,8 @@ func (x *fastReflection_Ticker) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Enabled = value.Bool()
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
 		x.Metadata_JSON = value.Interface().(string)
+	case "slinky.marketmap.v1.Ticker.state":
+		x.State = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Ticker"))
@@ -847,6 +864,8 @@ func (x *fastReflection_Ticker) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field enabled of message slinky.marketmap.v1.Ticker is not mutable"))
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
 		panic(fmt.Errorf("field metadata_JSON of message slinky.marketmap.v1.Ticker is not mutable"))
+	case "slinky.marketmap.v1.Ticker.state":
+		panic(fmt.Errorf("field state of message slinky.marketmap.v1.Ticker is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Ticker"))
@@ -871,6 +890,8 @@ func (x *fastReflection_Ticker) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "slinky.marketmap.v1.Ticker.metadata_JSON":
 		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.Ticker.state":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Ticker"))
@@ -957,6 +978,10 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.State)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -986,6 +1011,15 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.State) > 0 {
+			i -= len(x.State)
+			copy(dAtA[i:], x.State)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.State)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
 		if len(x.Metadata_JSON) > 0 {
 			i -= len(x.Metadata_JSON)
 			copy(dAtA[i:], x.Metadata_JSON)
@@ -1202,6 +1236,38 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 				}
 				x.Metadata_JSON = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.State = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1237,13 +1303,65 @@ func (x *fastReflection_Ticker) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_ProviderConfig_5_list)(nil)
+
+type _ProviderConfig_5_list struct {
+	list *[]*ConversionPair
+}
+
+func (x *_ProviderConfig_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ProviderConfig_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConversionPair)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ProviderConfig_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ConversionPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ProviderConfig_5_list) AppendMutable() protoreflect.Value {
+	v := new(ConversionPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ProviderConfig_5_list) NewElement() protoreflect.Value {
+	v := new(ConversionPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ProviderConfig_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ProviderConfig                   protoreflect.MessageDescriptor
-	fd_ProviderConfig_name              protoreflect.FieldDescriptor
-	fd_ProviderConfig_off_chain_ticker  protoreflect.FieldDescriptor
-	fd_ProviderConfig_normalize_by_pair protoreflect.FieldDescriptor
-	fd_ProviderConfig_invert            protoreflect.FieldDescriptor
-	fd_ProviderConfig_metadata_JSON     protoreflect.FieldDescriptor
+	md_ProviderConfig                    protoreflect.MessageDescriptor
+	fd_ProviderConfig_name               protoreflect.FieldDescriptor
+	fd_ProviderConfig_off_chain_ticker   protoreflect.FieldDescriptor
+	fd_ProviderConfig_normalize_by_pair  protoreflect.FieldDescriptor
+	fd_ProviderConfig_invert             protoreflect.FieldDescriptor
+	fd_ProviderConfig_normalize_by_pairs protoreflect.FieldDescriptor
+	fd_ProviderConfig_metadata_JSON      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1253,6 +1371,7 @@ func init() {
 	fd_ProviderConfig_off_chain_ticker = md_ProviderConfig.Fields().ByName("off_chain_ticker")
 	fd_ProviderConfig_normalize_by_pair = md_ProviderConfig.Fields().ByName("normalize_by_pair")
 	fd_ProviderConfig_invert = md_ProviderConfig.Fields().ByName("invert")
+	fd_ProviderConfig_normalize_by_pairs = md_ProviderConfig.Fields().ByName("normalize_by_pairs")
 	fd_ProviderConfig_metadata_JSON = md_ProviderConfig.Fields().ByName("metadata_JSON")
 }
 
@@ -1345,6 +1464,12 @@ func (x *fastReflection_ProviderConfig) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.NormalizeByPairs) != 0 {
+		value := protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &x.NormalizeByPairs})
+		if !f(fd_ProviderConfig_normalize_by_pairs, value) {
+			return
+		}
+	}
 	if x.Metadata_JSON != "" {
 		value := protoreflect.ValueOfString(x.Metadata_JSON)
 		if !f(fd_ProviderConfig_metadata_JSON, value) {
@@ -1374,6 +1499,8 @@ func (x *fastReflection_ProviderConfig) Has(fd protoreflect.FieldDescriptor) boo
 		return x.NormalizeByPair != nil
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		return x.Invert != false
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pairs":
+		return len(x.NormalizeByPairs) != 0
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		return x.Metadata_JSON != ""
 	default:
@@ -1400,6 +1527,8 @@ func (x *fastReflection_ProviderConfig) Clear(fd protoreflect.FieldDescriptor) {
 		x.NormalizeByPair = nil
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		x.Invert = false
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pairs":
+		x.NormalizeByPairs = nil
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		x.Metadata_JSON = ""
 	default:
@@ -1430,6 +1559,12 @@ func (x *fastReflection_ProviderConfig) Get(descriptor protoreflect.FieldDescrip
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		value := x.Invert
 		return protoreflect.ValueOfBool(value)
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pairs":
+		if len(x.NormalizeByPairs) == 0 {
+			return protoreflect.ValueOfList(&_ProviderConfig_5_list{})
+		}
+		listValue := &_ProviderConfig_5_list{list: &x.NormalizeByPairs}
+		return protoreflect.ValueOfList(listValue)
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		value := x.Metadata_JSON
 		return protoreflect.ValueOfString(value)
@@ -1461,6 +1596,10 @@ func (x *fastReflection_ProviderConfig) Set(fd protoreflect.FieldDescriptor, val
 		x.NormalizeByPair = value.Message().Interface().(*v1.CurrencyPair)
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		x.Invert = value.Bool()
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pairs":
+		lv := value.List()
+		clv := lv.(*_ProviderConfig_5_list)
+		x.NormalizeByPairs = *clv.list
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		x.Metadata_JSON = value.Interface().(string)
 	default:
@@ -1488,6 +1627,12 @@ func (x *fastReflection_ProviderConfig) Mutable(fd protoreflect.FieldDescriptor)
 			x.NormalizeByPair = new(v1.CurrencyPair)
 		}
 		return protoreflect.ValueOfMessage(x.NormalizeByPair.ProtoReflect())
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pairs":
+		if x.NormalizeByPairs == nil {
+			x.NormalizeByPairs = []*ConversionPair{}
+		}
+		value := &_ProviderConfig_5_list{list: &x.NormalizeByPairs}
+		return protoreflect.ValueOfList(value)
 	case "slinky.marketmap.v1.ProviderConfig.name":
 		panic(fmt.Errorf("field name of message slinky.marketmap.v1.ProviderConfig is not mutable"))
 	case "slinky.marketmap.v1.ProviderConfig.off_chain_ticker":
@@ -1518,6 +1663,9 @@ func (x *fastReflection_ProviderConfig) NewField(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "slinky.marketmap.v1.ProviderConfig.invert":
 		return protoreflect.ValueOfBool(false)
+	case "slinky.marketmap.v1.ProviderConfig.normalize_by_pairs":
+		list := []*ConversionPair{}
+		return protoreflect.ValueOfList(&_ProviderConfig_5_list{list: &list})
 	case "slinky.marketmap.v1.ProviderConfig.metadata_JSON":
 		return protoreflect.ValueOfString("")
 	default:
@@ -1604,6 +1752,12 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 		if x.Invert {
 			n += 2
 		}
+		if len(x.NormalizeByPairs) > 0 {
+			for _, e := range x.NormalizeByPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		l = len(x.Metadata_JSON)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -1644,6 +1798,22 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 			i--
 			dAtA[i] = 0x7a
 		}
+		if len(x.NormalizeByPairs) > 0 {
+			for iNdEx := len(x.NormalizeByPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.NormalizeByPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if x.Invert {
 			i--
 			if x.Invert {
@@ -1851,6 +2021,40 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Invert = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NormalizeByPairs = append(x.NormalizeByPairs, &ConversionPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NormalizeByPairs[len(x.NormalizeByPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 15:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -1918,116 +2122,28 @@ func (x *fastReflection_ProviderConfig) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.Map = (*_MarketMap_1_map)(nil)
-
-type _MarketMap_1_map struct {
-	m *map[string]*Market
-}
-
-func (x *_MarketMap_1_map) Len() int {
-	if x.m == nil {
-		return 0
-	}
-	return len(*x.m)
-}
-
-func (x *_MarketMap_1_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
-	if x.m == nil {
-		return
-	}
-	for k, v := range *x.m {
-		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
-		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
-		if !f(mapKey, mapValue) {
-			break
-		}
-	}
-}
-
-func (x *_MarketMap_1_map) Has(key protoreflect.MapKey) bool {
-	if x.m == nil {
-		return false
-	}
-	keyUnwrapped := key.String()
-	concreteValue := keyUnwrapped
-	_, ok := (*x.m)[concreteValue]
-	return ok
-}
-
-func (x *_MarketMap_1_map) Clear(key protoreflect.MapKey) {
-	if x.m == nil {
-		return
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	delete(*x.m, concreteKey)
-}
-
-func (x *_MarketMap_1_map) Get(key protoreflect.MapKey) protoreflect.Value {
-	if x.m == nil {
-		return protoreflect.Value{}
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if !ok {
-		return protoreflect.Value{}
-	}
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MarketMap_1_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
-	if !key.IsValid() || !value.IsValid() {
-		panic("invalid key or value provided")
-	}
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Market)
-	(*x.m)[concreteKey] = concreteValue
-}
-
-func (x *_MarketMap_1_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
-	keyUnwrapped := key.String()
-	concreteKey := keyUnwrapped
-	v, ok := (*x.m)[concreteKey]
-	if ok {
-		return protoreflect.ValueOfMessage(v.ProtoReflect())
-	}
-	newValue := new(Market)
-	(*x.m)[concreteKey] = newValue
-	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
-}
-
-func (x *_MarketMap_1_map) NewValue() protoreflect.Value {
-	v := new(Market)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MarketMap_1_map) IsValid() bool {
-	return x.m != nil
-}
-
 var (
-	md_MarketMap         protoreflect.MessageDescriptor
-	fd_MarketMap_markets protoreflect.FieldDescriptor
+	md_ConversionPair        protoreflect.MessageDescriptor
+	fd_ConversionPair_pair   protoreflect.FieldDescriptor
+	fd_ConversionPair_invert protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_marketmap_v1_market_proto_init()
-	md_MarketMap = File_slinky_marketmap_v1_market_proto.Messages().ByName("MarketMap")
-	fd_MarketMap_markets = md_MarketMap.Fields().ByName("markets")
+	md_ConversionPair = File_slinky_marketmap_v1_market_proto.Messages().ByName("ConversionPair")
+	fd_ConversionPair_pair = md_ConversionPair.Fields().ByName("pair")
+	fd_ConversionPair_invert = md_ConversionPair.Fields().ByName("invert")
 }
 
-var _ protoreflect.Message = (*fastReflection_MarketMap)(nil)
+var _ protoreflect.Message = (*fastReflection_ConversionPair)(nil)
 
-type fastReflection_MarketMap MarketMap
+type fastReflection_ConversionPair ConversionPair
 
-func (x *MarketMap) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MarketMap)(x)
+func (x *ConversionPair) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ConversionPair)(x)
 }
 
-func (x *MarketMap) slowProtoReflect() protoreflect.Message {
+func (x *ConversionPair) slowProtoReflect() protoreflect.Message {
 	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2039,43 +2155,43 @@ func (x *MarketMap) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MarketMap_messageType fastReflection_MarketMap_messageType
-var _ protoreflect.MessageType = fastReflection_MarketMap_messageType{}
+var _fastReflection_ConversionPair_messageType fastReflection_ConversionPair_messageType
+var _ protoreflect.MessageType = fastReflection_ConversionPair_messageType{}
 
-type fastReflection_MarketMap_messageType struct{}
+type fastReflection_ConversionPair_messageType struct{}
 
-func (x fastReflection_MarketMap_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MarketMap)(nil)
+func (x fastReflection_ConversionPair_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ConversionPair)(nil)
 }
-func (x fastReflection_MarketMap_messageType) New() protoreflect.Message {
-	return new(fastReflection_MarketMap)
+func (x fastReflection_ConversionPair_messageType) New() protoreflect.Message {
+	return new(fastReflection_ConversionPair)
 }
-func (x fastReflection_MarketMap_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketMap
+func (x fastReflection_ConversionPair_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConversionPair
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MarketMap) Descriptor() protoreflect.MessageDescriptor {
-	return md_MarketMap
+func (x *fastReflection_ConversionPair) Descriptor() protoreflect.MessageDescriptor {
+	return md_ConversionPair
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MarketMap) Type() protoreflect.MessageType {
-	return _fastReflection_MarketMap_messageType
+func (x *fastReflection_ConversionPair) Type() protoreflect.MessageType {
+	return _fastReflection_ConversionPair_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MarketMap) New() protoreflect.Message {
-	return new(fastReflection_MarketMap)
+func (x *fastReflection_ConversionPair) New() protoreflect.Message {
+	return new(fastReflection_ConversionPair)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MarketMap) Interface() protoreflect.ProtoMessage {
-	return (*MarketMap)(x)
+func (x *fastReflection_ConversionPair) Interface() protoreflect.ProtoMessage {
+	return (*ConversionPair)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2083,10 +2199,16 @@ func (x *fastReflection_MarketMap) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MarketMap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Markets) != 0 {
-		value := protoreflect.ValueOfMap(&_MarketMap_1_map{m: &x.Markets})
-		if !f(fd_MarketMap_markets, value) {
+func (x *fastReflection_ConversionPair) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pair != nil {
+		value := protoreflect.ValueOfMessage(x.Pair.ProtoReflect())
+		if !f(fd_ConversionPair_pair, value) {
+			return
+		}
+	}
+	if x.Invert != false {
+		value := protoreflect.ValueOfBool(x.Invert)
+		if !f(fd_ConversionPair_invert, value) {
 			return
 		}
 	}
@@ -2103,15 +2225,17 @@ func (x *fastReflection_MarketMap) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MarketMap) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ConversionPair) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.MarketMap.markets":
-		return len(x.Markets) != 0
+	case "slinky.marketmap.v1.ConversionPair.pair":
+		return x.Pair != nil
+	case "slinky.marketmap.v1.ConversionPair.invert":
+		return x.Invert != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ConversionPair"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.ConversionPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2121,15 +2245,17 @@ func (x *fastReflection_MarketMap) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMap) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ConversionPair) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.MarketMap.markets":
-		x.Markets = nil
+	case "slinky.marketmap.v1.ConversionPair.pair":
+		x.Pair = nil
+	case "slinky.marketmap.v1.ConversionPair.invert":
+		x.Invert = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ConversionPair"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.ConversionPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2139,19 +2265,19 @@ func (x *fastReflection_MarketMap) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MarketMap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConversionPair) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.marketmap.v1.MarketMap.markets":
-		if len(x.Markets) == 0 {
-			return protoreflect.ValueOfMap(&_MarketMap_1_map{})
-		}
-		mapValue := &_MarketMap_1_map{m: &x.Markets}
-		return protoreflect.ValueOfMap(mapValue)
+	case "slinky.marketmap.v1.ConversionPair.pair":
+		value := x.Pair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.marketmap.v1.ConversionPair.invert":
+		value := x.Invert
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ConversionPair"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.ConversionPair does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2165,17 +2291,17 @@ func (x *fastReflection_MarketMap) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ConversionPair) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.MarketMap.markets":
-		mv := value.Map()
-		cmv := mv.(*_MarketMap_1_map)
-		x.Markets = *cmv.m
+	case "slinky.marketmap.v1.ConversionPair.pair":
+		x.Pair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.marketmap.v1.ConversionPair.invert":
+		x.Invert = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ConversionPair"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.ConversionPair does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2189,45 +2315,48 @@ func (x *fastReflection_MarketMap) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConversionPair) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.MarketMap.markets":
-		if x.Markets == nil {
-			x.Markets = make(map[string]*Market)
+	case "slinky.marketmap.v1.ConversionPair.pair":
+		if x.Pair == nil {
+			x.Pair = new(v1.CurrencyPair)
 		}
-		value := &_MarketMap_1_map{m: &x.Markets}
-		return protoreflect.ValueOfMap(value)
+		return protoreflect.ValueOfMessage(x.Pair.ProtoReflect())
+	case "slinky.marketmap.v1.ConversionPair.invert":
+		panic(fmt.Errorf("field invert of message slinky.marketmap.v1.ConversionPair is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ConversionPair"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.ConversionPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MarketMap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ConversionPair) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.marketmap.v1.MarketMap.markets":
-		m := make(map[string]*Market)
-		return protoreflect.ValueOfMap(&_MarketMap_1_map{m: &m})
+	case "slinky.marketmap.v1.ConversionPair.pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.marketmap.v1.ConversionPair.invert":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ConversionPair"))
 		}
-		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.marketmap.v1.ConversionPair does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MarketMap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ConversionPair) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.MarketMap", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.ConversionPair", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2235,7 +2364,7 @@ func (x *fastReflection_MarketMap) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MarketMap) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ConversionPair) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2246,7 +2375,7 @@ func (x *fastReflection_MarketMap) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MarketMap) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ConversionPair) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2258,7 +2387,7 @@ func (x *fastReflection_MarketMap) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MarketMap) IsValid() bool {
+func (x *fastReflection_ConversionPair) IsValid() bool {
 	return x != nil
 }
 
@@ -2268,9 +2397,9 @@ func (x *fastReflection_MarketMap) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ConversionPair) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MarketMap)
+		x := input.Message.Interface().(*ConversionPair)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2282,31 +2411,12 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Markets) > 0 {
-			SiZeMaP := func(k string, v *Market) {
-				l := 0
-				if v != nil {
-					l = options.Size(v)
-				}
-				l += 1 + runtime.Sov(uint64(l))
-				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
-				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
-			}
-			if options.Deterministic {
-				sortme := make([]string, 0, len(x.Markets))
-				for k := range x.Markets {
-					sortme = append(sortme, k)
-				}
-				sort.Strings(sortme)
-				for _, k := range sortme {
-					v := x.Markets[k]
-					SiZeMaP(k, v)
-				}
-			} else {
-				for k, v := range x.Markets {
-					SiZeMaP(k, v)
-				}
-			}
+		if x.Pair != nil {
+			l = options.Size(x.Pair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Invert {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2318,7 +2428,7 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MarketMap)
+		x := input.Message.Interface().(*ConversionPair)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2337,55 +2447,29 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Markets) > 0 {
-			MaRsHaLmAp := func(k string, v *Market) (protoiface.MarshalOutput, error) {
-				baseI := i
-				encoded, err := options.Marshal(v)
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-				i -= len(k)
-				copy(dAtA[i:], k)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
-				i--
-				dAtA[i] = 0xa
-				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
-				i--
-				dAtA[i] = 0xa
-				return protoiface.MarshalOutput{}, nil
-			}
-			if options.Deterministic {
-				keysForMarkets := make([]string, 0, len(x.Markets))
-				for k := range x.Markets {
-					keysForMarkets = append(keysForMarkets, string(k))
-				}
-				sort.Slice(keysForMarkets, func(i, j int) bool {
-					return keysForMarkets[i] < keysForMarkets[j]
-				})
-				for iNdEx := len(keysForMarkets) - 1; iNdEx >= 0; iNdEx-- {
-					v := x.Markets[string(keysForMarkets[iNdEx])]
-					out, err := MaRsHaLmAp(keysForMarkets[iNdEx], v)
-					if err != nil {
-						return out, err
-					}
-				}
+		if x.Invert {
+			i--
+			if x.Invert {
+				dAtA[i] = 1
 			} else {
-				for k := range x.Markets {
-					v := x.Markets[k]
-					out, err := MaRsHaLmAp(k, v)
-					if err != nil {
-						return out, err
-					}
-				}
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Pair != nil {
+			encoded, err := options.Marshal(x.Pair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2398,7 +2482,7 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MarketMap)
+		x := input.Message.Interface().(*ConversionPair)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2430,15 +2514,15 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMap: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConversionPair: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMap: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ConversionPair: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2465,105 +2549,2170 @@ func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Markets == nil {
-					x.Markets = make(map[string]*Market)
+				if x.Pair == nil {
+					x.Pair = &v1.CurrencyPair{}
 				}
-				var mapkey string
-				var mapvalue *Market
-				for iNdEx < postIndex {
-					entryPreIndex := iNdEx
-					var wire uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						wire |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					fieldNum := int32(wire >> 3)
-					if fieldNum == 1 {
-						var stringLenmapkey uint64
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							stringLenmapkey |= uint64(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						intStringLenmapkey := int(stringLenmapkey)
-						if intStringLenmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postStringIndexmapkey := iNdEx + intStringLenmapkey
-						if postStringIndexmapkey < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postStringIndexmapkey > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-						iNdEx = postStringIndexmapkey
-					} else if fieldNum == 2 {
-						var mapmsglen int
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							mapmsglen |= int(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						if mapmsglen < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						postmsgIndex := iNdEx + mapmsglen
-						if postmsgIndex < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if postmsgIndex > l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						mapvalue = &Market{}
-						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						iNdEx = postmsgIndex
-					} else {
-						iNdEx = entryPreIndex
-						skippy, err := runtime.Skip(dAtA[iNdEx:])
-						if err != nil {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-						}
-						if (skippy < 0) || (iNdEx+skippy) < 0 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-						}
-						if (iNdEx + skippy) > postIndex {
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Invert = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.Map = (*_MarketMap_1_map)(nil)
+
+type _MarketMap_1_map struct {
+	m *map[string]*Market
+}
+
+func (x *_MarketMap_1_map) Len() int {
+	if x.m == nil {
+		return 0
+	}
+	return len(*x.m)
+}
+
+func (x *_MarketMap_1_map) Range(f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if x.m == nil {
+		return
+	}
+	for k, v := range *x.m {
+		mapKey := (protoreflect.MapKey)(protoreflect.ValueOfString(k))
+		mapValue := protoreflect.ValueOfMessage(v.ProtoReflect())
+		if !f(mapKey, mapValue) {
+			break
+		}
+	}
+}
+
+func (x *_MarketMap_1_map) Has(key protoreflect.MapKey) bool {
+	if x.m == nil {
+		return false
+	}
+	keyUnwrapped := key.String()
+	concreteValue := keyUnwrapped
+	_, ok := (*x.m)[concreteValue]
+	return ok
+}
+
+func (x *_MarketMap_1_map) Clear(key protoreflect.MapKey) {
+	if x.m == nil {
+		return
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	delete(*x.m, concreteKey)
+}
+
+func (x *_MarketMap_1_map) Get(key protoreflect.MapKey) protoreflect.Value {
+	if x.m == nil {
+		return protoreflect.Value{}
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketMap_1_map) Set(key protoreflect.MapKey, value protoreflect.Value) {
+	if !key.IsValid() || !value.IsValid() {
+		panic("invalid key or value provided")
+	}
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.m)[concreteKey] = concreteValue
+}
+
+func (x *_MarketMap_1_map) Mutable(key protoreflect.MapKey) protoreflect.Value {
+	keyUnwrapped := key.String()
+	concreteKey := keyUnwrapped
+	v, ok := (*x.m)[concreteKey]
+	if ok {
+		return protoreflect.ValueOfMessage(v.ProtoReflect())
+	}
+	newValue := new(Market)
+	(*x.m)[concreteKey] = newValue
+	return protoreflect.ValueOfMessage(newValue.ProtoReflect())
+}
+
+func (x *_MarketMap_1_map) NewValue() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MarketMap_1_map) IsValid() bool {
+	return x.m != nil
+}
+
+var (
+	md_MarketMap         protoreflect.MessageDescriptor
+	fd_MarketMap_markets protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_marketmap_v1_market_proto_init()
+	md_MarketMap = File_slinky_marketmap_v1_market_proto.Messages().ByName("MarketMap")
+	fd_MarketMap_markets = md_MarketMap.Fields().ByName("markets")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketMap)(nil)
+
+type fastReflection_MarketMap MarketMap
+
+func (x *MarketMap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketMap)(x)
+}
+
+func (x *MarketMap) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketMap_messageType fastReflection_MarketMap_messageType
+var _ protoreflect.MessageType = fastReflection_MarketMap_messageType{}
+
+type fastReflection_MarketMap_messageType struct{}
+
+func (x fastReflection_MarketMap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketMap)(nil)
+}
+func (x fastReflection_MarketMap_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketMap)
+}
+func (x fastReflection_MarketMap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMap
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketMap) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketMap
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketMap) Type() protoreflect.MessageType {
+	return _fastReflection_MarketMap_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketMap) New() protoreflect.Message {
+	return new(fastReflection_MarketMap)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketMap) Interface() protoreflect.ProtoMessage {
+	return (*MarketMap)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketMap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Markets) != 0 {
+		value := protoreflect.ValueOfMap(&_MarketMap_1_map{m: &x.Markets})
+		if !f(fd_MarketMap_markets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketMap) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketMap.markets":
+		return len(x.Markets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMap) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketMap.markets":
+		x.Markets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketMap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.MarketMap.markets":
+		if len(x.Markets) == 0 {
+			return protoreflect.ValueOfMap(&_MarketMap_1_map{})
+		}
+		mapValue := &_MarketMap_1_map{m: &x.Markets}
+		return protoreflect.ValueOfMap(mapValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketMap.markets":
+		mv := value.Map()
+		cmv := mv.(*_MarketMap_1_map)
+		x.Markets = *cmv.m
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketMap.markets":
+		if x.Markets == nil {
+			x.Markets = make(map[string]*Market)
+		}
+		value := &_MarketMap_1_map{m: &x.Markets}
+		return protoreflect.ValueOfMap(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketMap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketMap.markets":
+		m := make(map[string]*Market)
+		return protoreflect.ValueOfMap(&_MarketMap_1_map{m: &m})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketMap"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketMap does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketMap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.MarketMap", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketMap) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketMap) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketMap) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketMap) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketMap)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Markets) > 0 {
+			SiZeMaP := func(k string, v *Market) {
+				l := 0
+				if v != nil {
+					l = options.Size(v)
+				}
+				l += 1 + runtime.Sov(uint64(l))
+				mapEntrySize := 1 + len(k) + runtime.Sov(uint64(len(k))) + l
+				n += mapEntrySize + 1 + runtime.Sov(uint64(mapEntrySize))
+			}
+			if options.Deterministic {
+				sortme := make([]string, 0, len(x.Markets))
+				for k := range x.Markets {
+					sortme = append(sortme, k)
+				}
+				sort.Strings(sortme)
+				for _, k := range sortme {
+					v := x.Markets[k]
+					SiZeMaP(k, v)
+				}
+			} else {
+				for k, v := range x.Markets {
+					SiZeMaP(k, v)
+				}
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMap)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Markets) > 0 {
+			MaRsHaLmAp := func(k string, v *Market) (protoiface.MarshalOutput, error) {
+				baseI := i
+				encoded, err := options.Marshal(v)
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+				i -= len(k)
+				copy(dAtA[i:], k)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(k)))
+				i--
+				dAtA[i] = 0xa
+				i = runtime.EncodeVarint(dAtA, i, uint64(baseI-i))
+				i--
+				dAtA[i] = 0xa
+				return protoiface.MarshalOutput{}, nil
+			}
+			if options.Deterministic {
+				keysForMarkets := make([]string, 0, len(x.Markets))
+				for k := range x.Markets {
+					keysForMarkets = append(keysForMarkets, string(k))
+				}
+				sort.Slice(keysForMarkets, func(i, j int) bool {
+					return keysForMarkets[i] < keysForMarkets[j]
+				})
+				for iNdEx := len(keysForMarkets) - 1; iNdEx >= 0; iNdEx-- {
+					v := x.Markets[string(keysForMarkets[iNdEx])]
+					out, err := MaRsHaLmAp(keysForMarkets[iNdEx], v)
+					if err != nil {
+						return out, err
+					}
+				}
+			} else {
+				for k := range x.Markets {
+					v := x.Markets[k]
+					out, err := MaRsHaLmAp(k, v)
+					if err != nil {
+						return out, err
+					}
+				}
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketMap)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMap: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Markets == nil {
+					x.Markets = make(map[string]*Market)
+				}
+				var mapkey string
+				var mapvalue *Market
+				for iNdEx < postIndex {
+					entryPreIndex := iNdEx
+					var wire uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						wire |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					fieldNum := int32(wire >> 3)
+					if fieldNum == 1 {
+						var stringLenmapkey uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							stringLenmapkey |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						intStringLenmapkey := int(stringLenmapkey)
+						if intStringLenmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postStringIndexmapkey := iNdEx + intStringLenmapkey
+						if postStringIndexmapkey < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postStringIndexmapkey > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+						iNdEx = postStringIndexmapkey
+					} else if fieldNum == 2 {
+						var mapmsglen int
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							mapmsglen |= int(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						if mapmsglen < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						postmsgIndex := iNdEx + mapmsglen
+						if postmsgIndex < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if postmsgIndex > l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						mapvalue = &Market{}
+						if err := options.Unmarshal(dAtA[iNdEx:postmsgIndex], mapvalue); err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						iNdEx = postmsgIndex
+					} else {
+						iNdEx = entryPreIndex
+						skippy, err := runtime.Skip(dAtA[iNdEx:])
+						if err != nil {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+						}
+						if (skippy < 0) || (iNdEx+skippy) < 0 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+						}
+						if (iNdEx + skippy) > postIndex {
 							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 						}
 						iNdEx += skippy
 					}
 				}
-				x.Markets[mapkey] = mapvalue
+				x.Markets[mapkey] = mapvalue
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ScheduledMarketUpdate_4_list)(nil)
+
+type _ScheduledMarketUpdate_4_list struct {
+	list *[]*Market
+}
+
+func (x *_ScheduledMarketUpdate_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScheduledMarketUpdate_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScheduledMarketUpdate_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScheduledMarketUpdate_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Market)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScheduledMarketUpdate_4_list) AppendMutable() protoreflect.Value {
+	v := new(Market)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledMarketUpdate_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScheduledMarketUpdate_4_list) NewElement() protoreflect.Value {
+	v := new(Market)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScheduledMarketUpdate_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScheduledMarketUpdate           protoreflect.MessageDescriptor
+	fd_ScheduledMarketUpdate_id        protoreflect.FieldDescriptor
+	fd_ScheduledMarketUpdate_authority protoreflect.FieldDescriptor
+	fd_ScheduledMarketUpdate_height    protoreflect.FieldDescriptor
+	fd_ScheduledMarketUpdate_markets   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_marketmap_v1_market_proto_init()
+	md_ScheduledMarketUpdate = File_slinky_marketmap_v1_market_proto.Messages().ByName("ScheduledMarketUpdate")
+	fd_ScheduledMarketUpdate_id = md_ScheduledMarketUpdate.Fields().ByName("id")
+	fd_ScheduledMarketUpdate_authority = md_ScheduledMarketUpdate.Fields().ByName("authority")
+	fd_ScheduledMarketUpdate_height = md_ScheduledMarketUpdate.Fields().ByName("height")
+	fd_ScheduledMarketUpdate_markets = md_ScheduledMarketUpdate.Fields().ByName("markets")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScheduledMarketUpdate)(nil)
+
+type fastReflection_ScheduledMarketUpdate ScheduledMarketUpdate
+
+func (x *ScheduledMarketUpdate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScheduledMarketUpdate)(x)
+}
+
+func (x *ScheduledMarketUpdate) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScheduledMarketUpdate_messageType fastReflection_ScheduledMarketUpdate_messageType
+var _ protoreflect.MessageType = fastReflection_ScheduledMarketUpdate_messageType{}
+
+type fastReflection_ScheduledMarketUpdate_messageType struct{}
+
+func (x fastReflection_ScheduledMarketUpdate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScheduledMarketUpdate)(nil)
+}
+func (x fastReflection_ScheduledMarketUpdate_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMarketUpdate)
+}
+func (x fastReflection_ScheduledMarketUpdate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMarketUpdate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScheduledMarketUpdate) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScheduledMarketUpdate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScheduledMarketUpdate) Type() protoreflect.MessageType {
+	return _fastReflection_ScheduledMarketUpdate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScheduledMarketUpdate) New() protoreflect.Message {
+	return new(fastReflection_ScheduledMarketUpdate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScheduledMarketUpdate) Interface() protoreflect.ProtoMessage {
+	return (*ScheduledMarketUpdate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScheduledMarketUpdate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_ScheduledMarketUpdate_id, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_ScheduledMarketUpdate_authority, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_ScheduledMarketUpdate_height, value) {
+			return
+		}
+	}
+	if len(x.Markets) != 0 {
+		value := protoreflect.ValueOfList(&_ScheduledMarketUpdate_4_list{list: &x.Markets})
+		if !f(fd_ScheduledMarketUpdate_markets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScheduledMarketUpdate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.id":
+		return x.Id != uint64(0)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.authority":
+		return x.Authority != ""
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.height":
+		return x.Height != uint64(0)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.markets":
+		return len(x.Markets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledMarketUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledMarketUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketUpdate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.id":
+		x.Id = uint64(0)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.authority":
+		x.Authority = ""
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.height":
+		x.Height = uint64(0)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.markets":
+		x.Markets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledMarketUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledMarketUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScheduledMarketUpdate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.markets":
+		if len(x.Markets) == 0 {
+			return protoreflect.ValueOfList(&_ScheduledMarketUpdate_4_list{})
+		}
+		listValue := &_ScheduledMarketUpdate_4_list{list: &x.Markets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledMarketUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledMarketUpdate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketUpdate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.id":
+		x.Id = value.Uint()
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.authority":
+		x.Authority = value.Interface().(string)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.height":
+		x.Height = value.Uint()
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.markets":
+		lv := value.List()
+		clv := lv.(*_ScheduledMarketUpdate_4_list)
+		x.Markets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledMarketUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledMarketUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketUpdate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.markets":
+		if x.Markets == nil {
+			x.Markets = []*Market{}
+		}
+		value := &_ScheduledMarketUpdate_4_list{list: &x.Markets}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.id":
+		panic(fmt.Errorf("field id of message slinky.marketmap.v1.ScheduledMarketUpdate is not mutable"))
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.authority":
+		panic(fmt.Errorf("field authority of message slinky.marketmap.v1.ScheduledMarketUpdate is not mutable"))
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.height":
+		panic(fmt.Errorf("field height of message slinky.marketmap.v1.ScheduledMarketUpdate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledMarketUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledMarketUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScheduledMarketUpdate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.authority":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.ScheduledMarketUpdate.markets":
+		list := []*Market{}
+		return protoreflect.ValueOfList(&_ScheduledMarketUpdate_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.ScheduledMarketUpdate"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.ScheduledMarketUpdate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScheduledMarketUpdate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.ScheduledMarketUpdate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScheduledMarketUpdate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScheduledMarketUpdate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScheduledMarketUpdate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScheduledMarketUpdate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScheduledMarketUpdate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if len(x.Markets) > 0 {
+			for _, e := range x.Markets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledMarketUpdate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Markets) > 0 {
+			for iNdEx := len(x.Markets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Markets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScheduledMarketUpdate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMarketUpdate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScheduledMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Markets = append(x.Markets, &Market{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Markets[len(x.Markets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MarketRevision          protoreflect.MessageDescriptor
+	fd_MarketRevision_id       protoreflect.FieldDescriptor
+	fd_MarketRevision_ticker   protoreflect.FieldDescriptor
+	fd_MarketRevision_height   protoreflect.FieldDescriptor
+	fd_MarketRevision_signer   protoreflect.FieldDescriptor
+	fd_MarketRevision_action   protoreflect.FieldDescriptor
+	fd_MarketRevision_previous protoreflect.FieldDescriptor
+	fd_MarketRevision_market   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_marketmap_v1_market_proto_init()
+	md_MarketRevision = File_slinky_marketmap_v1_market_proto.Messages().ByName("MarketRevision")
+	fd_MarketRevision_id = md_MarketRevision.Fields().ByName("id")
+	fd_MarketRevision_ticker = md_MarketRevision.Fields().ByName("ticker")
+	fd_MarketRevision_height = md_MarketRevision.Fields().ByName("height")
+	fd_MarketRevision_signer = md_MarketRevision.Fields().ByName("signer")
+	fd_MarketRevision_action = md_MarketRevision.Fields().ByName("action")
+	fd_MarketRevision_previous = md_MarketRevision.Fields().ByName("previous")
+	fd_MarketRevision_market = md_MarketRevision.Fields().ByName("market")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketRevision)(nil)
+
+type fastReflection_MarketRevision MarketRevision
+
+func (x *MarketRevision) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketRevision)(x)
+}
+
+func (x *MarketRevision) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_market_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketRevision_messageType fastReflection_MarketRevision_messageType
+var _ protoreflect.MessageType = fastReflection_MarketRevision_messageType{}
+
+type fastReflection_MarketRevision_messageType struct{}
+
+func (x fastReflection_MarketRevision_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketRevision)(nil)
+}
+func (x fastReflection_MarketRevision_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketRevision)
+}
+func (x fastReflection_MarketRevision_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketRevision
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketRevision) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketRevision
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketRevision) Type() protoreflect.MessageType {
+	return _fastReflection_MarketRevision_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketRevision) New() protoreflect.Message {
+	return new(fastReflection_MarketRevision)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketRevision) Interface() protoreflect.ProtoMessage {
+	return (*MarketRevision)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketRevision) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_MarketRevision_id, value) {
+			return
+		}
+	}
+	if x.Ticker != "" {
+		value := protoreflect.ValueOfString(x.Ticker)
+		if !f(fd_MarketRevision_ticker, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_MarketRevision_height, value) {
+			return
+		}
+	}
+	if x.Signer != "" {
+		value := protoreflect.ValueOfString(x.Signer)
+		if !f(fd_MarketRevision_signer, value) {
+			return
+		}
+	}
+	if x.Action != "" {
+		value := protoreflect.ValueOfString(x.Action)
+		if !f(fd_MarketRevision_action, value) {
+			return
+		}
+	}
+	if x.Previous != nil {
+		value := protoreflect.ValueOfMessage(x.Previous.ProtoReflect())
+		if !f(fd_MarketRevision_previous, value) {
+			return
+		}
+	}
+	if x.Market != nil {
+		value := protoreflect.ValueOfMessage(x.Market.ProtoReflect())
+		if !f(fd_MarketRevision_market, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketRevision) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketRevision.id":
+		return x.Id != uint64(0)
+	case "slinky.marketmap.v1.MarketRevision.ticker":
+		return x.Ticker != ""
+	case "slinky.marketmap.v1.MarketRevision.height":
+		return x.Height != uint64(0)
+	case "slinky.marketmap.v1.MarketRevision.signer":
+		return x.Signer != ""
+	case "slinky.marketmap.v1.MarketRevision.action":
+		return x.Action != ""
+	case "slinky.marketmap.v1.MarketRevision.previous":
+		return x.Previous != nil
+	case "slinky.marketmap.v1.MarketRevision.market":
+		return x.Market != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketRevision"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketRevision does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRevision) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketRevision.id":
+		x.Id = uint64(0)
+	case "slinky.marketmap.v1.MarketRevision.ticker":
+		x.Ticker = ""
+	case "slinky.marketmap.v1.MarketRevision.height":
+		x.Height = uint64(0)
+	case "slinky.marketmap.v1.MarketRevision.signer":
+		x.Signer = ""
+	case "slinky.marketmap.v1.MarketRevision.action":
+		x.Action = ""
+	case "slinky.marketmap.v1.MarketRevision.previous":
+		x.Previous = nil
+	case "slinky.marketmap.v1.MarketRevision.market":
+		x.Market = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketRevision"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketRevision does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketRevision) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.MarketRevision.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.MarketRevision.ticker":
+		value := x.Ticker
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.MarketRevision.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.marketmap.v1.MarketRevision.signer":
+		value := x.Signer
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.MarketRevision.action":
+		value := x.Action
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.MarketRevision.previous":
+		value := x.Previous
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.marketmap.v1.MarketRevision.market":
+		value := x.Market
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketRevision"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketRevision does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRevision) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketRevision.id":
+		x.Id = value.Uint()
+	case "slinky.marketmap.v1.MarketRevision.ticker":
+		x.Ticker = value.Interface().(string)
+	case "slinky.marketmap.v1.MarketRevision.height":
+		x.Height = value.Uint()
+	case "slinky.marketmap.v1.MarketRevision.signer":
+		x.Signer = value.Interface().(string)
+	case "slinky.marketmap.v1.MarketRevision.action":
+		x.Action = value.Interface().(string)
+	case "slinky.marketmap.v1.MarketRevision.previous":
+		x.Previous = value.Message().Interface().(*Market)
+	case "slinky.marketmap.v1.MarketRevision.market":
+		x.Market = value.Message().Interface().(*Market)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketRevision"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketRevision does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRevision) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketRevision.previous":
+		if x.Previous == nil {
+			x.Previous = new(Market)
+		}
+		return protoreflect.ValueOfMessage(x.Previous.ProtoReflect())
+	case "slinky.marketmap.v1.MarketRevision.market":
+		if x.Market == nil {
+			x.Market = new(Market)
+		}
+		return protoreflect.ValueOfMessage(x.Market.ProtoReflect())
+	case "slinky.marketmap.v1.MarketRevision.id":
+		panic(fmt.Errorf("field id of message slinky.marketmap.v1.MarketRevision is not mutable"))
+	case "slinky.marketmap.v1.MarketRevision.ticker":
+		panic(fmt.Errorf("field ticker of message slinky.marketmap.v1.MarketRevision is not mutable"))
+	case "slinky.marketmap.v1.MarketRevision.height":
+		panic(fmt.Errorf("field height of message slinky.marketmap.v1.MarketRevision is not mutable"))
+	case "slinky.marketmap.v1.MarketRevision.signer":
+		panic(fmt.Errorf("field signer of message slinky.marketmap.v1.MarketRevision is not mutable"))
+	case "slinky.marketmap.v1.MarketRevision.action":
+		panic(fmt.Errorf("field action of message slinky.marketmap.v1.MarketRevision is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketRevision"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketRevision does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketRevision) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketRevision.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.MarketRevision.ticker":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.MarketRevision.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.marketmap.v1.MarketRevision.signer":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.MarketRevision.action":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.MarketRevision.previous":
+		m := new(Market)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.marketmap.v1.MarketRevision.market":
+		m := new(Market)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketRevision"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketRevision does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketRevision) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.MarketRevision", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketRevision) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketRevision) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketRevision) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketRevision) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketRevision)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Ticker)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Signer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Action)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Previous != nil {
+			l = options.Size(x.Previous)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Market != nil {
+			l = options.Size(x.Market)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketRevision)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Market != nil {
+			encoded, err := options.Marshal(x.Market)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Previous != nil {
+			encoded, err := options.Marshal(x.Previous)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Action) > 0 {
+			i -= len(x.Action)
+			copy(dAtA[i:], x.Action)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Action)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Signer) > 0 {
+			i -= len(x.Signer)
+			copy(dAtA[i:], x.Signer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signer)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Ticker) > 0 {
+			i -= len(x.Ticker)
+			copy(dAtA[i:], x.Ticker)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ticker)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketRevision)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRevision: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ticker = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Action = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Previous == nil {
+					x.Previous = &Market{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Previous); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Market == nil {
+					x.Market = &Market{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Market); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2683,6 +4832,11 @@ type Ticker struct {
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given ticker.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
+	// State is the lifecycle state of the market: proposed, shadow, active,
+	// reduce_only or delisted. Shadow, active and reduce_only markets must be
+	// enabled, while proposed and delisted markets must be disabled. If it is
+	// not set, the state of the market is derived from Enabled.
+	State string `protobuf:"bytes,16,opt,name=state,proto3" json:"state,omitempty"`
 }
 
 func (x *Ticker) Reset() {
@@ -2740,6 +4894,13 @@ func (x *Ticker) GetMetadata_JSON() string {
 	return ""
 }
 
+func (x *Ticker) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
 type ProviderConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2760,6 +4921,14 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizeByPairs is an ordered list of conversion pairs for this ticker to
+	// be normalized by, for markets that require more than one conversion step.
+	// For example, if the desired Ticker is FOO/USD, this market could be reached
+	// using: OffChainTicker = FOO/ETH NormalizeByPairs = [ETH/USDT, USDT/USD].
+	// Each step must quote the currency that the previous step (or the provider
+	// price) is quoted in. This field is optional, and cannot be set together
+	// with NormalizeByPair.
+	NormalizeByPairs []*ConversionPair `protobuf:"bytes,5,rep,name=normalize_by_pairs,json=normalizeByPairs,proto3" json:"normalize_by_pairs,omitempty"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -2813,6 +4982,13 @@ func (x *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (x *ProviderConfig) GetNormalizeByPairs() []*ConversionPair {
+	if x != nil {
+		return x.NormalizeByPairs
+	}
+	return nil
+}
+
 func (x *ProviderConfig) GetMetadata_JSON() string {
 	if x != nil {
 		return x.Metadata_JSON
@@ -2820,6 +4996,54 @@ func (x *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// ConversionPair is a single step of a conversion path used to normalize a
+// provider price.
+type ConversionPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pair is the currency pair whose index price is used for this step.
+	Pair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Invert is a boolean indicating if the inverse of the pair's index price
+	// should be used for this step, i.e. QUOTE/BASE instead of BASE/QUOTE.
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (x *ConversionPair) Reset() {
+	*x = ConversionPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConversionPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConversionPair) ProtoMessage() {}
+
+// Deprecated: Use ConversionPair.ProtoReflect.Descriptor instead.
+func (*ConversionPair) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConversionPair) GetPair() *v1.CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ConversionPair) GetInvert() bool {
+	if x != nil {
+		return x.Invert
+	}
+	return false
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	state         protoimpl.MessageState
@@ -2834,7 +5058,7 @@ type MarketMap struct {
 func (x *MarketMap) Reset() {
 	*x = MarketMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[3]
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2848,7 +5072,7 @@ func (*MarketMap) ProtoMessage() {}
 
 // Deprecated: Use MarketMap.ProtoReflect.Descriptor instead.
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{3}
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *MarketMap) GetMarkets() map[string]*Market {
@@ -2858,6 +5082,165 @@ func (x *MarketMap) GetMarkets() map[string]*Market {
 	return nil
 }
 
+// ScheduledMarketUpdate is a set of market upserts that is queued to be applied
+// at the beginning of a future block.
+type ScheduledMarketUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Authority is the market authority that scheduled the update. The update is
+	// applied with the permissions of this authority at the activation height.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height at the beginning of which the update is
+	// applied.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Markets is the list of markets to create, or update if they exist.
+	Markets []*Market `protobuf:"bytes,4,rep,name=markets,proto3" json:"markets,omitempty"`
+}
+
+func (x *ScheduledMarketUpdate) Reset() {
+	*x = ScheduledMarketUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledMarketUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMarketUpdate) ProtoMessage() {}
+
+// Deprecated: Use ScheduledMarketUpdate.ProtoReflect.Descriptor instead.
+func (*ScheduledMarketUpdate) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScheduledMarketUpdate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledMarketUpdate) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *ScheduledMarketUpdate) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ScheduledMarketUpdate) GetMarkets() []*Market {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+// MarketRevision is a record of a change made to a market in the market map.
+type MarketRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id is the unique identifier of the revision. Revisions are numbered in the
+	// order in which they were made.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ticker is the ticker, i.e. BTC/USD, of the market that was changed.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Height is the block height at which the change was made.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Signer is the authority that made the change.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Action is the change that was made: "create", "update" or "remove".
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// Previous is the market before the change (nil if it was created).
+	Previous *Market `protobuf:"bytes,6,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Market is the market after the change (nil if it was removed).
+	Market *Market `protobuf:"bytes,7,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (x *MarketRevision) Reset() {
+	*x = MarketRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_market_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketRevision) ProtoMessage() {}
+
+// Deprecated: Use MarketRevision.ProtoReflect.Descriptor instead.
+func (*MarketRevision) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_market_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MarketRevision) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MarketRevision) GetTicker() string {
+	if x != nil {
+		return x.Ticker
+	}
+	return ""
+}
+
+func (x *MarketRevision) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *MarketRevision) GetSigner() string {
+	if x != nil {
+		return x.Signer
+	}
+	return ""
+}
+
+func (x *MarketRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MarketRevision) GetPrevious() *Market {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *MarketRevision) GetMarket() *Market {
+	if x != nil {
+		return x.Market
+	}
+	return nil
+}
+
 var File_slinky_marketmap_v1_market_proto protoreflect.FileDescriptor
 
 var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
@@ -2878,7 +5261,7 @@ var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
 	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
 	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x70,
 	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x3a, 0x08,
-	0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xe5, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63,
+	0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xfb, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63,
 	0x6b, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
 	0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69,
 	0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72,
@@ -2892,33 +5275,71 @@ var file_slinky_marketmap_v1_market_proto_rawDesc = []byte{
 	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
 	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x4a, 0x53,
 	0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x3a, 0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00,
-	0x22, 0xd6, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65,
-	0x72, 0x12, 0x49, 0x0a, 0x11, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62,
-	0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
-	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0f, 0x6e, 0x6f, 0x72,
-	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e,
-	0x76, 0x65, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0xbb, 0x01, 0x0a, 0x09, 0x4d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
-	0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x08, 0x98,
-	0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x08, 0x98, 0xa0,
+	0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0xaf, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x11, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x0f, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x57, 0x0a, 0x12, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x10, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x61, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x37, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x09,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x12, 0x4b, 0x0a, 0x07, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x4d, 0x61, 0x70, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x57, 0x0a, 0x0c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a,
+	0x08, 0x98, 0xa0, 0x1f, 0x00, 0x80, 0xdc, 0x20, 0x00, 0x22, 0x9a, 0x01, 0x0a, 0x15, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x12, 0x33, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
 	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70,
 	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
 	0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
@@ -2946,27 +5367,35 @@ func file_slinky_marketmap_v1_market_proto_rawDescGZIP() []byte {
 	return file_slinky_marketmap_v1_market_proto_rawDescData
 }
 
-var file_slinky_marketmap_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_slinky_marketmap_v1_market_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_slinky_marketmap_v1_market_proto_goTypes = []interface{}{
-	(*Market)(nil),          // 0: slinky.marketmap.v1.Market
-	(*Ticker)(nil),          // 1: slinky.marketmap.v1.Ticker
-	(*ProviderConfig)(nil),  // 2: slinky.marketmap.v1.ProviderConfig
-	(*MarketMap)(nil),       // 3: slinky.marketmap.v1.MarketMap
-	nil,                     // 4: slinky.marketmap.v1.MarketMap.MarketsEntry
-	(*v1.CurrencyPair)(nil), // 5: slinky.types.v1.CurrencyPair
+	(*Market)(nil),                // 0: slinky.marketmap.v1.Market
+	(*Ticker)(nil),                // 1: slinky.marketmap.v1.Ticker
+	(*ProviderConfig)(nil),        // 2: slinky.marketmap.v1.ProviderConfig
+	(*ConversionPair)(nil),        // 3: slinky.marketmap.v1.ConversionPair
+	(*MarketMap)(nil),             // 4: slinky.marketmap.v1.MarketMap
+	(*ScheduledMarketUpdate)(nil), // 5: slinky.marketmap.v1.ScheduledMarketUpdate
+	(*MarketRevision)(nil),        // 6: slinky.marketmap.v1.MarketRevision
+	nil,                           // 7: slinky.marketmap.v1.MarketMap.MarketsEntry
+	(*v1.CurrencyPair)(nil),       // 8: slinky.types.v1.CurrencyPair
 }
 var file_slinky_marketmap_v1_market_proto_depIdxs = []int32{
-	1, // 0: slinky.marketmap.v1.Market.ticker:type_name -> slinky.marketmap.v1.Ticker
-	2, // 1: slinky.marketmap.v1.Market.provider_configs:type_name -> slinky.marketmap.v1.ProviderConfig
-	5, // 2: slinky.marketmap.v1.Ticker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
-	5, // 3: slinky.marketmap.v1.ProviderConfig.normalize_by_pair:type_name -> slinky.types.v1.CurrencyPair
-	4, // 4: slinky.marketmap.v1.MarketMap.markets:type_name -> slinky.marketmap.v1.MarketMap.MarketsEntry
-	0, // 5: slinky.marketmap.v1.MarketMap.MarketsEntry.value:type_name -> slinky.marketmap.v1.Market
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: slinky.marketmap.v1.Market.ticker:type_name -> slinky.marketmap.v1.Ticker
+	2,  // 1: slinky.marketmap.v1.Market.provider_configs:type_name -> slinky.marketmap.v1.ProviderConfig
+	8,  // 2: slinky.marketmap.v1.Ticker.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	8,  // 3: slinky.marketmap.v1.ProviderConfig.normalize_by_pair:type_name -> slinky.types.v1.CurrencyPair
+	3,  // 4: slinky.marketmap.v1.ProviderConfig.normalize_by_pairs:type_name -> slinky.marketmap.v1.ConversionPair
+	8,  // 5: slinky.marketmap.v1.ConversionPair.pair:type_name -> slinky.types.v1.CurrencyPair
+	7,  // 6: slinky.marketmap.v1.MarketMap.markets:type_name -> slinky.marketmap.v1.MarketMap.MarketsEntry
+	0,  // 7: slinky.marketmap.v1.ScheduledMarketUpdate.markets:type_name -> slinky.marketmap.v1.Market
+	0,  // 8: slinky.marketmap.v1.MarketRevision.previous:type_name -> slinky.marketmap.v1.Market
+	0,  // 9: slinky.marketmap.v1.MarketRevision.market:type_name -> slinky.marketmap.v1.Market
+	0,  // 10: slinky.marketmap.v1.MarketMap.MarketsEntry.value:type_name -> slinky.marketmap.v1.Market
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_slinky_marketmap_v1_market_proto_init() }
@@ -3012,6 +5441,18 @@ func file_slinky_marketmap_v1_market_proto_init() {
 			}
 		}
 		file_slinky_marketmap_v1_market_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConversionPair); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_marketmap_v1_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarketMap); i {
 			case 0:
 				return &v.state
@@ -3023,6 +5464,30 @@ func file_slinky_marketmap_v1_market_proto_init() {
 				return nil
 			}
 		}
+		file_slinky_marketmap_v1_market_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMarketUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_marketmap_v1_market_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3030,7 +5495,7 @@ func file_slinky_marketmap_v1_market_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_marketmap_v1_market_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
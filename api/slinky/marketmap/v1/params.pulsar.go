@@ -4,6 +4,7 @@ package marketmapv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -58,10 +59,63 @@ func (x *_Params_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]*MarketAuthorityGrant
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityGrant)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarketAuthorityGrant)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	v := new(MarketAuthorityGrant)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := new(MarketAuthorityGrant)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_market_authorities protoreflect.FieldDescriptor
-	fd_Params_admin              protoreflect.FieldDescriptor
+	md_Params                         protoreflect.MessageDescriptor
+	fd_Params_market_authorities      protoreflect.FieldDescriptor
+	fd_Params_admin                   protoreflect.FieldDescriptor
+	fd_Params_market_authority_grants protoreflect.FieldDescriptor
+	fd_Params_max_market_revisions    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -69,6 +123,8 @@ func init() {
 	md_Params = File_slinky_marketmap_v1_params_proto.Messages().ByName("Params")
 	fd_Params_market_authorities = md_Params.Fields().ByName("market_authorities")
 	fd_Params_admin = md_Params.Fields().ByName("admin")
+	fd_Params_market_authority_grants = md_Params.Fields().ByName("market_authority_grants")
+	fd_Params_max_market_revisions = md_Params.Fields().ByName("max_market_revisions")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -148,6 +204,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.MarketAuthorityGrants) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.MarketAuthorityGrants})
+		if !f(fd_Params_market_authority_grants, value) {
+			return
+		}
+	}
+	if x.MaxMarketRevisions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxMarketRevisions)
+		if !f(fd_Params_max_market_revisions, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -167,6 +235,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.MarketAuthorities) != 0
 	case "slinky.marketmap.v1.Params.admin":
 		return x.Admin != ""
+	case "slinky.marketmap.v1.Params.market_authority_grants":
+		return len(x.MarketAuthorityGrants) != 0
+	case "slinky.marketmap.v1.Params.max_market_revisions":
+		return x.MaxMarketRevisions != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -187,6 +259,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MarketAuthorities = nil
 	case "slinky.marketmap.v1.Params.admin":
 		x.Admin = ""
+	case "slinky.marketmap.v1.Params.market_authority_grants":
+		x.MarketAuthorityGrants = nil
+	case "slinky.marketmap.v1.Params.max_market_revisions":
+		x.MaxMarketRevisions = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -212,6 +288,15 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "slinky.marketmap.v1.Params.admin":
 		value := x.Admin
 		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.Params.market_authority_grants":
+		if len(x.MarketAuthorityGrants) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.MarketAuthorityGrants}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.Params.max_market_revisions":
+		value := x.MaxMarketRevisions
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -238,6 +323,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MarketAuthorities = *clv.list
 	case "slinky.marketmap.v1.Params.admin":
 		x.Admin = value.Interface().(string)
+	case "slinky.marketmap.v1.Params.market_authority_grants":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.MarketAuthorityGrants = *clv.list
+	case "slinky.marketmap.v1.Params.max_market_revisions":
+		x.MaxMarketRevisions = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -264,8 +355,16 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_1_list{list: &x.MarketAuthorities}
 		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.Params.market_authority_grants":
+		if x.MarketAuthorityGrants == nil {
+			x.MarketAuthorityGrants = []*MarketAuthorityGrant{}
+		}
+		value := &_Params_3_list{list: &x.MarketAuthorityGrants}
+		return protoreflect.ValueOfList(value)
 	case "slinky.marketmap.v1.Params.admin":
 		panic(fmt.Errorf("field admin of message slinky.marketmap.v1.Params is not mutable"))
+	case "slinky.marketmap.v1.Params.max_market_revisions":
+		panic(fmt.Errorf("field max_market_revisions of message slinky.marketmap.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -284,6 +383,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfList(&_Params_1_list{list: &list})
 	case "slinky.marketmap.v1.Params.admin":
 		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.Params.market_authority_grants":
+		list := []*MarketAuthorityGrant{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "slinky.marketmap.v1.Params.max_market_revisions":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.Params"))
@@ -363,6 +467,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.MarketAuthorityGrants) > 0 {
+			for _, e := range x.MarketAuthorityGrants {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxMarketRevisions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMarketRevisions))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -392,6 +505,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.MaxMarketRevisions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMarketRevisions))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.MarketAuthorityGrants) > 0 {
+			for iNdEx := len(x.MarketAuthorityGrants) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MarketAuthorityGrants[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Admin) > 0 {
 			i -= len(x.Admin)
 			copy(dAtA[i:], x.Admin)
@@ -521,6 +655,59 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityGrants", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarketAuthorityGrants = append(x.MarketAuthorityGrants, &MarketAuthorityGrant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarketAuthorityGrants[len(x.MarketAuthorityGrants)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMarketRevisions", wireType)
+				}
+				x.MaxMarketRevisions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMarketRevisions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -556,126 +743,1184 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: slinky/marketmap/v1/params.proto
+var _ protoreflect.List = (*_MarketAuthorityGrant_2_list)(nil)
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+type _MarketAuthorityGrant_2_list struct {
+	list *[]string
+}
 
-// Params defines the parameters for the x/marketmap module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *_MarketAuthorityGrant_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-	// MarketAuthorities is the list of authority accounts that are able to
-	// control updating the marketmap.
-	MarketAuthorities []string `protobuf:"bytes,1,rep,name=market_authorities,json=marketAuthorities,proto3" json:"market_authorities,omitempty"`
-	// Admin is an address that can remove addresses from the MarketAuthorities
-	// list. Only governance can add to the MarketAuthorities or change the Admin.
-	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+func (x *_MarketAuthorityGrant_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_marketmap_v1_params_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_MarketAuthorityGrant_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_MarketAuthorityGrant_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (*Params) ProtoMessage() {}
+func (x *_MarketAuthorityGrant_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field Actions as it is not of Message kind"))
+}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_slinky_marketmap_v1_params_proto_rawDescGZIP(), []int{0}
+func (x *_MarketAuthorityGrant_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Params) GetMarketAuthorities() []string {
-	if x != nil {
-		return x.MarketAuthorities
+func (x *_MarketAuthorityGrant_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityGrant_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketAuthorityGrant_3_list)(nil)
+
+type _MarketAuthorityGrant_3_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityGrant_3_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return nil
+	return len(*x.list)
 }
 
-func (x *Params) GetAdmin() string {
-	if x != nil {
-		return x.Admin
+func (x *_MarketAuthorityGrant_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketAuthorityGrant_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityGrant_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityGrant_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field Tickers as it is not of Message kind"))
+}
+
+func (x *_MarketAuthorityGrant_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityGrant_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityGrant_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketAuthorityGrant_4_list)(nil)
+
+type _MarketAuthorityGrant_4_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityGrant_4_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	return ""
+	return len(*x.list)
 }
 
-var File_slinky_marketmap_v1_params_proto protoreflect.FileDescriptor
+func (x *_MarketAuthorityGrant_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
 
-var file_slinky_marketmap_v1_params_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x13, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x22, 0x4d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73,
-	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e,
-	0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
-	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x6d, 0x61, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
-	0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e,
-	0x6b, 0x79, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
-	0x61, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
-	0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *_MarketAuthorityGrant_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-var (
-	file_slinky_marketmap_v1_params_proto_rawDescOnce sync.Once
-	file_slinky_marketmap_v1_params_proto_rawDescData = file_slinky_marketmap_v1_params_proto_rawDesc
-)
+func (x *_MarketAuthorityGrant_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
 
-func file_slinky_marketmap_v1_params_proto_rawDescGZIP() []byte {
-	file_slinky_marketmap_v1_params_proto_rawDescOnce.Do(func() {
-		file_slinky_marketmap_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_marketmap_v1_params_proto_rawDescData)
-	})
-	return file_slinky_marketmap_v1_params_proto_rawDescData
+func (x *_MarketAuthorityGrant_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field QuoteAssets as it is not of Message kind"))
 }
 
-var file_slinky_marketmap_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_slinky_marketmap_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: slinky.marketmap.v1.Params
+func (x *_MarketAuthorityGrant_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
-var file_slinky_marketmap_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *_MarketAuthorityGrant_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func init() { file_slinky_marketmap_v1_params_proto_init() }
-func file_slinky_marketmap_v1_params_proto_init() {
-	if File_slinky_marketmap_v1_params_proto != nil {
-		return
+func (x *_MarketAuthorityGrant_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MarketAuthorityGrant_5_list)(nil)
+
+type _MarketAuthorityGrant_5_list struct {
+	list *[]string
+}
+
+func (x *_MarketAuthorityGrant_5_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_slinky_marketmap_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Params); i {
+	return len(*x.list)
+}
+
+func (x *_MarketAuthorityGrant_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MarketAuthorityGrant_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MarketAuthorityGrant_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MarketAuthorityGrant_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MarketAuthorityGrant at list field ExcludedTickers as it is not of Message kind"))
+}
+
+func (x *_MarketAuthorityGrant_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MarketAuthorityGrant_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MarketAuthorityGrant_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MarketAuthorityGrant                  protoreflect.MessageDescriptor
+	fd_MarketAuthorityGrant_authority        protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_actions          protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_tickers          protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_quote_assets     protoreflect.FieldDescriptor
+	fd_MarketAuthorityGrant_excluded_tickers protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_marketmap_v1_params_proto_init()
+	md_MarketAuthorityGrant = File_slinky_marketmap_v1_params_proto.Messages().ByName("MarketAuthorityGrant")
+	fd_MarketAuthorityGrant_authority = md_MarketAuthorityGrant.Fields().ByName("authority")
+	fd_MarketAuthorityGrant_actions = md_MarketAuthorityGrant.Fields().ByName("actions")
+	fd_MarketAuthorityGrant_tickers = md_MarketAuthorityGrant.Fields().ByName("tickers")
+	fd_MarketAuthorityGrant_quote_assets = md_MarketAuthorityGrant.Fields().ByName("quote_assets")
+	fd_MarketAuthorityGrant_excluded_tickers = md_MarketAuthorityGrant.Fields().ByName("excluded_tickers")
+}
+
+var _ protoreflect.Message = (*fastReflection_MarketAuthorityGrant)(nil)
+
+type fastReflection_MarketAuthorityGrant MarketAuthorityGrant
+
+func (x *MarketAuthorityGrant) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityGrant)(x)
+}
+
+func (x *MarketAuthorityGrant) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_marketmap_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MarketAuthorityGrant_messageType fastReflection_MarketAuthorityGrant_messageType
+var _ protoreflect.MessageType = fastReflection_MarketAuthorityGrant_messageType{}
+
+type fastReflection_MarketAuthorityGrant_messageType struct{}
+
+func (x fastReflection_MarketAuthorityGrant_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MarketAuthorityGrant)(nil)
+}
+func (x fastReflection_MarketAuthorityGrant_messageType) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityGrant)
+}
+func (x fastReflection_MarketAuthorityGrant_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityGrant
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MarketAuthorityGrant) Descriptor() protoreflect.MessageDescriptor {
+	return md_MarketAuthorityGrant
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MarketAuthorityGrant) Type() protoreflect.MessageType {
+	return _fastReflection_MarketAuthorityGrant_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MarketAuthorityGrant) New() protoreflect.Message {
+	return new(fastReflection_MarketAuthorityGrant)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MarketAuthorityGrant) Interface() protoreflect.ProtoMessage {
+	return (*MarketAuthorityGrant)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MarketAuthorityGrant) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MarketAuthorityGrant_authority, value) {
+			return
+		}
+	}
+	if len(x.Actions) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_2_list{list: &x.Actions})
+		if !f(fd_MarketAuthorityGrant_actions, value) {
+			return
+		}
+	}
+	if len(x.Tickers) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_3_list{list: &x.Tickers})
+		if !f(fd_MarketAuthorityGrant_tickers, value) {
+			return
+		}
+	}
+	if len(x.QuoteAssets) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_4_list{list: &x.QuoteAssets})
+		if !f(fd_MarketAuthorityGrant_quote_assets, value) {
+			return
+		}
+	}
+	if len(x.ExcludedTickers) != 0 {
+		value := protoreflect.ValueOfList(&_MarketAuthorityGrant_5_list{list: &x.ExcludedTickers})
+		if !f(fd_MarketAuthorityGrant_excluded_tickers, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MarketAuthorityGrant) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityGrant.authority":
+		return x.Authority != ""
+	case "slinky.marketmap.v1.MarketAuthorityGrant.actions":
+		return len(x.Actions) != 0
+	case "slinky.marketmap.v1.MarketAuthorityGrant.tickers":
+		return len(x.Tickers) != 0
+	case "slinky.marketmap.v1.MarketAuthorityGrant.quote_assets":
+		return len(x.QuoteAssets) != 0
+	case "slinky.marketmap.v1.MarketAuthorityGrant.excluded_tickers":
+		return len(x.ExcludedTickers) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityGrant.authority":
+		x.Authority = ""
+	case "slinky.marketmap.v1.MarketAuthorityGrant.actions":
+		x.Actions = nil
+	case "slinky.marketmap.v1.MarketAuthorityGrant.tickers":
+		x.Tickers = nil
+	case "slinky.marketmap.v1.MarketAuthorityGrant.quote_assets":
+		x.QuoteAssets = nil
+	case "slinky.marketmap.v1.MarketAuthorityGrant.excluded_tickers":
+		x.ExcludedTickers = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MarketAuthorityGrant) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityGrant.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.actions":
+		if len(x.Actions) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_2_list{})
+		}
+		listValue := &_MarketAuthorityGrant_2_list{list: &x.Actions}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.tickers":
+		if len(x.Tickers) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_3_list{})
+		}
+		listValue := &_MarketAuthorityGrant_3_list{list: &x.Tickers}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.quote_assets":
+		if len(x.QuoteAssets) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_4_list{})
+		}
+		listValue := &_MarketAuthorityGrant_4_list{list: &x.QuoteAssets}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.excluded_tickers":
+		if len(x.ExcludedTickers) == 0 {
+			return protoreflect.ValueOfList(&_MarketAuthorityGrant_5_list{})
+		}
+		listValue := &_MarketAuthorityGrant_5_list{list: &x.ExcludedTickers}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityGrant does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityGrant.authority":
+		x.Authority = value.Interface().(string)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.actions":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_2_list)
+		x.Actions = *clv.list
+	case "slinky.marketmap.v1.MarketAuthorityGrant.tickers":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_3_list)
+		x.Tickers = *clv.list
+	case "slinky.marketmap.v1.MarketAuthorityGrant.quote_assets":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_4_list)
+		x.QuoteAssets = *clv.list
+	case "slinky.marketmap.v1.MarketAuthorityGrant.excluded_tickers":
+		lv := value.List()
+		clv := lv.(*_MarketAuthorityGrant_5_list)
+		x.ExcludedTickers = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityGrant.actions":
+		if x.Actions == nil {
+			x.Actions = []string{}
+		}
+		value := &_MarketAuthorityGrant_2_list{list: &x.Actions}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.tickers":
+		if x.Tickers == nil {
+			x.Tickers = []string{}
+		}
+		value := &_MarketAuthorityGrant_3_list{list: &x.Tickers}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.quote_assets":
+		if x.QuoteAssets == nil {
+			x.QuoteAssets = []string{}
+		}
+		value := &_MarketAuthorityGrant_4_list{list: &x.QuoteAssets}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.excluded_tickers":
+		if x.ExcludedTickers == nil {
+			x.ExcludedTickers = []string{}
+		}
+		value := &_MarketAuthorityGrant_5_list{list: &x.ExcludedTickers}
+		return protoreflect.ValueOfList(value)
+	case "slinky.marketmap.v1.MarketAuthorityGrant.authority":
+		panic(fmt.Errorf("field authority of message slinky.marketmap.v1.MarketAuthorityGrant is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MarketAuthorityGrant) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.marketmap.v1.MarketAuthorityGrant.authority":
+		return protoreflect.ValueOfString("")
+	case "slinky.marketmap.v1.MarketAuthorityGrant.actions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_2_list{list: &list})
+	case "slinky.marketmap.v1.MarketAuthorityGrant.tickers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_3_list{list: &list})
+	case "slinky.marketmap.v1.MarketAuthorityGrant.quote_assets":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_4_list{list: &list})
+	case "slinky.marketmap.v1.MarketAuthorityGrant.excluded_tickers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MarketAuthorityGrant_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.marketmap.v1.MarketAuthorityGrant"))
+		}
+		panic(fmt.Errorf("message slinky.marketmap.v1.MarketAuthorityGrant does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MarketAuthorityGrant) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.marketmap.v1.MarketAuthorityGrant", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MarketAuthorityGrant) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MarketAuthorityGrant) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MarketAuthorityGrant) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MarketAuthorityGrant) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MarketAuthorityGrant)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Actions) > 0 {
+			for _, s := range x.Actions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Tickers) > 0 {
+			for _, s := range x.Tickers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QuoteAssets) > 0 {
+			for _, s := range x.QuoteAssets {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExcludedTickers) > 0 {
+			for _, s := range x.ExcludedTickers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityGrant)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExcludedTickers) > 0 {
+			for iNdEx := len(x.ExcludedTickers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ExcludedTickers[iNdEx])
+				copy(dAtA[i:], x.ExcludedTickers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExcludedTickers[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.QuoteAssets) > 0 {
+			for iNdEx := len(x.QuoteAssets) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.QuoteAssets[iNdEx])
+				copy(dAtA[i:], x.QuoteAssets[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.QuoteAssets[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Tickers) > 0 {
+			for iNdEx := len(x.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tickers[iNdEx])
+				copy(dAtA[i:], x.Tickers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tickers[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Actions) > 0 {
+			for iNdEx := len(x.Actions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Actions[iNdEx])
+				copy(dAtA[i:], x.Actions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Actions[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MarketAuthorityGrant)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityGrant: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MarketAuthorityGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Actions = append(x.Actions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tickers = append(x.Tickers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteAssets", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QuoteAssets = append(x.QuoteAssets, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExcludedTickers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExcludedTickers = append(x.ExcludedTickers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/marketmap/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the x/marketmap module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MarketAuthorities is the list of authority accounts that are able to
+	// control updating the marketmap.
+	MarketAuthorities []string `protobuf:"bytes,1,rep,name=market_authorities,json=marketAuthorities,proto3" json:"market_authorities,omitempty"`
+	// Admin is an address that can remove addresses from the MarketAuthorities
+	// list. Only governance can add to the MarketAuthorities or change the Admin.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MarketAuthorityGrants is the list of scoped permissions of accounts that
+	// are not MarketAuthorities. Each grant allows an account to perform a set of
+	// actions on the markets in the scope of the grant. Only governance can change
+	// the grants, and the Admin can remove them.
+	MarketAuthorityGrants []*MarketAuthorityGrant `protobuf:"bytes,3,rep,name=market_authority_grants,json=marketAuthorityGrants,proto3" json:"market_authority_grants,omitempty"`
+	// MaxMarketRevisions is the maximum number of revisions retained per market.
+	// The oldest revisions of a market are pruned once it has more, including at
+	// the beginning of every block after this is lowered. If zero, no revisions
+	// are recorded and the retained revisions are removed. This cannot exceed
+	// 1000.
+	MaxMarketRevisions uint64 `protobuf:"varint,4,opt,name=max_market_revisions,json=maxMarketRevisions,proto3" json:"max_market_revisions,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetMarketAuthorities() []string {
+	if x != nil {
+		return x.MarketAuthorities
+	}
+	return nil
+}
+
+func (x *Params) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *Params) GetMarketAuthorityGrants() []*MarketAuthorityGrant {
+	if x != nil {
+		return x.MarketAuthorityGrants
+	}
+	return nil
+}
+
+func (x *Params) GetMaxMarketRevisions() uint64 {
+	if x != nil {
+		return x.MaxMarketRevisions
+	}
+	return 0
+}
+
+// MarketAuthorityGrant allows an account to perform a set of actions on a
+// subset of the markets in the marketmap. A market is in the scope of the grant
+// if its ticker is in Tickers or its quote asset is in QuoteAssets (or if both
+// are empty), and its ticker is not in ExcludedTickers.
+type MarketAuthorityGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Authority is the account that is granted the permissions.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Actions is the list of actions that the authority may perform on the
+	// markets in scope. The supported actions are "create", "update_ticker",
+	// "update_provider_configs", "set_enabled" and "remove".
+	Actions []string `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Tickers is the list of tickers, i.e. BTC/USD, in the scope of the grant.
+	Tickers []string `protobuf:"bytes,3,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	// QuoteAssets is the list of quote assets, i.e. USD, whose markets are in
+	// the scope of the grant.
+	QuoteAssets []string `protobuf:"bytes,4,rep,name=quote_assets,json=quoteAssets,proto3" json:"quote_assets,omitempty"`
+	// ExcludedTickers is the list of tickers that are never in the scope of the
+	// grant, even if their quote asset is in QuoteAssets.
+	ExcludedTickers []string `protobuf:"bytes,5,rep,name=excluded_tickers,json=excludedTickers,proto3" json:"excluded_tickers,omitempty"`
+}
+
+func (x *MarketAuthorityGrant) Reset() {
+	*x = MarketAuthorityGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_marketmap_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarketAuthorityGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketAuthorityGrant) ProtoMessage() {}
+
+// Deprecated: Use MarketAuthorityGrant.ProtoReflect.Descriptor instead.
+func (*MarketAuthorityGrant) Descriptor() ([]byte, []int) {
+	return file_slinky_marketmap_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MarketAuthorityGrant) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MarketAuthorityGrant) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *MarketAuthorityGrant) GetTickers() []string {
+	if x != nil {
+		return x.Tickers
+	}
+	return nil
+}
+
+func (x *MarketAuthorityGrant) GetQuoteAssets() []string {
+	if x != nil {
+		return x.QuoteAssets
+	}
+	return nil
+}
+
+func (x *MarketAuthorityGrant) GetExcludedTickers() []string {
+	if x != nil {
+		return x.ExcludedTickers
+	}
+	return nil
+}
+
+var File_slinky_marketmap_v1_params_proto protoreflect.FileDescriptor
+
+var file_slinky_marketmap_v1_params_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d,
+	0x61, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x13, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x01,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x67, 0x0a,
+	0x17, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x5f, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x14, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x47, 0x72, 0x61, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x73, 0x42, 0xc6, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2f,
+	0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x53, 0x4d, 0x58, 0xaa, 0x02, 0x13, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x53, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1f, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x6d, 0x61, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x15, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x6d, 0x61, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_slinky_marketmap_v1_params_proto_rawDescOnce sync.Once
+	file_slinky_marketmap_v1_params_proto_rawDescData = file_slinky_marketmap_v1_params_proto_rawDesc
+)
+
+func file_slinky_marketmap_v1_params_proto_rawDescGZIP() []byte {
+	file_slinky_marketmap_v1_params_proto_rawDescOnce.Do(func() {
+		file_slinky_marketmap_v1_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_marketmap_v1_params_proto_rawDescData)
+	})
+	return file_slinky_marketmap_v1_params_proto_rawDescData
+}
+
+var file_slinky_marketmap_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_slinky_marketmap_v1_params_proto_goTypes = []interface{}{
+	(*Params)(nil),               // 0: slinky.marketmap.v1.Params
+	(*MarketAuthorityGrant)(nil), // 1: slinky.marketmap.v1.MarketAuthorityGrant
+}
+var file_slinky_marketmap_v1_params_proto_depIdxs = []int32{
+	1, // 0: slinky.marketmap.v1.Params.market_authority_grants:type_name -> slinky.marketmap.v1.MarketAuthorityGrant
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_slinky_marketmap_v1_params_proto_init() }
+func file_slinky_marketmap_v1_params_proto_init() {
+	if File_slinky_marketmap_v1_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slinky_marketmap_v1_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_marketmap_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarketAuthorityGrant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -693,7 +1938,7 @@ func file_slinky_marketmap_v1_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_marketmap_v1_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
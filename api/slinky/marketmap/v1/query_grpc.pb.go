@@ -19,11 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_MarketMap_FullMethodName   = "/slinky.marketmap.v1.Query/MarketMap"
-	Query_Markets_FullMethodName     = "/slinky.marketmap.v1.Query/Markets"
-	Query_Market_FullMethodName      = "/slinky.marketmap.v1.Query/Market"
-	Query_LastUpdated_FullMethodName = "/slinky.marketmap.v1.Query/LastUpdated"
-	Query_Params_FullMethodName      = "/slinky.marketmap.v1.Query/Params"
+	Query_MarketMap_FullMethodName               = "/slinky.marketmap.v1.Query/MarketMap"
+	Query_Markets_FullMethodName                 = "/slinky.marketmap.v1.Query/Markets"
+	Query_Market_FullMethodName                  = "/slinky.marketmap.v1.Query/Market"
+	Query_LastUpdated_FullMethodName             = "/slinky.marketmap.v1.Query/LastUpdated"
+	Query_Params_FullMethodName                  = "/slinky.marketmap.v1.Query/Params"
+	Query_MarketAuthorityGrants_FullMethodName   = "/slinky.marketmap.v1.Query/MarketAuthorityGrants"
+	Query_ScheduledMarketUpdates_FullMethodName  = "/slinky.marketmap.v1.Query/ScheduledMarketUpdates"
+	Query_MarketRevisions_FullMethodName         = "/slinky.marketmap.v1.Query/MarketRevisions"
+	Query_MarketRevisionsByHeight_FullMethodName = "/slinky.marketmap.v1.Query/MarketRevisionsByHeight"
+	Query_MarketMapUpdates_FullMethodName        = "/slinky.marketmap.v1.Query/MarketMapUpdates"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +51,23 @@ type QueryClient interface {
 	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// MarketAuthorityGrants returns the permissions of each market authority.
+	// MarketAuthorities are returned with a grant of every action on every
+	// market.
+	MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error)
+	// ScheduledMarketUpdates returns the market updates that are queued to be
+	// applied at a future block height, ordered by activation height.
+	ScheduledMarketUpdates(ctx context.Context, in *ScheduledMarketUpdatesRequest, opts ...grpc.CallOption) (*ScheduledMarketUpdatesResponse, error)
+	// MarketRevisions returns the retained history of changes made to a market,
+	// ordered from oldest to newest.
+	MarketRevisions(ctx context.Context, in *MarketRevisionsRequest, opts ...grpc.CallOption) (*MarketRevisionsResponse, error)
+	// MarketRevisionsByHeight returns the retained changes made to any market
+	// within a range of block heights, ordered from oldest to newest.
+	MarketRevisionsByHeight(ctx context.Context, in *MarketRevisionsByHeightRequest, opts ...grpc.CallOption) (*MarketRevisionsByHeightResponse, error)
+	// MarketMapUpdates returns the markets that were created, updated or removed
+	// after a given block height, so that clients can keep a copy of the market
+	// map in sync without downloading all of it.
+	MarketMapUpdates(ctx context.Context, in *MarketMapUpdatesRequest, opts ...grpc.CallOption) (*MarketMapUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +128,56 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_MarketAuthorityGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMarketUpdates(ctx context.Context, in *ScheduledMarketUpdatesRequest, opts ...grpc.CallOption) (*ScheduledMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketRevisions(ctx context.Context, in *MarketRevisionsRequest, opts ...grpc.CallOption) (*MarketRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketRevisionsResponse)
+	err := c.cc.Invoke(ctx, Query_MarketRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketRevisionsByHeight(ctx context.Context, in *MarketRevisionsByHeightRequest, opts ...grpc.CallOption) (*MarketRevisionsByHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketRevisionsByHeightResponse)
+	err := c.cc.Invoke(ctx, Query_MarketRevisionsByHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketMapUpdates(ctx context.Context, in *MarketMapUpdatesRequest, opts ...grpc.CallOption) (*MarketMapUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketMapUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_MarketMapUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +198,23 @@ type QueryServer interface {
 	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
 	// Params returns the current x/marketmap module parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// MarketAuthorityGrants returns the permissions of each market authority.
+	// MarketAuthorities are returned with a grant of every action on every
+	// market.
+	MarketAuthorityGrants(context.Context, *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error)
+	// ScheduledMarketUpdates returns the market updates that are queued to be
+	// applied at a future block height, ordered by activation height.
+	ScheduledMarketUpdates(context.Context, *ScheduledMarketUpdatesRequest) (*ScheduledMarketUpdatesResponse, error)
+	// MarketRevisions returns the retained history of changes made to a market,
+	// ordered from oldest to newest.
+	MarketRevisions(context.Context, *MarketRevisionsRequest) (*MarketRevisionsResponse, error)
+	// MarketRevisionsByHeight returns the retained changes made to any market
+	// within a range of block heights, ordered from oldest to newest.
+	MarketRevisionsByHeight(context.Context, *MarketRevisionsByHeightRequest) (*MarketRevisionsByHeightResponse, error)
+	// MarketMapUpdates returns the markets that were created, updated or removed
+	// after a given block height, so that clients can keep a copy of the market
+	// map in sync without downloading all of it.
+	MarketMapUpdates(context.Context, *MarketMapUpdatesRequest) (*MarketMapUpdatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +240,21 @@ func (UnimplementedQueryServer) LastUpdated(context.Context, *LastUpdatedRequest
 func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) MarketAuthorityGrants(context.Context, *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketAuthorityGrants not implemented")
+}
+func (UnimplementedQueryServer) ScheduledMarketUpdates(context.Context, *ScheduledMarketUpdatesRequest) (*ScheduledMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMarketUpdates not implemented")
+}
+func (UnimplementedQueryServer) MarketRevisions(context.Context, *MarketRevisionsRequest) (*MarketRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketRevisions not implemented")
+}
+func (UnimplementedQueryServer) MarketRevisionsByHeight(context.Context, *MarketRevisionsByHeightRequest) (*MarketRevisionsByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketRevisionsByHeight not implemented")
+}
+func (UnimplementedQueryServer) MarketMapUpdates(context.Context, *MarketMapUpdatesRequest) (*MarketMapUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapUpdates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +366,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketAuthorityGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketAuthorityGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, req.(*MarketAuthorityGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledMarketUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMarketUpdates(ctx, req.(*ScheduledMarketUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketRevisions(ctx, req.(*MarketRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketRevisionsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRevisionsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketRevisionsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketRevisionsByHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketRevisionsByHeight(ctx, req.(*MarketRevisionsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketMapUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketMapUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapUpdates(ctx, req.(*MarketMapUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +483,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "MarketAuthorityGrants",
+			Handler:    _Query_MarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "ScheduledMarketUpdates",
+			Handler:    _Query_ScheduledMarketUpdates_Handler,
+		},
+		{
+			MethodName: "MarketRevisions",
+			Handler:    _Query_MarketRevisions_Handler,
+		},
+		{
+			MethodName: "MarketRevisionsByHeight",
+			Handler:    _Query_MarketRevisionsByHeight_Handler,
+		},
+		{
+			MethodName: "MarketMapUpdates",
+			Handler:    _Query_MarketMapUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/query.proto",
@@ -19,12 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/CreateMarkets"
-	Msg_UpdateMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/UpdateMarkets"
-	Msg_UpdateParams_FullMethodName            = "/slinky.marketmap.v1.Msg/UpdateParams"
-	Msg_RemoveMarketAuthorities_FullMethodName = "/slinky.marketmap.v1.Msg/RemoveMarketAuthorities"
-	Msg_UpsertMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/UpsertMarkets"
-	Msg_RemoveMarkets_FullMethodName           = "/slinky.marketmap.v1.Msg/RemoveMarkets"
+	Msg_CreateMarkets_FullMethodName                = "/slinky.marketmap.v1.Msg/CreateMarkets"
+	Msg_UpdateMarkets_FullMethodName                = "/slinky.marketmap.v1.Msg/UpdateMarkets"
+	Msg_UpdateParams_FullMethodName                 = "/slinky.marketmap.v1.Msg/UpdateParams"
+	Msg_RemoveMarketAuthorities_FullMethodName      = "/slinky.marketmap.v1.Msg/RemoveMarketAuthorities"
+	Msg_UpsertMarkets_FullMethodName                = "/slinky.marketmap.v1.Msg/UpsertMarkets"
+	Msg_RemoveMarkets_FullMethodName                = "/slinky.marketmap.v1.Msg/RemoveMarkets"
+	Msg_ScheduleMarketUpdates_FullMethodName        = "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates"
+	Msg_CancelScheduledMarketUpdates_FullMethodName = "/slinky.marketmap.v1.Msg/CancelScheduledMarketUpdates"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +53,12 @@ type MsgClient interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates queues market upserts to be applied at the beginning
+	// of a future block.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledMarketUpdates removes a pending scheduled market update
+	// from the queue.
+	CancelScheduledMarketUpdates(ctx context.Context, in *MsgCancelScheduledMarketUpdates, opts ...grpc.CallOption) (*MsgCancelScheduledMarketUpdatesResponse, error)
 }
 
 type msgClient struct {
@@ -121,6 +129,26 @@ func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMarketUpdates(ctx context.Context, in *MsgCancelScheduledMarketUpdates, opts ...grpc.CallOption) (*MsgCancelScheduledMarketUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelScheduledMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledMarketUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -145,6 +173,12 @@ type MsgServer interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates queues market upserts to be applied at the beginning
+	// of a future block.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledMarketUpdates removes a pending scheduled market update
+	// from the queue.
+	CancelScheduledMarketUpdates(context.Context, *MsgCancelScheduledMarketUpdates) (*MsgCancelScheduledMarketUpdatesResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -173,6 +207,12 @@ func (UnimplementedMsgServer) UpsertMarkets(context.Context, *MsgUpsertMarkets)
 func (UnimplementedMsgServer) RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
 }
+func (UnimplementedMsgServer) ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledMarketUpdates(context.Context, *MsgCancelScheduledMarketUpdates) (*MsgCancelScheduledMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMarketUpdates not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -302,6 +342,42 @@ func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledMarketUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMarketUpdates(ctx, req.(*MsgCancelScheduledMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -333,6 +409,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarkets",
 			Handler:    _Msg_RemoveMarkets_Handler,
 		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMarketUpdates",
+			Handler:    _Msg_CancelScheduledMarketUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/tx.proto",
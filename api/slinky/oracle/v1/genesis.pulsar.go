@@ -564,29 +564,39 @@ func (x *fastReflection_QuotePrice) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CurrencyPairState       protoreflect.MessageDescriptor
-	fd_CurrencyPairState_price protoreflect.FieldDescriptor
-	fd_CurrencyPairState_nonce protoreflect.FieldDescriptor
-	fd_CurrencyPairState_id    protoreflect.FieldDescriptor
+	md_PriceDispersion                protoreflect.MessageDescriptor
+	fd_PriceDispersion_min            protoreflect.FieldDescriptor
+	fd_PriceDispersion_max            protoreflect.FieldDescriptor
+	fd_PriceDispersion_lower_quartile protoreflect.FieldDescriptor
+	fd_PriceDispersion_upper_quartile protoreflect.FieldDescriptor
+	fd_PriceDispersion_num_validators protoreflect.FieldDescriptor
+	fd_PriceDispersion_power          protoreflect.FieldDescriptor
+	fd_PriceDispersion_total_power    protoreflect.FieldDescriptor
+	fd_PriceDispersion_block_height   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_oracle_v1_genesis_proto_init()
-	md_CurrencyPairState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairState")
-	fd_CurrencyPairState_price = md_CurrencyPairState.Fields().ByName("price")
-	fd_CurrencyPairState_nonce = md_CurrencyPairState.Fields().ByName("nonce")
-	fd_CurrencyPairState_id = md_CurrencyPairState.Fields().ByName("id")
+	md_PriceDispersion = File_slinky_oracle_v1_genesis_proto.Messages().ByName("PriceDispersion")
+	fd_PriceDispersion_min = md_PriceDispersion.Fields().ByName("min")
+	fd_PriceDispersion_max = md_PriceDispersion.Fields().ByName("max")
+	fd_PriceDispersion_lower_quartile = md_PriceDispersion.Fields().ByName("lower_quartile")
+	fd_PriceDispersion_upper_quartile = md_PriceDispersion.Fields().ByName("upper_quartile")
+	fd_PriceDispersion_num_validators = md_PriceDispersion.Fields().ByName("num_validators")
+	fd_PriceDispersion_power = md_PriceDispersion.Fields().ByName("power")
+	fd_PriceDispersion_total_power = md_PriceDispersion.Fields().ByName("total_power")
+	fd_PriceDispersion_block_height = md_PriceDispersion.Fields().ByName("block_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyPairState)(nil)
+var _ protoreflect.Message = (*fastReflection_PriceDispersion)(nil)
 
-type fastReflection_CurrencyPairState CurrencyPairState
+type fastReflection_PriceDispersion PriceDispersion
 
-func (x *CurrencyPairState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyPairState)(x)
+func (x *PriceDispersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceDispersion)(x)
 }
 
-func (x *CurrencyPairState) slowProtoReflect() protoreflect.Message {
+func (x *PriceDispersion) slowProtoReflect() protoreflect.Message {
 	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -598,43 +608,43 @@ func (x *CurrencyPairState) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyPairState_messageType fastReflection_CurrencyPairState_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyPairState_messageType{}
+var _fastReflection_PriceDispersion_messageType fastReflection_PriceDispersion_messageType
+var _ protoreflect.MessageType = fastReflection_PriceDispersion_messageType{}
 
-type fastReflection_CurrencyPairState_messageType struct{}
+type fastReflection_PriceDispersion_messageType struct{}
 
-func (x fastReflection_CurrencyPairState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyPairState)(nil)
+func (x fastReflection_PriceDispersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceDispersion)(nil)
 }
-func (x fastReflection_CurrencyPairState_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairState)
+func (x fastReflection_PriceDispersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceDispersion)
 }
-func (x fastReflection_CurrencyPairState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairState
+func (x fastReflection_PriceDispersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceDispersion
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyPairState) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairState
+func (x *fastReflection_PriceDispersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceDispersion
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyPairState) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyPairState_messageType
+func (x *fastReflection_PriceDispersion) Type() protoreflect.MessageType {
+	return _fastReflection_PriceDispersion_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyPairState) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairState)
+func (x *fastReflection_PriceDispersion) New() protoreflect.Message {
+	return new(fastReflection_PriceDispersion)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyPairState) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyPairState)(x)
+func (x *fastReflection_PriceDispersion) Interface() protoreflect.ProtoMessage {
+	return (*PriceDispersion)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -642,22 +652,52 @@ func (x *fastReflection_CurrencyPairState) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyPairState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Price != nil {
-		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
-		if !f(fd_CurrencyPairState_price, value) {
+func (x *fastReflection_PriceDispersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Min != "" {
+		value := protoreflect.ValueOfString(x.Min)
+		if !f(fd_PriceDispersion_min, value) {
 			return
 		}
 	}
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_CurrencyPairState_nonce, value) {
+	if x.Max != "" {
+		value := protoreflect.ValueOfString(x.Max)
+		if !f(fd_PriceDispersion_max, value) {
 			return
 		}
 	}
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_CurrencyPairState_id, value) {
+	if x.LowerQuartile != "" {
+		value := protoreflect.ValueOfString(x.LowerQuartile)
+		if !f(fd_PriceDispersion_lower_quartile, value) {
+			return
+		}
+	}
+	if x.UpperQuartile != "" {
+		value := protoreflect.ValueOfString(x.UpperQuartile)
+		if !f(fd_PriceDispersion_upper_quartile, value) {
+			return
+		}
+	}
+	if x.NumValidators != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumValidators)
+		if !f(fd_PriceDispersion_num_validators, value) {
+			return
+		}
+	}
+	if x.Power != "" {
+		value := protoreflect.ValueOfString(x.Power)
+		if !f(fd_PriceDispersion_power, value) {
+			return
+		}
+	}
+	if x.TotalPower != "" {
+		value := protoreflect.ValueOfString(x.TotalPower)
+		if !f(fd_PriceDispersion_total_power, value) {
+			return
+		}
+	}
+	if x.BlockHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockHeight)
+		if !f(fd_PriceDispersion_block_height, value) {
 			return
 		}
 	}
@@ -674,19 +714,29 @@ func (x *fastReflection_CurrencyPairState) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyPairState) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PriceDispersion) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		return x.Price != nil
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		return x.Nonce != uint64(0)
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		return x.Id != uint64(0)
+	case "slinky.oracle.v1.PriceDispersion.min":
+		return x.Min != ""
+	case "slinky.oracle.v1.PriceDispersion.max":
+		return x.Max != ""
+	case "slinky.oracle.v1.PriceDispersion.lower_quartile":
+		return x.LowerQuartile != ""
+	case "slinky.oracle.v1.PriceDispersion.upper_quartile":
+		return x.UpperQuartile != ""
+	case "slinky.oracle.v1.PriceDispersion.num_validators":
+		return x.NumValidators != uint64(0)
+	case "slinky.oracle.v1.PriceDispersion.power":
+		return x.Power != ""
+	case "slinky.oracle.v1.PriceDispersion.total_power":
+		return x.TotalPower != ""
+	case "slinky.oracle.v1.PriceDispersion.block_height":
+		return x.BlockHeight != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceDispersion"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceDispersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -696,19 +746,29 @@ func (x *fastReflection_CurrencyPairState) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PriceDispersion) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		x.Price = nil
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		x.Nonce = uint64(0)
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		x.Id = uint64(0)
+	case "slinky.oracle.v1.PriceDispersion.min":
+		x.Min = ""
+	case "slinky.oracle.v1.PriceDispersion.max":
+		x.Max = ""
+	case "slinky.oracle.v1.PriceDispersion.lower_quartile":
+		x.LowerQuartile = ""
+	case "slinky.oracle.v1.PriceDispersion.upper_quartile":
+		x.UpperQuartile = ""
+	case "slinky.oracle.v1.PriceDispersion.num_validators":
+		x.NumValidators = uint64(0)
+	case "slinky.oracle.v1.PriceDispersion.power":
+		x.Power = ""
+	case "slinky.oracle.v1.PriceDispersion.total_power":
+		x.TotalPower = ""
+	case "slinky.oracle.v1.PriceDispersion.block_height":
+		x.BlockHeight = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceDispersion"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceDispersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -718,22 +778,37 @@ func (x *fastReflection_CurrencyPairState) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyPairState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceDispersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		value := x.Price
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		value := x.Nonce
+	case "slinky.oracle.v1.PriceDispersion.min":
+		value := x.Min
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.PriceDispersion.max":
+		value := x.Max
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.PriceDispersion.lower_quartile":
+		value := x.LowerQuartile
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.PriceDispersion.upper_quartile":
+		value := x.UpperQuartile
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.PriceDispersion.num_validators":
+		value := x.NumValidators
 		return protoreflect.ValueOfUint64(value)
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		value := x.Id
+	case "slinky.oracle.v1.PriceDispersion.power":
+		value := x.Power
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.PriceDispersion.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.PriceDispersion.block_height":
+		value := x.BlockHeight
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceDispersion"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceDispersion does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -747,19 +822,29 @@ func (x *fastReflection_CurrencyPairState) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PriceDispersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		x.Price = value.Message().Interface().(*QuotePrice)
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		x.Nonce = value.Uint()
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		x.Id = value.Uint()
+	case "slinky.oracle.v1.PriceDispersion.min":
+		x.Min = value.Interface().(string)
+	case "slinky.oracle.v1.PriceDispersion.max":
+		x.Max = value.Interface().(string)
+	case "slinky.oracle.v1.PriceDispersion.lower_quartile":
+		x.LowerQuartile = value.Interface().(string)
+	case "slinky.oracle.v1.PriceDispersion.upper_quartile":
+		x.UpperQuartile = value.Interface().(string)
+	case "slinky.oracle.v1.PriceDispersion.num_validators":
+		x.NumValidators = value.Uint()
+	case "slinky.oracle.v1.PriceDispersion.power":
+		x.Power = value.Interface().(string)
+	case "slinky.oracle.v1.PriceDispersion.total_power":
+		x.TotalPower = value.Interface().(string)
+	case "slinky.oracle.v1.PriceDispersion.block_height":
+		x.BlockHeight = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceDispersion"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceDispersion does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -773,52 +858,68 @@ func (x *fastReflection_CurrencyPairState) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceDispersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		if x.Price == nil {
-			x.Price = new(QuotePrice)
-		}
-		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
-		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairState is not mutable"))
-	case "slinky.oracle.v1.CurrencyPairState.id":
-		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.min":
+		panic(fmt.Errorf("field min of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.max":
+		panic(fmt.Errorf("field max of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.lower_quartile":
+		panic(fmt.Errorf("field lower_quartile of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.upper_quartile":
+		panic(fmt.Errorf("field upper_quartile of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.num_validators":
+		panic(fmt.Errorf("field num_validators of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.power":
+		panic(fmt.Errorf("field power of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.total_power":
+		panic(fmt.Errorf("field total_power of message slinky.oracle.v1.PriceDispersion is not mutable"))
+	case "slinky.oracle.v1.PriceDispersion.block_height":
+		panic(fmt.Errorf("field block_height of message slinky.oracle.v1.PriceDispersion is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceDispersion"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceDispersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyPairState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PriceDispersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairState.price":
-		m := new(QuotePrice)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairState.nonce":
+	case "slinky.oracle.v1.PriceDispersion.min":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.PriceDispersion.max":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.PriceDispersion.lower_quartile":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.PriceDispersion.upper_quartile":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.PriceDispersion.num_validators":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "slinky.oracle.v1.CurrencyPairState.id":
+	case "slinky.oracle.v1.PriceDispersion.power":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.PriceDispersion.total_power":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.PriceDispersion.block_height":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.PriceDispersion"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.PriceDispersion does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyPairState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PriceDispersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairState", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.PriceDispersion", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -826,7 +927,7 @@ func (x *fastReflection_CurrencyPairState) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyPairState) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PriceDispersion) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -837,7 +938,7 @@ func (x *fastReflection_CurrencyPairState) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairState) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PriceDispersion) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -849,7 +950,7 @@ func (x *fastReflection_CurrencyPairState) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyPairState) IsValid() bool {
+func (x *fastReflection_PriceDispersion) IsValid() bool {
 	return x != nil
 }
 
@@ -859,9 +960,9 @@ func (x *fastReflection_CurrencyPairState) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PriceDispersion) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyPairState)
+		x := input.Message.Interface().(*PriceDispersion)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -873,15 +974,35 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Price != nil {
-			l = options.Size(x.Price)
+		l = len(x.Min)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
+		l = len(x.Max)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		l = len(x.LowerQuartile)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UpperQuartile)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumValidators != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumValidators))
+		}
+		l = len(x.Power)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TotalPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -893,7 +1014,7 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairState)
+		x := input.Message.Interface().(*PriceDispersion)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -912,27 +1033,55 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x40
 		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+		if len(x.TotalPower) > 0 {
+			i -= len(x.TotalPower)
+			copy(dAtA[i:], x.TotalPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalPower)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x3a
 		}
-		if x.Price != nil {
-			encoded, err := options.Marshal(x.Price)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Power) > 0 {
+			i -= len(x.Power)
+			copy(dAtA[i:], x.Power)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Power)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.NumValidators != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumValidators))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.UpperQuartile) > 0 {
+			i -= len(x.UpperQuartile)
+			copy(dAtA[i:], x.UpperQuartile)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UpperQuartile)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LowerQuartile) > 0 {
+			i -= len(x.LowerQuartile)
+			copy(dAtA[i:], x.LowerQuartile)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LowerQuartile)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Max) > 0 {
+			i -= len(x.Max)
+			copy(dAtA[i:], x.Max)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Max)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Min) > 0 {
+			i -= len(x.Min)
+			copy(dAtA[i:], x.Min)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Min)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -947,7 +1096,7 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairState)
+		x := input.Message.Interface().(*PriceDispersion)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -979,17 +1128,17 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceDispersion: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -999,33 +1148,29 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Price == nil {
-					x.Price = &QuotePrice{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Min = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 				}
-				x.Nonce = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1035,16 +1180,29 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Max = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowerQuartile", wireType)
 				}
-				x.Id = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1054,27 +1212,174 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.LowerQuartile = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpperQuartile", wireType)
 				}
-				iNdEx += skippy
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpperQuartile = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumValidators", wireType)
+				}
+				x.NumValidators = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumValidators |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Power = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalPower = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
 			}
 		}
 
@@ -1095,31 +1400,29 @@ func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CurrencyPairGenesis                     protoreflect.MessageDescriptor
-	fd_CurrencyPairGenesis_currency_pair       protoreflect.FieldDescriptor
-	fd_CurrencyPairGenesis_currency_pair_price protoreflect.FieldDescriptor
-	fd_CurrencyPairGenesis_nonce               protoreflect.FieldDescriptor
-	fd_CurrencyPairGenesis_id                  protoreflect.FieldDescriptor
+	md_CurrencyPairState       protoreflect.MessageDescriptor
+	fd_CurrencyPairState_price protoreflect.FieldDescriptor
+	fd_CurrencyPairState_nonce protoreflect.FieldDescriptor
+	fd_CurrencyPairState_id    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_slinky_oracle_v1_genesis_proto_init()
-	md_CurrencyPairGenesis = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairGenesis")
-	fd_CurrencyPairGenesis_currency_pair = md_CurrencyPairGenesis.Fields().ByName("currency_pair")
-	fd_CurrencyPairGenesis_currency_pair_price = md_CurrencyPairGenesis.Fields().ByName("currency_pair_price")
-	fd_CurrencyPairGenesis_nonce = md_CurrencyPairGenesis.Fields().ByName("nonce")
-	fd_CurrencyPairGenesis_id = md_CurrencyPairGenesis.Fields().ByName("id")
+	md_CurrencyPairState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairState")
+	fd_CurrencyPairState_price = md_CurrencyPairState.Fields().ByName("price")
+	fd_CurrencyPairState_nonce = md_CurrencyPairState.Fields().ByName("nonce")
+	fd_CurrencyPairState_id = md_CurrencyPairState.Fields().ByName("id")
 }
 
-var _ protoreflect.Message = (*fastReflection_CurrencyPairGenesis)(nil)
+var _ protoreflect.Message = (*fastReflection_CurrencyPairState)(nil)
 
-type fastReflection_CurrencyPairGenesis CurrencyPairGenesis
+type fastReflection_CurrencyPairState CurrencyPairState
 
-func (x *CurrencyPairGenesis) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CurrencyPairGenesis)(x)
+func (x *CurrencyPairState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairState)(x)
 }
 
-func (x *CurrencyPairGenesis) slowProtoReflect() protoreflect.Message {
+func (x *CurrencyPairState) slowProtoReflect() protoreflect.Message {
 	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1131,43 +1434,43 @@ func (x *CurrencyPairGenesis) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CurrencyPairGenesis_messageType fastReflection_CurrencyPairGenesis_messageType
-var _ protoreflect.MessageType = fastReflection_CurrencyPairGenesis_messageType{}
+var _fastReflection_CurrencyPairState_messageType fastReflection_CurrencyPairState_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairState_messageType{}
 
-type fastReflection_CurrencyPairGenesis_messageType struct{}
+type fastReflection_CurrencyPairState_messageType struct{}
 
-func (x fastReflection_CurrencyPairGenesis_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CurrencyPairGenesis)(nil)
+func (x fastReflection_CurrencyPairState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairState)(nil)
 }
-func (x fastReflection_CurrencyPairGenesis_messageType) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairGenesis)
+func (x fastReflection_CurrencyPairState_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairState)
 }
-func (x fastReflection_CurrencyPairGenesis_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairGenesis
+func (x fastReflection_CurrencyPairState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairState
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CurrencyPairGenesis) Descriptor() protoreflect.MessageDescriptor {
-	return md_CurrencyPairGenesis
+func (x *fastReflection_CurrencyPairState) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairState
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CurrencyPairGenesis) Type() protoreflect.MessageType {
-	return _fastReflection_CurrencyPairGenesis_messageType
+func (x *fastReflection_CurrencyPairState) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairState_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CurrencyPairGenesis) New() protoreflect.Message {
-	return new(fastReflection_CurrencyPairGenesis)
+func (x *fastReflection_CurrencyPairState) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairState)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CurrencyPairGenesis) Interface() protoreflect.ProtoMessage {
-	return (*CurrencyPairGenesis)(x)
+func (x *fastReflection_CurrencyPairState) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairState)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1175,28 +1478,22 @@ func (x *fastReflection_CurrencyPairGenesis) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.CurrencyPair != nil {
-		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
-		if !f(fd_CurrencyPairGenesis_currency_pair, value) {
-			return
-		}
-	}
-	if x.CurrencyPairPrice != nil {
-		value := protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
-		if !f(fd_CurrencyPairGenesis_currency_pair_price, value) {
+func (x *fastReflection_CurrencyPairState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Price != nil {
+		value := protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+		if !f(fd_CurrencyPairState_price, value) {
 			return
 		}
 	}
 	if x.Nonce != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_CurrencyPairGenesis_nonce, value) {
+		if !f(fd_CurrencyPairState_nonce, value) {
 			return
 		}
 	}
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_CurrencyPairGenesis_id, value) {
+		if !f(fd_CurrencyPairState_id, value) {
 			return
 		}
 	}
@@ -1213,21 +1510,19 @@ func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CurrencyPairState) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		return x.CurrencyPair != nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		return x.CurrencyPairPrice != nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		return x.Price != nil
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		return x.Nonce != uint64(0)
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
 		return x.Id != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1237,21 +1532,19 @@ func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_CurrencyPairState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		x.CurrencyPair = nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		x.CurrencyPairPrice = nil
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		x.Price = nil
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
 		x.Nonce = uint64(0)
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+	case "slinky.oracle.v1.CurrencyPairState.id":
 		x.Id = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1261,25 +1554,2688 @@ func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrencyPairState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
-		value := x.CurrencyPair
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		value := x.Price
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		value := x.CurrencyPairPrice
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairState.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		x.Price = value.Message().Interface().(*QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
+		x.Nonce = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairState.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		if x.Price == nil {
+			x.Price = new(QuotePrice)
+		}
+		return protoreflect.ValueOfMessage(x.Price.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
+		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairState.id":
+		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairState.price":
+		m := new(QuotePrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairState.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairState.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairState"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Price != nil {
+			l = options.Size(x.Price)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Price != nil {
+			encoded, err := options.Marshal(x.Price)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Price == nil {
+					x.Price = &QuotePrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CurrencyPairGenesis                     protoreflect.MessageDescriptor
+	fd_CurrencyPairGenesis_currency_pair       protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_currency_pair_price protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_nonce               protoreflect.FieldDescriptor
+	fd_CurrencyPairGenesis_id                  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_CurrencyPairGenesis = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairGenesis")
+	fd_CurrencyPairGenesis_currency_pair = md_CurrencyPairGenesis.Fields().ByName("currency_pair")
+	fd_CurrencyPairGenesis_currency_pair_price = md_CurrencyPairGenesis.Fields().ByName("currency_pair_price")
+	fd_CurrencyPairGenesis_nonce = md_CurrencyPairGenesis.Fields().ByName("nonce")
+	fd_CurrencyPairGenesis_id = md_CurrencyPairGenesis.Fields().ByName("id")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairGenesis)(nil)
+
+type fastReflection_CurrencyPairGenesis CurrencyPairGenesis
+
+func (x *CurrencyPairGenesis) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairGenesis)(x)
+}
+
+func (x *CurrencyPairGenesis) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairGenesis_messageType fastReflection_CurrencyPairGenesis_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairGenesis_messageType{}
+
+type fastReflection_CurrencyPairGenesis_messageType struct{}
+
+func (x fastReflection_CurrencyPairGenesis_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairGenesis)(nil)
+}
+func (x fastReflection_CurrencyPairGenesis_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairGenesis)
+}
+func (x fastReflection_CurrencyPairGenesis_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairGenesis
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairGenesis) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairGenesis
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairGenesis) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairGenesis_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairGenesis) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairGenesis)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairGenesis) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairGenesis)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairGenesis) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_CurrencyPairGenesis_currency_pair, value) {
+			return
+		}
+	}
+	if x.CurrencyPairPrice != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
+		if !f(fd_CurrencyPairGenesis_currency_pair_price, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_CurrencyPairGenesis_nonce, value) {
+			return
+		}
+	}
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_CurrencyPairGenesis_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairGenesis) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		return x.CurrencyPair != nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		return x.CurrencyPairPrice != nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		return x.Nonce != uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		return x.Id != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		x.CurrencyPair = nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		x.CurrencyPairPrice = nil
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		x.Nonce = uint64(0)
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		x.Id = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		value := x.CurrencyPairPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		x.CurrencyPairPrice = value.Message().Interface().(*QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		x.Nonce = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		x.Id = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v1.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		if x.CurrencyPairPrice == nil {
+			x.CurrencyPairPrice = new(QuotePrice)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairGenesis) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
+		m := new(QuotePrice)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairGenesis.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairGenesis) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairGenesis", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairGenesis) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairGenesis) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairGenesis) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairGenesis)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CurrencyPairPrice != nil {
+			l = options.Size(x.CurrencyPairPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairGenesis)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.CurrencyPairPrice != nil {
+			encoded, err := options.Marshal(x.CurrencyPairPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairGenesis)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v1.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPairPrice == nil {
+					x.CurrencyPairPrice = &QuotePrice{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPairPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CircuitBreakerTrips        protoreflect.MessageDescriptor
+	fd_CircuitBreakerTrips_upward protoreflect.FieldDescriptor
+	fd_CircuitBreakerTrips_count  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_CircuitBreakerTrips = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CircuitBreakerTrips")
+	fd_CircuitBreakerTrips_upward = md_CircuitBreakerTrips.Fields().ByName("upward")
+	fd_CircuitBreakerTrips_count = md_CircuitBreakerTrips.Fields().ByName("count")
+}
+
+var _ protoreflect.Message = (*fastReflection_CircuitBreakerTrips)(nil)
+
+type fastReflection_CircuitBreakerTrips CircuitBreakerTrips
+
+func (x *CircuitBreakerTrips) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CircuitBreakerTrips)(x)
+}
+
+func (x *CircuitBreakerTrips) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CircuitBreakerTrips_messageType fastReflection_CircuitBreakerTrips_messageType
+var _ protoreflect.MessageType = fastReflection_CircuitBreakerTrips_messageType{}
+
+type fastReflection_CircuitBreakerTrips_messageType struct{}
+
+func (x fastReflection_CircuitBreakerTrips_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CircuitBreakerTrips)(nil)
+}
+func (x fastReflection_CircuitBreakerTrips_messageType) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreakerTrips)
+}
+func (x fastReflection_CircuitBreakerTrips_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreakerTrips
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CircuitBreakerTrips) Descriptor() protoreflect.MessageDescriptor {
+	return md_CircuitBreakerTrips
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CircuitBreakerTrips) Type() protoreflect.MessageType {
+	return _fastReflection_CircuitBreakerTrips_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CircuitBreakerTrips) New() protoreflect.Message {
+	return new(fastReflection_CircuitBreakerTrips)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CircuitBreakerTrips) Interface() protoreflect.ProtoMessage {
+	return (*CircuitBreakerTrips)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CircuitBreakerTrips) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Upward != false {
+		value := protoreflect.ValueOfBool(x.Upward)
+		if !f(fd_CircuitBreakerTrips_upward, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_CircuitBreakerTrips_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CircuitBreakerTrips) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreakerTrips.upward":
+		return x.Upward != false
+	case "slinky.oracle.v1.CircuitBreakerTrips.count":
+		return x.Count != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerTrips) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreakerTrips.upward":
+		x.Upward = false
+	case "slinky.oracle.v1.CircuitBreakerTrips.count":
+		x.Count = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CircuitBreakerTrips) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CircuitBreakerTrips.upward":
+		value := x.Upward
+		return protoreflect.ValueOfBool(value)
+	case "slinky.oracle.v1.CircuitBreakerTrips.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreakerTrips does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerTrips) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreakerTrips.upward":
+		x.Upward = value.Bool()
+	case "slinky.oracle.v1.CircuitBreakerTrips.count":
+		x.Count = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerTrips) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreakerTrips.upward":
+		panic(fmt.Errorf("field upward of message slinky.oracle.v1.CircuitBreakerTrips is not mutable"))
+	case "slinky.oracle.v1.CircuitBreakerTrips.count":
+		panic(fmt.Errorf("field count of message slinky.oracle.v1.CircuitBreakerTrips is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CircuitBreakerTrips) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CircuitBreakerTrips.upward":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.oracle.v1.CircuitBreakerTrips.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CircuitBreakerTrips) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CircuitBreakerTrips", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CircuitBreakerTrips) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CircuitBreakerTrips) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CircuitBreakerTrips) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CircuitBreakerTrips) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CircuitBreakerTrips)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Upward {
+			n += 2
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreakerTrips)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Upward {
+			i--
+			if x.Upward {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CircuitBreakerTrips)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreakerTrips: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CircuitBreakerTrips: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Upward", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Upward = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CurrencyPairCircuitBreakerTrips               protoreflect.MessageDescriptor
+	fd_CurrencyPairCircuitBreakerTrips_currency_pair protoreflect.FieldDescriptor
+	fd_CurrencyPairCircuitBreakerTrips_trips         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_CurrencyPairCircuitBreakerTrips = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairCircuitBreakerTrips")
+	fd_CurrencyPairCircuitBreakerTrips_currency_pair = md_CurrencyPairCircuitBreakerTrips.Fields().ByName("currency_pair")
+	fd_CurrencyPairCircuitBreakerTrips_trips = md_CurrencyPairCircuitBreakerTrips.Fields().ByName("trips")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairCircuitBreakerTrips)(nil)
+
+type fastReflection_CurrencyPairCircuitBreakerTrips CurrencyPairCircuitBreakerTrips
+
+func (x *CurrencyPairCircuitBreakerTrips) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairCircuitBreakerTrips)(x)
+}
+
+func (x *CurrencyPairCircuitBreakerTrips) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairCircuitBreakerTrips_messageType fastReflection_CurrencyPairCircuitBreakerTrips_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairCircuitBreakerTrips_messageType{}
+
+type fastReflection_CurrencyPairCircuitBreakerTrips_messageType struct{}
+
+func (x fastReflection_CurrencyPairCircuitBreakerTrips_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairCircuitBreakerTrips)(nil)
+}
+func (x fastReflection_CurrencyPairCircuitBreakerTrips_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairCircuitBreakerTrips)
+}
+func (x fastReflection_CurrencyPairCircuitBreakerTrips_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairCircuitBreakerTrips
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairCircuitBreakerTrips
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairCircuitBreakerTrips_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairCircuitBreakerTrips)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairCircuitBreakerTrips)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_CurrencyPairCircuitBreakerTrips_currency_pair, value) {
+			return
+		}
+	}
+	if x.Trips != nil {
+		value := protoreflect.ValueOfMessage(x.Trips.ProtoReflect())
+		if !f(fd_CurrencyPairCircuitBreakerTrips_trips, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair":
+		return x.CurrencyPair != nil
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips":
+		return x.Trips != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair":
+		x.CurrencyPair = nil
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips":
+		x.Trips = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips":
+		value := x.Trips
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreakerTrips does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips":
+		x.Trips = value.Message().Interface().(*CircuitBreakerTrips)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v1.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips":
+		if x.Trips == nil {
+			x.Trips = new(CircuitBreakerTrips)
+		}
+		return protoreflect.ValueOfMessage(x.Trips.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips":
+		m := new(CircuitBreakerTrips)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairCircuitBreakerTrips does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairCircuitBreakerTrips", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairCircuitBreakerTrips) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairCircuitBreakerTrips)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Trips != nil {
+			l = options.Size(x.Trips)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairCircuitBreakerTrips)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Trips != nil {
+			encoded, err := options.Marshal(x.Trips)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairCircuitBreakerTrips)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairCircuitBreakerTrips: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairCircuitBreakerTrips: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v1.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Trips", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Trips == nil {
+					x.Trips = &CircuitBreakerTrips{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Trips); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CurrencyPairPriceDispersion               protoreflect.MessageDescriptor
+	fd_CurrencyPairPriceDispersion_currency_pair protoreflect.FieldDescriptor
+	fd_CurrencyPairPriceDispersion_dispersion    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_CurrencyPairPriceDispersion = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairPriceDispersion")
+	fd_CurrencyPairPriceDispersion_currency_pair = md_CurrencyPairPriceDispersion.Fields().ByName("currency_pair")
+	fd_CurrencyPairPriceDispersion_dispersion = md_CurrencyPairPriceDispersion.Fields().ByName("dispersion")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairPriceDispersion)(nil)
+
+type fastReflection_CurrencyPairPriceDispersion CurrencyPairPriceDispersion
+
+func (x *CurrencyPairPriceDispersion) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairPriceDispersion)(x)
+}
+
+func (x *CurrencyPairPriceDispersion) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairPriceDispersion_messageType fastReflection_CurrencyPairPriceDispersion_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairPriceDispersion_messageType{}
+
+type fastReflection_CurrencyPairPriceDispersion_messageType struct{}
+
+func (x fastReflection_CurrencyPairPriceDispersion_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairPriceDispersion)(nil)
+}
+func (x fastReflection_CurrencyPairPriceDispersion_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairPriceDispersion)
+}
+func (x fastReflection_CurrencyPairPriceDispersion_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairPriceDispersion
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairPriceDispersion) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairPriceDispersion
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairPriceDispersion) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairPriceDispersion_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairPriceDispersion) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairPriceDispersion)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairPriceDispersion) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairPriceDispersion)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairPriceDispersion) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_CurrencyPairPriceDispersion_currency_pair, value) {
+			return
+		}
+	}
+	if x.Dispersion != nil {
+		value := protoreflect.ValueOfMessage(x.Dispersion.ProtoReflect())
+		if !f(fd_CurrencyPairPriceDispersion_dispersion, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairPriceDispersion) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair":
+		return x.CurrencyPair != nil
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion":
+		return x.Dispersion != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceDispersion"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairPriceDispersion) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair":
+		x.CurrencyPair = nil
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion":
+		x.Dispersion = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceDispersion"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairPriceDispersion) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair":
+		value := x.CurrencyPair
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion":
+		value := x.Dispersion
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceDispersion"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceDispersion does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairPriceDispersion) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair":
+		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion":
+		x.Dispersion = value.Message().Interface().(*PriceDispersion)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceDispersion"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairPriceDispersion) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair":
+		if x.CurrencyPair == nil {
+			x.CurrencyPair = new(v1.CurrencyPair)
+		}
+		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion":
+		if x.Dispersion == nil {
+			x.Dispersion = new(PriceDispersion)
+		}
+		return protoreflect.ValueOfMessage(x.Dispersion.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceDispersion"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CurrencyPairPriceDispersion) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair":
+		m := new(v1.CurrencyPair)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion":
+		m := new(PriceDispersion)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceDispersion"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceDispersion does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CurrencyPairPriceDispersion) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairPriceDispersion", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CurrencyPairPriceDispersion) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairPriceDispersion) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CurrencyPairPriceDispersion) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CurrencyPairPriceDispersion) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CurrencyPairPriceDispersion)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.CurrencyPair != nil {
+			l = options.Size(x.CurrencyPair)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Dispersion != nil {
+			l = options.Size(x.Dispersion)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairPriceDispersion)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Dispersion != nil {
+			encoded, err := options.Marshal(x.Dispersion)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.CurrencyPair != nil {
+			encoded, err := options.Marshal(x.CurrencyPair)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CurrencyPairPriceDispersion)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairPriceDispersion: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairPriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CurrencyPair == nil {
+					x.CurrencyPair = &v1.CurrencyPair{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPair); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Dispersion == nil {
+					x.Dispersion = &PriceDispersion{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dispersion); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CurrencyPairPriceHistory_2_list)(nil)
+
+type _CurrencyPairPriceHistory_2_list struct {
+	list *[]*QuotePrice
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuotePrice)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QuotePrice)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) AppendMutable() protoreflect.Value {
+	v := new(QuotePrice)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) NewElement() protoreflect.Value {
+	v := new(QuotePrice)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_CurrencyPairPriceHistory_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CurrencyPairPriceHistory               protoreflect.MessageDescriptor
+	fd_CurrencyPairPriceHistory_currency_pair protoreflect.FieldDescriptor
+	fd_CurrencyPairPriceHistory_prices        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_genesis_proto_init()
+	md_CurrencyPairPriceHistory = File_slinky_oracle_v1_genesis_proto.Messages().ByName("CurrencyPairPriceHistory")
+	fd_CurrencyPairPriceHistory_currency_pair = md_CurrencyPairPriceHistory.Fields().ByName("currency_pair")
+	fd_CurrencyPairPriceHistory_prices = md_CurrencyPairPriceHistory.Fields().ByName("prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_CurrencyPairPriceHistory)(nil)
+
+type fastReflection_CurrencyPairPriceHistory CurrencyPairPriceHistory
+
+func (x *CurrencyPairPriceHistory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CurrencyPairPriceHistory)(x)
+}
+
+func (x *CurrencyPairPriceHistory) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CurrencyPairPriceHistory_messageType fastReflection_CurrencyPairPriceHistory_messageType
+var _ protoreflect.MessageType = fastReflection_CurrencyPairPriceHistory_messageType{}
+
+type fastReflection_CurrencyPairPriceHistory_messageType struct{}
+
+func (x fastReflection_CurrencyPairPriceHistory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CurrencyPairPriceHistory)(nil)
+}
+func (x fastReflection_CurrencyPairPriceHistory_messageType) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairPriceHistory)
+}
+func (x fastReflection_CurrencyPairPriceHistory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairPriceHistory
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CurrencyPairPriceHistory) Descriptor() protoreflect.MessageDescriptor {
+	return md_CurrencyPairPriceHistory
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CurrencyPairPriceHistory) Type() protoreflect.MessageType {
+	return _fastReflection_CurrencyPairPriceHistory_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CurrencyPairPriceHistory) New() protoreflect.Message {
+	return new(fastReflection_CurrencyPairPriceHistory)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CurrencyPairPriceHistory) Interface() protoreflect.ProtoMessage {
+	return (*CurrencyPairPriceHistory)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CurrencyPairPriceHistory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CurrencyPair != nil {
+		value := protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
+		if !f(fd_CurrencyPairPriceHistory_currency_pair, value) {
+			return
+		}
+	}
+	if len(x.Prices) != 0 {
+		value := protoreflect.ValueOfList(&_CurrencyPairPriceHistory_2_list{list: &x.Prices})
+		if !f(fd_CurrencyPairPriceHistory_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CurrencyPairPriceHistory) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair":
+		return x.CurrencyPair != nil
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.prices":
+		return len(x.Prices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceHistory"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CurrencyPairPriceHistory) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair":
+		x.CurrencyPair = nil
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.prices":
+		x.Prices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceHistory"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CurrencyPairPriceHistory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair":
+		value := x.CurrencyPair
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.prices":
+		if len(x.Prices) == 0 {
+			return protoreflect.ValueOfList(&_CurrencyPairPriceHistory_2_list{})
+		}
+		listValue := &_CurrencyPairPriceHistory_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceHistory"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceHistory does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1293,21 +4249,19 @@ func (x *fastReflection_CurrencyPairGenesis) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_CurrencyPairPriceHistory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair":
 		x.CurrencyPair = value.Message().Interface().(*v1.CurrencyPair)
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		x.CurrencyPairPrice = value.Message().Interface().(*QuotePrice)
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
-		x.Nonce = value.Uint()
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
-		x.Id = value.Uint()
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.prices":
+		lv := value.List()
+		clv := lv.(*_CurrencyPairPriceHistory_2_list)
+		x.Prices = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceHistory"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceHistory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1321,60 +4275,53 @@ func (x *fastReflection_CurrencyPairGenesis) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrencyPairPriceHistory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair":
 		if x.CurrencyPair == nil {
 			x.CurrencyPair = new(v1.CurrencyPair)
 		}
 		return protoreflect.ValueOfMessage(x.CurrencyPair.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		if x.CurrencyPairPrice == nil {
-			x.CurrencyPairPrice = new(QuotePrice)
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.prices":
+		if x.Prices == nil {
+			x.Prices = []*QuotePrice{}
 		}
-		return protoreflect.ValueOfMessage(x.CurrencyPairPrice.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
-		panic(fmt.Errorf("field nonce of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
-		panic(fmt.Errorf("field id of message slinky.oracle.v1.CurrencyPairGenesis is not mutable"))
+		value := &_CurrencyPairPriceHistory_2_list{list: &x.Prices}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceHistory"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceHistory does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CurrencyPairGenesis) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_CurrencyPairPriceHistory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair":
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair":
 		m := new(v1.CurrencyPair)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price":
-		m := new(QuotePrice)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "slinky.oracle.v1.CurrencyPairGenesis.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "slinky.oracle.v1.CurrencyPairGenesis.id":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.CurrencyPairPriceHistory.prices":
+		list := []*QuotePrice{}
+		return protoreflect.ValueOfList(&_CurrencyPairPriceHistory_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairGenesis"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.CurrencyPairPriceHistory"))
 		}
-		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairGenesis does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message slinky.oracle.v1.CurrencyPairPriceHistory does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CurrencyPairGenesis) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_CurrencyPairPriceHistory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairGenesis", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.CurrencyPairPriceHistory", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1382,7 +4329,7 @@ func (x *fastReflection_CurrencyPairGenesis) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CurrencyPairGenesis) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_CurrencyPairPriceHistory) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1393,7 +4340,7 @@ func (x *fastReflection_CurrencyPairGenesis) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CurrencyPairGenesis) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_CurrencyPairPriceHistory) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1405,7 +4352,7 @@ func (x *fastReflection_CurrencyPairGenesis) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CurrencyPairGenesis) IsValid() bool {
+func (x *fastReflection_CurrencyPairPriceHistory) IsValid() bool {
 	return x != nil
 }
 
@@ -1415,9 +4362,9 @@ func (x *fastReflection_CurrencyPairGenesis) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_CurrencyPairPriceHistory) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CurrencyPairGenesis)
+		x := input.Message.Interface().(*CurrencyPairPriceHistory)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1433,15 +4380,11 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			l = options.Size(x.CurrencyPair)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.CurrencyPairPrice != nil {
-			l = options.Size(x.CurrencyPairPrice)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
-		}
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
+		if len(x.Prices) > 0 {
+			for _, e := range x.Prices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1453,7 +4396,7 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairGenesis)
+		x := input.Message.Interface().(*CurrencyPairPriceHistory)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1472,29 +4415,21 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.CurrencyPairPrice != nil {
-			encoded, err := options.Marshal(x.CurrencyPairPrice)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Prices) > 0 {
+			for iNdEx := len(x.Prices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Prices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
 		if x.CurrencyPair != nil {
 			encoded, err := options.Marshal(x.CurrencyPair)
@@ -1521,7 +4456,7 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CurrencyPairGenesis)
+		x := input.Message.Interface().(*CurrencyPairPriceHistory)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1553,10 +4488,10 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairPriceHistory: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CurrencyPairPriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1597,7 +4532,7 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1624,51 +4559,11 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CurrencyPairPrice == nil {
-					x.CurrencyPairPrice = &QuotePrice{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CurrencyPairPrice); err != nil {
+				x.Prices = append(x.Prices, &QuotePrice{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Prices[len(x.Prices)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				x.Nonce = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1688,70 +4583,325 @@ func (x *fastReflection_CurrencyPairGenesis) ProtoMethods() *protoiface.Methods
 			}
 		}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GenesisState_1_list)(nil)
+
+type _GenesisState_1_list struct {
+	list *[]*CurrencyPairGenesis
+}
+
+func (x *_GenesisState_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_1_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairGenesis)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_1_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairGenesis)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*CurrencyPairPriceHistory
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairPriceHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairPriceHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairPriceHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairPriceHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*ValidatorReport
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorReport)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ValidatorReport)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(ValidatorReport)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(ValidatorReport)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*v1.CurrencyPair
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-var _ protoreflect.List = (*_GenesisState_1_list)(nil)
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-type _GenesisState_1_list struct {
-	list *[]*CurrencyPairGenesis
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CurrencyPair)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *_GenesisState_1_list) Len() int {
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CurrencyPair)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1.CurrencyPair)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(v1.CurrencyPair)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*CurrencyPairPriceDispersion
+}
+
+func (x *_GenesisState_7_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_GenesisState_1_list) Get(i int) protoreflect.Value {
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) Set(i int, value protoreflect.Value) {
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairPriceDispersion)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_GenesisState_1_list) Append(value protoreflect.Value) {
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*CurrencyPairGenesis)
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairPriceDispersion)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_GenesisState_1_list) AppendMutable() protoreflect.Value {
-	v := new(CurrencyPairGenesis)
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairPriceDispersion)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) Truncate(n int) {
+func (x *_GenesisState_7_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_GenesisState_1_list) NewElement() protoreflect.Value {
-	v := new(CurrencyPairGenesis)
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairPriceDispersion)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GenesisState_1_list) IsValid() bool {
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*CurrencyPairCircuitBreakerTrips
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairCircuitBreakerTrips)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CurrencyPairCircuitBreakerTrips)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(CurrencyPairCircuitBreakerTrips)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(CurrencyPairCircuitBreakerTrips)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
@@ -1759,6 +4909,12 @@ var (
 	md_GenesisState                       protoreflect.MessageDescriptor
 	fd_GenesisState_currency_pair_genesis protoreflect.FieldDescriptor
 	fd_GenesisState_next_id               protoreflect.FieldDescriptor
+	fd_GenesisState_price_history         protoreflect.FieldDescriptor
+	fd_GenesisState_params                protoreflect.FieldDescriptor
+	fd_GenesisState_validator_reports     protoreflect.FieldDescriptor
+	fd_GenesisState_stale_currency_pairs  protoreflect.FieldDescriptor
+	fd_GenesisState_price_dispersions     protoreflect.FieldDescriptor
+	fd_GenesisState_circuit_breaker_trips protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1766,6 +4922,12 @@ func init() {
 	md_GenesisState = File_slinky_oracle_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_currency_pair_genesis = md_GenesisState.Fields().ByName("currency_pair_genesis")
 	fd_GenesisState_next_id = md_GenesisState.Fields().ByName("next_id")
+	fd_GenesisState_price_history = md_GenesisState.Fields().ByName("price_history")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_validator_reports = md_GenesisState.Fields().ByName("validator_reports")
+	fd_GenesisState_stale_currency_pairs = md_GenesisState.Fields().ByName("stale_currency_pairs")
+	fd_GenesisState_price_dispersions = md_GenesisState.Fields().ByName("price_dispersions")
+	fd_GenesisState_circuit_breaker_trips = md_GenesisState.Fields().ByName("circuit_breaker_trips")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -1777,7 +4939,7 @@ func (x *GenesisState) ProtoReflect() protoreflect.Message {
 }
 
 func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
+	mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1845,6 +5007,42 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PriceHistory) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.PriceHistory})
+		if !f(fd_GenesisState_price_history, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.ValidatorReports) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ValidatorReports})
+		if !f(fd_GenesisState_validator_reports, value) {
+			return
+		}
+	}
+	if len(x.StaleCurrencyPairs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.StaleCurrencyPairs})
+		if !f(fd_GenesisState_stale_currency_pairs, value) {
+			return
+		}
+	}
+	if len(x.PriceDispersions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.PriceDispersions})
+		if !f(fd_GenesisState_price_dispersions, value) {
+			return
+		}
+	}
+	if len(x.CircuitBreakerTrips) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.CircuitBreakerTrips})
+		if !f(fd_GenesisState_circuit_breaker_trips, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1864,6 +5062,18 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.CurrencyPairGenesis) != 0
 	case "slinky.oracle.v1.GenesisState.next_id":
 		return x.NextId != uint64(0)
+	case "slinky.oracle.v1.GenesisState.price_history":
+		return len(x.PriceHistory) != 0
+	case "slinky.oracle.v1.GenesisState.params":
+		return x.Params != nil
+	case "slinky.oracle.v1.GenesisState.validator_reports":
+		return len(x.ValidatorReports) != 0
+	case "slinky.oracle.v1.GenesisState.stale_currency_pairs":
+		return len(x.StaleCurrencyPairs) != 0
+	case "slinky.oracle.v1.GenesisState.price_dispersions":
+		return len(x.PriceDispersions) != 0
+	case "slinky.oracle.v1.GenesisState.circuit_breaker_trips":
+		return len(x.CircuitBreakerTrips) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1884,6 +5094,18 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.CurrencyPairGenesis = nil
 	case "slinky.oracle.v1.GenesisState.next_id":
 		x.NextId = uint64(0)
+	case "slinky.oracle.v1.GenesisState.price_history":
+		x.PriceHistory = nil
+	case "slinky.oracle.v1.GenesisState.params":
+		x.Params = nil
+	case "slinky.oracle.v1.GenesisState.validator_reports":
+		x.ValidatorReports = nil
+	case "slinky.oracle.v1.GenesisState.stale_currency_pairs":
+		x.StaleCurrencyPairs = nil
+	case "slinky.oracle.v1.GenesisState.price_dispersions":
+		x.PriceDispersions = nil
+	case "slinky.oracle.v1.GenesisState.circuit_breaker_trips":
+		x.CircuitBreakerTrips = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1909,6 +5131,39 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "slinky.oracle.v1.GenesisState.next_id":
 		value := x.NextId
 		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.GenesisState.price_history":
+		if len(x.PriceHistory) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "slinky.oracle.v1.GenesisState.validator_reports":
+		if len(x.ValidatorReports) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ValidatorReports}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.stale_currency_pairs":
+		if len(x.StaleCurrencyPairs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.StaleCurrencyPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.price_dispersions":
+		if len(x.PriceDispersions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.PriceDispersions}
+		return protoreflect.ValueOfList(listValue)
+	case "slinky.oracle.v1.GenesisState.circuit_breaker_trips":
+		if len(x.CircuitBreakerTrips) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.CircuitBreakerTrips}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1935,6 +5190,28 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.CurrencyPairGenesis = *clv.list
 	case "slinky.oracle.v1.GenesisState.next_id":
 		x.NextId = value.Uint()
+	case "slinky.oracle.v1.GenesisState.price_history":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.PriceHistory = *clv.list
+	case "slinky.oracle.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "slinky.oracle.v1.GenesisState.validator_reports":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ValidatorReports = *clv.list
+	case "slinky.oracle.v1.GenesisState.stale_currency_pairs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.StaleCurrencyPairs = *clv.list
+	case "slinky.oracle.v1.GenesisState.price_dispersions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.PriceDispersions = *clv.list
+	case "slinky.oracle.v1.GenesisState.circuit_breaker_trips":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.CircuitBreakerTrips = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -1961,6 +5238,41 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_1_list{list: &x.CurrencyPairGenesis}
 		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.price_history":
+		if x.PriceHistory == nil {
+			x.PriceHistory = []*CurrencyPairPriceHistory{}
+		}
+		value := &_GenesisState_3_list{list: &x.PriceHistory}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "slinky.oracle.v1.GenesisState.validator_reports":
+		if x.ValidatorReports == nil {
+			x.ValidatorReports = []*ValidatorReport{}
+		}
+		value := &_GenesisState_5_list{list: &x.ValidatorReports}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.stale_currency_pairs":
+		if x.StaleCurrencyPairs == nil {
+			x.StaleCurrencyPairs = []*v1.CurrencyPair{}
+		}
+		value := &_GenesisState_6_list{list: &x.StaleCurrencyPairs}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.price_dispersions":
+		if x.PriceDispersions == nil {
+			x.PriceDispersions = []*CurrencyPairPriceDispersion{}
+		}
+		value := &_GenesisState_7_list{list: &x.PriceDispersions}
+		return protoreflect.ValueOfList(value)
+	case "slinky.oracle.v1.GenesisState.circuit_breaker_trips":
+		if x.CircuitBreakerTrips == nil {
+			x.CircuitBreakerTrips = []*CurrencyPairCircuitBreakerTrips{}
+		}
+		value := &_GenesisState_8_list{list: &x.CircuitBreakerTrips}
+		return protoreflect.ValueOfList(value)
 	case "slinky.oracle.v1.GenesisState.next_id":
 		panic(fmt.Errorf("field next_id of message slinky.oracle.v1.GenesisState is not mutable"))
 	default:
@@ -1981,6 +5293,24 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 		return protoreflect.ValueOfList(&_GenesisState_1_list{list: &list})
 	case "slinky.oracle.v1.GenesisState.next_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.GenesisState.price_history":
+		list := []*CurrencyPairPriceHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "slinky.oracle.v1.GenesisState.validator_reports":
+		list := []*ValidatorReport{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.stale_currency_pairs":
+		list := []*v1.CurrencyPair{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.price_dispersions":
+		list := []*CurrencyPairPriceDispersion{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "slinky.oracle.v1.GenesisState.circuit_breaker_trips":
+		list := []*CurrencyPairCircuitBreakerTrips{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.GenesisState"))
@@ -2059,6 +5389,40 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		if x.NextId != 0 {
 			n += 1 + runtime.Sov(uint64(x.NextId))
 		}
+		if len(x.PriceHistory) > 0 {
+			for _, e := range x.PriceHistory {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ValidatorReports) > 0 {
+			for _, e := range x.ValidatorReports {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.StaleCurrencyPairs) > 0 {
+			for _, e := range x.StaleCurrencyPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PriceDispersions) > 0 {
+			for _, e := range x.PriceDispersions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CircuitBreakerTrips) > 0 {
+			for _, e := range x.CircuitBreakerTrips {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2076,17 +5440,111 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CircuitBreakerTrips) > 0 {
+			for iNdEx := len(x.CircuitBreakerTrips) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CircuitBreakerTrips[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.PriceDispersions) > 0 {
+			for iNdEx := len(x.PriceDispersions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceDispersions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.StaleCurrencyPairs) > 0 {
+			for iNdEx := len(x.StaleCurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.StaleCurrencyPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.ValidatorReports) > 0 {
+			for iNdEx := len(x.ValidatorReports) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ValidatorReports[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.PriceHistory) > 0 {
+			for iNdEx := len(x.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PriceHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
 		if x.NextId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextId))
@@ -2211,6 +5669,212 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceHistory = append(x.PriceHistory, &CurrencyPairPriceHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceHistory[len(x.PriceHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ValidatorReports", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ValidatorReports = append(x.ValidatorReports, &ValidatorReport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ValidatorReports[len(x.ValidatorReports)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StaleCurrencyPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StaleCurrencyPairs = append(x.StaleCurrencyPairs, &v1.CurrencyPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StaleCurrencyPairs[len(x.StaleCurrencyPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceDispersions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceDispersions = append(x.PriceDispersions, &CurrencyPairPriceDispersion{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriceDispersions[len(x.PriceDispersions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerTrips", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CircuitBreakerTrips = append(x.CircuitBreakerTrips, &CurrencyPairCircuitBreakerTrips{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CircuitBreakerTrips[len(x.CircuitBreakerTrips)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2275,41 +5939,145 @@ type QuotePrice struct {
 	BlockHeight uint64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (x *QuotePrice) Reset() {
-	*x = QuotePrice{}
+func (x *QuotePrice) Reset() {
+	*x = QuotePrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuotePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePrice) ProtoMessage() {}
+
+// Deprecated: Use QuotePrice.ProtoReflect.Descriptor instead.
+func (*QuotePrice) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuotePrice) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *QuotePrice) GetBlockTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return nil
+}
+
+func (x *QuotePrice) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// PriceDispersion describes the spread of the prices reported by validators for
+// a CurrencyPair in the block in which its QuotePrice was last updated. All
+// prices are represented in the same decimals as the QuotePrice.
+type PriceDispersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Min is the lowest price reported by a validator.
+	Min string `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Max is the highest price reported by a validator.
+	Max string `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	// LowerQuartile is the stake-weighted 25th percentile of the reported
+	// prices.
+	LowerQuartile string `protobuf:"bytes,3,opt,name=lower_quartile,json=lowerQuartile,proto3" json:"lower_quartile,omitempty"`
+	// UpperQuartile is the stake-weighted 75th percentile of the reported
+	// prices.
+	UpperQuartile string `protobuf:"bytes,4,opt,name=upper_quartile,json=upperQuartile,proto3" json:"upper_quartile,omitempty"`
+	// NumValidators is the number of validators that reported a price.
+	NumValidators uint64 `protobuf:"varint,5,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+	// Power is the total voting power of the validators that reported a price.
+	Power string `protobuf:"bytes,6,opt,name=power,proto3" json:"power,omitempty"`
+	// TotalPower is the total voting power of the validator set.
+	TotalPower string `protobuf:"bytes,7,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	// BlockHeight is the height of the block in which the prices were reported.
+	BlockHeight uint64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *PriceDispersion) Reset() {
+	*x = PriceDispersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[0]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QuotePrice) String() string {
+func (x *PriceDispersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QuotePrice) ProtoMessage() {}
+func (*PriceDispersion) ProtoMessage() {}
 
-// Deprecated: Use QuotePrice.ProtoReflect.Descriptor instead.
-func (*QuotePrice) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PriceDispersion.ProtoReflect.Descriptor instead.
+func (*PriceDispersion) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *QuotePrice) GetPrice() string {
+func (x *PriceDispersion) GetMin() string {
 	if x != nil {
-		return x.Price
+		return x.Min
 	}
 	return ""
 }
 
-func (x *QuotePrice) GetBlockTimestamp() *timestamppb.Timestamp {
+func (x *PriceDispersion) GetMax() string {
 	if x != nil {
-		return x.BlockTimestamp
+		return x.Max
 	}
-	return nil
+	return ""
 }
 
-func (x *QuotePrice) GetBlockHeight() uint64 {
+func (x *PriceDispersion) GetLowerQuartile() string {
+	if x != nil {
+		return x.LowerQuartile
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetUpperQuartile() string {
+	if x != nil {
+		return x.UpperQuartile
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetNumValidators() uint64 {
+	if x != nil {
+		return x.NumValidators
+	}
+	return 0
+}
+
+func (x *PriceDispersion) GetPower() string {
+	if x != nil {
+		return x.Power
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetTotalPower() string {
+	if x != nil {
+		return x.TotalPower
+	}
+	return ""
+}
+
+func (x *PriceDispersion) GetBlockHeight() uint64 {
 	if x != nil {
 		return x.BlockHeight
 	}
@@ -2335,7 +6103,7 @@ type CurrencyPairState struct {
 func (x *CurrencyPairState) Reset() {
 	*x = CurrencyPairState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[1]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2349,7 +6117,7 @@ func (*CurrencyPairState) ProtoMessage() {}
 
 // Deprecated: Use CurrencyPairState.ProtoReflect.Descriptor instead.
 func (*CurrencyPairState) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{1}
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CurrencyPairState) GetPrice() *QuotePrice {
@@ -2395,7 +6163,7 @@ type CurrencyPairGenesis struct {
 func (x *CurrencyPairGenesis) Reset() {
 	*x = CurrencyPairGenesis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[2]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2409,7 +6177,7 @@ func (*CurrencyPairGenesis) ProtoMessage() {}
 
 // Deprecated: Use CurrencyPairGenesis.ProtoReflect.Descriptor instead.
 func (*CurrencyPairGenesis) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{2}
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CurrencyPairGenesis) GetCurrencyPair() *v1.CurrencyPair {
@@ -2440,6 +6208,196 @@ func (x *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+// CircuitBreakerTrips is the number of consecutive prices for a CurrencyPair
+// that the circuit breaker rejected for moving in the same direction from its
+// reference price.
+type CircuitBreakerTrips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Upward is true if the rejected prices were above the reference price.
+	Upward bool `protobuf:"varint,1,opt,name=upward,proto3" json:"upward,omitempty"`
+	// Count is the number of consecutive rejected prices.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CircuitBreakerTrips) Reset() {
+	*x = CircuitBreakerTrips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CircuitBreakerTrips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CircuitBreakerTrips) ProtoMessage() {}
+
+// Deprecated: Use CircuitBreakerTrips.ProtoReflect.Descriptor instead.
+func (*CircuitBreakerTrips) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CircuitBreakerTrips) GetUpward() bool {
+	if x != nil {
+		return x.Upward
+	}
+	return false
+}
+
+func (x *CircuitBreakerTrips) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// CurrencyPairCircuitBreakerTrips is the stored CircuitBreakerTrips of a
+// CurrencyPair.
+type CurrencyPairCircuitBreakerTrips struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the pair that the trips belong to.
+	CurrencyPair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Trips is the consecutive circuit breaker rejections of the CurrencyPair.
+	Trips *CircuitBreakerTrips `protobuf:"bytes,2,opt,name=trips,proto3" json:"trips,omitempty"`
+}
+
+func (x *CurrencyPairCircuitBreakerTrips) Reset() {
+	*x = CurrencyPairCircuitBreakerTrips{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyPairCircuitBreakerTrips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPairCircuitBreakerTrips) ProtoMessage() {}
+
+// Deprecated: Use CurrencyPairCircuitBreakerTrips.ProtoReflect.Descriptor instead.
+func (*CurrencyPairCircuitBreakerTrips) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CurrencyPairCircuitBreakerTrips) GetCurrencyPair() *v1.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *CurrencyPairCircuitBreakerTrips) GetTrips() *CircuitBreakerTrips {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+// CurrencyPairPriceDispersion is the stored PriceDispersion of a CurrencyPair.
+type CurrencyPairPriceDispersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the pair that the dispersion belongs to.
+	CurrencyPair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Dispersion is the dispersion of the validator prices that the
+	// CurrencyPair's price was aggregated from.
+	Dispersion *PriceDispersion `protobuf:"bytes,2,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+}
+
+func (x *CurrencyPairPriceDispersion) Reset() {
+	*x = CurrencyPairPriceDispersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyPairPriceDispersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPairPriceDispersion) ProtoMessage() {}
+
+// Deprecated: Use CurrencyPairPriceDispersion.ProtoReflect.Descriptor instead.
+func (*CurrencyPairPriceDispersion) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CurrencyPairPriceDispersion) GetCurrencyPair() *v1.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *CurrencyPairPriceDispersion) GetDispersion() *PriceDispersion {
+	if x != nil {
+		return x.Dispersion
+	}
+	return nil
+}
+
+// CurrencyPairPriceHistory is the bounded set of historical QuotePrices stored
+// for a CurrencyPair, ordered from oldest to newest.
+type CurrencyPairPriceHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CurrencyPair is the pair that the history belongs to.
+	CurrencyPair *v1.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Prices is the list of historical QuotePrices for the CurrencyPair, ordered
+	// by ascending block height.
+	Prices []*QuotePrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+}
+
+func (x *CurrencyPairPriceHistory) Reset() {
+	*x = CurrencyPairPriceHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyPairPriceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyPairPriceHistory) ProtoMessage() {}
+
+// Deprecated: Use CurrencyPairPriceHistory.ProtoReflect.Descriptor instead.
+func (*CurrencyPairPriceHistory) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CurrencyPairPriceHistory) GetCurrencyPair() *v1.CurrencyPair {
+	if x != nil {
+		return x.CurrencyPair
+	}
+	return nil
+}
+
+func (x *CurrencyPairPriceHistory) GetPrices() []*QuotePrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -2453,12 +6411,28 @@ type GenesisState struct {
 	CurrencyPairGenesis []*CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis,omitempty"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// PriceHistory is the set of historical prices stored per CurrencyPair.
+	PriceHistory []*CurrencyPairPriceHistory `protobuf:"bytes,3,rep,name=price_history,json=priceHistory,proto3" json:"price_history,omitempty"`
+	// Params are the parameters for the x/oracle module.
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	// ValidatorReports is the set of validator reports within the performance
+	// window.
+	ValidatorReports []*ValidatorReport `protobuf:"bytes,5,rep,name=validator_reports,json=validatorReports,proto3" json:"validator_reports,omitempty"`
+	// StaleCurrencyPairs is the set of CurrencyPairs whose prices are currently
+	// stale.
+	StaleCurrencyPairs []*v1.CurrencyPair `protobuf:"bytes,6,rep,name=stale_currency_pairs,json=staleCurrencyPairs,proto3" json:"stale_currency_pairs,omitempty"`
+	// PriceDispersions is the set of stored price dispersions of each
+	// CurrencyPair.
+	PriceDispersions []*CurrencyPairPriceDispersion `protobuf:"bytes,7,rep,name=price_dispersions,json=priceDispersions,proto3" json:"price_dispersions,omitempty"`
+	// CircuitBreakerTrips is the set of consecutive circuit breaker rejections
+	// of each CurrencyPair.
+	CircuitBreakerTrips []*CurrencyPairCircuitBreakerTrips `protobuf:"bytes,8,rep,name=circuit_breaker_trips,json=circuitBreakerTrips,proto3" json:"circuit_breaker_trips,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
 	*x = GenesisState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[3]
+		mi := &file_slinky_oracle_v1_genesis_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2472,7 +6446,7 @@ func (*GenesisState) ProtoMessage() {}
 
 // Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{3}
+	return file_slinky_oracle_v1_genesis_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GenesisState) GetCurrencyPairGenesis() []*CurrencyPairGenesis {
@@ -2489,6 +6463,48 @@ func (x *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (x *GenesisState) GetPriceHistory() []*CurrencyPairPriceHistory {
+	if x != nil {
+		return x.PriceHistory
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetValidatorReports() []*ValidatorReport {
+	if x != nil {
+		return x.ValidatorReports
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStaleCurrencyPairs() []*v1.CurrencyPair {
+	if x != nil {
+		return x.StaleCurrencyPairs
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPriceDispersions() []*CurrencyPairPriceDispersion {
+	if x != nil {
+		return x.PriceDispersions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCircuitBreakerTrips() []*CurrencyPairCircuitBreakerTrips {
+	if x != nil {
+		return x.CircuitBreakerTrips
+	}
+	return nil
+}
+
 var File_slinky_oracle_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
@@ -2502,61 +6518,168 @@ var file_slinky_oracle_v1_genesis_proto_rawDesc = []byte{
 	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x74, 0x79, 0x70,
 	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
-	0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x51, 0x75,
-	0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x73, 0x0a,
-	0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
-	0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
-	0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
-	0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x5f, 0x0a, 0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72,
-	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8,
+	0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x92, 0x04, 0x0a, 0x0f, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x3d, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x52, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x72, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0d, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x51, 0x75, 0x61, 0x72, 0x74, 0x69, 0x6c, 0x65,
+	0x12, 0x52, 0x0a, 0x0e, 0x75, 0x70, 0x70, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61, 0x72, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x75, 0x70, 0x70, 0x65, 0x72, 0x51, 0x75, 0x61, 0x72,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x05, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x4c,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x73, 0x0a, 0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x48, 0x0a, 0x0d,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x52, 0x0a, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x43, 0x0a, 0x13, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x54, 0x72, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x77, 0x61, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x70, 0x77, 0x61, 0x72, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x1f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x54, 0x72, 0x69, 0x70, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50,
+	0x61, 0x69, 0x72, 0x12, 0x41, 0x0a, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x54, 0x72, 0x69, 0x70, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x1b, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x47, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x18, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x3a, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x93, 0x05, 0x0a,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5f, 0x0a,
+	0x15, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73,
+	0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
 	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6e, 0x65, 0x78, 0x74, 0x49, 0x64, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f,
-	0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63,
-	0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72,
-	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
-	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69,
-	0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e,
-	0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x36,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x55, 0x0a, 0x14,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70,
+	0x61, 0x69, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69,
+	0x6e, 0x6b, 0x79, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x12, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x11, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x73,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x61, 0x69, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x70, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x69, 0x73, 0x70, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6b, 0x0a, 0x15, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74,
+	0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x61, 0x69, 0x72, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x54, 0x72, 0x69, 0x70, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x54, 0x72, 0x69,
+	0x70, 0x73, 0x42, 0xb2, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b,
+	0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2a, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x6c,
+	0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6f,
+	0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f, 0x58, 0xaa, 0x02, 0x10,
+	0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a, 0x3a, 0x4f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2571,26 +6694,45 @@ func file_slinky_oracle_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_slinky_oracle_v1_genesis_proto_rawDescData
 }
 
-var file_slinky_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_slinky_oracle_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_slinky_oracle_v1_genesis_proto_goTypes = []interface{}{
-	(*QuotePrice)(nil),            // 0: slinky.oracle.v1.QuotePrice
-	(*CurrencyPairState)(nil),     // 1: slinky.oracle.v1.CurrencyPairState
-	(*CurrencyPairGenesis)(nil),   // 2: slinky.oracle.v1.CurrencyPairGenesis
-	(*GenesisState)(nil),          // 3: slinky.oracle.v1.GenesisState
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*v1.CurrencyPair)(nil),       // 5: slinky.types.v1.CurrencyPair
+	(*QuotePrice)(nil),                      // 0: slinky.oracle.v1.QuotePrice
+	(*PriceDispersion)(nil),                 // 1: slinky.oracle.v1.PriceDispersion
+	(*CurrencyPairState)(nil),               // 2: slinky.oracle.v1.CurrencyPairState
+	(*CurrencyPairGenesis)(nil),             // 3: slinky.oracle.v1.CurrencyPairGenesis
+	(*CircuitBreakerTrips)(nil),             // 4: slinky.oracle.v1.CircuitBreakerTrips
+	(*CurrencyPairCircuitBreakerTrips)(nil), // 5: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips
+	(*CurrencyPairPriceDispersion)(nil),     // 6: slinky.oracle.v1.CurrencyPairPriceDispersion
+	(*CurrencyPairPriceHistory)(nil),        // 7: slinky.oracle.v1.CurrencyPairPriceHistory
+	(*GenesisState)(nil),                    // 8: slinky.oracle.v1.GenesisState
+	(*timestamppb.Timestamp)(nil),           // 9: google.protobuf.Timestamp
+	(*v1.CurrencyPair)(nil),                 // 10: slinky.types.v1.CurrencyPair
+	(*Params)(nil),                          // 11: slinky.oracle.v1.Params
+	(*ValidatorReport)(nil),                 // 12: slinky.oracle.v1.ValidatorReport
 }
 var file_slinky_oracle_v1_genesis_proto_depIdxs = []int32{
-	4, // 0: slinky.oracle.v1.QuotePrice.block_timestamp:type_name -> google.protobuf.Timestamp
-	0, // 1: slinky.oracle.v1.CurrencyPairState.price:type_name -> slinky.oracle.v1.QuotePrice
-	5, // 2: slinky.oracle.v1.CurrencyPairGenesis.currency_pair:type_name -> slinky.types.v1.CurrencyPair
-	0, // 3: slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price:type_name -> slinky.oracle.v1.QuotePrice
-	2, // 4: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	9,  // 0: slinky.oracle.v1.QuotePrice.block_timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 1: slinky.oracle.v1.CurrencyPairState.price:type_name -> slinky.oracle.v1.QuotePrice
+	10, // 2: slinky.oracle.v1.CurrencyPairGenesis.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	0,  // 3: slinky.oracle.v1.CurrencyPairGenesis.currency_pair_price:type_name -> slinky.oracle.v1.QuotePrice
+	10, // 4: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	4,  // 5: slinky.oracle.v1.CurrencyPairCircuitBreakerTrips.trips:type_name -> slinky.oracle.v1.CircuitBreakerTrips
+	10, // 6: slinky.oracle.v1.CurrencyPairPriceDispersion.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	1,  // 7: slinky.oracle.v1.CurrencyPairPriceDispersion.dispersion:type_name -> slinky.oracle.v1.PriceDispersion
+	10, // 8: slinky.oracle.v1.CurrencyPairPriceHistory.currency_pair:type_name -> slinky.types.v1.CurrencyPair
+	0,  // 9: slinky.oracle.v1.CurrencyPairPriceHistory.prices:type_name -> slinky.oracle.v1.QuotePrice
+	3,  // 10: slinky.oracle.v1.GenesisState.currency_pair_genesis:type_name -> slinky.oracle.v1.CurrencyPairGenesis
+	7,  // 11: slinky.oracle.v1.GenesisState.price_history:type_name -> slinky.oracle.v1.CurrencyPairPriceHistory
+	11, // 12: slinky.oracle.v1.GenesisState.params:type_name -> slinky.oracle.v1.Params
+	12, // 13: slinky.oracle.v1.GenesisState.validator_reports:type_name -> slinky.oracle.v1.ValidatorReport
+	10, // 14: slinky.oracle.v1.GenesisState.stale_currency_pairs:type_name -> slinky.types.v1.CurrencyPair
+	6,  // 15: slinky.oracle.v1.GenesisState.price_dispersions:type_name -> slinky.oracle.v1.CurrencyPairPriceDispersion
+	5,  // 16: slinky.oracle.v1.GenesisState.circuit_breaker_trips:type_name -> slinky.oracle.v1.CurrencyPairCircuitBreakerTrips
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_slinky_oracle_v1_genesis_proto_init() }
@@ -2598,6 +6740,8 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 	if File_slinky_oracle_v1_genesis_proto != nil {
 		return
 	}
+	file_slinky_oracle_v1_params_proto_init()
+	file_slinky_oracle_v1_validator_performance_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_slinky_oracle_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuotePrice); i {
@@ -2612,7 +6756,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			}
 		}
 		file_slinky_oracle_v1_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyPairState); i {
+			switch v := v.(*PriceDispersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2624,7 +6768,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			}
 		}
 		file_slinky_oracle_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrencyPairGenesis); i {
+			switch v := v.(*CurrencyPairState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2636,6 +6780,66 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			}
 		}
 		file_slinky_oracle_v1_genesis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CircuitBreakerTrips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairCircuitBreakerTrips); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairPriceDispersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyPairPriceHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_genesis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
 			case 0:
 				return &v.state
@@ -2654,7 +6858,7 @@ func file_slinky_oracle_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_slinky_oracle_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -24,6 +24,11 @@ const (
 	Query_GetPrices_FullMethodName                  = "/slinky.oracle.v1.Query/GetPrices"
 	Query_GetCurrencyPairMapping_FullMethodName     = "/slinky.oracle.v1.Query/GetCurrencyPairMapping"
 	Query_GetCurrencyPairMappingList_FullMethodName = "/slinky.oracle.v1.Query/GetCurrencyPairMappingList"
+	Query_GetPriceHistory_FullMethodName            = "/slinky.oracle.v1.Query/GetPriceHistory"
+	Query_GetTWAP_FullMethodName                    = "/slinky.oracle.v1.Query/GetTWAP"
+	Query_Params_FullMethodName                     = "/slinky.oracle.v1.Query/Params"
+	Query_ValidatorPerformance_FullMethodName       = "/slinky.oracle.v1.Query/ValidatorPerformance"
+	Query_ValidatorPerformances_FullMethodName      = "/slinky.oracle.v1.Query/ValidatorPerformances"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +51,19 @@ type QueryClient interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Given a CurrencyPair return the historical QuotePrices stored for it,
+	// ordered from oldest to newest.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window (either a number of blocks or a
+	// duration), return the time-weighted average price over that window.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Get the oracle performance of a validator over the performance window.
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	// Get the oracle performance of every validator with reports in the
+	// performance window.
+	ValidatorPerformances(ctx context.Context, in *ValidatorPerformancesRequest, opts ...grpc.CallOption) (*ValidatorPerformancesResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +124,56 @@ func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCur
 	return out, nil
 }
 
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, Query_GetTWAP_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPerformance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPerformances(ctx context.Context, in *ValidatorPerformancesRequest, opts ...grpc.CallOption) (*ValidatorPerformancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatorPerformancesResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPerformances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -126,6 +194,19 @@ type QueryServer interface {
 	// useful for indexers that have access to the ID of a currency pair, but no
 	// way to get the underlying currency pair from it.
 	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Given a CurrencyPair return the historical QuotePrices stored for it,
+	// ordered from oldest to newest.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window (either a number of blocks or a
+	// duration), return the time-weighted average price over that window.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Get the oracle performance of a validator over the performance window.
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	// Get the oracle performance of every validator with reports in the
+	// performance window.
+	ValidatorPerformances(context.Context, *ValidatorPerformancesRequest) (*ValidatorPerformancesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -151,6 +232,21 @@ func (UnimplementedQueryServer) GetCurrencyPairMapping(context.Context, *GetCurr
 func (UnimplementedQueryServer) GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
 }
+func (UnimplementedQueryServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (UnimplementedQueryServer) ValidatorPerformances(context.Context, *ValidatorPerformancesRequest) (*ValidatorPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformances not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -262,6 +358,96 @@ func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTWAP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPerformances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformances(ctx, req.(*ValidatorPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -289,6 +475,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyPairMappingList",
 			Handler:    _Query_GetCurrencyPairMappingList_Handler,
 		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformances",
+			Handler:    _Query_ValidatorPerformances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/query.proto",
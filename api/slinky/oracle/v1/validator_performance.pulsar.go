@@ -0,0 +1,1705 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package oraclev1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ValidatorReport                 protoreflect.MessageDescriptor
+	fd_ValidatorReport_validator       protoreflect.FieldDescriptor
+	fd_ValidatorReport_height          protoreflect.FieldDescriptor
+	fd_ValidatorReport_absent          protoreflect.FieldDescriptor
+	fd_ValidatorReport_missing_prices  protoreflect.FieldDescriptor
+	fd_ValidatorReport_reported_prices protoreflect.FieldDescriptor
+	fd_ValidatorReport_deviation       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_validator_performance_proto_init()
+	md_ValidatorReport = File_slinky_oracle_v1_validator_performance_proto.Messages().ByName("ValidatorReport")
+	fd_ValidatorReport_validator = md_ValidatorReport.Fields().ByName("validator")
+	fd_ValidatorReport_height = md_ValidatorReport.Fields().ByName("height")
+	fd_ValidatorReport_absent = md_ValidatorReport.Fields().ByName("absent")
+	fd_ValidatorReport_missing_prices = md_ValidatorReport.Fields().ByName("missing_prices")
+	fd_ValidatorReport_reported_prices = md_ValidatorReport.Fields().ByName("reported_prices")
+	fd_ValidatorReport_deviation = md_ValidatorReport.Fields().ByName("deviation")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorReport)(nil)
+
+type fastReflection_ValidatorReport ValidatorReport
+
+func (x *ValidatorReport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorReport)(x)
+}
+
+func (x *ValidatorReport) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_validator_performance_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorReport_messageType fastReflection_ValidatorReport_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorReport_messageType{}
+
+type fastReflection_ValidatorReport_messageType struct{}
+
+func (x fastReflection_ValidatorReport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorReport)(nil)
+}
+func (x fastReflection_ValidatorReport_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReport)
+}
+func (x fastReflection_ValidatorReport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorReport) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorReport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorReport) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorReport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorReport) New() protoreflect.Message {
+	return new(fastReflection_ValidatorReport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorReport) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorReport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorReport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorReport_validator, value) {
+			return
+		}
+	}
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_ValidatorReport_height, value) {
+			return
+		}
+	}
+	if x.Absent != false {
+		value := protoreflect.ValueOfBool(x.Absent)
+		if !f(fd_ValidatorReport_absent, value) {
+			return
+		}
+	}
+	if x.MissingPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MissingPrices)
+		if !f(fd_ValidatorReport_missing_prices, value) {
+			return
+		}
+	}
+	if x.ReportedPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportedPrices)
+		if !f(fd_ValidatorReport_reported_prices, value) {
+			return
+		}
+	}
+	if x.Deviation != "" {
+		value := protoreflect.ValueOfString(x.Deviation)
+		if !f(fd_ValidatorReport_deviation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorReport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorReport.validator":
+		return x.Validator != ""
+	case "slinky.oracle.v1.ValidatorReport.height":
+		return x.Height != uint64(0)
+	case "slinky.oracle.v1.ValidatorReport.absent":
+		return x.Absent != false
+	case "slinky.oracle.v1.ValidatorReport.missing_prices":
+		return x.MissingPrices != uint64(0)
+	case "slinky.oracle.v1.ValidatorReport.reported_prices":
+		return x.ReportedPrices != uint64(0)
+	case "slinky.oracle.v1.ValidatorReport.deviation":
+		return x.Deviation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorReport.validator":
+		x.Validator = ""
+	case "slinky.oracle.v1.ValidatorReport.height":
+		x.Height = uint64(0)
+	case "slinky.oracle.v1.ValidatorReport.absent":
+		x.Absent = false
+	case "slinky.oracle.v1.ValidatorReport.missing_prices":
+		x.MissingPrices = uint64(0)
+	case "slinky.oracle.v1.ValidatorReport.reported_prices":
+		x.ReportedPrices = uint64(0)
+	case "slinky.oracle.v1.ValidatorReport.deviation":
+		x.Deviation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorReport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.ValidatorReport.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.ValidatorReport.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorReport.absent":
+		value := x.Absent
+		return protoreflect.ValueOfBool(value)
+	case "slinky.oracle.v1.ValidatorReport.missing_prices":
+		value := x.MissingPrices
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorReport.reported_prices":
+		value := x.ReportedPrices
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorReport.deviation":
+		value := x.Deviation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorReport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorReport.validator":
+		x.Validator = value.Interface().(string)
+	case "slinky.oracle.v1.ValidatorReport.height":
+		x.Height = value.Uint()
+	case "slinky.oracle.v1.ValidatorReport.absent":
+		x.Absent = value.Bool()
+	case "slinky.oracle.v1.ValidatorReport.missing_prices":
+		x.MissingPrices = value.Uint()
+	case "slinky.oracle.v1.ValidatorReport.reported_prices":
+		x.ReportedPrices = value.Uint()
+	case "slinky.oracle.v1.ValidatorReport.deviation":
+		x.Deviation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorReport.validator":
+		panic(fmt.Errorf("field validator of message slinky.oracle.v1.ValidatorReport is not mutable"))
+	case "slinky.oracle.v1.ValidatorReport.height":
+		panic(fmt.Errorf("field height of message slinky.oracle.v1.ValidatorReport is not mutable"))
+	case "slinky.oracle.v1.ValidatorReport.absent":
+		panic(fmt.Errorf("field absent of message slinky.oracle.v1.ValidatorReport is not mutable"))
+	case "slinky.oracle.v1.ValidatorReport.missing_prices":
+		panic(fmt.Errorf("field missing_prices of message slinky.oracle.v1.ValidatorReport is not mutable"))
+	case "slinky.oracle.v1.ValidatorReport.reported_prices":
+		panic(fmt.Errorf("field reported_prices of message slinky.oracle.v1.ValidatorReport is not mutable"))
+	case "slinky.oracle.v1.ValidatorReport.deviation":
+		panic(fmt.Errorf("field deviation of message slinky.oracle.v1.ValidatorReport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorReport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorReport.validator":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.ValidatorReport.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorReport.absent":
+		return protoreflect.ValueOfBool(false)
+	case "slinky.oracle.v1.ValidatorReport.missing_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorReport.reported_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorReport.deviation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorReport"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorReport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorReport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.ValidatorReport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorReport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorReport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorReport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorReport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorReport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Absent {
+			n += 2
+		}
+		if x.MissingPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.MissingPrices))
+		}
+		if x.ReportedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportedPrices))
+		}
+		l = len(x.Deviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorReport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Deviation) > 0 {
+			i -= len(x.Deviation)
+			copy(dAtA[i:], x.Deviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deviation)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.ReportedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportedPrices))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MissingPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MissingPrices))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Absent {
+			i--
+			if x.Absent {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorReport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorReport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Absent", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Absent = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MissingPrices", wireType)
+				}
+				x.MissingPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MissingPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportedPrices", wireType)
+				}
+				x.ReportedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportedPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ValidatorPerformance                     protoreflect.MessageDescriptor
+	fd_ValidatorPerformance_validator           protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_blocks          protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_absent          protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_missing_prices  protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_num_reported_prices protoreflect.FieldDescriptor
+	fd_ValidatorPerformance_total_deviation     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_slinky_oracle_v1_validator_performance_proto_init()
+	md_ValidatorPerformance = File_slinky_oracle_v1_validator_performance_proto.Messages().ByName("ValidatorPerformance")
+	fd_ValidatorPerformance_validator = md_ValidatorPerformance.Fields().ByName("validator")
+	fd_ValidatorPerformance_num_blocks = md_ValidatorPerformance.Fields().ByName("num_blocks")
+	fd_ValidatorPerformance_num_absent = md_ValidatorPerformance.Fields().ByName("num_absent")
+	fd_ValidatorPerformance_num_missing_prices = md_ValidatorPerformance.Fields().ByName("num_missing_prices")
+	fd_ValidatorPerformance_num_reported_prices = md_ValidatorPerformance.Fields().ByName("num_reported_prices")
+	fd_ValidatorPerformance_total_deviation = md_ValidatorPerformance.Fields().ByName("total_deviation")
+}
+
+var _ protoreflect.Message = (*fastReflection_ValidatorPerformance)(nil)
+
+type fastReflection_ValidatorPerformance ValidatorPerformance
+
+func (x *ValidatorPerformance) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(x)
+}
+
+func (x *ValidatorPerformance) slowProtoReflect() protoreflect.Message {
+	mi := &file_slinky_oracle_v1_validator_performance_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ValidatorPerformance_messageType fastReflection_ValidatorPerformance_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorPerformance_messageType{}
+
+type fastReflection_ValidatorPerformance_messageType struct{}
+
+func (x fastReflection_ValidatorPerformance_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorPerformance)(nil)
+}
+func (x fastReflection_ValidatorPerformance_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
+}
+func (x fastReflection_ValidatorPerformance_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorPerformance) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorPerformance
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorPerformance) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorPerformance_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorPerformance) New() protoreflect.Message {
+	return new(fastReflection_ValidatorPerformance)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorPerformance) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorPerformance)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorPerformance) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Validator != "" {
+		value := protoreflect.ValueOfString(x.Validator)
+		if !f(fd_ValidatorPerformance_validator, value) {
+			return
+		}
+	}
+	if x.NumBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumBlocks)
+		if !f(fd_ValidatorPerformance_num_blocks, value) {
+			return
+		}
+	}
+	if x.NumAbsent != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumAbsent)
+		if !f(fd_ValidatorPerformance_num_absent, value) {
+			return
+		}
+	}
+	if x.NumMissingPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumMissingPrices)
+		if !f(fd_ValidatorPerformance_num_missing_prices, value) {
+			return
+		}
+	}
+	if x.NumReportedPrices != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumReportedPrices)
+		if !f(fd_ValidatorPerformance_num_reported_prices, value) {
+			return
+		}
+	}
+	if x.TotalDeviation != "" {
+		value := protoreflect.ValueOfString(x.TotalDeviation)
+		if !f(fd_ValidatorPerformance_total_deviation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorPerformance) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPerformance.validator":
+		return x.Validator != ""
+	case "slinky.oracle.v1.ValidatorPerformance.num_blocks":
+		return x.NumBlocks != uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.num_absent":
+		return x.NumAbsent != uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.num_missing_prices":
+		return x.NumMissingPrices != uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.num_reported_prices":
+		return x.NumReportedPrices != uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.total_deviation":
+		return x.TotalDeviation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPerformance.validator":
+		x.Validator = ""
+	case "slinky.oracle.v1.ValidatorPerformance.num_blocks":
+		x.NumBlocks = uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.num_absent":
+		x.NumAbsent = uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.num_missing_prices":
+		x.NumMissingPrices = uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.num_reported_prices":
+		x.NumReportedPrices = uint64(0)
+	case "slinky.oracle.v1.ValidatorPerformance.total_deviation":
+		x.TotalDeviation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorPerformance) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "slinky.oracle.v1.ValidatorPerformance.validator":
+		value := x.Validator
+		return protoreflect.ValueOfString(value)
+	case "slinky.oracle.v1.ValidatorPerformance.num_blocks":
+		value := x.NumBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPerformance.num_absent":
+		value := x.NumAbsent
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPerformance.num_missing_prices":
+		value := x.NumMissingPrices
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPerformance.num_reported_prices":
+		value := x.NumReportedPrices
+		return protoreflect.ValueOfUint64(value)
+	case "slinky.oracle.v1.ValidatorPerformance.total_deviation":
+		value := x.TotalDeviation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPerformance does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPerformance.validator":
+		x.Validator = value.Interface().(string)
+	case "slinky.oracle.v1.ValidatorPerformance.num_blocks":
+		x.NumBlocks = value.Uint()
+	case "slinky.oracle.v1.ValidatorPerformance.num_absent":
+		x.NumAbsent = value.Uint()
+	case "slinky.oracle.v1.ValidatorPerformance.num_missing_prices":
+		x.NumMissingPrices = value.Uint()
+	case "slinky.oracle.v1.ValidatorPerformance.num_reported_prices":
+		x.NumReportedPrices = value.Uint()
+	case "slinky.oracle.v1.ValidatorPerformance.total_deviation":
+		x.TotalDeviation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPerformance.validator":
+		panic(fmt.Errorf("field validator of message slinky.oracle.v1.ValidatorPerformance is not mutable"))
+	case "slinky.oracle.v1.ValidatorPerformance.num_blocks":
+		panic(fmt.Errorf("field num_blocks of message slinky.oracle.v1.ValidatorPerformance is not mutable"))
+	case "slinky.oracle.v1.ValidatorPerformance.num_absent":
+		panic(fmt.Errorf("field num_absent of message slinky.oracle.v1.ValidatorPerformance is not mutable"))
+	case "slinky.oracle.v1.ValidatorPerformance.num_missing_prices":
+		panic(fmt.Errorf("field num_missing_prices of message slinky.oracle.v1.ValidatorPerformance is not mutable"))
+	case "slinky.oracle.v1.ValidatorPerformance.num_reported_prices":
+		panic(fmt.Errorf("field num_reported_prices of message slinky.oracle.v1.ValidatorPerformance is not mutable"))
+	case "slinky.oracle.v1.ValidatorPerformance.total_deviation":
+		panic(fmt.Errorf("field total_deviation of message slinky.oracle.v1.ValidatorPerformance is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorPerformance) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "slinky.oracle.v1.ValidatorPerformance.validator":
+		return protoreflect.ValueOfString("")
+	case "slinky.oracle.v1.ValidatorPerformance.num_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPerformance.num_absent":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPerformance.num_missing_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPerformance.num_reported_prices":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "slinky.oracle.v1.ValidatorPerformance.total_deviation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: slinky.oracle.v1.ValidatorPerformance"))
+		}
+		panic(fmt.Errorf("message slinky.oracle.v1.ValidatorPerformance does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorPerformance) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in slinky.oracle.v1.ValidatorPerformance", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorPerformance) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorPerformance) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorPerformance) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorPerformance) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Validator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumBlocks))
+		}
+		if x.NumAbsent != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumAbsent))
+		}
+		if x.NumMissingPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumMissingPrices))
+		}
+		if x.NumReportedPrices != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumReportedPrices))
+		}
+		l = len(x.TotalDeviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalDeviation) > 0 {
+			i -= len(x.TotalDeviation)
+			copy(dAtA[i:], x.TotalDeviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalDeviation)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.NumReportedPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumReportedPrices))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.NumMissingPrices != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumMissingPrices))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.NumAbsent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumAbsent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.NumBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumBlocks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Validator) > 0 {
+			i -= len(x.Validator)
+			copy(dAtA[i:], x.Validator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Validator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorPerformance)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Validator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+				}
+				x.NumBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumAbsent", wireType)
+				}
+				x.NumAbsent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumAbsent |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumMissingPrices", wireType)
+				}
+				x.NumMissingPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumMissingPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumReportedPrices", wireType)
+				}
+				x.NumReportedPrices = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumReportedPrices |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalDeviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalDeviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: slinky/oracle/v1/validator_performance.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorReport is the oracle report of a single validator for a single
+// block, as derived from the validator's vote extension.
+type ValidatorReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Height is the block height at which the prices aggregated from the
+	// validator's vote extension were written to state.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Absent is true if the validator's vote was not included in the commit for
+	// the block.
+	Absent bool `protobuf:"varint,3,opt,name=absent,proto3" json:"absent,omitempty"`
+	// MissingPrices is the number of currency-pairs for which the validator did
+	// not report a price.
+	MissingPrices uint64 `protobuf:"varint,4,opt,name=missing_prices,json=missingPrices,proto3" json:"missing_prices,omitempty"`
+	// ReportedPrices is the number of currency-pairs for which the validator
+	// reported a price.
+	ReportedPrices uint64 `protobuf:"varint,5,opt,name=reported_prices,json=reportedPrices,proto3" json:"reported_prices,omitempty"`
+	// Deviation is the sum, over every reported price, of the absolute
+	// fractional deviation of the reported price from the final aggregated
+	// price. The deviation of a single price is capped at 1.
+	Deviation string `protobuf:"bytes,6,opt,name=deviation,proto3" json:"deviation,omitempty"`
+}
+
+func (x *ValidatorReport) Reset() {
+	*x = ValidatorReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_validator_performance_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorReport) ProtoMessage() {}
+
+// Deprecated: Use ValidatorReport.ProtoReflect.Descriptor instead.
+func (*ValidatorReport) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_validator_performance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorReport) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorReport) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ValidatorReport) GetAbsent() bool {
+	if x != nil {
+		return x.Absent
+	}
+	return false
+}
+
+func (x *ValidatorReport) GetMissingPrices() uint64 {
+	if x != nil {
+		return x.MissingPrices
+	}
+	return 0
+}
+
+func (x *ValidatorReport) GetReportedPrices() uint64 {
+	if x != nil {
+		return x.ReportedPrices
+	}
+	return 0
+}
+
+func (x *ValidatorReport) GetDeviation() string {
+	if x != nil {
+		return x.Deviation
+	}
+	return ""
+}
+
+// ValidatorPerformance is the oracle performance of a single validator,
+// accumulated over the ValidatorReports within the module's performance
+// window.
+type ValidatorPerformance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// NumBlocks is the number of blocks within the window for which the
+	// validator was part of the commit.
+	NumBlocks uint64 `protobuf:"varint,2,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// NumAbsent is the number of blocks within the window for which the
+	// validator's vote was absent.
+	NumAbsent uint64 `protobuf:"varint,3,opt,name=num_absent,json=numAbsent,proto3" json:"num_absent,omitempty"`
+	// NumMissingPrices is the number of prices the validator did not report
+	// within the window.
+	NumMissingPrices uint64 `protobuf:"varint,4,opt,name=num_missing_prices,json=numMissingPrices,proto3" json:"num_missing_prices,omitempty"`
+	// NumReportedPrices is the number of prices the validator reported within
+	// the window.
+	NumReportedPrices uint64 `protobuf:"varint,5,opt,name=num_reported_prices,json=numReportedPrices,proto3" json:"num_reported_prices,omitempty"`
+	// TotalDeviation is the sum of the deviations of the validator's reported
+	// prices from the final aggregated prices within the window.
+	TotalDeviation string `protobuf:"bytes,6,opt,name=total_deviation,json=totalDeviation,proto3" json:"total_deviation,omitempty"`
+}
+
+func (x *ValidatorPerformance) Reset() {
+	*x = ValidatorPerformance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_slinky_oracle_v1_validator_performance_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorPerformance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorPerformance) ProtoMessage() {}
+
+// Deprecated: Use ValidatorPerformance.ProtoReflect.Descriptor instead.
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return file_slinky_oracle_v1_validator_performance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ValidatorPerformance) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *ValidatorPerformance) GetNumBlocks() uint64 {
+	if x != nil {
+		return x.NumBlocks
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetNumAbsent() uint64 {
+	if x != nil {
+		return x.NumAbsent
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetNumMissingPrices() uint64 {
+	if x != nil {
+		return x.NumMissingPrices
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetNumReportedPrices() uint64 {
+	if x != nil {
+		return x.NumReportedPrices
+	}
+	return 0
+}
+
+func (x *ValidatorPerformance) GetTotalDeviation() string {
+	if x != nil {
+		return x.TotalDeviation
+	}
+	return ""
+}
+
+var File_slinky_oracle_v1_validator_performance_proto protoreflect.FileDescriptor
+
+var file_slinky_oracle_v1_validator_performance_proto_rawDesc = []byte{
+	0x0a, 0x2c, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa3, 0x02, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x09, 0x64, 0x65,
+	0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcf, 0x02, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x3f, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x21, 0xd2, 0xb4, 0x2d, 0x1d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x75, 0x6d,
+	0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6e, 0x75, 0x6d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x5a, 0x0a,
+	0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xbf, 0x01, 0x0a, 0x14, 0x63, 0x6f,
+	0x6d, 0x2e, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x42, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x65, 0x72,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x73, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x4f,
+	0x58, 0xaa, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x5c, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79,
+	0x5c, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x12, 0x53, 0x6c, 0x69, 0x6e, 0x6b, 0x79, 0x3a,
+	0x3a, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_slinky_oracle_v1_validator_performance_proto_rawDescOnce sync.Once
+	file_slinky_oracle_v1_validator_performance_proto_rawDescData = file_slinky_oracle_v1_validator_performance_proto_rawDesc
+)
+
+func file_slinky_oracle_v1_validator_performance_proto_rawDescGZIP() []byte {
+	file_slinky_oracle_v1_validator_performance_proto_rawDescOnce.Do(func() {
+		file_slinky_oracle_v1_validator_performance_proto_rawDescData = protoimpl.X.CompressGZIP(file_slinky_oracle_v1_validator_performance_proto_rawDescData)
+	})
+	return file_slinky_oracle_v1_validator_performance_proto_rawDescData
+}
+
+var file_slinky_oracle_v1_validator_performance_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_slinky_oracle_v1_validator_performance_proto_goTypes = []interface{}{
+	(*ValidatorReport)(nil),      // 0: slinky.oracle.v1.ValidatorReport
+	(*ValidatorPerformance)(nil), // 1: slinky.oracle.v1.ValidatorPerformance
+}
+var file_slinky_oracle_v1_validator_performance_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_slinky_oracle_v1_validator_performance_proto_init() }
+func file_slinky_oracle_v1_validator_performance_proto_init() {
+	if File_slinky_oracle_v1_validator_performance_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_slinky_oracle_v1_validator_performance_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_slinky_oracle_v1_validator_performance_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorPerformance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_slinky_oracle_v1_validator_performance_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_slinky_oracle_v1_validator_performance_proto_goTypes,
+		DependencyIndexes: file_slinky_oracle_v1_validator_performance_proto_depIdxs,
+		MessageInfos:      file_slinky_oracle_v1_validator_performance_proto_msgTypes,
+	}.Build()
+	File_slinky_oracle_v1_validator_performance_proto = out.File
+	file_slinky_oracle_v1_validator_performance_proto_rawDesc = nil
+	file_slinky_oracle_v1_validator_performance_proto_goTypes = nil
+	file_slinky_oracle_v1_validator_performance_proto_depIdxs = nil
+}
@@ -9,6 +9,8 @@ import (
 	coinbaseapi "github.com/1119-Labs/slinky/providers/apis/coinbase"
 	"github.com/1119-Labs/slinky/providers/apis/coingecko"
 	"github.com/1119-Labs/slinky/providers/apis/coinmarketcap"
+	"github.com/1119-Labs/slinky/providers/apis/defi/balancer"
+	"github.com/1119-Labs/slinky/providers/apis/defi/curve"
 	"github.com/1119-Labs/slinky/providers/apis/defi/osmosis"
 	"github.com/1119-Labs/slinky/providers/apis/defi/raydium"
 	"github.com/1119-Labs/slinky/providers/apis/defi/uniswapv3"
@@ -50,6 +52,26 @@ var (
 			API:  uniswapv3.DefaultBaseAPIConfig,
 			Type: types.ConfigType,
 		},
+		{
+			Name: curve.ProviderNames[constants.ETHEREUM],
+			API:  curve.DefaultETHAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: curve.ProviderNames[constants.BASE],
+			API:  curve.DefaultBaseAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: balancer.ProviderNames[constants.ETHEREUM],
+			API:  balancer.DefaultETHAPIConfig,
+			Type: types.ConfigType,
+		},
+		{
+			Name: balancer.ProviderNames[constants.BASE],
+			API:  balancer.DefaultBaseAPIConfig,
+			Type: types.ConfigType,
+		},
 		{
 			Name: osmosis.Name,
 			API:  osmosis.DefaultAPIConfig,
@@ -96,6 +118,7 @@ var (
 		{
 			Name:      binancews.Name,
 			WebSocket: binancews.DefaultWebSocketConfig,
+			API:       binancews.DefaultAPIConfig,
 			Type:      types.ConfigType,
 		},
 		{
@@ -191,6 +214,11 @@ var (
 			API:  perpx.DefaultResearchCMCAPIConfig,
 			Type: mmtypes.ConfigType,
 		},
+		{
+			Name: marketmap.IncrementalName,
+			API:  marketmap.DefaultIncrementalAPIConfig,
+			Type: mmtypes.ConfigType,
+		},
 	}
 
 	MarketMapProviderNames = map[string]struct{}{
@@ -199,5 +227,6 @@ var (
 		perpx.ResearchAPIHandlerName:    {},
 		perpx.ResearchCMCAPIHandlerName: {},
 		marketmap.Name:                 {},
+		marketmap.IncrementalName:      {},
 	}
 )
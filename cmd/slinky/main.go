@@ -88,7 +88,7 @@ func init() {
 		"marketmap-provider",
 		"",
 		marketmap.Name,
-		"MarketMap provider to use (marketmap_api, perpx_api, perpx_migration_api).",
+		"MarketMap provider to use (marketmap_api, marketmap_incremental_api, perpx_api, perpx_migration_api).",
 	)
 	rootCmd.Flags().StringVarP(
 		&oracleCfgPath,
@@ -287,7 +287,7 @@ func runOracle() error {
 	}
 
 	// check that the marketmap endpoint they provided is correct.
-	if marketMapProvider == marketmap.Name {
+	if marketMapProvider == marketmap.Name || marketMapProvider == marketmap.IncrementalName {
 		mmEndpoint := cfg.Providers[marketMapProvider].API.Endpoints[0].URL
 		if err := isValidGRPCEndpoint(mmEndpoint); err != nil {
 			return err
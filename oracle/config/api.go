@@ -45,6 +45,50 @@ type APIConfig struct {
 	// block height incremented.  In the case where a data source has exceeded this limit and the block
 	// height is not increasing, price reporting will be skipped until the block height increases.
 	MaxBlockHeightAge time.Duration `json:"maxBlockHeightAge"`
+
+	// RateLimit configures the request budget of the provider and how it backs off from
+	// failed requests. The zero value disables rate limiting and backoff.
+	RateLimit RateLimitConfig `json:"rateLimit"`
+}
+
+// RateLimitConfig defines the request budget of an API provider and how it backs off from
+// failed (errored, rate limited or 5XX) requests. Requests that would exceed the budget are
+// delayed until they fit within it. For providers with a custom fetcher, e.g. one that queries
+// an RPC, each fetch counts as a single request.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the maximum number of requests the provider can make within any
+	// second. A value of 0 means there is no per-second limit.
+	RequestsPerSecond int `json:"requestsPerSecond"`
+
+	// RequestsPerMinute is the maximum number of requests the provider can make within any
+	// minute. A value of 0 means there is no per-minute limit.
+	RequestsPerMinute int `json:"requestsPerMinute"`
+
+	// InitialBackoff is the amount of time the provider waits before making another request
+	// after a failed request. The backoff doubles with each consecutive failure, and is
+	// jittered. A value of 0 disables backoff.
+	InitialBackoff time.Duration `json:"initialBackoff"`
+
+	// MaxBackoff is the maximum amount of time the provider backs off for after consecutive
+	// failures.
+	MaxBackoff time.Duration `json:"maxBackoff"`
+}
+
+// ValidateBasic performs basic validation of the rate limit config.
+func (c RateLimitConfig) ValidateBasic() error {
+	if c.RequestsPerSecond < 0 || c.RequestsPerMinute < 0 {
+		return fmt.Errorf("rate limit requests per second and per minute cannot be negative")
+	}
+
+	if c.InitialBackoff < 0 || c.MaxBackoff < 0 {
+		return fmt.Errorf("rate limit initial and max backoff cannot be negative")
+	}
+
+	if c.InitialBackoff > 0 && c.MaxBackoff < c.InitialBackoff {
+		return fmt.Errorf("rate limit max backoff must be at least the initial backoff")
+	}
+
+	return nil
 }
 
 // Endpoint holds all data necessary for an API provider to connect to a given endpoint
@@ -132,5 +176,5 @@ func (c *APIConfig) ValidateBasic() error {
 		return fmt.Errorf("max_block_height_age cannot be negative")
 	}
 
-	return nil
+	return c.RateLimit.ValidateBasic()
 }
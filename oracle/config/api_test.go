@@ -165,6 +165,58 @@ func TestAPIConfig(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "good config with rate limit",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerSecond: 1,
+					RequestsPerMinute: 30,
+					InitialBackoff:    time.Second,
+					MaxBackoff:        time.Minute,
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "bad config with negative rate limit",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					RequestsPerMinute: -1,
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "bad config with max backoff less than initial backoff",
+			config: config.APIConfig{
+				Enabled:          true,
+				Timeout:          time.Second,
+				Interval:         time.Second,
+				ReconnectTimeout: time.Second,
+				MaxQueries:       1,
+				Name:             "test",
+				Endpoints:        []config.Endpoint{{URL: "http://test.com"}},
+				RateLimit: config.RateLimitConfig{
+					InitialBackoff: time.Minute,
+					MaxBackoff:     time.Second,
+				},
+			},
+			expectedErr: true,
+		},
 		{
 			name: "bad config with invalid endpoint (no url)",
 			config: config.APIConfig{
@@ -14,8 +14,11 @@ import (
 type Oracle interface {
 	IsRunning() bool
 	GetLastSyncTime() time.Time
+	GetHealth() types.Health
 	GetPrices() types.Prices
+	GetPriceDetails() types.PriceDetails
 	GetMarketMap() mmtypes.MarketMap
+	SubscribePrices(ctx context.Context) <-chan types.Prices
 	Start(ctx context.Context) error
 	Stop()
 }
@@ -32,6 +35,21 @@ type PriceAggregator interface {
 	Reset()
 }
 
+// PriceDetailsAggregator is a PriceAggregator that additionally tracks how each aggregated price is
+// derived from the provider prices. If the oracle's aggregator implements this interface, the oracle
+// reports the raw prices of each provider, including the prices that are too stale to be aggregated,
+// along with any volume or liquidity the provider reported for them.
+type PriceDetailsAggregator interface {
+	PriceAggregator
+
+	// SetProviderPriceInputs sets the latest prices reported by the given provider, indexed by
+	// off-chain ticker, including any stale prices. The volume and liquidity of the prices may be
+	// used to weight them in the aggregation.
+	SetProviderPriceInputs(provider string, prices map[string]types.ProviderPrice)
+	// GetPriceDetails returns the details of the prices computed by the latest aggregation.
+	GetPriceDetails() types.PriceDetails
+}
+
 // generalProvider is an interface for a provider that implements the base provider.
 type generalProvider interface {
 	// Start starts the provider.
@@ -3,6 +3,7 @@ package oracle_test
 import (
 	"context"
 	"errors"
+	"sort"
 	"testing"
 	"time"
 
@@ -113,4 +114,92 @@ func TestStart(t *testing.T) {
 		// Stop the oracle.
 		o.Stop()
 	})
+	t.Run("subscribers receive prices on every tick", func(t *testing.T) {
+		orc, err := oracle.New(
+			oracleCfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMap(marketMap),
+		)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			err := orc.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Start() should have returned context.Canceled error")
+			}
+		}()
+
+		subCtx, subCancel := context.WithCancel(context.Background())
+		prices := orc.SubscribePrices(subCtx)
+
+		for i := 0; i < 2; i++ {
+			select {
+			case p := <-prices:
+				require.NotNil(t, p)
+			case <-time.After(2 * oracleCfg.UpdateInterval):
+				t.Fatal("no prices received from the oracle")
+			}
+		}
+
+		// the channel is closed once the subscription is cancelled
+		subCancel()
+		require.Eventually(t, func() bool {
+			select {
+			case _, ok := <-prices:
+				return !ok
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+
+		// Stop the oracle.
+		orc.Stop()
+	})
+
+	t.Run("health reports readiness once prices are aggregated", func(t *testing.T) {
+		orc, err := oracle.New(
+			oracleCfg,
+			noOpPriceAggregator{},
+			oracle.WithLogger(logger),
+			oracle.WithPriceAPIQueryHandlerFactory(oraclefactory.APIQueryHandlerFactory),
+			oracle.WithPriceWebSocketQueryHandlerFactory(oraclefactory.WebSocketQueryHandlerFactory),
+			oracle.WithMarketMap(marketMap),
+		)
+		require.NoError(t, err)
+
+		health := orc.GetHealth()
+		require.False(t, health.Running)
+		require.False(t, health.Ready)
+		require.Equal(t, "oracle is not running", health.NotReadyReason)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			err := orc.Start(ctx)
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Start() should have returned context.Canceled error")
+			}
+		}()
+
+		require.Eventually(t, func() bool {
+			return orc.GetHealth().Ready
+		}, 5*time.Second, 50*time.Millisecond)
+
+		health = orc.GetHealth()
+		require.True(t, health.Running)
+		require.Empty(t, health.NotReadyReason)
+		require.Len(t, health.Providers, len(oracleCfg.Providers))
+		require.True(t, sort.SliceIsSorted(health.Providers, func(i, j int) bool {
+			return health.Providers[i].Name < health.Providers[j].Name
+		}))
+
+		// Stop the oracle.
+		orc.Stop()
+		require.False(t, orc.GetHealth().Ready)
+	})
 }
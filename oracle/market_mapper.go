@@ -107,7 +107,9 @@ func (o *OracleImpl) listenForMarketMapUpdates(ctx context.Context) {
 				continue
 			}
 
+			o.mut.Lock()
 			o.lastUpdated = result.Value.GetLastUpdated()
+			o.mut.Unlock()
 
 			// Write the market map to the configured path.
 			if err := o.WriteMarketMap(); err != nil {
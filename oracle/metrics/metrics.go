@@ -23,6 +23,8 @@ const (
 	SuccessLabel = "success"
 	// Version is a label for the Slinky version.
 	Version = "version"
+	// ReasonLabel is a label for the reason a provider price was rejected.
+	ReasonLabel = "reason"
 )
 
 // Metrics is an interface that defines the API for oracle metrics.
@@ -52,6 +54,10 @@ type Metrics interface {
 	// to calculate the final price for a given market.
 	AddProviderCountForMarket(market string, count int)
 
+	// AddProviderOutlier increments the number of times a provider price was rejected as
+	// an outlier for a given market, along with the filter that rejected it.
+	AddProviderOutlier(providerName, pairID, reason string)
+
 	// SetSlinkyBuildInfo sets the build information for the Slinky binary.
 	SetSlinkyBuildInfo()
 }
@@ -64,6 +70,7 @@ type OracleMetricsImpl struct {
 	aggregatePrices *prometheus.GaugeVec
 	providerTick    *prometheus.CounterVec
 	providerCount   *prometheus.GaugeVec
+	outliers        *prometheus.CounterVec
 	slinkyBuildInfo *prometheus.GaugeVec
 }
 
@@ -109,6 +116,11 @@ func NewMetrics() Metrics {
 			Name:      "health_check_market_providers",
 			Help:      "Number of providers that were utilized to calculate the final price for a given market.",
 		}, []string{PairIDLabel}),
+		outliers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: OracleSubsystem,
+			Name:      "provider_outliers_total",
+			Help:      "Number of provider prices that were rejected as outliers for a given market.",
+		}, []string{ProviderLabel, PairIDLabel, ReasonLabel}),
 		slinkyBuildInfo: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: OracleSubsystem,
 			Name:      "slinky_build_info",
@@ -123,6 +135,7 @@ func NewMetrics() Metrics {
 	prometheus.MustRegister(m.aggregatePrices)
 	prometheus.MustRegister(m.providerTick)
 	prometheus.MustRegister(m.providerCount)
+	prometheus.MustRegister(m.outliers)
 	prometheus.MustRegister(m.slinkyBuildInfo)
 
 	return m
@@ -163,6 +176,11 @@ func (m *noOpOracleMetrics) AddProviderTick(_, _ string, _ bool) {
 func (m *noOpOracleMetrics) AddProviderCountForMarket(string, int) {
 }
 
+// AddProviderOutlier increments the number of times a provider price was rejected as
+// an outlier for a given market, along with the filter that rejected it.
+func (m *noOpOracleMetrics) AddProviderOutlier(_, _, _ string) {
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary.
 func (m *noOpOracleMetrics) SetSlinkyBuildInfo() {}
 
@@ -228,6 +246,17 @@ func (m *OracleMetricsImpl) AddProviderCountForMarket(market string, count int)
 	).Set(float64(count))
 }
 
+// AddProviderOutlier increments the number of times a provider price was rejected as
+// an outlier for a given market, along with the filter that rejected it.
+func (m *OracleMetricsImpl) AddProviderOutlier(providerName, pairID, reason string) {
+	m.outliers.With(prometheus.Labels{
+		ProviderLabel: strings.ToLower(providerName),
+		PairIDLabel:   strings.ToLower(pairID),
+		ReasonLabel:   reason,
+	},
+	).Add(1)
+}
+
 // SetSlinkyBuildInfo sets the build information for the Slinky binary. The version exported
 // is determined by the build time version in accordance with the build pkg.
 func (m *OracleMetricsImpl) SetSlinkyBuildInfo() {
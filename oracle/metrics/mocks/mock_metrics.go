@@ -14,6 +14,11 @@ func (_m *Metrics) AddProviderCountForMarket(market string, count int) {
 	_m.Called(market, count)
 }
 
+// AddProviderOutlier provides a mock function with given fields: providerName, pairID, reason
+func (_m *Metrics) AddProviderOutlier(providerName string, pairID string, reason string) {
+	_m.Called(providerName, pairID, reason)
+}
+
 // AddProviderTick provides a mock function with given fields: providerName, pairID, success
 func (_m *Metrics) AddProviderTick(providerName string, pairID string, success bool) {
 	_m.Called(providerName, pairID, success)
@@ -6,11 +6,12 @@ import (
 	context "context"
 	big "math/big"
 
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
 
-	types "github.com/1119-Labs/slinky/x/marketmap/types"
+	types "github.com/1119-Labs/slinky/oracle/types"
 )
 
 // Oracle is an autogenerated mock type for the Oracle type
@@ -18,6 +19,24 @@ type Oracle struct {
 	mock.Mock
 }
 
+// GetHealth provides a mock function with no fields
+func (_m *Oracle) GetHealth() types.Health {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHealth")
+	}
+
+	var r0 types.Health
+	if rf, ok := ret.Get(0).(func() types.Health); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.Health)
+	}
+
+	return r0
+}
+
 // GetLastSyncTime provides a mock function with no fields
 func (_m *Oracle) GetLastSyncTime() time.Time {
 	ret := _m.Called()
@@ -37,18 +56,38 @@ func (_m *Oracle) GetLastSyncTime() time.Time {
 }
 
 // GetMarketMap provides a mock function with no fields
-func (_m *Oracle) GetMarketMap() types.MarketMap {
+func (_m *Oracle) GetMarketMap() marketmaptypes.MarketMap {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetMarketMap")
 	}
 
-	var r0 types.MarketMap
-	if rf, ok := ret.Get(0).(func() types.MarketMap); ok {
+	var r0 marketmaptypes.MarketMap
+	if rf, ok := ret.Get(0).(func() marketmaptypes.MarketMap); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(marketmaptypes.MarketMap)
+	}
+
+	return r0
+}
+
+// GetPriceDetails provides a mock function with no fields
+func (_m *Oracle) GetPriceDetails() map[string]types.MarketPriceDetails {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceDetails")
+	}
+
+	var r0 map[string]types.MarketPriceDetails
+	if rf, ok := ret.Get(0).(func() map[string]types.MarketPriceDetails); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(types.MarketMap)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]types.MarketPriceDetails)
+		}
 	}
 
 	return r0
@@ -115,6 +154,26 @@ func (_m *Oracle) Stop() {
 	_m.Called()
 }
 
+// SubscribePrices provides a mock function with given fields: ctx
+func (_m *Oracle) SubscribePrices(ctx context.Context) <-chan map[string]*big.Float {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePrices")
+	}
+
+	var r0 <-chan map[string]*big.Float
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan map[string]*big.Float); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan map[string]*big.Float)
+		}
+	}
+
+	return r0
+}
+
 // NewOracle creates a new instance of Oracle. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOracle(t interface {
@@ -3,6 +3,8 @@ package oracle
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -12,9 +14,11 @@ import (
 	"github.com/1119-Labs/slinky/oracle/config"
 	oraclemetrics "github.com/1119-Labs/slinky/oracle/metrics"
 	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/providers/base"
 	apimetrics "github.com/1119-Labs/slinky/providers/base/api/metrics"
 	providermetrics "github.com/1119-Labs/slinky/providers/base/metrics"
 	wsmetrics "github.com/1119-Labs/slinky/providers/base/websocket/metrics"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
 	mmclienttypes "github.com/1119-Labs/slinky/service/clients/marketmap/types"
 	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
 )
@@ -47,6 +51,8 @@ type OracleImpl struct { //nolint:revive
 	aggregator PriceAggregator
 	// lastPriceSync is the last time the oracle successfully updated its prices.
 	lastPriceSync time.Time
+	// subscriptions are the subscribers to the prices aggregated by the oracle.
+	subscriptions priceSubscriptions
 
 	// -------------------Oracle Configuration Fields-------------------//
 	//
@@ -142,6 +148,68 @@ func (o *OracleImpl) GetMarketMapProvider() *mmclienttypes.MarketMapProvider {
 	return o.mmProvider
 }
 
+// GetHealth returns the status of the oracle, its market map provider and each of its price providers. The
+// oracle is ready if it is running, it has aggregated prices within the maximum price age, and at least one
+// of its price providers is running.
+func (o *OracleImpl) GetHealth() types.Health {
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	health := types.Health{
+		Running:      o.IsRunning(),
+		LastSyncTime: o.lastPriceSync,
+		Providers:    make([]types.ProviderHealth, 0, len(o.priceProviders)),
+	}
+
+	numRunning := 0
+	for _, state := range o.priceProviders {
+		providerHealth := newProviderHealth(state.Provider)
+		if providerHealth.Running {
+			numRunning++
+		}
+
+		health.Providers = append(health.Providers, providerHealth)
+	}
+	sort.Slice(health.Providers, func(i, j int) bool {
+		return health.Providers[i].Name < health.Providers[j].Name
+	})
+
+	if o.mmProvider != nil {
+		health.MarketMap = &types.MarketMapHealth{
+			ProviderHealth: newProviderHealth(o.mmProvider),
+			LastUpdated:    o.lastUpdated,
+		}
+	}
+
+	switch age := time.Since(o.lastPriceSync); {
+	case !health.Running:
+		health.NotReadyReason = "oracle is not running"
+	case o.lastPriceSync.IsZero():
+		health.NotReadyReason = "oracle has not aggregated any prices"
+	case age > o.cfg.MaxPriceAge:
+		health.NotReadyReason = fmt.Sprintf("oracle last aggregated prices %s ago", age)
+	case len(o.priceProviders) > 0 && numRunning == 0:
+		health.NotReadyReason = "no price providers are running"
+	default:
+		health.Ready = true
+	}
+
+	return health
+}
+
+// newProviderHealth returns the status of the given provider.
+func newProviderHealth[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	provider *base.Provider[K, V],
+) types.ProviderHealth {
+	return types.ProviderHealth{
+		Name:           provider.Name(),
+		Type:           provider.Type(),
+		Running:        provider.IsRunning(),
+		NumTickers:     len(provider.GetIDs()),
+		ProviderHealth: provider.GetHealth(),
+	}
+}
+
 func (o *OracleImpl) GetLastSyncTime() time.Time {
 	o.mut.RLock()
 	defer o.mut.RUnlock()
@@ -151,3 +219,22 @@ func (o *OracleImpl) GetLastSyncTime() time.Time {
 func (o *OracleImpl) GetPrices() types.Prices {
 	return o.aggregator.GetPrices()
 }
+
+// GetPriceDetails returns the details of how each aggregated price was derived from the provider prices.
+// Nil is returned if the oracle's aggregator does not track price details.
+func (o *OracleImpl) GetPriceDetails() types.PriceDetails {
+	aggregator, ok := o.aggregator.(PriceDetailsAggregator)
+	if !ok {
+		return nil
+	}
+
+	return aggregator.GetPriceDetails()
+}
+
+// SubscribePrices returns a channel that receives the prices aggregated by the oracle on every tick. The
+// channel is buffered with the latest prices only, so a slow consumer skips stale prices rather than
+// blocking the oracle. The channel is closed once the given context is done. The received prices must
+// not be modified.
+func (o *OracleImpl) SubscribePrices(ctx context.Context) <-chan types.Prices {
+	return o.subscriptions.subscribe(ctx)
+}
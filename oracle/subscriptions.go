@@ -0,0 +1,72 @@
+package oracle
+
+import (
+	"context"
+	"sync"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+)
+
+// priceSubscriptions tracks the subscribers to the prices aggregated by the oracle. Each subscriber
+// is only guaranteed to receive the latest prices, i.e. if a subscriber is slow to consume prices,
+// stale prices are dropped in favour of the latest ones.
+type priceSubscriptions struct {
+	mut sync.Mutex
+
+	subscribers map[uint64]chan types.Prices
+	nextID      uint64
+}
+
+// subscribe registers a new subscriber, which is removed (and its channel closed) once the given
+// context is done.
+func (s *priceSubscriptions) subscribe(ctx context.Context) <-chan types.Prices {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	if s.subscribers == nil {
+		s.subscribers = make(map[uint64]chan types.Prices)
+	}
+
+	id := s.nextID
+	s.nextID++
+
+	ch := make(chan types.Prices, 1)
+	s.subscribers[id] = ch
+
+	go func() {
+		<-ctx.Done()
+
+		s.mut.Lock()
+		defer s.mut.Unlock()
+
+		delete(s.subscribers, id)
+		close(ch)
+	}()
+
+	return ch
+}
+
+// publish sends the given prices to every subscriber without blocking. Any prices that a subscriber
+// has not yet consumed are replaced.
+func (s *priceSubscriptions) publish(prices types.Prices) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	for _, ch := range s.subscribers {
+		// drop the stale prices if the subscriber has not consumed them yet
+		select {
+		case <-ch:
+		default:
+		}
+
+		ch <- prices
+	}
+}
+
+// len returns the number of subscribers.
+func (s *priceSubscriptions) len() int {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+
+	return len(s.subscribers)
+}
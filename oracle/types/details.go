@@ -0,0 +1,97 @@
+package types
+
+import (
+	"math/big"
+	"time"
+
+	pkgtypes "github.com/1119-Labs/slinky/pkg/types"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// PriceInputStatus describes whether a provider price was included in the aggregated price of a
+// market, or why it was dropped.
+type PriceInputStatus string
+
+const (
+	// PriceInputIncluded indicates that the provider price was included in the aggregated price.
+	PriceInputIncluded PriceInputStatus = "included"
+	// PriceInputMissing indicates that the provider did not report a price for the ticker.
+	PriceInputMissing PriceInputStatus = "missing"
+	// PriceInputStale indicates that the provider price was older than the maximum price age.
+	PriceInputStale PriceInputStatus = "stale"
+	// PriceInputMissingIndexPrice indicates that the provider price could not be converted to the
+	// market's ticker, as there was no index price for the normalization pair.
+	PriceInputMissingIndexPrice PriceInputStatus = "missing_index_price"
+	// PriceInputOutlier indicates that the provider price was converted, but was rejected by the market's
+	// outlier filter.
+	PriceInputOutlier PriceInputStatus = "outlier"
+	// PriceInputBelowMinProviderCount indicates that the provider price was converted, but that the
+	// market did not have enough converted prices to meet its minimum provider count.
+	PriceInputBelowMinProviderCount PriceInputStatus = "below_min_provider_count"
+)
+
+type (
+	// ProviderPrice is the latest price reported by a provider for an off-chain ticker.
+	ProviderPrice struct {
+		// Price is the price reported by the provider.
+		Price *big.Float
+		// Timestamp is the time at which the price was reported.
+		Timestamp time.Time
+		// Stale is true if the price is older than the maximum price age, in which case it is not
+		// aggregated.
+		Stale bool
+		// Volume is the trading volume reported alongside the price, if any.
+		Volume *big.Float
+		// Liquidity is the liquidity reported alongside the price, if any.
+		Liquidity *big.Float
+	}
+
+	// ProviderPriceDetails describes how a single provider price was used in the aggregated price of a
+	// market.
+	ProviderPriceDetails struct {
+		// Provider is the name of the provider.
+		Provider string
+		// OffChainTicker is the provider's ticker for the market.
+		OffChainTicker string
+		// Invert is true if the provider price is inverted before it is converted.
+		Invert bool
+		// NormalizeByPair is the pair whose index price the provider price is converted by, if any.
+		NormalizeByPair *pkgtypes.CurrencyPair
+		// NormalizeByPairs is the ordered conversion path the provider price is converted by, if it is
+		// converted by more than one pair.
+		NormalizeByPairs []mmtypes.ConversionPair
+		// Price is the raw price reported by the provider, if any.
+		Price *big.Float
+		// Timestamp is the time at which the provider reported the price, if any.
+		Timestamp time.Time
+		// NormalizeByPrice is the index price of the normalization pair used in the conversion, or the
+		// product of the conversion path, if any.
+		NormalizeByPrice *big.Float
+		// ConvertedPrice is the provider price converted to the market's ticker, if it could be converted.
+		ConvertedPrice *big.Float
+		// Weight is the weight of the provider price in a volume weighted aggregation, denominated in the
+		// market's base asset, i.e. the volume reported alongside the price or, if not every included
+		// price has a volume, the liquidity. This is nil if not every included price has either.
+		Weight *big.Float
+		// Status describes whether the price was included in the aggregated price.
+		Status PriceInputStatus
+	}
+
+	// MarketPriceDetails describes how the aggregated price of a market was derived from the provider
+	// prices.
+	MarketPriceDetails struct {
+		// Price is the unscaled aggregated price of the market, if there is one.
+		Price *big.Float
+		// ScaledPrice is the aggregated price of the market scaled by the ticker's decimals, if there is one.
+		ScaledPrice *big.Float
+		// MinProviderCount is the minimum number of converted prices required to aggregate a price.
+		MinProviderCount uint64
+		// Aggregation is the method used to aggregate the converted prices, e.g. "median" or "vwap".
+		Aggregation string
+		// Providers describes each provider price configured for the market.
+		Providers []ProviderPriceDetails
+	}
+
+	// PriceDetails is a map of ticker to the details of the market's aggregated price.
+	PriceDetails = map[string]MarketPriceDetails
+)
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+type (
+	// ProviderHealth describes the status of a single provider.
+	ProviderHealth struct {
+		// Name is the name of the provider.
+		Name string
+		// Type is the type of the provider's data handler.
+		Type providertypes.ProviderType
+		// Running is true if the provider is running.
+		Running bool
+		// NumTickers is the number of tickers the provider is fetching data for.
+		NumTickers int
+
+		providertypes.ProviderHealth
+	}
+
+	// MarketMapHealth describes the status of the market map provider.
+	MarketMapHealth struct {
+		ProviderHealth
+
+		// LastUpdated is the height at which the market map used by the oracle was last updated.
+		LastUpdated uint64
+	}
+
+	// Health describes the status of the oracle and its providers.
+	Health struct {
+		// Running is true if the oracle is running.
+		Running bool
+		// Ready is true if the oracle is serving fresh prices.
+		Ready bool
+		// NotReadyReason describes why the oracle is not ready, if it is not.
+		NotReadyReason string
+		// LastSyncTime is the last time the oracle aggregated prices.
+		LastSyncTime time.Time
+		// MarketMap is the status of the market map provider, if one is configured.
+		MarketMap *MarketMapHealth
+		// Providers is the status of each price provider, ordered by name.
+		Providers []ProviderHealth
+	}
+)
@@ -106,6 +106,9 @@ func (o *OracleImpl) fetchAllPrices() {
 	o.aggregator.AggregatePrices()
 	o.setLastSyncTime(time.Now().UTC())
 
+	// Push the aggregated prices to any subscribers.
+	o.subscriptions.publish(o.aggregator.GetPrices())
+
 	// update the last sync time
 	o.metrics.AddTick()
 }
@@ -150,9 +153,17 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 	}
 
 	timeFilteredPrices := make(types.Prices)
+	priceInputs := make(map[string]types.ProviderPrice, len(prices))
 	for pair, result := range prices {
 		// If the price is older than the maxCacheAge, skip it.
 		diff := time.Now().UTC().Sub(result.Timestamp)
+		priceInputs[pair.GetOffChainTicker()] = types.ProviderPrice{
+			Price:     result.Value,
+			Timestamp: result.Timestamp,
+			Stale:     diff > o.cfg.MaxPriceAge,
+			Volume:    result.Volume,
+			Liquidity: result.Liquidity,
+		}
 		if diff > o.cfg.MaxPriceAge {
 			o.logger.Debug(
 				"skipping price",
@@ -182,6 +193,12 @@ func (o *OracleImpl) fetchPrices(provider *types.PriceProvider) {
 		zap.Int("prices", len(prices)),
 	)
 	o.aggregator.SetProviderPrices(provider.Name(), timeFilteredPrices)
+
+	// Record the prices used as inputs, including any stale prices and the volume or liquidity reported
+	// alongside them, if the aggregator tracks them.
+	if aggregator, ok := o.aggregator.(PriceDetailsAggregator); ok {
+		aggregator.SetProviderPriceInputs(provider.Name(), priceInputs)
+	}
 }
 
 func (o *OracleImpl) setLastSyncTime(t time.Time) {
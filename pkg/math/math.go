@@ -138,6 +138,74 @@ func CalculateMedian(values []*big.Float) *big.Float {
 	return median
 }
 
+// CalculateWeightedMedian calculates the weighted median from a list of big.Float values and
+// their respective weights, i.e. the value at which the cumulative weight of the sorted values
+// reaches half of the total weight. If the cumulative weight is exactly half of the total weight,
+// the average of the value and the next value is returned, such that equal weights yield the same
+// result as CalculateMedian. Returns nil if there are no values, the number of values and weights
+// differ, or the total weight is not positive.
+func CalculateWeightedMedian(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	indices := make([]int, len(values))
+	total := new(big.Float)
+	for i := range values {
+		indices[i] = i
+		total.Add(total, weights[i])
+	}
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	sort.SliceStable(indices, func(i, j int) bool {
+		return values[indices[i]].Cmp(values[indices[j]]) < 0
+	})
+
+	half := new(big.Float).Quo(total, big.NewFloat(2))
+	cumulative := new(big.Float)
+	for i, index := range indices {
+		cumulative.Add(cumulative, weights[index])
+
+		switch cumulative.Cmp(half) {
+		case 0:
+			if i+1 < len(indices) {
+				median := new(big.Float).Add(values[index], values[indices[i+1]])
+				return median.Quo(median, big.NewFloat(2))
+			}
+
+			return values[index]
+		case 1:
+			return values[index]
+		}
+	}
+
+	return values[indices[len(indices)-1]]
+}
+
+// CalculateWeightedMean calculates the weighted mean from a list of big.Float values and their
+// respective weights. When the weights are volumes, this is the volume-weighted average price
+// (VWAP). Returns nil if there are no values, the number of values and weights differ, or the
+// total weight is not positive.
+func CalculateWeightedMean(values, weights []*big.Float) *big.Float {
+	if len(values) == 0 || len(values) != len(weights) {
+		return nil
+	}
+
+	sum := new(big.Float)
+	total := new(big.Float)
+	for i := range values {
+		sum.Add(sum, new(big.Float).Mul(values[i], weights[i]))
+		total.Add(total, weights[i])
+	}
+	if total.Sign() <= 0 {
+		return nil
+	}
+
+	return sum.Quo(sum, total)
+}
+
 // GetScalingFactor returns the scaling factor for the price based on the difference between
 // the token decimals in the erc20 token contracts or similar.
 func GetScalingFactor(
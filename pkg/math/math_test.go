@@ -412,6 +412,114 @@ func TestCalculateMedian(t *testing.T) {
 	}
 }
 
+func TestCalculateWeightedMedian(t *testing.T) {
+	floats := func(values ...float64) []*big.Float {
+		out := make([]*big.Float, len(values))
+		for i, v := range values {
+			out[i] = big.NewFloat(v)
+		}
+		return out
+	}
+
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			expected: nil,
+		},
+		{
+			name:     "mismatched values and weights",
+			values:   floats(1, 2),
+			weights:  floats(1),
+			expected: nil,
+		},
+		{
+			name:     "zero total weight",
+			values:   floats(1, 2),
+			weights:  floats(0, 0),
+			expected: nil,
+		},
+		{
+			name:     "equal weights match the median for an even number of values",
+			values:   floats(100, -2, 10, 0),
+			weights:  floats(1, 1, 1, 1),
+			expected: big.NewFloat(5),
+		},
+		{
+			name:     "equal weights match the median for an odd number of values",
+			values:   floats(10, -2, 100, 0, 0),
+			weights:  floats(2, 2, 2, 2, 2),
+			expected: big.NewFloat(0),
+		},
+		{
+			name:     "heavily weighted value is the median",
+			values:   floats(100, 101, 150),
+			weights:  floats(10, 1000, 1),
+			expected: big.NewFloat(101),
+		},
+		{
+			name:     "thin outlier does not move the median",
+			values:   floats(100, 102, 10_000),
+			weights:  floats(500, 400, 1),
+			expected: big.NewFloat(100),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			median := math.CalculateWeightedMedian(tc.values, tc.weights)
+			if tc.expected == nil {
+				require.Nil(t, median)
+				return
+			}
+
+			require.Zero(t, tc.expected.Cmp(median), "expected %s, got %s", tc.expected, median)
+		})
+	}
+}
+
+func TestCalculateWeightedMean(t *testing.T) {
+	testCases := []struct {
+		name     string
+		values   []*big.Float
+		weights  []*big.Float
+		expected *big.Float
+	}{
+		{
+			name:     "do nothing for nil slice",
+			expected: nil,
+		},
+		{
+			name:     "zero total weight",
+			values:   []*big.Float{big.NewFloat(1)},
+			weights:  []*big.Float{big.NewFloat(0)},
+			expected: nil,
+		},
+		{
+			name:     "volume weighted average price",
+			values:   []*big.Float{big.NewFloat(100), big.NewFloat(110)},
+			weights:  []*big.Float{big.NewFloat(3), big.NewFloat(1)},
+			expected: big.NewFloat(102.5),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mean := math.CalculateWeightedMean(tc.values, tc.weights)
+			if tc.expected == nil {
+				require.Nil(t, mean)
+				return
+			}
+
+			require.Zero(t, tc.expected.Cmp(mean), "expected %s, got %s", tc.expected, mean)
+		})
+	}
+}
+
 func TestSortBigInts(t *testing.T) {
 	testCases := []struct {
 		name     string
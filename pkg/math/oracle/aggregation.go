@@ -0,0 +1,117 @@
+package oracle
+
+import (
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/pkg/math"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+const (
+	// MedianAggregation aggregates the converted prices of a market by taking their median. This is
+	// the default aggregation method.
+	MedianAggregation = "median"
+	// VolumeWeightedMedianAggregation aggregates the converted prices of a market by taking their
+	// median weighted by the volume (or liquidity) reported alongside each price.
+	VolumeWeightedMedianAggregation = "volume_weighted_median"
+	// VWAPAggregation aggregates the converted prices of a market by taking their mean weighted by
+	// the volume (or liquidity) reported alongside each price, i.e. the volume weighted average price.
+	VWAPAggregation = "vwap"
+)
+
+// IsValidAggregation returns true if the given name is a supported aggregation method.
+func IsValidAggregation(aggregation string) bool {
+	switch aggregation {
+	case MedianAggregation, VolumeWeightedMedianAggregation, VWAPAggregation:
+		return true
+	default:
+		return false
+	}
+}
+
+// providerAggregation returns the method used to aggregate the converted prices of the given ticker.
+// This is configured by the provider_aggregation field of the ticker's metadata, and defaults to the
+// median if it is not set or is invalid.
+func (m *IndexPriceAggregator) providerAggregation(ticker mmtypes.Ticker) string {
+	// the ticker metadata is free-form, so metadata without an aggregation configuration is not an error
+	if ticker.Metadata_JSON == "" {
+		return MedianAggregation
+	}
+
+	aggregation, err := tickermetadata.AggregationFromJSONString(ticker.Metadata_JSON)
+	if err != nil || aggregation.ProviderAggregation == "" {
+		return MedianAggregation
+	}
+
+	if !IsValidAggregation(aggregation.ProviderAggregation) {
+		m.logger.Warn(
+			"invalid provider aggregation in ticker metadata; using the median",
+			zap.String("ticker", ticker.String()),
+			zap.String("provider_aggregation", aggregation.ProviderAggregation),
+		)
+
+		return MedianAggregation
+	}
+
+	return aggregation.ProviderAggregation
+}
+
+// aggregateConvertedPrices aggregates the converted prices of the given ticker using the given aggregation
+// method, and returns the aggregated price along with the method that was used. The details must contain
+// the details of each converted price, in the same order. Volume weighted methods fall back to the median
+// if any of the converted prices was reported without a volume or liquidity, or if the total weight is zero,
+// as the prices cannot be weighted consistently.
+func (m *IndexPriceAggregator) aggregateConvertedPrices(
+	ticker mmtypes.Ticker,
+	aggregation string,
+	convertedPrices []*big.Float,
+	details []types.ProviderPriceDetails,
+) (*big.Float, string) {
+	if aggregation == MedianAggregation {
+		return math.CalculateMedian(convertedPrices), MedianAggregation
+	}
+
+	weights := make([]*big.Float, 0, len(convertedPrices))
+	for _, detail := range details {
+		if detail.Status != types.PriceInputIncluded {
+			continue
+		}
+
+		if detail.Weight == nil {
+			m.logger.Debug(
+				"converted prices are not all weighted by volume or all by liquidity; using the median",
+				zap.String("ticker", ticker.String()),
+				zap.String("provider", detail.Provider),
+				zap.String("provider_aggregation", aggregation),
+			)
+
+			return math.CalculateMedian(convertedPrices), MedianAggregation
+		}
+
+		weights = append(weights, detail.Weight)
+	}
+
+	var price *big.Float
+	switch aggregation {
+	case VolumeWeightedMedianAggregation:
+		price = math.CalculateWeightedMedian(convertedPrices, weights)
+	case VWAPAggregation:
+		price = math.CalculateWeightedMean(convertedPrices, weights)
+	}
+
+	if price == nil {
+		m.logger.Debug(
+			"unable to weight converted prices; using the median",
+			zap.String("ticker", ticker.String()),
+			zap.String("provider_aggregation", aggregation),
+		)
+
+		return math.CalculateMedian(convertedPrices), MedianAggregation
+	}
+
+	return price, aggregation
+}
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"fmt"
+	"maps"
 	"math/big"
 	"sync"
 
@@ -14,7 +15,7 @@ import (
 	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
 )
 
-var _ oracle.PriceAggregator = &IndexPriceAggregator{}
+var _ oracle.PriceDetailsAggregator = &IndexPriceAggregator{}
 
 // IndexPriceAggregator is an aggregator that calculates the median price for each ticker,
 // resolved from a predefined set of conversion markets. A conversion market is a set of
@@ -34,6 +35,11 @@ type IndexPriceAggregator struct {
 	// providerPrices cache the unscaled prices for each provider. These are indexed by
 	// provider -> offChainTicker -> price.
 	providerPrices map[string]types.Prices
+	// priceInputs cache the raw prices reported by each provider, including stale prices. These
+	// are indexed by provider -> offChainTicker -> price.
+	priceInputs map[string]map[string]types.ProviderPrice
+	// priceDetails cache the details of how each price was aggregated.
+	priceDetails types.PriceDetails
 }
 
 // NewIndexPriceAggregator returns a new Index Price Aggregator.
@@ -58,6 +64,8 @@ func NewIndexPriceAggregator(
 		indexPrices:    make(types.Prices),
 		scaledPrices:   make(types.Prices),
 		providerPrices: make(map[string]types.Prices),
+		priceInputs:    make(map[string]map[string]types.ProviderPrice),
+		priceDetails:   make(types.PriceDetails),
 	}, nil
 }
 
@@ -69,17 +77,27 @@ func NewIndexPriceAggregator(
 //  2. Using the index price of an asset. i.e. I have BTC/USDT and I want BTC/USD. I can convert
 //     BTC/USDT to BTC/USD using the index price of USDT/USD.
 //
-// The index price cache contains the previously calculated median prices.
+// Markets are aggregated in dependency order (see SortMarkets), so that the index price used to convert a
+// price is calculated earlier in the same aggregation. If it could not be calculated in this aggregation,
+// e.g. because the markets depend on each other in a cycle, the previously calculated index price is used.
 func (m *IndexPriceAggregator) AggregatePrices() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	indexPrices := make(types.Prices)
 	scaledPrices := make(types.Prices)
+	priceDetails := make(types.PriceDetails)
+
+	// The index price cache is updated with each index price as it is calculated, so that it can be
+	// used to convert the prices of the markets that depend on it.
+	previousIndexPrices := m.indexPrices
+	m.indexPrices = make(types.Prices, len(previousIndexPrices))
+	maps.Copy(m.indexPrices, previousIndexPrices)
 
 	var missingPrices []string
 
-	for ticker, market := range m.cfg.Markets {
+	for _, market := range SortMarkets(m.cfg) {
+		ticker := market.Ticker.String()
 		if !market.Ticker.Enabled {
 			m.logger.Debug("skipping disabled market", zap.Any("market", market))
 			continue
@@ -89,11 +107,27 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 		// ex. BTC/USDT * Index USDT/USD = BTC/USD
 		//     BTC/USDC * Index USDC/USD = BTC/USD
 		target := market.Ticker
-		convertedPrices := m.CalculateConvertedPrices(market)
+		_, providerDetails := m.calculateConvertedPrices(market)
+
+		// Reject any outlying converted prices before they are aggregated.
+		convertedPrices := m.filterOutliers(target, providerDetails)
 		m.metrics.AddProviderCountForMarket(target.String(), len(convertedPrices))
 
+		details := types.MarketPriceDetails{
+			MinProviderCount: target.MinProviderCount,
+			Aggregation:      m.providerAggregation(target),
+			Providers:        providerDetails,
+		}
+
 		// We need to have at least the minimum number of providers to calculate the median.
 		if len(convertedPrices) < int(target.MinProviderCount) { //nolint:gosec
+			for i := range details.Providers {
+				if details.Providers[i].Status == types.PriceInputIncluded {
+					details.Providers[i].Status = types.PriceInputBelowMinProviderCount
+				}
+			}
+			priceDetails[target.String()] = details
+
 			missingPrices = append(missingPrices, ticker)
 			m.logger.Debug(
 				"insufficient amount of converted prices",
@@ -106,17 +140,25 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 			continue
 		}
 
-		// Take the median of the converted prices. This takes the average of the middle two
-		// prices if the number of prices is even.
-		price := math.CalculateMedian(convertedPrices)
+		// Aggregate the converted prices using the market's aggregation method. By default, this
+		// takes the median of the converted prices, which is the average of the middle two prices
+		// if the number of prices is even.
+		var price *big.Float
+		price, details.Aggregation = m.aggregateConvertedPrices(target, details.Aggregation, convertedPrices, providerDetails)
 		indexPrices[target.String()] = new(big.Float).Copy(price)
+		m.indexPrices[target.String()] = indexPrices[target.String()]
 
 		// Scale the price to the target ticker's decimals.
 		scaledPrices[target.String()] = math.ScaleBigFloat(new(big.Float).Copy(price), target.Decimals)
 
+		details.Price = indexPrices[target.String()]
+		details.ScaledPrice = scaledPrices[target.String()]
+		priceDetails[target.String()] = details
+
 		m.logger.Debug(
-			"calculated median price",
+			"calculated price",
 			zap.String("target_ticker", ticker),
+			zap.String("aggregation", details.Aggregation),
 
 			zap.String("unscaled_price", indexPrices[target.String()].String()),
 			zap.String("scaled_price", scaledPrices[target.String()].String()),
@@ -135,6 +177,7 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 	}
 	m.indexPrices = indexPrices
 	m.scaledPrices = scaledPrices
+	m.priceDetails = priceDetails
 }
 
 // CalculateConvertedPrices calculates the converted prices for a given set of paths and target ticker.
@@ -143,6 +186,15 @@ func (m *IndexPriceAggregator) AggregatePrices() {
 func (m *IndexPriceAggregator) CalculateConvertedPrices(
 	market mmtypes.Market,
 ) []*big.Float {
+	convertedPrices, _ := m.calculateConvertedPrices(market)
+	return convertedPrices
+}
+
+// calculateConvertedPrices calculates the converted prices for a given market, along with the details of
+// how each provider price was (or was not) converted.
+func (m *IndexPriceAggregator) calculateConvertedPrices(
+	market mmtypes.Market,
+) ([]*big.Float, []types.ProviderPriceDetails) {
 	m.logger.Debug("calculating converted prices", zap.String("ticker", market.Ticker.String()))
 	if len(market.ProviderConfigs) == 0 {
 		m.logger.Error(
@@ -150,11 +202,14 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 			zap.String("target_ticker", market.Ticker.String()),
 		)
 
-		return nil
+		return nil, nil
 	}
 
 	convertedPrices := make([]*big.Float, 0, len(market.ProviderConfigs))
+	details := make([]types.ProviderPriceDetails, 0, len(market.ProviderConfigs))
 	for _, cfg := range market.ProviderConfigs {
+		detail := m.newProviderPriceDetails(cfg)
+
 		// Calculate the converted price.
 		adjustedPrice, err := m.CalculateAdjustedPrice(cfg)
 		if err != nil {
@@ -165,10 +220,15 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 				zap.Any("provider", cfg.Name),
 			)
 
+			details = append(details, detail)
 			m.metrics.AddProviderTick(cfg.Name, market.Ticker.String(), false)
 			continue
 		}
 
+		detail.ConvertedPrice = adjustedPrice
+		detail.Status = types.PriceInputIncluded
+		details = append(details, detail)
+
 		convertedPrices = append(convertedPrices, adjustedPrice)
 		m.logger.Debug(
 			"calculated converted price",
@@ -182,7 +242,79 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 		m.metrics.UpdatePrice(cfg.Name, market.Ticker.String(), market.Ticker.GetDecimals(), floatPrice)
 	}
 
-	return convertedPrices
+	m.setProviderWeights(market, details)
+	return convertedPrices, details
+}
+
+// setProviderWeights sets the weight of each included provider price in a volume weighted aggregation.
+// Volumes and liquidities are not comparable, so the volumes are used if every included price has one,
+// otherwise the liquidities if every included price has one. If neither, no weights are set.
+func (m *IndexPriceAggregator) setProviderWeights(market mmtypes.Market, details []types.ProviderPriceDetails) {
+	for _, getWeight := range []func(mmtypes.ProviderConfig) *big.Float{
+		m.GetProviderVolume,
+		m.GetProviderLiquidity,
+	} {
+		weights := make([]*big.Float, len(details))
+		complete := true
+		for i, detail := range details {
+			if detail.Status != types.PriceInputIncluded {
+				continue
+			}
+
+			weights[i] = getWeight(market.ProviderConfigs[i])
+			if weights[i] == nil {
+				complete = false
+				break
+			}
+		}
+
+		if complete {
+			for i := range details {
+				details[i].Weight = weights[i]
+			}
+
+			return
+		}
+	}
+}
+
+// newProviderPriceDetails returns the details of the given provider configuration's price before it is
+// converted. The status is set to why the price cannot be converted, if it cannot be.
+func (m *IndexPriceAggregator) newProviderPriceDetails(cfg mmtypes.ProviderConfig) types.ProviderPriceDetails {
+	detail := types.ProviderPriceDetails{
+		Provider:        cfg.Name,
+		OffChainTicker:  cfg.OffChainTicker,
+		Invert:          cfg.Invert,
+		NormalizeByPair: cfg.NormalizeByPair,
+		Status:          types.PriceInputMissing,
+	}
+	if len(cfg.NormalizeByPairs) > 0 {
+		detail.NormalizeByPairs = cfg.NormalizeByPairs
+	}
+
+	// Prefer the raw input, which includes the timestamp and stale prices.
+	if input, ok := m.priceInputs[cfg.Name][cfg.OffChainTicker]; ok {
+		detail.Price = input.Price
+		detail.Timestamp = input.Timestamp
+		if input.Stale {
+			detail.Status = types.PriceInputStale
+		}
+	} else if price, ok := m.providerPrices[cfg.Name][cfg.OffChainTicker]; ok {
+		detail.Price = price
+	}
+
+	if _, err := m.GetProviderPrice(cfg); err != nil {
+		return detail
+	}
+
+	normalizeByPrice, err := m.GetConversionPrice(cfg)
+	if err != nil {
+		detail.Status = types.PriceInputMissingIndexPrice
+		return detail
+	}
+	detail.NormalizeByPrice = normalizeByPrice
+
+	return detail
 }
 
 // CalculateAdjustedPrice calculates an adjusted price for a given set of operations (if applicable).
@@ -191,10 +323,12 @@ func (m *IndexPriceAggregator) CalculateConvertedPrices(
 //  1. A direct conversion from the base ticker to the target ticker i.e. we want BTC/USD and
 //     we have BTC/USD from a provider (e.g. Coinbase).
 //  2. We need to convert the price of a given asset against the index price of an asset.
+//  3. We need to convert the price of a given asset along a conversion path of index prices i.e.
+//     we want FOO/USD and we have FOO/ETH, which is converted by ETH/USDT and then USDT/USD.
 //
-// In the first case, we can simply return the price of the provider. In the second case, we need
-// to adjust the price by the index price of the asset. If the index price is not available, we
-// return an error.
+// In the first case, we can simply return the price of the provider. In the other cases, we need
+// to adjust the price by the index price of each asset along the path. If any index price is not
+// available, we return an error.
 func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 	cfg mmtypes.ProviderConfig,
 ) (*big.Float, error) {
@@ -203,15 +337,15 @@ func (m *IndexPriceAggregator) CalculateAdjustedPrice(
 		return nil, err
 	}
 
-	if cfg.NormalizeByPair == nil {
-		return price, nil
-	}
-
-	normalizeByIndexPrice, err := m.GetIndexPrice(*cfg.NormalizeByPair)
+	normalizeByPrice, err := m.GetConversionPrice(cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	if normalizeByPrice == nil {
+		return price, nil
+	}
+
 	// Make sure that the price is adjusted by the market price.
-	return new(big.Float).Mul(price, normalizeByIndexPrice), nil
+	return new(big.Float).Mul(price, normalizeByPrice), nil
 }
@@ -3,6 +3,7 @@ package oracle_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -82,6 +83,27 @@ func TestAggregateData(t *testing.T) {
 				BTC_USD.String(): big.NewFloat(75_900), // median of 70_000, 75_900, 77_000
 			},
 		},
+		{
+			name: "BTC/USDT feeds are normalized by the USDT/USD index price calculated in the first aggregation - success",
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				prices := types.Prices{
+					"BTC-USD":  big.NewFloat(70_000),
+					"BTC-USDT": big.NewFloat(70_000),
+					"USDT-USD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(coinbase.Name, prices)
+
+				prices = types.Prices{
+					"BTCUSDT": big.NewFloat(69_000),
+					"USDTUSD": big.NewFloat(1.1),
+				}
+				aggregator.SetProviderPrices(binance.Name, prices)
+			},
+			expectedPrices: types.Prices{
+				USDT_USD.String(): big.NewFloat(1.1),    // average of 1.1, 1.1
+				BTC_USD.String():  big.NewFloat(75_900), // median of 70_000, 75_900, 77_000
+			},
+		},
 		{
 			name: "coinbase USDT direct, coinbase USDC/USDT inverted, binance direct feeds for USDT/USD - success",
 			malleate: func(aggregator *oracle.IndexPriceAggregator) {
@@ -164,6 +186,355 @@ func TestAggregateData(t *testing.T) {
 	}
 }
 
+func TestAggregateDataInDependencyOrder(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	m.SetProviderPrices(coinbase.Name, types.Prices{
+		"USDT-USD": big.NewFloat(1.0),
+		"BTC-USD":  big.NewFloat(70_000),
+		"BTC-USDT": big.NewFloat(70_000),
+		"ETH-USDT": big.NewFloat(3_000),
+	})
+	m.SetProviderPrices(binance.Name, types.Prices{
+		"USDTUSD":  big.NewFloat(1.2),
+		"BTCUSDT":  big.NewFloat(69_000),
+		"ETHUSDT":  big.NewFloat(3_100),
+		"PEPEUSDT": big.NewFloat(0.00001),
+	})
+
+	// No index prices have been calculated yet, so every normalized market must be converted by the index
+	// prices calculated earlier in the same aggregation.
+	require.Empty(t, m.GetIndexPrices())
+	m.AggregatePrices()
+
+	expectedPrices := types.Prices{
+		USDT_USD.String(): big.NewFloat(1.1),      // average of 1.0, 1.2
+		BTC_USD.String():  big.NewFloat(75_900),   // median of 70_000, 77_000, 75_900
+		PEPE_USD.String(): big.NewFloat(0.000011), // 0.00001 * 1.1
+	}
+
+	result := m.GetIndexPrices()
+	require.Equal(t, len(expectedPrices), len(result))
+	for ticker, expectedPrice := range expectedPrices {
+		price, ok := result[ticker]
+		require.True(t, ok, ticker)
+		require.Equal(t, expectedPrice.SetPrec(36), price.SetPrec(36), ticker)
+	}
+
+	// ETH/USD only has two of its three providers, but both were converted in the first aggregation.
+	eth, ok := m.GetPriceDetails()[ethusdCP.String()]
+	require.True(t, ok)
+	for _, provider := range eth.Providers[1:] {
+		require.Equal(t, types.PriceInputBelowMinProviderCount, provider.Status)
+	}
+}
+
+func TestGetPriceDetails(t *testing.T) {
+	m, err := oracle.NewIndexPriceAggregator(logger, marketmap, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	ts := time.Now().UTC()
+	setProviderPrices := func(provider string, inputs map[string]types.ProviderPrice) {
+		prices := make(types.Prices)
+		for ticker, input := range inputs {
+			if !input.Stale {
+				prices[ticker] = input.Price
+			}
+		}
+
+		m.SetProviderPrices(provider, prices)
+		m.SetProviderPriceInputs(provider, inputs)
+	}
+
+	setProviderPrices(coinbase.Name, map[string]types.ProviderPrice{
+		"BTC-USD":  {Price: big.NewFloat(70_000), Timestamp: ts},
+		"BTC-USDT": {Price: big.NewFloat(70_000), Timestamp: ts},
+		"USDT-USD": {Price: big.NewFloat(1.0), Timestamp: ts},
+	})
+	setProviderPrices(binance.Name, map[string]types.ProviderPrice{
+		"BTCUSDT": {Price: big.NewFloat(69_000), Timestamp: ts.Add(-time.Hour), Stale: true},
+		"USDTUSD": {Price: big.NewFloat(1.2), Timestamp: ts},
+	})
+	setProviderPrices(kucoin.Name, map[string]types.ProviderPrice{
+		"BTC-USDT": {Price: big.NewFloat(70_000), Timestamp: ts},
+	})
+	m.SetIndexPrices(types.Prices{
+		usdtusdCP.String(): big.NewFloat(1.1),
+	})
+
+	m.AggregatePrices()
+	details := m.GetPriceDetails()
+
+	statuses := func(market types.MarketPriceDetails) []types.PriceInputStatus {
+		statuses := make([]types.PriceInputStatus, len(market.Providers))
+		for i, provider := range market.Providers {
+			statuses[i] = provider.Status
+		}
+		return statuses
+	}
+
+	t.Run("market below the min provider count", func(t *testing.T) {
+		btc, ok := details[btcusdCP.String()]
+		require.True(t, ok)
+		require.Nil(t, btc.Price)
+		require.Nil(t, btc.ScaledPrice)
+		require.Equal(t, BTC_USD.MinProviderCount, btc.MinProviderCount)
+		require.Equal(t, []types.PriceInputStatus{
+			types.PriceInputBelowMinProviderCount,
+			types.PriceInputBelowMinProviderCount,
+			types.PriceInputStale,
+		}, statuses(btc))
+
+		// the converted price uses the index price of the normalization pair
+		converted := btc.Providers[1]
+		require.Equal(t, ts, converted.Timestamp)
+		require.Equal(t, big.NewFloat(1.1).String(), converted.NormalizeByPrice.String())
+		require.Equal(t, big.NewFloat(77_000).String(), converted.ConvertedPrice.String())
+
+		// the stale price is reported, but not converted
+		stale := btc.Providers[2]
+		require.Equal(t, big.NewFloat(69_000), stale.Price)
+		require.Equal(t, ts.Add(-time.Hour), stale.Timestamp)
+		require.Nil(t, stale.ConvertedPrice)
+	})
+
+	t.Run("market with an aggregated price", func(t *testing.T) {
+		usdt, ok := details[usdtusdCP.String()]
+		require.True(t, ok)
+		require.Equal(t, big.NewFloat(1.1).SetPrec(36), usdt.Price.SetPrec(36))
+		require.NotNil(t, usdt.ScaledPrice)
+		require.Equal(t, []types.PriceInputStatus{
+			types.PriceInputIncluded,
+			types.PriceInputMissing,
+			types.PriceInputIncluded,
+			types.PriceInputMissingIndexPrice,
+		}, statuses(usdt))
+
+		// the missing index price is reported with the raw price
+		missingIndex := usdt.Providers[3]
+		require.Equal(t, big.NewFloat(70_000), missingIndex.Price)
+		require.True(t, missingIndex.Invert)
+		require.Nil(t, missingIndex.NormalizeByPrice)
+		require.Nil(t, missingIndex.ConvertedPrice)
+	})
+
+	t.Run("details are reset with the next aggregation", func(t *testing.T) {
+		m.Reset()
+		m.AggregatePrices()
+
+		for _, market := range m.GetPriceDetails() {
+			for _, status := range statuses(market) {
+				require.Equal(t, types.PriceInputMissing, status)
+			}
+		}
+	})
+}
+
+func TestVolumeWeightedAggregation(t *testing.T) {
+	ts := time.Now().UTC()
+	market := func(aggregation string) mmtypes.MarketMap {
+		ticker := BTC_USD
+		if aggregation != "" {
+			ticker.Metadata_JSON = `{"provider_aggregation":"` + aggregation + `"}`
+		}
+
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				ticker.String(): {
+					Ticker: ticker,
+					ProviderConfigs: []mmtypes.ProviderConfig{
+						{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+						{Name: binance.Name, OffChainTicker: "BTCUSD"},
+						{Name: kucoin.Name, OffChainTicker: "BTC-USD"},
+					},
+				},
+			},
+		}
+	}
+
+	// binance reports the most volume, and kucoin is a thin venue with an outlier price
+	inputs := map[string]types.ProviderPrice{
+		coinbase.Name: {Price: big.NewFloat(100), Timestamp: ts, Volume: big.NewFloat(300)},
+		binance.Name:  {Price: big.NewFloat(101), Timestamp: ts, Volume: big.NewFloat(600)},
+		kucoin.Name:   {Price: big.NewFloat(200), Timestamp: ts, Volume: big.NewFloat(100), Liquidity: big.NewFloat(900)},
+	}
+	tickers := map[string]string{
+		coinbase.Name: "BTC-USD",
+		binance.Name:  "BTCUSD",
+		kucoin.Name:   "BTC-USD",
+	}
+
+	cases := []struct {
+		name                string
+		aggregation         string
+		inputs              func() map[string]types.ProviderPrice
+		expectedPrice       *big.Float
+		expectedAggregation string
+	}{
+		{
+			name:                "defaults to the median",
+			expectedPrice:       big.NewFloat(101),
+			expectedAggregation: oracle.MedianAggregation,
+		},
+		{
+			name:                "invalid aggregation uses the median",
+			aggregation:         "mode",
+			expectedPrice:       big.NewFloat(101),
+			expectedAggregation: oracle.MedianAggregation,
+		},
+		{
+			name:                "volume weighted median",
+			aggregation:         oracle.VolumeWeightedMedianAggregation,
+			expectedPrice:       big.NewFloat(101),
+			expectedAggregation: oracle.VolumeWeightedMedianAggregation,
+		},
+		{
+			name:        "vwap",
+			aggregation: oracle.VWAPAggregation,
+			// (100 * 300 + 101 * 600 + 200 * 100) / 1000
+			expectedPrice:       big.NewFloat(110.6),
+			expectedAggregation: oracle.VWAPAggregation,
+		},
+		{
+			name:        "liquidity is used if not every price has a volume",
+			aggregation: oracle.VWAPAggregation,
+			inputs: func() map[string]types.ProviderPrice {
+				return map[string]types.ProviderPrice{
+					coinbase.Name: {Price: big.NewFloat(100), Timestamp: ts, Volume: big.NewFloat(300), Liquidity: big.NewFloat(100)},
+					binance.Name:  {Price: big.NewFloat(101), Timestamp: ts, Liquidity: big.NewFloat(100)},
+					kucoin.Name:   inputs[kucoin.Name],
+				}
+			},
+			// (100 * 100 + 101 * 100 + 200 * 900) / 1100
+			expectedPrice:       new(big.Float).Quo(big.NewFloat(200100), big.NewFloat(1100)),
+			expectedAggregation: oracle.VWAPAggregation,
+		},
+		{
+			name:        "volumes and liquidities are not mixed",
+			aggregation: oracle.VWAPAggregation,
+			inputs: func() map[string]types.ProviderPrice {
+				return map[string]types.ProviderPrice{
+					coinbase.Name: inputs[coinbase.Name],
+					binance.Name:  inputs[binance.Name],
+					kucoin.Name:   {Price: big.NewFloat(200), Timestamp: ts, Liquidity: big.NewFloat(100)},
+				}
+			},
+			expectedPrice:       big.NewFloat(101),
+			expectedAggregation: oracle.MedianAggregation,
+		},
+		{
+			name:        "price without a volume or liquidity falls back to the median",
+			aggregation: oracle.VWAPAggregation,
+			inputs: func() map[string]types.ProviderPrice {
+				return map[string]types.ProviderPrice{
+					coinbase.Name: {Price: big.NewFloat(100), Timestamp: ts},
+					binance.Name:  inputs[binance.Name],
+					kucoin.Name:   inputs[kucoin.Name],
+				}
+			},
+			expectedPrice:       big.NewFloat(101),
+			expectedAggregation: oracle.MedianAggregation,
+		},
+		{
+			name:        "zero total volume falls back to the median",
+			aggregation: oracle.VolumeWeightedMedianAggregation,
+			inputs: func() map[string]types.ProviderPrice {
+				zero := make(map[string]types.ProviderPrice)
+				for provider, input := range inputs {
+					input.Volume, input.Liquidity = big.NewFloat(0), nil
+					zero[provider] = input
+				}
+				return zero
+			},
+			expectedPrice:       big.NewFloat(101),
+			expectedAggregation: oracle.MedianAggregation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := oracle.NewIndexPriceAggregator(logger, market(tc.aggregation), metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			providerInputs := inputs
+			if tc.inputs != nil {
+				providerInputs = tc.inputs()
+			}
+			for provider, input := range providerInputs {
+				m.SetProviderPrices(provider, types.Prices{tickers[provider]: input.Price})
+				m.SetProviderPriceInputs(provider, map[string]types.ProviderPrice{tickers[provider]: input})
+			}
+
+			m.AggregatePrices()
+
+			details, ok := m.GetPriceDetails()[btcusdCP.String()]
+			require.True(t, ok)
+			require.Equal(t, tc.expectedAggregation, details.Aggregation)
+			require.Zero(t, tc.expectedPrice.Cmp(details.Price), "expected %s, got %s", tc.expectedPrice, details.Price)
+
+			// the weights are the volumes, or the liquidities if not every price has a volume
+			var weight *big.Float
+			switch {
+			case providerInputs[coinbase.Name].Volume != nil && providerInputs[binance.Name].Volume != nil &&
+				providerInputs[kucoin.Name].Volume != nil:
+				weight = providerInputs[kucoin.Name].Volume
+			case providerInputs[coinbase.Name].Liquidity != nil && providerInputs[binance.Name].Liquidity != nil &&
+				providerInputs[kucoin.Name].Liquidity != nil:
+				weight = providerInputs[kucoin.Name].Liquidity
+			}
+			require.Equal(t, weight, details.Providers[2].Weight)
+		})
+	}
+}
+
+func TestVolumeWeightedAggregationInvertedVolume(t *testing.T) {
+	ts := time.Now().UTC()
+	ticker := BTC_USD
+	ticker.Metadata_JSON = `{"provider_aggregation":"` + oracle.VWAPAggregation + `"}`
+	ticker.MinProviderCount = 2
+
+	m, err := oracle.NewIndexPriceAggregator(logger, mmtypes.MarketMap{
+		Markets: map[string]mmtypes.Market{
+			ticker.String(): {
+				Ticker: ticker,
+				ProviderConfigs: []mmtypes.ProviderConfig{
+					{Name: coinbase.Name, OffChainTicker: "BTC-USD"},
+					{Name: binance.Name, OffChainTicker: "USDBTC", Invert: true},
+				},
+			},
+		},
+	}, metrics.NewNopMetrics())
+	require.NoError(t, err)
+
+	// binance reports USD/BTC, so its volume of 20000 USD is 200 BTC at a price of 100 USD per BTC
+	inputs := map[string]types.ProviderPrice{
+		coinbase.Name: {Price: big.NewFloat(110), Timestamp: ts, Volume: big.NewFloat(100)},
+		binance.Name:  {Price: big.NewFloat(0.01), Timestamp: ts, Volume: big.NewFloat(20000)},
+	}
+	tickers := map[string]string{
+		coinbase.Name: "BTC-USD",
+		binance.Name:  "USDBTC",
+	}
+	for provider, input := range inputs {
+		m.SetProviderPrices(provider, types.Prices{tickers[provider]: input.Price})
+		m.SetProviderPriceInputs(provider, map[string]types.ProviderPrice{tickers[provider]: input})
+	}
+
+	m.AggregatePrices()
+
+	details, ok := m.GetPriceDetails()[btcusdCP.String()]
+	require.True(t, ok)
+	require.Equal(t, oracle.VWAPAggregation, details.Aggregation)
+
+	weight, _ := details.Providers[1].Weight.Float64()
+	require.InDelta(t, 200, weight, 1e-9)
+
+	// (110 * 100 + 100 * 200) / 300
+	price, _ := details.Price.Float64()
+	require.InDelta(t, 31000.0/300, price, 1e-9)
+}
+
 func TestCalculateConvertedPrices(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -617,6 +988,77 @@ func TestCalculateAdjustedPrice(t *testing.T) {
 			expectedPrice: big.NewFloat(0.1e-18),
 			expectedErr:   false,
 		},
+		{
+			name:   "price is adjusted along a conversion path (PEPE/ETH * ETH/USDT * USDT/USD = PEPE/USD)",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-ETH",
+				NormalizeByPairs: []mmtypes.ConversionPair{
+					{Pair: pkgtypes.NewCurrencyPair("ETH", "USDT")},
+					{Pair: usdtusdCP},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"PEPE-ETH": big.NewFloat(0.000005),
+				})
+
+				aggregator.SetIndexPrices(types.Prices{
+					"ETH/USDT":         big.NewFloat(4_000),
+					usdtusdCP.String(): big.NewFloat(1.1),
+				})
+			},
+			expectedPrice: big.NewFloat(0.022),
+			expectedErr:   false,
+		},
+		{
+			name:   "price is adjusted along a conversion path with an inverted step (PEPE/BTC * (USD/BTC)^-1 = PEPE/USD)",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-BTC",
+				NormalizeByPairs: []mmtypes.ConversionPair{
+					{Pair: pkgtypes.NewCurrencyPair("BTC", "ETH")},
+					{Pair: pkgtypes.NewCurrencyPair("USD", "ETH"), Invert: true},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"PEPE-BTC": big.NewFloat(0.0000001),
+				})
+
+				aggregator.SetIndexPrices(types.Prices{
+					"BTC/ETH": big.NewFloat(20),
+					"USD/ETH": big.NewFloat(0.00025),
+				})
+			},
+			expectedPrice: big.NewFloat(0.008),
+			expectedErr:   false,
+		},
+		{
+			name:   "price cannot be adjusted if an intermediate index price does not exist",
+			target: PEPE_USD,
+			cfg: mmtypes.ProviderConfig{
+				Name:           coinbase.Name,
+				OffChainTicker: "PEPE-ETH",
+				NormalizeByPairs: []mmtypes.ConversionPair{
+					{Pair: pkgtypes.NewCurrencyPair("ETH", "USDT")},
+					{Pair: usdtusdCP},
+				},
+			},
+			malleate: func(aggregator *oracle.IndexPriceAggregator) {
+				aggregator.SetProviderPrices(coinbase.Name, types.Prices{
+					"PEPE-ETH": big.NewFloat(0.000005),
+				})
+
+				aggregator.SetIndexPrices(types.Prices{
+					usdtusdCP.String(): big.NewFloat(1.1),
+				})
+			},
+			expectedPrice: nil,
+			expectedErr:   true,
+		},
 	}
 
 	for _, tc := range testCases {
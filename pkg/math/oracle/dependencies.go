@@ -0,0 +1,163 @@
+package oracle
+
+import (
+	"sort"
+
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// SortMarkets returns the markets of the given market map ordered such that every market comes after the
+// markets that its provider prices are normalized by, so that their index prices can be calculated first
+// within the same aggregation. Markets are otherwise ordered by ticker, so that the order is deterministic.
+//
+// Markets that depend on each other in a cycle cannot be ordered this way. The cycle is broken at the
+// market in the cycle with the fewest provider configs that are normalized by markets that are not yet
+// ordered (ties are broken by ticker). Those provider configs are then converted using the previous index
+// prices.
+func SortMarkets(mm mmtypes.MarketMap) []mmtypes.Market {
+	// dependencies maps each ticker to the set of tickers that it is normalized by, and dependents is
+	// the inverse.
+	dependencies := make(map[string]map[string]struct{}, len(mm.Markets))
+	dependents := make(map[string][]string, len(mm.Markets))
+	for ticker, market := range mm.Markets {
+		dependencies[ticker] = make(map[string]struct{})
+		for _, dependency := range marketDependencies(market) {
+			if _, ok := mm.Markets[dependency]; !ok || dependency == ticker {
+				continue
+			}
+
+			if _, ok := dependencies[ticker][dependency]; ok {
+				continue
+			}
+
+			dependencies[ticker][dependency] = struct{}{}
+			dependents[dependency] = append(dependents[dependency], ticker)
+		}
+	}
+
+	var ready []string
+	for ticker, deps := range dependencies {
+		if len(deps) == 0 {
+			ready = append(ready, ticker)
+		}
+	}
+
+	sorted := make([]mmtypes.Market, 0, len(mm.Markets))
+	ordered := make(map[string]struct{}, len(mm.Markets))
+	for len(sorted) < len(mm.Markets) {
+		if len(ready) == 0 {
+			ready = []string{breakCycle(mm, dependencies, dependents, ordered)}
+		}
+
+		sort.Strings(ready)
+
+		var next []string
+		for _, ticker := range ready {
+			if _, ok := ordered[ticker]; ok {
+				continue
+			}
+
+			ordered[ticker] = struct{}{}
+			sorted = append(sorted, mm.Markets[ticker])
+
+			for _, dependent := range dependents[ticker] {
+				if _, ok := ordered[dependent]; ok {
+					continue
+				}
+
+				delete(dependencies[dependent], ticker)
+				if len(dependencies[dependent]) == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+
+		ready = next
+	}
+
+	return sorted
+}
+
+// breakCycle returns the unordered market in a cycle with the fewest provider configs that are normalized
+// by unordered markets, breaking ties by ticker.
+func breakCycle(
+	mm mmtypes.MarketMap,
+	dependencies map[string]map[string]struct{},
+	dependents map[string][]string,
+	ordered map[string]struct{},
+) string {
+	// Every unordered market is either in a cycle or depends on one. Markets that no unordered market
+	// depends on are pruned until only the markets in (or between) cycles remain.
+	numDependents := make(map[string]int)
+	for ticker := range mm.Markets {
+		if _, ok := ordered[ticker]; ok {
+			continue
+		}
+
+		numDependents[ticker] = 0
+		for _, dependent := range dependents[ticker] {
+			if _, ok := ordered[dependent]; !ok {
+				numDependents[ticker]++
+			}
+		}
+	}
+
+	var pruned []string
+	for ticker, n := range numDependents {
+		if n == 0 {
+			pruned = append(pruned, ticker)
+		}
+	}
+	for len(pruned) > 0 {
+		ticker := pruned[len(pruned)-1]
+		pruned = pruned[:len(pruned)-1]
+		delete(numDependents, ticker)
+
+		for dependency := range dependencies[ticker] {
+			if _, ok := numDependents[dependency]; !ok {
+				continue
+			}
+
+			numDependents[dependency]--
+			if numDependents[dependency] == 0 {
+				pruned = append(pruned, dependency)
+			}
+		}
+	}
+
+	var (
+		cycleTicker string
+		minConfigs  = -1
+	)
+	for ticker := range numDependents {
+		var numConfigs int
+		for _, cfg := range mm.Markets[ticker].ProviderConfigs {
+			for _, step := range cfg.ConversionPairs() {
+				if _, ok := dependencies[ticker][step.Pair.String()]; ok {
+					numConfigs++
+					break
+				}
+			}
+		}
+
+		if minConfigs < 0 || numConfigs < minConfigs || (numConfigs == minConfigs && ticker < cycleTicker) {
+			cycleTicker = ticker
+			minConfigs = numConfigs
+		}
+	}
+
+	return cycleTicker
+}
+
+// marketDependencies returns the tickers of the markets that the provider prices of the given market are
+// normalized by.
+func marketDependencies(market mmtypes.Market) []string {
+	var dependencies []string
+	for _, cfg := range market.ProviderConfigs {
+		for _, step := range cfg.ConversionPairs() {
+			dependencies = append(dependencies, step.Pair.String())
+		}
+	}
+
+	return dependencies
+}
@@ -0,0 +1,87 @@
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/pkg/math/oracle"
+	pkgtypes "github.com/1119-Labs/slinky/pkg/types"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func TestSortMarkets(t *testing.T) {
+	normalizedBy := func(ticker mmtypes.Ticker, pairs ...pkgtypes.CurrencyPair) mmtypes.Market {
+		market := mmtypes.Market{Ticker: ticker}
+		for _, pair := range pairs {
+			market.ProviderConfigs = append(market.ProviderConfigs, mmtypes.ProviderConfig{
+				Name:            "coinbase",
+				OffChainTicker:  ticker.String(),
+				NormalizeByPair: &pair,
+			})
+		}
+		return market
+	}
+
+	tickers := func(markets []mmtypes.Market) []string {
+		tickers := make([]string, len(markets))
+		for i, market := range markets {
+			tickers[i] = market.Ticker.String()
+		}
+		return tickers
+	}
+
+	t.Run("empty market map", func(t *testing.T) {
+		require.Empty(t, oracle.SortMarkets(mmtypes.MarketMap{}))
+	})
+
+	t.Run("independent markets are sorted by ticker", func(t *testing.T) {
+		mm := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+			USDT_USD.String(): normalizedBy(USDT_USD),
+			BTC_USD.String():  normalizedBy(BTC_USD),
+			ETH_USD.String():  normalizedBy(ETH_USD),
+		}}
+
+		require.Equal(t, []string{"BTC/USD", "ETH/USD", "USDT/USD"}, tickers(oracle.SortMarkets(mm)))
+	})
+
+	t.Run("markets are sorted after the markets they are normalized by", func(t *testing.T) {
+		mm := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+			BTC_USD.String():  normalizedBy(BTC_USD, usdtusdCP),
+			ETH_USD.String():  normalizedBy(ETH_USD, btcusdCP),
+			USDT_USD.String(): normalizedBy(USDT_USD),
+			PEPE_USD.String(): normalizedBy(PEPE_USD, ethusdCP, usdtusdCP),
+		}}
+
+		require.Equal(t, []string{"USDT/USD", "BTC/USD", "ETH/USD", "PEPE/USD"}, tickers(oracle.SortMarkets(mm)))
+	})
+
+	t.Run("every step of a conversion path is a dependency", func(t *testing.T) {
+		pepe := normalizedBy(PEPE_USD)
+		pepe.ProviderConfigs = []mmtypes.ProviderConfig{{
+			Name:           "coinbase",
+			OffChainTicker: "PEPE-BTC",
+			NormalizeByPairs: []mmtypes.ConversionPair{
+				{Pair: pkgtypes.NewCurrencyPair("BTC", "ETH")},
+				{Pair: ethusdCP},
+			},
+		}}
+
+		mm := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
+			PEPE_USD.String(): pepe,
+			"BTC/ETH":         normalizedBy(mmtypes.Ticker{CurrencyPair: pkgtypes.NewCurrencyPair("BTC", "ETH")}),
+			ETH_USD.String():  normalizedBy(ETH_USD, pkgtypes.NewCurrencyPair("BTC", "ETH")),
+		}}
+
+		require.Equal(t, []string{"BTC/ETH", "ETH/USD", "PEPE/USD"}, tickers(oracle.SortMarkets(mm)))
+	})
+
+	t.Run("cycles are broken at the market with the fewest normalized provider configs", func(t *testing.T) {
+		// BTC/USD and USDT/USD are normalized by each other, and PEPE/USD depends on the cycle.
+		require.Equal(
+			t,
+			[]string{"USDT/USD", "BTC/USD", "ETH/USD", "PEPE/USD"},
+			tickers(oracle.SortMarkets(marketmap)),
+		)
+	})
+}
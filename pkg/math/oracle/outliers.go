@@ -0,0 +1,208 @@
+package oracle
+
+import (
+	"math/big"
+
+	"go.uber.org/zap"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/pkg/math"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+const (
+	// OutlierIndexPriceDeviation is the reason reported for a provider price that deviates too far from
+	// the market's previous index price.
+	OutlierIndexPriceDeviation = "index_price_deviation"
+	// OutlierMAD is the reason reported for a provider price that deviates too far from the median of the
+	// provider prices, relative to their median absolute deviation.
+	OutlierMAD = "mad"
+	// OutlierMedianDeviation is the reason reported for a provider price that deviates too far from the
+	// median of the provider prices.
+	OutlierMedianDeviation = "median_deviation"
+)
+
+// OutlierFilter configures how outlying converted prices of a market are rejected before they are
+// aggregated. A nil bound disables the corresponding filter. The filters are applied in the order of
+// the fields, each to the prices that were not rejected by the previous filters.
+type OutlierFilter struct {
+	// MaxIndexPriceDeviation is the maximum fractional deviation of a converted price from the market's
+	// previous index price. This filter is skipped if there is no previous index price, or if it would
+	// reject a majority of the prices, as a consensus move away from the previous index price is a price
+	// move rather than an outlier.
+	MaxIndexPriceDeviation *big.Float
+	// MADMultiplier is the maximum deviation of a converted price from the median of the converted prices,
+	// as a multiple of their median absolute deviation (MAD). This filter is skipped if the MAD is zero,
+	// i.e. if a majority of the prices equal the median, as is common for pegged assets.
+	MADMultiplier *big.Float
+	// MaxMedianDeviation is the maximum fractional deviation of a converted price from the median of the
+	// converted prices.
+	MaxMedianDeviation *big.Float
+}
+
+// outlierFilter returns the outlier filter configured by the given ticker's metadata. Bounds that are
+// not positive decimals are ignored.
+func (m *IndexPriceAggregator) outlierFilter(ticker mmtypes.Ticker) OutlierFilter {
+	// the ticker metadata is free-form, so metadata without an outlier filter is not an error
+	if ticker.Metadata_JSON == "" {
+		return OutlierFilter{}
+	}
+
+	cfg, err := tickermetadata.OutlierFilterFromJSONString(ticker.Metadata_JSON)
+	if err != nil {
+		return OutlierFilter{}
+	}
+
+	parse := func(field, value string) *big.Float {
+		if value == "" {
+			return nil
+		}
+
+		bound, ok := new(big.Float).SetString(value)
+		if !ok || bound.Sign() <= 0 {
+			m.logger.Warn(
+				"invalid outlier filter bound in ticker metadata; ignoring it",
+				zap.String("ticker", ticker.String()),
+				zap.String(field, value),
+			)
+
+			return nil
+		}
+
+		return bound
+	}
+
+	return OutlierFilter{
+		MaxIndexPriceDeviation: parse("max_index_price_deviation", cfg.MaxIndexPriceDeviation),
+		MADMultiplier:          parse("outlier_mad_multiplier", cfg.MADMultiplier),
+		MaxMedianDeviation:     parse("max_median_deviation", cfg.MaxMedianDeviation),
+	}
+}
+
+// filterOutliers applies the outlier filter configured for the given ticker to the converted prices in
+// the given details, marking the rejected prices as outliers. It returns the converted prices that were
+// not rejected, in the order of the details.
+func (m *IndexPriceAggregator) filterOutliers(
+	ticker mmtypes.Ticker,
+	details []types.ProviderPriceDetails,
+) []*big.Float {
+	filter := m.outlierFilter(ticker)
+
+	if filter.MaxIndexPriceDeviation != nil {
+		if indexPrice, err := m.GetIndexPrice(ticker.CurrencyPair); err == nil && indexPrice.Sign() != 0 {
+			m.rejectOutliers(ticker, details, OutlierIndexPriceDeviation, true, func(price *big.Float) bool {
+				return relativeDeviation(price, indexPrice).Cmp(filter.MaxIndexPriceDeviation) > 0
+			})
+		}
+	}
+
+	if prices := includedPrices(details); filter.MADMultiplier != nil && len(prices) > 0 {
+		median := math.CalculateMedian(prices)
+
+		deviations := make([]*big.Float, len(prices))
+		for i, price := range prices {
+			deviations[i] = absDiff(price, median)
+		}
+
+		// a zero MAD would reject every price that differs from the median at all
+		if mad := math.CalculateMedian(deviations); mad.Sign() != 0 {
+			bound := new(big.Float).Mul(mad, filter.MADMultiplier)
+
+			m.rejectOutliers(ticker, details, OutlierMAD, false, func(price *big.Float) bool {
+				return absDiff(price, median).Cmp(bound) > 0
+			})
+		}
+	}
+
+	if filter.MaxMedianDeviation != nil {
+		median := math.CalculateMedian(includedPrices(details))
+		if median != nil && median.Sign() != 0 {
+			m.rejectOutliers(ticker, details, OutlierMedianDeviation, false, func(price *big.Float) bool {
+				return relativeDeviation(price, median).Cmp(filter.MaxMedianDeviation) > 0
+			})
+		}
+	}
+
+	return includedPrices(details)
+}
+
+// rejectOutliers marks the included converted prices in the given details for which reject returns true
+// as outliers, and reports them in the logs and metrics. The prices are not rejected if every price would
+// be rejected or, if requireMajority is set, if a majority of the prices would be rejected.
+func (m *IndexPriceAggregator) rejectOutliers(
+	ticker mmtypes.Ticker,
+	details []types.ProviderPriceDetails,
+	reason string,
+	requireMajority bool,
+	reject func(*big.Float) bool,
+) {
+	var (
+		numIncluded int
+		rejected    []int
+	)
+	for i, detail := range details {
+		if detail.Status != types.PriceInputIncluded {
+			continue
+		}
+
+		numIncluded++
+		if reject(detail.ConvertedPrice) {
+			rejected = append(rejected, i)
+		}
+	}
+
+	if len(rejected) == 0 {
+		return
+	}
+
+	if len(rejected) == numIncluded || (requireMajority && 2*len(rejected) > numIncluded) {
+		m.logger.Debug(
+			"outlier filter would reject too many prices; skipping it",
+			zap.String("ticker", ticker.String()),
+			zap.String("reason", reason),
+			zap.Int("num_rejected", len(rejected)),
+			zap.Int("num_prices", numIncluded),
+		)
+
+		return
+	}
+
+	providers := make([]string, len(rejected))
+	for i, index := range rejected {
+		details[index].Status = types.PriceInputOutlier
+		providers[i] = details[index].Provider
+		m.metrics.AddProviderOutlier(details[index].Provider, ticker.String(), reason)
+	}
+
+	m.logger.Info(
+		"rejected outlying provider prices",
+		zap.String("ticker", ticker.String()),
+		zap.String("reason", reason),
+		zap.Strings("providers", providers),
+	)
+}
+
+// includedPrices returns the converted prices in the given details that are included in the aggregation.
+func includedPrices(details []types.ProviderPriceDetails) []*big.Float {
+	prices := make([]*big.Float, 0, len(details))
+	for _, detail := range details {
+		if detail.Status == types.PriceInputIncluded {
+			prices = append(prices, detail.ConvertedPrice)
+		}
+	}
+
+	return prices
+}
+
+// absDiff returns |a - b|.
+func absDiff(a, b *big.Float) *big.Float {
+	diff := new(big.Float).Sub(a, b)
+	return diff.Abs(diff)
+}
+
+// relativeDeviation returns |price - reference| / |reference|. The reference must be non-zero.
+func relativeDeviation(price, reference *big.Float) *big.Float {
+	deviation := absDiff(price, reference)
+	return deviation.Quo(deviation, new(big.Float).Abs(reference))
+}
@@ -0,0 +1,209 @@
+package oracle_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/metrics"
+	metricmocks "github.com/1119-Labs/slinky/oracle/metrics/mocks"
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/pkg/math/oracle"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func TestOutlierFilter(t *testing.T) {
+	providers := []string{"coinbase", "binance", "kucoin", "okx", "kraken"}
+	market := func(metadata string, minProviderCount uint64) mmtypes.MarketMap {
+		ticker := BTC_USD
+		ticker.Metadata_JSON = metadata
+		ticker.MinProviderCount = minProviderCount
+
+		cfgs := make([]mmtypes.ProviderConfig, len(providers))
+		for i, provider := range providers {
+			cfgs[i] = mmtypes.ProviderConfig{Name: provider, OffChainTicker: "BTC-USD"}
+		}
+
+		return mmtypes.MarketMap{
+			Markets: map[string]mmtypes.Market{
+				ticker.String(): {
+					Ticker:          ticker,
+					ProviderConfigs: cfgs,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name             string
+		metadata         string
+		minProviderCount uint64
+		prices           []float64
+		indexPrice       *big.Float
+		expectedPrice    *big.Float
+		expectedStatuses []types.PriceInputStatus
+	}{
+		{
+			name:          "no outlier filter",
+			prices:        []float64{100, 101, 102, 99, 150},
+			expectedPrice: big.NewFloat(101),
+		},
+		{
+			name:          "invalid bounds are ignored",
+			metadata:      `{"max_median_deviation":"-1","outlier_mad_multiplier":"abc"}`,
+			prices:        []float64{100, 101, 102, 99, 150},
+			expectedPrice: big.NewFloat(101),
+		},
+		{
+			name:          "max deviation from the median",
+			metadata:      `{"max_median_deviation":"0.05"}`,
+			prices:        []float64{100, 101, 102, 99, 150},
+			expectedPrice: big.NewFloat(100.5),
+			expectedStatuses: []types.PriceInputStatus{
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputOutlier,
+			},
+		},
+		{
+			name:          "MAD based rejection",
+			metadata:      `{"outlier_mad_multiplier":"3"}`,
+			prices:        []float64{100, 101, 102, 99, 150},
+			expectedPrice: big.NewFloat(100.5),
+			expectedStatuses: []types.PriceInputStatus{
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputOutlier,
+			},
+		},
+		{
+			name:          "MAD based rejection is skipped if a majority of the prices equal the median",
+			metadata:      `{"outlier_mad_multiplier":"3"}`,
+			prices:        []float64{1, 1, 1, 1.001, 0.999},
+			expectedPrice: big.NewFloat(1),
+		},
+		{
+			name:          "deviation from the previous index price",
+			metadata:      `{"max_index_price_deviation":"0.1"}`,
+			prices:        []float64{100, 101, 102, 99, 150},
+			indexPrice:    big.NewFloat(100),
+			expectedPrice: big.NewFloat(100.5),
+			expectedStatuses: []types.PriceInputStatus{
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputOutlier,
+			},
+		},
+		{
+			name:          "deviation from the previous index price is skipped without an index price",
+			metadata:      `{"max_index_price_deviation":"0.1"}`,
+			prices:        []float64{100, 101, 102, 99, 150},
+			expectedPrice: big.NewFloat(101),
+		},
+		{
+			name:          "a majority moving away from the previous index price is not rejected",
+			metadata:      `{"max_index_price_deviation":"0.1"}`,
+			prices:        []float64{150, 151, 152, 99, 100},
+			indexPrice:    big.NewFloat(100),
+			expectedPrice: big.NewFloat(150),
+		},
+		{
+			name:          "filters are applied in sequence",
+			metadata:      `{"max_index_price_deviation":"0.1","max_median_deviation":"0.015"}`,
+			prices:        []float64{100, 101, 103, 99, 150},
+			indexPrice:    big.NewFloat(100),
+			expectedPrice: big.NewFloat(100),
+			expectedStatuses: []types.PriceInputStatus{
+				types.PriceInputIncluded,
+				types.PriceInputIncluded,
+				types.PriceInputOutlier,
+				types.PriceInputIncluded,
+				types.PriceInputOutlier,
+			},
+		},
+		{
+			name:             "rejected prices count towards the min provider count",
+			metadata:         `{"max_median_deviation":"0.05"}`,
+			minProviderCount: 5,
+			prices:           []float64{100, 101, 102, 99, 150},
+			expectedStatuses: []types.PriceInputStatus{
+				types.PriceInputBelowMinProviderCount,
+				types.PriceInputBelowMinProviderCount,
+				types.PriceInputBelowMinProviderCount,
+				types.PriceInputBelowMinProviderCount,
+				types.PriceInputOutlier,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			minProviderCount := tc.minProviderCount
+			if minProviderCount == 0 {
+				minProviderCount = 3
+			}
+
+			m, err := oracle.NewIndexPriceAggregator(logger, market(tc.metadata, minProviderCount), metrics.NewNopMetrics())
+			require.NoError(t, err)
+
+			for i, provider := range providers {
+				m.SetProviderPrices(provider, types.Prices{"BTC-USD": big.NewFloat(tc.prices[i])})
+			}
+			if tc.indexPrice != nil {
+				m.SetIndexPrices(types.Prices{btcusdCP.String(): tc.indexPrice})
+			}
+
+			m.AggregatePrices()
+
+			details, ok := m.GetPriceDetails()[btcusdCP.String()]
+			require.True(t, ok)
+
+			if tc.expectedPrice == nil {
+				require.Nil(t, details.Price)
+			} else {
+				require.Zero(t, tc.expectedPrice.Cmp(details.Price), "expected %s, got %s", tc.expectedPrice, details.Price)
+			}
+
+			expectedStatuses := tc.expectedStatuses
+			if expectedStatuses == nil {
+				expectedStatuses = make([]types.PriceInputStatus, len(providers))
+				for i := range expectedStatuses {
+					expectedStatuses[i] = types.PriceInputIncluded
+				}
+			}
+
+			statuses := make([]types.PriceInputStatus, len(details.Providers))
+			for i, provider := range details.Providers {
+				statuses[i] = provider.Status
+			}
+			require.Equal(t, expectedStatuses, statuses)
+		})
+	}
+
+	t.Run("rejected providers are reported in metrics", func(t *testing.T) {
+		metricsMock := metricmocks.NewMetrics(t)
+		metricsMock.On("AddProviderOutlier", "kraken", btcusdCP.String(), oracle.OutlierMedianDeviation).Once()
+		metricsMock.On("AddProviderCountForMarket", btcusdCP.String(), 4).Once()
+		metricsMock.On("AddProviderTick", mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metricsMock.On("UpdatePrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+		metricsMock.On("AddTickerTick", mock.Anything).Maybe()
+		metricsMock.On("UpdateAggregatePrice", mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+		m, err := oracle.NewIndexPriceAggregator(logger, market(`{"max_median_deviation":"0.05"}`, 3), metricsMock)
+		require.NoError(t, err)
+
+		for i, provider := range providers {
+			m.SetProviderPrices(provider, types.Prices{"BTC-USD": big.NewFloat([]float64{100, 101, 102, 99, 150}[i])})
+		}
+
+		m.AggregatePrices()
+	})
+}
@@ -36,6 +36,54 @@ func (m *IndexPriceAggregator) GetProviderPrice(
 	return price, nil
 }
 
+// GetProviderVolume returns the trading volume reported alongside the relevant provider price, denominated
+// in the base asset of the market. Returns nil if the provider did not report a volume, or if it is negative.
+func (m *IndexPriceAggregator) GetProviderVolume(
+	cfg mmtypes.ProviderConfig,
+) *big.Float {
+	return m.getProviderWeight(cfg, func(input types.ProviderPrice) *big.Float {
+		return input.Volume
+	})
+}
+
+// GetProviderLiquidity returns the liquidity reported alongside the relevant provider price, denominated in
+// the base asset of the market. Returns nil if the provider did not report a liquidity, or if it is negative.
+func (m *IndexPriceAggregator) GetProviderLiquidity(
+	cfg mmtypes.ProviderConfig,
+) *big.Float {
+	return m.getProviderWeight(cfg, func(input types.ProviderPrice) *big.Float {
+		return input.Liquidity
+	})
+}
+
+// getProviderWeight returns the given weight of the relevant provider price, denominated in the base asset
+// of the market. Providers report weights in the base asset of their ticker, which is the market's quote
+// asset if the provider price is inverted, in which case the weight is converted by the provider price.
+func (m *IndexPriceAggregator) getProviderWeight(
+	cfg mmtypes.ProviderConfig,
+	get func(types.ProviderPrice) *big.Float,
+) *big.Float {
+	input, ok := m.priceInputs[cfg.Name][cfg.OffChainTicker]
+	if !ok {
+		return nil
+	}
+
+	weight := get(input)
+	if weight == nil || weight.Sign() < 0 {
+		return nil
+	}
+
+	if cfg.Invert {
+		if input.Price == nil || input.Price.Sign() <= 0 {
+			return nil
+		}
+
+		weight = new(big.Float).Mul(weight, input.Price)
+	}
+
+	return weight
+}
+
 // GetIndexPrice returns the relevant index price. Note that the aggregator's
 // index price cache stores prices in the form of ticker -> price.
 func (m *IndexPriceAggregator) GetIndexPrice(
@@ -53,6 +101,41 @@ func (m *IndexPriceAggregator) GetIndexPrice(
 	return price, nil
 }
 
+// GetConversionPrice returns the price that the relevant provider price is multiplied by to convert it to
+// the market's ticker, i.e. the product of the index prices along the provider's conversion path, where
+// inverted steps use the inverse of the index price. Returns nil if the provider price is not converted.
+func (m *IndexPriceAggregator) GetConversionPrice(
+	cfg mmtypes.ProviderConfig,
+) (*big.Float, error) {
+	steps := cfg.ConversionPairs()
+	if len(steps) == 0 {
+		return nil, nil
+	}
+
+	var conversionPrice *big.Float
+	for _, step := range steps {
+		indexPrice, err := m.GetIndexPrice(step.Pair)
+		if err != nil {
+			return nil, err
+		}
+
+		if step.Invert {
+			if indexPrice.Sign() == 0 {
+				return nil, fmt.Errorf("cannot invert zero index price for ticker: %s", step.Pair)
+			}
+			indexPrice = new(big.Float).Quo(big.NewFloat(1), indexPrice)
+		}
+
+		if conversionPrice == nil {
+			conversionPrice = indexPrice
+			continue
+		}
+		conversionPrice = new(big.Float).Mul(conversionPrice, indexPrice)
+	}
+
+	return conversionPrice, nil
+}
+
 // SetIndexPrice sets the index price for the given currency pair.
 func (m *IndexPriceAggregator) SetIndexPrices(
 	prices types.Prices,
@@ -102,12 +185,27 @@ func (m *IndexPriceAggregator) SetProviderPrices(provider string, data types.Pri
 	m.providerPrices[provider] = data
 }
 
+// SetProviderPriceInputs updates the data aggregator with the raw prices reported by the given provider,
+// including any stale prices. These are used to report the details of the aggregated prices, and to
+// weight the provider prices by the volume or liquidity reported alongside them.
+func (m *IndexPriceAggregator) SetProviderPriceInputs(provider string, prices map[string]types.ProviderPrice) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if prices == nil {
+		prices = make(map[string]types.ProviderPrice)
+	}
+
+	m.priceInputs[provider] = prices
+}
+
 // Reset resets the data aggregator for all providers.
 func (m *IndexPriceAggregator) Reset() {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
 	m.providerPrices = make(map[string]types.Prices)
+	m.priceInputs = make(map[string]map[string]types.ProviderPrice)
 }
 
 // GetPrices returns the aggregated data the aggregator has. Specifically, the
@@ -122,3 +220,15 @@ func (m *IndexPriceAggregator) GetPrices() types.Prices {
 
 	return cpy
 }
+
+// GetPriceDetails returns the details of how each price was derived from the provider prices in the
+// latest aggregation.
+func (m *IndexPriceAggregator) GetPriceDetails() types.PriceDetails {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	cpy := make(types.PriceDetails)
+	maps.Copy(cpy, m.priceDetails)
+
+	return cpy
+}
@@ -0,0 +1,134 @@
+package voteweighted
+
+import (
+	"math/big"
+	"sort"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/aggregator"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+var (
+	// lowerQuartile is the quantile of the lower quartile of the prices.
+	lowerQuartile = math.LegacyNewDecWithPrec(25, 2)
+
+	// upperQuartile is the quantile of the upper quartile of the prices.
+	upperQuartile = math.LegacyNewDecWithPrec(75, 2)
+)
+
+// Dispersion describes the spread of the prices reported by validators for a given currency pair.
+type Dispersion struct {
+	// Min is the lowest reported price.
+	Min *big.Int
+	// Max is the highest reported price.
+	Max *big.Int
+	// LowerQuartile is the stake-weighted 25th percentile of the reported prices.
+	LowerQuartile *big.Int
+	// UpperQuartile is the stake-weighted 75th percentile of the reported prices.
+	UpperQuartile *big.Int
+	// NumValidators is the number of validators that reported a price.
+	NumValidators uint64
+	// Power is the total stake weight of the validators that reported a price.
+	Power math.Int
+	// TotalPower is the total stake weight of the validator set.
+	TotalPower math.Int
+}
+
+// InterquartileRange returns the stake-weighted interquartile range of the reported prices.
+func (d Dispersion) InterquartileRange() *big.Int {
+	return new(big.Int).Sub(d.UpperQuartile, d.LowerQuartile)
+}
+
+// DispersionFn computes the dispersion of the prices reported by validators for each currency pair, given
+// the prices reported by each validator (keyed by consensus address).
+type DispersionFn func(
+	ctx sdk.Context,
+	providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int],
+) map[slinkytypes.CurrencyPair]Dispersion
+
+// PriceDispersion returns a DispersionFn that computes the stake-weighted dispersion of the prices reported
+// by validators for every currency pair that at least one validator reported a price for. Unlike Median, no
+// power threshold is applied, so callers should only use the dispersion of currency pairs for which a price
+// was aggregated.
+func PriceDispersion(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+) DispersionFn {
+	return func(
+		ctx sdk.Context,
+		providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int],
+	) map[slinkytypes.CurrencyPair]Dispersion {
+		priceInfo := getPriceInfo(ctx, logger, validatorStore, providers)
+
+		totalBondedTokens, err := validatorStore.TotalBondedTokens(ctx)
+		if err != nil {
+			// This should never error.
+			panic(err)
+		}
+
+		dispersions := make(map[slinkytypes.CurrencyPair]Dispersion, len(priceInfo))
+		for currencyPair, info := range priceInfo {
+			if len(info.Prices) == 0 {
+				continue
+			}
+
+			dispersions[currencyPair] = ComputeDispersion(info, totalBondedTokens)
+		}
+
+		return dispersions
+	}
+}
+
+// ComputeDispersion computes the stake-weighted dispersion of the prices for a given asset. The price
+// info must contain at least one price.
+func ComputeDispersion(priceInfo PriceInfo, totalPower math.Int) Dispersion {
+	sortPrices(priceInfo)
+
+	return Dispersion{
+		Min:           priceInfo.Prices[0].Price,
+		Max:           priceInfo.Prices[len(priceInfo.Prices)-1].Price,
+		LowerQuartile: ComputeWeightedQuantile(priceInfo, lowerQuartile),
+		UpperQuartile: ComputeWeightedQuantile(priceInfo, upperQuartile),
+		NumValidators: uint64(len(priceInfo.Prices)),
+		Power:         priceInfo.TotalWeight,
+		TotalPower:    totalPower,
+	}
+}
+
+// ComputeWeightedQuantile computes the stake-weighted quantile q (between 0 and 1) of the prices for a
+// given asset, i.e. the lowest price at which the cumulative stake weight of the prices reaches q of
+// the total stake weight. For q = 0.5 this is equivalent to ComputeMedian.
+func ComputeWeightedQuantile(priceInfo PriceInfo, q math.LegacyDec) *big.Int {
+	sortPrices(priceInfo)
+
+	// Compute the quantile weight.
+	target := math.LegacyNewDecFromInt(priceInfo.TotalWeight).Mul(q).TruncateInt()
+
+	// Iterate through the prices and compute the quantile price.
+	sum := math.ZeroInt()
+	for index, price := range priceInfo.Prices {
+		sum = sum.Add(price.VoteWeight)
+
+		if sum.GTE(target) {
+			return price.Price
+		}
+
+		// If we reached the end of the list, return the last price.
+		if index == len(priceInfo.Prices)-1 {
+			return price.Price
+		}
+	}
+
+	return nil
+}
+
+// sortPrices sorts the prices of the given price info in ascending order.
+func sortPrices(priceInfo PriceInfo) {
+	sort.SliceStable(priceInfo.Prices, func(i, j int) bool {
+		return priceInfo.Prices[i].Price.Cmp(priceInfo.Prices[j].Price) < 0
+	})
+}
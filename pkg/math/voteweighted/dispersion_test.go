@@ -0,0 +1,141 @@
+package voteweighted_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/1119-Labs/slinky/aggregator"
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+func (s *MathTestSuite) TestPriceDispersion() {
+	btc := slinkytypes.NewCurrencyPair("BTC", "USD")
+	eth := slinkytypes.NewCurrencyPair("ETH", "USD")
+
+	validators := []validator{
+		{
+			stake:    sdkmath.NewInt(10),
+			consAddr: validator1,
+		},
+		{
+			stake:    sdkmath.NewInt(30),
+			consAddr: validator2,
+		},
+		{
+			stake:    sdkmath.NewInt(20),
+			consAddr: validator3,
+		},
+	}
+
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): {
+			btc: big.NewInt(90),
+			eth: big.NewInt(10),
+		},
+		validator2.String(): {
+			btc: big.NewInt(100),
+		},
+		validator3.String(): {
+			btc: big.NewInt(120),
+			eth: nil,
+		},
+	}
+
+	dispersionFn := voteweighted.PriceDispersion(
+		log.NewTestLogger(s.T()),
+		s.createMockValidatorStore(validators, sdkmath.NewInt(100)),
+	)
+
+	dispersions := dispersionFn(s.ctx, providerPrices)
+	s.Require().Len(dispersions, 2)
+
+	// cumulative weights of the sorted prices are 10 (90), 40 (100), 60 (120)
+	s.Require().Equal(voteweighted.Dispersion{
+		Min:           big.NewInt(90),
+		Max:           big.NewInt(120),
+		LowerQuartile: big.NewInt(100),
+		UpperQuartile: big.NewInt(120),
+		NumValidators: 3,
+		Power:         sdkmath.NewInt(60),
+		TotalPower:    sdkmath.NewInt(100),
+	}, dispersions[btc])
+	s.Require().Equal(big.NewInt(20), dispersions[btc].InterquartileRange())
+
+	s.Require().Equal(voteweighted.Dispersion{
+		Min:           big.NewInt(10),
+		Max:           big.NewInt(10),
+		LowerQuartile: big.NewInt(10),
+		UpperQuartile: big.NewInt(10),
+		NumValidators: 1,
+		Power:         sdkmath.NewInt(10),
+		TotalPower:    sdkmath.NewInt(100),
+	}, dispersions[eth])
+}
+
+func (s *MathTestSuite) TestComputeWeightedQuantile() {
+	priceInfo := func() voteweighted.PriceInfo {
+		return voteweighted.PriceInfo{
+			Prices: []voteweighted.PricePerValidator{
+				{
+					VoteWeight: sdkmath.NewInt(25),
+					Price:      big.NewInt(400),
+				},
+				{
+					VoteWeight: sdkmath.NewInt(25),
+					Price:      big.NewInt(100),
+				},
+				{
+					VoteWeight: sdkmath.NewInt(25),
+					Price:      big.NewInt(300),
+				},
+				{
+					VoteWeight: sdkmath.NewInt(25),
+					Price:      big.NewInt(200),
+				},
+			},
+			TotalWeight: sdkmath.NewInt(100),
+		}
+	}
+
+	cases := []struct {
+		name     string
+		quantile sdkmath.LegacyDec
+		expected *big.Int
+	}{
+		{
+			name:     "zero quantile is the minimum price",
+			quantile: sdkmath.LegacyZeroDec(),
+			expected: big.NewInt(100),
+		},
+		{
+			name:     "lower quartile",
+			quantile: sdkmath.LegacyNewDecWithPrec(25, 2),
+			expected: big.NewInt(100),
+		},
+		{
+			name:     "median is equivalent to ComputeMedian",
+			quantile: sdkmath.LegacyNewDecWithPrec(5, 1),
+			expected: voteweighted.ComputeMedian(priceInfo()),
+		},
+		{
+			name:     "upper quartile",
+			quantile: sdkmath.LegacyNewDecWithPrec(75, 2),
+			expected: big.NewInt(300),
+		},
+		{
+			name:     "one quantile is the maximum price",
+			quantile: sdkmath.LegacyOneDec(),
+			expected: big.NewInt(400),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			result := voteweighted.ComputeWeightedQuantile(priceInfo(), tc.quantile)
+			s.Require().Equal(tc.expected, result)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package voteweighted_test
 
 import (
 	"crypto"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -506,3 +507,57 @@ func (s *MathTestSuite) createMockCCVConsumerCompatKeeper(
 
 	return ccvCompatKeeper
 }
+
+func (s *MathTestSuite) TestMedianFromThresholdContext() {
+	btcusd := slinkytypes.CurrencyPair{Base: "BTC", Quote: "USD"}
+	validators := []validator{
+		{stake: sdkmath.NewInt(50), consAddr: validator1},
+		{stake: sdkmath.NewInt(50), consAddr: validator2},
+	}
+	// only half of the stake reports a price
+	providerPrices := aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]{
+		validator1.String(): {btcusd: big.NewInt(100)},
+	}
+
+	cases := []struct {
+		name        string
+		thresholdFn voteweighted.ThresholdFromContext
+		expectPrice bool
+	}{
+		{
+			name: "threshold met",
+			thresholdFn: func(sdk.Context) (sdkmath.LegacyDec, error) {
+				return sdkmath.LegacyNewDecWithPrec(5, 1), nil
+			},
+			expectPrice: true,
+		},
+		{
+			name: "threshold not met",
+			thresholdFn: func(sdk.Context) (sdkmath.LegacyDec, error) {
+				return sdkmath.LegacyNewDecWithPrec(6, 1), nil
+			},
+			expectPrice: false,
+		},
+		{
+			name: "threshold error falls back to the default threshold",
+			thresholdFn: func(sdk.Context) (sdkmath.LegacyDec, error) {
+				return sdkmath.LegacyDec{}, fmt.Errorf("no params")
+			},
+			expectPrice: false,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			mockValidatorStore := s.createMockValidatorStore(validators, sdkmath.NewInt(100))
+			aggregateFn := voteweighted.MedianFromThresholdContext(log.NewTestLogger(s.T()), mockValidatorStore, tc.thresholdFn)
+			result := aggregateFn(s.ctx)(providerPrices)
+
+			if tc.expectPrice {
+				s.Require().Equal(big.NewInt(100), result[btcusd])
+			} else {
+				s.Require().Empty(result)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package voteweighted
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+)
+
+// AggregationStrategy computes the final price for a given asset from the prices reported by validators
+// and their stake weights. Implementations must be deterministic, i.e. the result must only depend on the
+// (price, stake weight) pairs, and not on the order in which they are given.
+type AggregationStrategy func(priceInfo PriceInfo) *big.Int
+
+// TrimmedMean returns an AggregationStrategy that computes the stake-weighted mean of the prices after
+// the given fraction (between 0 and 0.5) of the total stake weight is trimmed from each end of the sorted
+// prices. A price whose stake weight straddles a trim boundary is only partially included. If no stake
+// weight remains after trimming, the stake-weighted median is returned.
+func TrimmedMean(trim math.LegacyDec) AggregationStrategy {
+	return func(priceInfo PriceInfo) *big.Int {
+		sortPrices(priceInfo)
+
+		// compute the bounds of the stake weight to include
+		total := priceInfo.TotalWeight.BigInt()
+		lower := math.LegacyNewDecFromInt(priceInfo.TotalWeight).Mul(trim).TruncateInt().BigInt()
+		upper := new(big.Int).Sub(total, lower)
+
+		sum := new(big.Int)
+		included := new(big.Int)
+		cumulative := new(big.Int)
+		for _, price := range priceInfo.Prices {
+			start := new(big.Int).Set(cumulative)
+			cumulative.Add(cumulative, price.VoteWeight.BigInt())
+
+			// the included weight of the price is the overlap of [start, cumulative] and [lower, upper]
+			weight := new(big.Int).Sub(minInt(cumulative, upper), maxInt(start, lower))
+			if weight.Sign() <= 0 {
+				continue
+			}
+
+			sum.Add(sum, new(big.Int).Mul(price.Price, weight))
+			included.Add(included, weight)
+		}
+
+		if included.Sign() == 0 {
+			return ComputeMedian(priceInfo)
+		}
+
+		return sum.Quo(sum, included)
+	}
+}
+
+// MADMean returns an AggregationStrategy that computes the stake-weighted mean of the prices that deviate
+// from the stake-weighted median by at most the given multiple of the stake-weighted median absolute
+// deviation (MAD). If the MAD is zero, only the prices equal to the median are included, i.e. the median
+// is returned.
+func MADMean(multiplier int64) AggregationStrategy {
+	return func(priceInfo PriceInfo) *big.Int {
+		median := ComputeMedian(priceInfo)
+		if median == nil {
+			return nil
+		}
+
+		// compute the stake-weighted median of the absolute deviations from the median
+		deviations := PriceInfo{
+			Prices:      make([]PricePerValidator, len(priceInfo.Prices)),
+			TotalWeight: priceInfo.TotalWeight,
+		}
+		for i, price := range priceInfo.Prices {
+			deviations.Prices[i] = PricePerValidator{
+				VoteWeight: price.VoteWeight,
+				Price:      absDiff(price.Price, median),
+			}
+		}
+		bound := new(big.Int).Mul(ComputeMedian(deviations), big.NewInt(multiplier))
+
+		sum := new(big.Int)
+		included := new(big.Int)
+		for _, price := range priceInfo.Prices {
+			if absDiff(price.Price, median).Cmp(bound) > 0 {
+				continue
+			}
+
+			sum.Add(sum, new(big.Int).Mul(price.Price, price.VoteWeight.BigInt()))
+			included.Add(included, price.VoteWeight.BigInt())
+		}
+
+		if included.Sign() == 0 {
+			return median
+		}
+
+		return sum.Quo(sum, included)
+	}
+}
+
+// absDiff returns |a - b|.
+func absDiff(a, b *big.Int) *big.Int {
+	diff := new(big.Int).Sub(a, b)
+	return diff.Abs(diff)
+}
+
+// minInt returns the lesser of a and b.
+func minInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
+
+// maxInt returns the greater of a and b.
+func maxInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) > 0 {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,184 @@
+package voteweighted_test
+
+import (
+	"math/big"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/1119-Labs/slinky/pkg/math/voteweighted"
+)
+
+// interquartile trims a quarter of the total stake weight from each end of the sorted prices.
+var interquartile = sdkmath.LegacyNewDecWithPrec(25, 2)
+
+// newPriceInfo returns a PriceInfo with the given prices, each with the corresponding stake weight.
+func newPriceInfo(prices []int64, weights []int64) voteweighted.PriceInfo {
+	info := voteweighted.PriceInfo{
+		Prices:      make([]voteweighted.PricePerValidator, len(prices)),
+		TotalWeight: sdkmath.ZeroInt(),
+	}
+	for i := range prices {
+		info.Prices[i] = voteweighted.PricePerValidator{
+			VoteWeight: sdkmath.NewInt(weights[i]),
+			Price:      big.NewInt(prices[i]),
+		}
+		info.TotalWeight = info.TotalWeight.Add(sdkmath.NewInt(weights[i]))
+	}
+
+	return info
+}
+
+func (s *MathTestSuite) TestTrimmedMean() {
+	cases := []struct {
+		name     string
+		trim     sdkmath.LegacyDec
+		prices   []int64
+		weights  []int64
+		expected *big.Int
+	}{
+		{
+			name:     "no prices",
+			trim:     interquartile,
+			expected: nil,
+		},
+		{
+			name:     "single price",
+			trim:     interquartile,
+			prices:   []int64{100},
+			weights:  []int64{10},
+			expected: big.NewInt(100),
+		},
+		{
+			name:     "no trimming is the weighted mean",
+			trim:     sdkmath.LegacyZeroDec(),
+			prices:   []int64{100, 200, 400},
+			weights:  []int64{10, 20, 10},
+			expected: big.NewInt(225),
+		},
+		{
+			name:     "outer prices are trimmed",
+			trim:     interquartile,
+			prices:   []int64{100, 3, 2, 1},
+			weights:  []int64{10, 10, 10, 10},
+			expected: big.NewInt(2),
+		},
+		{
+			name:     "prices straddling the trim boundary are partially included",
+			trim:     interquartile,
+			prices:   []int64{10, 20, 90},
+			weights:  []int64{10, 10, 10},
+			expected: big.NewInt(31),
+		},
+		{
+			name:     "trimming all of the weight falls back to the median",
+			trim:     sdkmath.LegacyNewDecWithPrec(5, 1),
+			prices:   []int64{10, 20, 90},
+			weights:  []int64{10, 10, 10},
+			expected: big.NewInt(20),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			info := newPriceInfo(tc.prices, tc.weights)
+			s.Require().Equal(tc.expected, voteweighted.TrimmedMean(tc.trim)(info))
+		})
+	}
+}
+
+func (s *MathTestSuite) TestMADMean() {
+	cases := []struct {
+		name     string
+		prices   []int64
+		weights  []int64
+		expected *big.Int
+	}{
+		{
+			name:     "no prices",
+			expected: nil,
+		},
+		{
+			name:     "single price",
+			prices:   []int64{100},
+			weights:  []int64{10},
+			expected: big.NewInt(100),
+		},
+		{
+			name:     "outliers are rejected",
+			prices:   []int64{100, 101, 99, 1000, 102},
+			weights:  []int64{10, 10, 10, 10, 10},
+			expected: big.NewInt(100),
+		},
+		{
+			name:     "outlier with a large stake weight is rejected",
+			prices:   []int64{10, 20, 90},
+			weights:  []int64{10, 10, 10},
+			expected: big.NewInt(15),
+		},
+		{
+			name:     "zero MAD only includes prices equal to the median",
+			prices:   []int64{100, 100, 100, 100, 200},
+			weights:  []int64{10, 10, 10, 10, 10},
+			expected: big.NewInt(100),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			info := newPriceInfo(tc.prices, tc.weights)
+			s.Require().Equal(tc.expected, voteweighted.MADMean(3)(info))
+		})
+	}
+}
+
+// TestAggregationStrategiesAreDeterministic checks that every aggregation strategy returns the same price
+// regardless of the order in which validator prices are received, which is required for every node to
+// write the same price to state, and that the price is always within the range of the reported prices.
+func (s *MathTestSuite) TestAggregationStrategiesAreDeterministic() {
+	const (
+		iterations   = 200
+		permutations = 10
+	)
+
+	strategies := map[string]voteweighted.AggregationStrategy{
+		"median":       voteweighted.ComputeMedian,
+		"trimmed_mean": voteweighted.TrimmedMean(interquartile),
+		"mad_mean":     voteweighted.MADMean(3),
+	}
+
+	rng := rand.New(rand.NewSource(1)) //nolint:gosec
+	for name, strategy := range strategies {
+		s.Run(name, func() {
+			for i := 0; i < iterations; i++ {
+				n := rng.Intn(20) + 1
+				prices := make([]int64, n)
+				weights := make([]int64, n)
+				for j := 0; j < n; j++ {
+					// draw from a small range of prices to exercise ties
+					prices[j] = rng.Int63n(50) + 1
+					weights[j] = rng.Int63n(1000) + 1
+				}
+
+				info := newPriceInfo(prices, weights)
+				expected := strategy(newPriceInfo(prices, weights))
+
+				minPrice, maxPrice := prices[0], prices[0]
+				for _, price := range prices {
+					minPrice = min(minPrice, price)
+					maxPrice = max(maxPrice, price)
+				}
+				s.Require().True(expected.Cmp(big.NewInt(minPrice)) >= 0, "price %s below min %d", expected, minPrice)
+				s.Require().True(expected.Cmp(big.NewInt(maxPrice)) <= 0, "price %s above max %d", expected, maxPrice)
+
+				for p := 0; p < permutations; p++ {
+					rng.Shuffle(len(info.Prices), func(a, b int) {
+						info.Prices[a], info.Prices[b] = info.Prices[b], info.Prices[a]
+					})
+
+					s.Require().Equal(expected, strategy(info), "prices %v, weights %v", prices, weights)
+				}
+			}
+		})
+	}
+}
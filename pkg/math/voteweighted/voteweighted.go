@@ -44,6 +44,34 @@ func MedianFromContext(
 	}
 }
 
+// ThresholdFromContext returns the power threshold to use for a given block, e.g. as read from the
+// x/oracle module's params.
+type ThresholdFromContext func(ctx sdk.Context) (math.LegacyDec, error)
+
+// MedianFromThresholdContext returns a new Median aggregate function that is parametrized by the
+// latest state of the application, including the power threshold. If the threshold cannot be retrieved,
+// the DefaultPowerThreshold is used.
+func MedianFromThresholdContext(
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	thresholdFn ThresholdFromContext,
+) aggregator.AggregateFnFromContext[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	return func(ctx sdk.Context) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+		threshold, err := thresholdFn(ctx)
+		if err != nil {
+			logger.Error(
+				"failed to retrieve power threshold; using default",
+				"default_threshold", DefaultPowerThreshold.String(),
+				"err", err,
+			)
+
+			threshold = DefaultPowerThreshold
+		}
+
+		return Median(ctx, logger, validatorStore, threshold)
+	}
+}
+
 // Median returns an aggregation function that computes the stake weighted median price as the
 // final deterministic oracle price for any qualifying currency pair (base, quote). There are a few things to
 // note about the implementation:
@@ -62,70 +90,26 @@ func Median(
 	validatorStore ValidatorStore,
 	threshold math.LegacyDec,
 ) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
-	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
-		priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
-
-		// Iterate through all providers and store stake weight + price for each currency pair.
-		for valAddress, validatorPrices := range providers {
-			// Retrieve the validator from the validator store and get its vote weight.
-			address, err := sdk.ConsAddressFromBech32(valAddress)
-			if err != nil {
-				logger.Error(
-					"failed to parse validator address; skipping validator prices",
-					"validator_address", valAddress,
-					"err", err,
-				)
-
-				continue
-			}
-
-			validator, err := validatorStore.ValidatorByConsAddr(ctx, address)
-			if err != nil {
-				logger.Error(
-					"failed to retrieve validator from store; skipping validator prices",
-					"validator_address", valAddress,
-					"err", err,
-				)
-
-				continue
-			}
-
-			voteWeight := validator.GetBondedTokens()
-
-			// Iterate through all prices and store the price + vote weight for each currency pair.
-			for currencyPair, price := range validatorPrices {
-				// Only include prices that are not nil.
-				if price == nil {
-					logger.Debug(
-						"price is nil",
-						"currency_pair", currencyPair.String(),
-						"validator_address", valAddress,
-					)
-
-					continue
-				}
-
-				// Initialize the price info if it does not exist for the given currency pair.
-				if _, ok := priceInfo[currencyPair]; !ok {
-					priceInfo[currencyPair] = PriceInfo{
-						Prices:      make([]PricePerValidator, 0),
-						TotalWeight: math.ZeroInt(),
-					}
-				}
+	return Aggregate(ctx, logger, validatorStore, threshold, func(slinkytypes.CurrencyPair) (string, AggregationStrategy) {
+		return "median", ComputeMedian
+	})
+}
 
-				// Update the price info.
-				cpInfo := priceInfo[currencyPair]
-				priceInfo[currencyPair] = PriceInfo{
-					Prices: append(cpInfo.Prices, PricePerValidator{
-						VoteWeight: voteWeight,
-						Price:      price,
-					}),
-					TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
-				}
-			}
-		}
+// Aggregate returns an aggregation function that computes the final deterministic oracle price for any
+// qualifying currency pair (base, quote) with the AggregationStrategy returned by strategyFor for the
+// currency pair. As with Median, prices are only aggregated for currency pairs for which the power % threshold
+// is met.
+func Aggregate(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	threshold math.LegacyDec,
+	strategyFor func(cp slinkytypes.CurrencyPair) (string, AggregationStrategy),
+) aggregator.AggregateFn[string, map[slinkytypes.CurrencyPair]*big.Int] {
+	return func(providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int]) map[slinkytypes.CurrencyPair]*big.Int {
+		priceInfo := getPriceInfo(ctx, logger, validatorStore, providers)
 
-		// Iterate through all prices and compute the median price for each asset.
+		// Iterate through all prices and compute the final price for each asset.
 		prices := make(map[slinkytypes.CurrencyPair]*big.Int)
 		totalBondedTokens, err := validatorStore.TotalBondedTokens(ctx)
 		if err != nil {
@@ -137,11 +121,13 @@ func Median(
 			// The total voting power % that submitted a price update for the given currency pair must be
 			// greater than the threshold to be included in the final oracle price.
 			if percentSubmitted := math.LegacyNewDecFromInt(info.TotalWeight).Quo(math.LegacyNewDecFromInt(totalBondedTokens)); percentSubmitted.GTE(threshold) {
-				prices[currencyPair] = ComputeMedian(info)
+				name, strategy := strategyFor(currencyPair)
+				prices[currencyPair] = strategy(info)
 
 				logger.Debug(
-					"computed stake-weighted median price for currency pair",
+					"computed stake-weighted price for currency pair",
 					"currency_pair", currencyPair.String(),
+					"strategy", name,
 					"percent_submitted", percentSubmitted.String(),
 					"threshold", threshold.String(),
 					"final_price", prices[currencyPair].String(),
@@ -149,7 +135,7 @@ func Median(
 				)
 			} else {
 				logger.Debug(
-					"not enough voting power to compute stake-weighted median price price for currency pair",
+					"not enough voting power to compute stake-weighted price for currency pair",
 					"currency_pair", currencyPair.String(),
 					"threshold", threshold.String(),
 					"percent_submitted", percentSubmitted.String(),
@@ -162,6 +148,79 @@ func Median(
 	}
 }
 
+// getPriceInfo collects the stake weight + price reported by each validator for each currency pair. Prices
+// reported by validators that cannot be found in the validator store are skipped.
+func getPriceInfo(
+	ctx sdk.Context,
+	logger log.Logger,
+	validatorStore ValidatorStore,
+	providers aggregator.AggregatedProviderData[string, map[slinkytypes.CurrencyPair]*big.Int],
+) map[slinkytypes.CurrencyPair]PriceInfo {
+	priceInfo := make(map[slinkytypes.CurrencyPair]PriceInfo)
+
+	// Iterate through all providers and store stake weight + price for each currency pair.
+	for valAddress, validatorPrices := range providers {
+		// Retrieve the validator from the validator store and get its vote weight.
+		address, err := sdk.ConsAddressFromBech32(valAddress)
+		if err != nil {
+			logger.Error(
+				"failed to parse validator address; skipping validator prices",
+				"validator_address", valAddress,
+				"err", err,
+			)
+
+			continue
+		}
+
+		validator, err := validatorStore.ValidatorByConsAddr(ctx, address)
+		if err != nil {
+			logger.Error(
+				"failed to retrieve validator from store; skipping validator prices",
+				"validator_address", valAddress,
+				"err", err,
+			)
+
+			continue
+		}
+
+		voteWeight := validator.GetBondedTokens()
+
+		// Iterate through all prices and store the price + vote weight for each currency pair.
+		for currencyPair, price := range validatorPrices {
+			// Only include prices that are not nil.
+			if price == nil {
+				logger.Debug(
+					"price is nil",
+					"currency_pair", currencyPair.String(),
+					"validator_address", valAddress,
+				)
+
+				continue
+			}
+
+			// Initialize the price info if it does not exist for the given currency pair.
+			if _, ok := priceInfo[currencyPair]; !ok {
+				priceInfo[currencyPair] = PriceInfo{
+					Prices:      make([]PricePerValidator, 0),
+					TotalWeight: math.ZeroInt(),
+				}
+			}
+
+			// Update the price info.
+			cpInfo := priceInfo[currencyPair]
+			priceInfo[currencyPair] = PriceInfo{
+				Prices: append(cpInfo.Prices, PricePerValidator{
+					VoteWeight: voteWeight,
+					Price:      price,
+				}),
+				TotalWeight: cpInfo.TotalWeight.Add(voteWeight),
+			}
+		}
+	}
+
+	return priceInfo
+}
+
 // ComputeMedian computes the stake-weighted median price for a given asset.
 func ComputeMedian(priceInfo PriceInfo) *big.Int {
 	// Sort the prices by price.
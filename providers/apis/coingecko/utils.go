@@ -55,6 +55,11 @@ var DefaultAPIConfig = config.APIConfig{
 	ReconnectTimeout: 2000 * time.Millisecond,
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: URL}},
+	RateLimit: config.RateLimitConfig{
+		RequestsPerMinute: 10,
+		InitialBackoff:    20 * time.Second,
+		MaxBackoff:        5 * time.Minute,
+	},
 }
 
 type (
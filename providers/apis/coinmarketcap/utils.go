@@ -43,6 +43,11 @@ var DefaultAPIConfig = config.APIConfig{
 			URL: URL,
 		},
 	},
+	RateLimit: config.RateLimitConfig{
+		RequestsPerMinute: 30,
+		InitialBackoff:    2000 * time.Millisecond,
+		MaxBackoff:        time.Minute,
+	},
 }
 
 // CoinMarketCapResponse is the response from the CoinMarketCap API.
@@ -0,0 +1,328 @@
+package balancer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/oracle/types"
+	balancerpool "github.com/1119-Labs/slinky/providers/apis/defi/balancer/pool"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/1119-Labs/slinky/providers/base/api/metrics"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+// PriceFetcher is the Balancer price fetcher. This fetcher is responsible for querying Balancer V2
+// weighted pools and returning the price of a given ticker. The price is the spot price implied by
+// the weighted invariant for the balances of the pool, which are held by the vault, and the
+// normalized weights of the pool, which are held by the pool contract.
+//
+// To read more about the weighted invariant, see the Balancer documentation
+// https://docs.balancer.fi/concepts/explore-available-balancer-pools/weighted-pool/weighted-math.html.
+//
+// The balances and weights of a pool are always queried in the same batch call via the eth
+// client's BatchCallContext.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// client is the EVM client implementation. This is used to interact with the ethereum network.
+	client ethmulticlient.EVMClient
+	// vaultABI is the balancer vault abi. This is used to pack the getPoolTokens call to the vault
+	// contract and parse the result.
+	vaultABI *abi.ABI
+	// poolABI is the balancer weighted pool abi. This is used to pack the getNormalizedWeights
+	// call to the pool contract and parse the result.
+	poolABI *abi.ABI
+	// weightsPayload is the packed getNormalizedWeights call to the pool contract. This is the
+	// same for all pools.
+	weightsPayload []byte
+	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	poolCache map[types.ProviderTicker]PoolConfig
+}
+
+// NewPriceFetcher returns a new Balancer price fetcher.
+func NewPriceFetcher(
+	ctx context.Context,
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context cannot be nil")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("invalid api config name %s", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	client, err := ethmulticlient.NewEVMClient(ctx, logger, api, apiMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client ethmulticlient.EVMClient,
+) (*PriceFetcher, error) {
+	vaultABI, err := balancerpool.VaultMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balancer vault abi: %w", err)
+	}
+
+	poolABI, err := balancerpool.WeightedPoolMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balancer weighted pool abi: %w", err)
+	}
+
+	weightsPayload, err := poolABI.Pack(NormalizedWeightsMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", NormalizedWeightsMethod, err)
+	}
+
+	return &PriceFetcher{
+		logger:         logger.With(zap.String("fetcher", api.Name)),
+		api:            api,
+		client:         client,
+		vaultABI:       vaultABI,
+		poolABI:        poolABI,
+		weightsPayload: weightsPayload,
+		poolCache:      make(map[types.ProviderTicker]PoolConfig),
+	}, nil
+}
+
+// Fetch returns the price of a given set of tickers. For each ticker, the balances of the pool
+// are queried from the vault and the normalized weights are queried from the pool, and the price
+// of the base token in units of the quote token is derived from the weighted invariant.
+func (f *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	// Create the batch elements for each ticker and pool. Tickers with an invalid pool config
+	// are not queried.
+	groups := make([][]rpc.BatchElem, 0, len(tickers))
+	queried := make([]types.ProviderTicker, 0, len(tickers))
+	pools := make([]PoolConfig, 0, len(tickers))
+
+	for _, ticker := range tickers {
+		pool, err := f.GetPool(ticker)
+		if err != nil {
+			f.logger.Debug(
+				"failed to get pool for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get pool: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			}
+
+			continue
+		}
+
+		elems, err := f.batchElems(pool)
+		if err != nil {
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+			}
+
+			continue
+		}
+
+		groups = append(groups, elems)
+		queried = append(queried, ticker)
+		pools = append(pools, pool)
+	}
+
+	// Batch call to the EVM.
+	if err := ethmulticlient.BatchCallGroups(ctx, f.client, groups, MaxBatchSize); err != nil {
+		f.logger.Debug(
+			"failed to batch call to ethereum network for all tickers",
+			zap.Error(err),
+		)
+
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+		)
+	}
+
+	// Parse the results from the batch call for each ticker.
+	for i, ticker := range queried {
+		if err := ethmulticlient.BatchElemsError(groups[i]); err != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorUnknown,
+				),
+			}
+
+			continue
+		}
+
+		price, err := f.parsePrice(pools[i], groups[i])
+		if err != nil {
+			f.logger.Debug(
+				"failed to parse price",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	}
+
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// GetPool returns the balancer pool for the given ticker. This will unmarshal the metadata
+// and validate the pool config which contains all required information to query the EVM.
+func (f *PriceFetcher) GetPool(
+	ticker types.ProviderTicker,
+) (PoolConfig, error) {
+	if pool, ok := f.poolCache[ticker]; ok {
+		return pool, nil
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker pool config: %w", err)
+	}
+
+	f.poolCache[ticker] = cfg
+	return cfg, nil
+}
+
+// batchElems returns the batch elements used to query the state of the given pool. The first
+// element is the getPoolTokens call to the vault, and the second is the getNormalizedWeights call
+// to the pool.
+func (f *PriceFetcher) batchElems(pool PoolConfig) ([]rpc.BatchElem, error) {
+	tokensPayload, err := f.vaultABI.Pack(PoolTokensMethod, pool.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", PoolTokensMethod, err)
+	}
+
+	return []rpc.BatchElem{
+		ethmulticlient.EthCallBatchElem(pool.VaultAddress(), tokensPayload),
+		ethmulticlient.EthCallBatchElem(pool.PoolAddress(), f.weightsPayload),
+	}, nil
+}
+
+// parsePrice parses the results of the batch elements of the given pool and returns the price of
+// the base token in units of the quote token.
+func (f *PriceFetcher) parsePrice(
+	pool PoolConfig,
+	elems []rpc.BatchElem,
+) (*big.Float, error) {
+	balances, err := f.ParseBalances(elems[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", PoolTokensMethod, err)
+	}
+
+	weights, err := f.ParseWeights(elems[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", NormalizedWeightsMethod, err)
+	}
+
+	return SpotPrice(pool, balances, weights)
+}
+
+// ParseBalances parses the balances of the pool's tokens from the result of the getPoolTokens
+// batch element.
+func (f *PriceFetcher) ParseBalances(
+	elem rpc.BatchElem,
+) ([]*big.Int, error) {
+	bz, err := ethmulticlient.DecodeEthCallResult(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := f.vaultABI.Methods[PoolTokensMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	// The outputs are the tokens, the balances and the last change block of the pool.
+	return *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int), nil
+}
+
+// ParseWeights parses the normalized weights of the pool's tokens from the result of the
+// getNormalizedWeights batch element.
+func (f *PriceFetcher) ParseWeights(
+	elem rpc.BatchElem,
+) ([]*big.Int, error) {
+	bz, err := ethmulticlient.DecodeEthCallResult(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := f.poolABI.Methods[NormalizedWeightsMethod].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	return *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int), nil
+}
@@ -0,0 +1,216 @@
+package balancer_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/providers/apis/defi/balancer"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient/mocks"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+func TestFetch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tickers  []types.ProviderTicker
+		client   func() ethmulticlient.EVMClient
+		expected types.PriceResponse
+	}{
+		{
+			name:    "no tickers",
+			tickers: []types.ProviderTicker{},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved:   map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "fails to retrieve pool for an empty ticker",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("BAL/WETH", ""),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					types.NewProviderTicker("BAL/WETH", ""): {},
+				},
+			},
+		},
+		{
+			name: "fails to make a batch call",
+			tickers: []types.ProviderTicker{
+				balwethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(fmt.Errorf("failed to make a batch call"))
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					balwethTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request has an error for a single call",
+			tickers: []types.ProviderTicker{
+				balwethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				mockBlockNumber(c)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+					elems := args.Get(1).([]rpc.BatchElem)
+					elems[1].Error = fmt.Errorf("request for weights did not return a result")
+				})
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					balwethTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request returns a result that cannot be parsed",
+			tickers: []types.ProviderTicker{
+				balwethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				mockBlockNumber(c)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+					for _, elem := range args.Get(1).([]rpc.BatchElem) {
+						*elem.Result.(*string) = "not a valid result"
+					}
+				})
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					balwethTicker: {},
+				},
+			},
+		},
+		{
+			name: "token index is out of range for the pool",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("BAL/WETH", (&balancer.PoolConfig{
+					PoolID:        balwethCfg.PoolID,
+					BaseIndex:     0,
+					QuoteIndex:    2,
+					BaseDecimals:  18,
+					QuoteDecimals: 18,
+				}).MustToJSON()),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithFixtures(t, "80bal-20weth")
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					types.NewProviderTicker("BAL/WETH", (&balancer.PoolConfig{
+						PoolID:        balwethCfg.PoolID,
+						BaseIndex:     0,
+						QuoteIndex:    2,
+						BaseDecimals:  18,
+						QuoteDecimals: 18,
+					}).MustToJSON()): {},
+				},
+			},
+		},
+		{
+			name: "80bal-20weth and 50wbtc-50weth mainnet results",
+			tickers: []types.ProviderTicker{
+				balwethTicker,
+				wbtcwethTicker,
+				wethbalTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithFixtures(t, "80bal-20weth", "50wbtc-50weth")
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					balwethTicker: {
+						Value: big.NewFloat(0.000687116341449960368091391105420209022358632759714158811635344),
+					},
+					wbtcwethTicker: {
+						Value: big.NewFloat(24.2000002183598019693870539619018396823926920954996900093117),
+					},
+					wethbalTicker: {
+						Value: big.NewFloat(1455.35761511622491277309665125641165833378895848141150645874),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, tc.client())
+
+			response := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Equal(t, len(tc.expected.Resolved), len(response.Resolved))
+			require.Equal(t, len(tc.expected.UnResolved), len(response.UnResolved))
+
+			for ticker, result := range tc.expected.Resolved {
+				require.Contains(t, response.Resolved, ticker)
+				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+			}
+
+			for ticker := range tc.expected.UnResolved {
+				require.Contains(t, response.UnResolved, ticker)
+			}
+		})
+	}
+}
+
+func TestGetPool(t *testing.T) {
+	fetcher := createPriceFetcherWithClient(t, mocks.NewEVMClient(t))
+
+	t.Run("ticker is empty", func(t *testing.T) {
+		ticker := types.NewProviderTicker("", "")
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker does not have valid metadata", func(t *testing.T) {
+		expected := balancer.PoolConfig{
+			PoolID: "0x1234",
+		}
+		ticker := types.NewProviderTicker("BAL/WETH", expected.MustToJSON())
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker is not json formatted", func(t *testing.T) {
+		ticker := types.NewProviderTicker("BAL/WETH", "not json, something else")
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker has valid metadata", func(t *testing.T) {
+		pool, err := fetcher.GetPool(balwethTicker)
+		require.NoError(t, err)
+		require.Equal(t, balwethCfg, pool)
+	})
+}
@@ -0,0 +1,62 @@
+package balancer
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/1119-Labs/slinky/pkg/math"
+)
+
+// SpotPrice returns the spot price of the base token in units of the quote token of a weighted
+// pool with the given balances and normalized weights, excluding the swap fee. The weighted
+// invariant is V = prod(B_i ^ W_i), which gives the spot price
+//
+//	price = (B_quote / W_quote) / (B_base / W_base),
+//
+// where the raw balances are scaled to the token decimals in the erc20 token contracts.
+func SpotPrice(
+	cfg PoolConfig,
+	balances []*big.Int,
+	weights []*big.Int,
+) (*big.Float, error) {
+	if len(balances) != len(weights) {
+		return nil, fmt.Errorf("expected %d weights, got %d", len(balances), len(weights))
+	}
+
+	if cfg.BaseIndex >= int64(len(balances)) || cfg.QuoteIndex >= int64(len(balances)) {
+		return nil, fmt.Errorf(
+			"base index %d or quote index %d is out of range for a pool with %d tokens",
+			cfg.BaseIndex,
+			cfg.QuoteIndex,
+			len(balances),
+		)
+	}
+
+	base, err := weightedBalance(balances[cfg.BaseIndex], weights[cfg.BaseIndex])
+	if err != nil {
+		return nil, fmt.Errorf("invalid base token: %w", err)
+	}
+
+	quote, err := weightedBalance(balances[cfg.QuoteIndex], weights[cfg.QuoteIndex])
+	if err != nil {
+		return nil, fmt.Errorf("invalid quote token: %w", err)
+	}
+
+	// Adjust the price based on the difference between the token decimals in the erc20 token
+	// contracts.
+	price := new(big.Float).Quo(quote, base)
+	return price.Mul(price, math.GetScalingFactor(cfg.BaseDecimals, cfg.QuoteDecimals)), nil
+}
+
+// weightedBalance returns the balance of a token divided by its weight.
+func weightedBalance(balance, weight *big.Int) (*big.Float, error) {
+	if balance.Sign() <= 0 {
+		return nil, fmt.Errorf("balance must be positive")
+	}
+
+	if weight.Sign() <= 0 {
+		return nil, fmt.Errorf("weight must be positive")
+	}
+
+	return new(big.Float).Quo(new(big.Float).SetInt(balance), new(big.Float).SetInt(weight)), nil
+}
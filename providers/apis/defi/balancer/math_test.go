@@ -0,0 +1,96 @@
+package balancer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/providers/apis/defi/balancer"
+)
+
+func TestSpotPrice(t *testing.T) {
+	weight := func(w float64) *big.Int {
+		out, _ := new(big.Float).Mul(big.NewFloat(w), big.NewFloat(1e18)).Int(nil)
+		return out
+	}
+
+	testCases := []struct {
+		name     string
+		cfg      balancer.PoolConfig
+		balances []*big.Int
+		weights  []*big.Int
+		expected *big.Float
+		err      bool
+	}{
+		{
+			name:     "50/50 pool is priced like a constant product pool",
+			cfg:      balancer.PoolConfig{BaseIndex: 0, QuoteIndex: 1},
+			balances: []*big.Int{big.NewInt(100), big.NewInt(250)},
+			weights:  []*big.Int{weight(0.5), weight(0.5)},
+			expected: big.NewFloat(2.5),
+		},
+		{
+			name:     "80/20 pool",
+			cfg:      balancer.PoolConfig{BaseIndex: 0, QuoteIndex: 1},
+			balances: []*big.Int{big.NewInt(400), big.NewInt(100)},
+			weights:  []*big.Int{weight(0.8), weight(0.2)},
+			expected: big.NewFloat(1),
+		},
+		{
+			name:     "inverted 80/20 pool",
+			cfg:      balancer.PoolConfig{BaseIndex: 1, QuoteIndex: 0},
+			balances: []*big.Int{big.NewInt(400), big.NewInt(200)},
+			weights:  []*big.Int{weight(0.8), weight(0.2)},
+			expected: big.NewFloat(0.5),
+		},
+		{
+			name:     "three token pool with different decimals",
+			cfg:      balancer.PoolConfig{BaseIndex: 2, QuoteIndex: 0, BaseDecimals: 8, QuoteDecimals: 6},
+			balances: []*big.Int{big.NewInt(60_000_000_000), big.NewInt(1), big.NewInt(100_000_000)},
+			weights:  []*big.Int{weight(0.4), weight(0.2), weight(0.4)},
+			expected: big.NewFloat(60_000),
+		},
+		{
+			name:     "mismatched balances and weights",
+			cfg:      balancer.PoolConfig{BaseIndex: 0, QuoteIndex: 1},
+			balances: []*big.Int{big.NewInt(100), big.NewInt(250)},
+			weights:  []*big.Int{weight(1)},
+			err:      true,
+		},
+		{
+			name:     "index out of range",
+			cfg:      balancer.PoolConfig{BaseIndex: 0, QuoteIndex: 2},
+			balances: []*big.Int{big.NewInt(100), big.NewInt(250)},
+			weights:  []*big.Int{weight(0.5), weight(0.5)},
+			err:      true,
+		},
+		{
+			name:     "zero balance",
+			cfg:      balancer.PoolConfig{BaseIndex: 0, QuoteIndex: 1},
+			balances: []*big.Int{big.NewInt(0), big.NewInt(250)},
+			weights:  []*big.Int{weight(0.5), weight(0.5)},
+			err:      true,
+		},
+		{
+			name:     "zero weight",
+			cfg:      balancer.PoolConfig{BaseIndex: 0, QuoteIndex: 1},
+			balances: []*big.Int{big.NewInt(100), big.NewInt(250)},
+			weights:  []*big.Int{weight(0.5), big.NewInt(0)},
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := balancer.SpotPrice(tc.cfg, tc.balances, tc.weights)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.SetPrec(40), price.SetPrec(40))
+		})
+	}
+}
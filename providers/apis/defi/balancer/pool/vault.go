@@ -0,0 +1,231 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package pool
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// VaultMetaData contains all meta data concerning the Vault contract.
+var VaultMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"poolId\",\"type\":\"bytes32\"}],\"name\":\"getPoolTokens\",\"outputs\":[{\"internalType\":\"contractIERC20[]\",\"name\":\"tokens\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"balances\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"lastChangeBlock\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// VaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use VaultMetaData.ABI instead.
+var VaultABI = VaultMetaData.ABI
+
+// Vault is an auto generated Go binding around an Ethereum contract.
+type Vault struct {
+	VaultCaller     // Read-only binding to the contract
+	VaultTransactor // Write-only binding to the contract
+	VaultFilterer   // Log filterer for contract events
+}
+
+// VaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type VaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type VaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type VaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// VaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type VaultSession struct {
+	Contract     *Vault            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type VaultCallerSession struct {
+	Contract *VaultCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// VaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type VaultTransactorSession struct {
+	Contract     *VaultTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// VaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type VaultRaw struct {
+	Contract *Vault // Generic contract binding to access the raw methods on
+}
+
+// VaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type VaultCallerRaw struct {
+	Contract *VaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// VaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type VaultTransactorRaw struct {
+	Contract *VaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewVault creates a new instance of Vault, bound to a specific deployed contract.
+func NewVault(address common.Address, backend bind.ContractBackend) (*Vault, error) {
+	contract, err := bindVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{VaultCaller: VaultCaller{contract: contract}, VaultTransactor: VaultTransactor{contract: contract}, VaultFilterer: VaultFilterer{contract: contract}}, nil
+}
+
+// NewVaultCaller creates a new read-only instance of Vault, bound to a specific deployed contract.
+func NewVaultCaller(address common.Address, caller bind.ContractCaller) (*VaultCaller, error) {
+	contract, err := bindVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultCaller{contract: contract}, nil
+}
+
+// NewVaultTransactor creates a new write-only instance of Vault, bound to a specific deployed contract.
+func NewVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*VaultTransactor, error) {
+	contract, err := bindVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultTransactor{contract: contract}, nil
+}
+
+// NewVaultFilterer creates a new log filterer instance of Vault, bound to a specific deployed contract.
+func NewVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*VaultFilterer, error) {
+	contract, err := bindVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &VaultFilterer{contract: contract}, nil
+}
+
+// bindVault binds a generic wrapper to an already deployed contract.
+func bindVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := VaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vault *VaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Vault.Contract.VaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vault *VaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vault.Contract.VaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vault *VaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vault.Contract.VaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Vault *VaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Vault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Vault *VaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Vault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Vault *VaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Vault.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetPoolTokens is a free data retrieval call binding the contract method 0xf94d4668.
+//
+// Solidity: function getPoolTokens(bytes32 poolId) view returns(address[] tokens, uint256[] balances, uint256 lastChangeBlock)
+func (_Vault *VaultCaller) GetPoolTokens(opts *bind.CallOpts, poolId [32]byte) (struct {
+	Tokens          []common.Address
+	Balances        []*big.Int
+	LastChangeBlock *big.Int
+}, error) {
+	var out []interface{}
+	err := _Vault.contract.Call(opts, &out, "getPoolTokens", poolId)
+
+	outstruct := new(struct {
+		Tokens          []common.Address
+		Balances        []*big.Int
+		LastChangeBlock *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Tokens = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Balances = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+	outstruct.LastChangeBlock = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// GetPoolTokens is a free data retrieval call binding the contract method 0xf94d4668.
+//
+// Solidity: function getPoolTokens(bytes32 poolId) view returns(address[] tokens, uint256[] balances, uint256 lastChangeBlock)
+func (_Vault *VaultSession) GetPoolTokens(poolId [32]byte) (struct {
+	Tokens          []common.Address
+	Balances        []*big.Int
+	LastChangeBlock *big.Int
+}, error) {
+	return _Vault.Contract.GetPoolTokens(&_Vault.CallOpts, poolId)
+}
+
+// GetPoolTokens is a free data retrieval call binding the contract method 0xf94d4668.
+//
+// Solidity: function getPoolTokens(bytes32 poolId) view returns(address[] tokens, uint256[] balances, uint256 lastChangeBlock)
+func (_Vault *VaultCallerSession) GetPoolTokens(poolId [32]byte) (struct {
+	Tokens          []common.Address
+	Balances        []*big.Int
+	LastChangeBlock *big.Int
+}, error) {
+	return _Vault.Contract.GetPoolTokens(&_Vault.CallOpts, poolId)
+}
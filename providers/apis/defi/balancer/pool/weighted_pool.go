@@ -0,0 +1,243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package pool
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// WeightedPoolMetaData contains all meta data concerning the WeightedPool contract.
+var WeightedPoolMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"getNormalizedWeights\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPoolId\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// WeightedPoolABI is the input ABI used to generate the binding from.
+// Deprecated: Use WeightedPoolMetaData.ABI instead.
+var WeightedPoolABI = WeightedPoolMetaData.ABI
+
+// WeightedPool is an auto generated Go binding around an Ethereum contract.
+type WeightedPool struct {
+	WeightedPoolCaller     // Read-only binding to the contract
+	WeightedPoolTransactor // Write-only binding to the contract
+	WeightedPoolFilterer   // Log filterer for contract events
+}
+
+// WeightedPoolCaller is an auto generated read-only Go binding around an Ethereum contract.
+type WeightedPoolCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WeightedPoolTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type WeightedPoolTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WeightedPoolFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type WeightedPoolFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WeightedPoolSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type WeightedPoolSession struct {
+	Contract     *WeightedPool     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// WeightedPoolCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type WeightedPoolCallerSession struct {
+	Contract *WeightedPoolCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// WeightedPoolTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type WeightedPoolTransactorSession struct {
+	Contract     *WeightedPoolTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// WeightedPoolRaw is an auto generated low-level Go binding around an Ethereum contract.
+type WeightedPoolRaw struct {
+	Contract *WeightedPool // Generic contract binding to access the raw methods on
+}
+
+// WeightedPoolCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type WeightedPoolCallerRaw struct {
+	Contract *WeightedPoolCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// WeightedPoolTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type WeightedPoolTransactorRaw struct {
+	Contract *WeightedPoolTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewWeightedPool creates a new instance of WeightedPool, bound to a specific deployed contract.
+func NewWeightedPool(address common.Address, backend bind.ContractBackend) (*WeightedPool, error) {
+	contract, err := bindWeightedPool(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &WeightedPool{WeightedPoolCaller: WeightedPoolCaller{contract: contract}, WeightedPoolTransactor: WeightedPoolTransactor{contract: contract}, WeightedPoolFilterer: WeightedPoolFilterer{contract: contract}}, nil
+}
+
+// NewWeightedPoolCaller creates a new read-only instance of WeightedPool, bound to a specific deployed contract.
+func NewWeightedPoolCaller(address common.Address, caller bind.ContractCaller) (*WeightedPoolCaller, error) {
+	contract, err := bindWeightedPool(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WeightedPoolCaller{contract: contract}, nil
+}
+
+// NewWeightedPoolTransactor creates a new write-only instance of WeightedPool, bound to a specific deployed contract.
+func NewWeightedPoolTransactor(address common.Address, transactor bind.ContractTransactor) (*WeightedPoolTransactor, error) {
+	contract, err := bindWeightedPool(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WeightedPoolTransactor{contract: contract}, nil
+}
+
+// NewWeightedPoolFilterer creates a new log filterer instance of WeightedPool, bound to a specific deployed contract.
+func NewWeightedPoolFilterer(address common.Address, filterer bind.ContractFilterer) (*WeightedPoolFilterer, error) {
+	contract, err := bindWeightedPool(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &WeightedPoolFilterer{contract: contract}, nil
+}
+
+// bindWeightedPool binds a generic wrapper to an already deployed contract.
+func bindWeightedPool(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := WeightedPoolMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WeightedPool *WeightedPoolRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WeightedPool.Contract.WeightedPoolCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WeightedPool *WeightedPoolRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WeightedPool.Contract.WeightedPoolTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WeightedPool *WeightedPoolRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WeightedPool.Contract.WeightedPoolTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WeightedPool *WeightedPoolCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WeightedPool.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WeightedPool *WeightedPoolTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WeightedPool.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WeightedPool *WeightedPoolTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WeightedPool.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetNormalizedWeights is a free data retrieval call binding the contract method 0xf89f27ed.
+//
+// Solidity: function getNormalizedWeights() view returns(uint256[])
+func (_WeightedPool *WeightedPoolCaller) GetNormalizedWeights(opts *bind.CallOpts) ([]*big.Int, error) {
+	var out []interface{}
+	err := _WeightedPool.contract.Call(opts, &out, "getNormalizedWeights")
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetNormalizedWeights is a free data retrieval call binding the contract method 0xf89f27ed.
+//
+// Solidity: function getNormalizedWeights() view returns(uint256[])
+func (_WeightedPool *WeightedPoolSession) GetNormalizedWeights() ([]*big.Int, error) {
+	return _WeightedPool.Contract.GetNormalizedWeights(&_WeightedPool.CallOpts)
+}
+
+// GetNormalizedWeights is a free data retrieval call binding the contract method 0xf89f27ed.
+//
+// Solidity: function getNormalizedWeights() view returns(uint256[])
+func (_WeightedPool *WeightedPoolCallerSession) GetNormalizedWeights() ([]*big.Int, error) {
+	return _WeightedPool.Contract.GetNormalizedWeights(&_WeightedPool.CallOpts)
+}
+
+// GetPoolId is a free data retrieval call binding the contract method 0x38fff2d0.
+//
+// Solidity: function getPoolId() view returns(bytes32)
+func (_WeightedPool *WeightedPoolCaller) GetPoolId(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WeightedPool.contract.Call(opts, &out, "getPoolId")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetPoolId is a free data retrieval call binding the contract method 0x38fff2d0.
+//
+// Solidity: function getPoolId() view returns(bytes32)
+func (_WeightedPool *WeightedPoolSession) GetPoolId() ([32]byte, error) {
+	return _WeightedPool.Contract.GetPoolId(&_WeightedPool.CallOpts)
+}
+
+// GetPoolId is a free data retrieval call binding the contract method 0x38fff2d0.
+//
+// Solidity: function getPoolId() view returns(bytes32)
+func (_WeightedPool *WeightedPoolCallerSession) GetPoolId() ([32]byte, error) {
+	return _WeightedPool.Contract.GetPoolId(&_WeightedPool.CallOpts)
+}
@@ -0,0 +1,176 @@
+package balancer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/oracle/constants"
+)
+
+const (
+	// BaseName is the name of the Balancer API.
+	BaseName = "balancer_api"
+
+	// NameSeparator is the character used to separate elements of dynamic naming for the provider.
+	NameSeparator = "-"
+
+	// PoolTokensMethod is the vault contract method that returns the tokens and balances of a pool.
+	PoolTokensMethod = "getPoolTokens"
+
+	// NormalizedWeightsMethod is the weighted pool contract method that returns the normalized
+	// weights of the tokens of the pool.
+	NormalizedWeightsMethod = "getNormalizedWeights"
+
+	// DefaultVaultAddress is the address of the Balancer V2 vault, which is the same on all
+	// chains that Balancer is deployed to.
+	DefaultVaultAddress = "0xBA12222222228d8Ba445958a75a0704d566BF2C8"
+
+	// MaxBatchSize is the maximum number of calls sent to the EVM in a single batch call.
+	MaxBatchSize = 10
+
+	// ETH_URL is the URL for the Balancer API. This uses a free public RPC provider on Ethereum Mainnet.
+	ETH_URL = "https://eth.public-rpc.com/"
+
+	// BASE_URL is the URL for the Balancer API. This uses a free public RPC provider on Base Mainnet.
+	BASE_URL = "https://mainnet.base.org"
+)
+
+// ProviderNames is the set of all supported "dynamic" names mapped by chain.
+var ProviderNames = map[string]string{
+	constants.ETHEREUM: strings.Join([]string{BaseName, constants.ETHEREUM}, NameSeparator),
+	constants.BASE:     strings.Join([]string{BaseName, constants.BASE}, NameSeparator),
+}
+
+// IsValidProviderName returns a bool based on the validity of the passed in name.
+// Dynamic provider naming is supported via `BaseName“NameSeparator“SupportedChain`.
+func IsValidProviderName(name string) bool {
+	for _, providerName := range ProviderNames {
+		if name == providerName {
+			return true
+		}
+	}
+	return false
+}
+
+// PoolConfig is the configuration for a Balancer V2 weighted pool. This is specific to each pair
+// of tokens in the pool.
+type PoolConfig struct {
+	// PoolID is the 32 byte id of the pool in the vault. The first 20 bytes of the id are the
+	// address of the pool contract.
+	PoolID string `json:"pool_id"`
+	// Vault is the address of the vault that holds the pool's balances. If empty, the
+	// DefaultVaultAddress is used.
+	Vault string `json:"vault,omitempty"`
+	// BaseIndex is the index of the base token in the pool's tokens.
+	BaseIndex int64 `json:"base_index"`
+	// QuoteIndex is the index of the quote token in the pool's tokens.
+	QuoteIndex int64 `json:"quote_index"`
+	// BaseDecimals is the number of decimals for the base token. This should be derived from the
+	// token contract.
+	BaseDecimals int64 `json:"base_decimals"`
+	// QuoteDecimals is the number of decimals for the quote token. This should be derived from the
+	// token contract.
+	QuoteDecimals int64 `json:"quote_decimals"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (pc *PoolConfig) ValidateBasic() error {
+	id, err := hexutil.Decode(pc.PoolID)
+	if err != nil {
+		return fmt.Errorf("pool id is not valid hex: %w", err)
+	}
+
+	if len(id) != common.HashLength {
+		return fmt.Errorf("pool id must be %d bytes, got %d", common.HashLength, len(id))
+	}
+
+	if len(pc.Vault) > 0 && !common.IsHexAddress(pc.Vault) {
+		return fmt.Errorf("vault address is not a valid ethereum address")
+	}
+
+	if pc.BaseIndex < 0 {
+		return fmt.Errorf("base index must be non-negative")
+	}
+
+	if pc.QuoteIndex < 0 {
+		return fmt.Errorf("quote index must be non-negative")
+	}
+
+	if pc.BaseIndex == pc.QuoteIndex {
+		return fmt.Errorf("base and quote index must be different")
+	}
+
+	if pc.BaseDecimals < 0 {
+		return fmt.Errorf("base decimals must be non-negative")
+	}
+
+	if pc.QuoteDecimals < 0 {
+		return fmt.Errorf("quote decimals must be non-negative")
+	}
+
+	return nil
+}
+
+// ID returns the pool id. This assumes that the pool config has been validated.
+func (pc *PoolConfig) ID() common.Hash {
+	return common.HexToHash(pc.PoolID)
+}
+
+// PoolAddress returns the address of the pool contract, which is encoded in the first 20 bytes
+// of the pool id.
+func (pc *PoolConfig) PoolAddress() common.Address {
+	return common.BytesToAddress(pc.ID().Bytes()[:common.AddressLength])
+}
+
+// VaultAddress returns the address of the vault that holds the pool's balances.
+func (pc *PoolConfig) VaultAddress() common.Address {
+	if len(pc.Vault) == 0 {
+		return common.HexToAddress(DefaultVaultAddress)
+	}
+	return common.HexToAddress(pc.Vault)
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (pc *PoolConfig) MustToJSON() string {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+var (
+	// DefaultETHAPIConfig is the default configuration for the Balancer API. Specifically this is
+	// for Ethereum mainnet.
+	DefaultETHAPIConfig = config.APIConfig{
+		Name:              fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.ETHEREUM),
+		Atomic:            true,
+		Enabled:           true,
+		Timeout:           1000 * time.Millisecond,
+		Interval:          2000 * time.Millisecond,
+		ReconnectTimeout:  2000 * time.Millisecond,
+		MaxQueries:        1,
+		Endpoints:         []config.Endpoint{{URL: ETH_URL}},
+		MaxBlockHeightAge: 30 * time.Second,
+	}
+
+	// DefaultBaseAPIConfig is the default configuration for the Balancer API. Specifically this is
+	// for Base mainnet.
+	DefaultBaseAPIConfig = config.APIConfig{
+		Name:              fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.BASE),
+		Atomic:            true,
+		Enabled:           true,
+		Timeout:           1000 * time.Millisecond,
+		Interval:          2000 * time.Millisecond,
+		ReconnectTimeout:  2000 * time.Millisecond,
+		MaxQueries:        1,
+		Endpoints:         []config.Endpoint{{URL: BASE_URL}},
+		MaxBlockHeightAge: 30 * time.Second,
+	}
+)
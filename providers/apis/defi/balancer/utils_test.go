@@ -0,0 +1,110 @@
+package balancer_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/constants"
+	"github.com/1119-Labs/slinky/providers/apis/defi/balancer"
+)
+
+func TestPoolConfig(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  balancer.PoolConfig
+		err  bool
+	}{
+		{
+			name: "empty config",
+			cfg:  balancer.PoolConfig{},
+			err:  true,
+		},
+		{
+			name: "invalid pool id",
+			cfg: balancer.PoolConfig{
+				PoolID:     "not a pool id",
+				QuoteIndex: 1,
+			},
+			err: true,
+		},
+		{
+			name: "pool id is an address",
+			cfg: balancer.PoolConfig{
+				PoolID:     "0x5c6Ee304399DBdB9C8Ef030aB642B10820DB8F56",
+				QuoteIndex: 1,
+			},
+			err: true,
+		},
+		{
+			name: "invalid vault",
+			cfg: balancer.PoolConfig{
+				PoolID:     balwethCfg.PoolID,
+				Vault:      "invalid",
+				QuoteIndex: 1,
+			},
+			err: true,
+		},
+		{
+			name: "negative index",
+			cfg: balancer.PoolConfig{
+				PoolID:     balwethCfg.PoolID,
+				BaseIndex:  -1,
+				QuoteIndex: 1,
+			},
+			err: true,
+		},
+		{
+			name: "same base and quote index",
+			cfg: balancer.PoolConfig{
+				PoolID: balwethCfg.PoolID,
+			},
+			err: true,
+		},
+		{
+			name: "negative decimals",
+			cfg: balancer.PoolConfig{
+				PoolID:       balwethCfg.PoolID,
+				QuoteIndex:   1,
+				BaseDecimals: -1,
+			},
+			err: true,
+		},
+		{
+			name: "valid config",
+			cfg:  balwethCfg,
+		},
+		{
+			name: "valid config with a vault",
+			cfg:  wbtcwethCfg,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.ValidateBasic()
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPoolConfigAddresses(t *testing.T) {
+	require.Equal(t, common.HexToAddress("0x5c6Ee304399DBdB9C8Ef030aB642B10820DB8F56"), balwethCfg.PoolAddress())
+	require.Equal(t, common.HexToAddress(balancer.DefaultVaultAddress), balwethCfg.VaultAddress())
+
+	cfg := balwethCfg
+	cfg.Vault = "0x0000000000000000000000000000000000000001"
+	require.Equal(t, common.HexToAddress(cfg.Vault), cfg.VaultAddress())
+}
+
+func TestIsValidProviderName(t *testing.T) {
+	require.True(t, balancer.IsValidProviderName(balancer.ProviderNames[constants.ETHEREUM]))
+	require.True(t, balancer.IsValidProviderName(balancer.ProviderNames[constants.BASE]))
+	require.False(t, balancer.IsValidProviderName(balancer.BaseName))
+	require.False(t, balancer.IsValidProviderName("balancer_api-solana"))
+}
@@ -0,0 +1,312 @@
+package curve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/oracle/types"
+	curvepool "github.com/1119-Labs/slinky/providers/apis/defi/curve/pool"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/1119-Labs/slinky/providers/base/api/metrics"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+var _ types.PriceAPIFetcher = (*PriceFetcher)(nil)
+
+// PriceFetcher is the Curve price fetcher. This fetcher is responsible for querying Curve
+// StableSwap pool contracts and returning the price of a given ticker. The price is the spot
+// price implied by the StableSwap invariant for the amplification coefficient and the coin
+// balances of the pool.
+//
+// To read more about the StableSwap invariant, see the Curve whitepaper
+// https://docs.curve.fi/assets/pdf/stableswap-paper.pdf.
+//
+// The amplification coefficient and balances of a pool are always queried in the same batch call
+// via the eth client's BatchCallContext.
+type PriceFetcher struct {
+	logger *zap.Logger
+	api    config.APIConfig
+
+	// client is the EVM client implementation. This is used to interact with the ethereum network.
+	client ethmulticlient.EVMClient
+	// abi is the curve pool abi. This is used to pack the calls to the pool contract and parse
+	// the results.
+	abi *abi.ABI
+	// ampPayload is the packed A call to the pool contract. This is the same for all pools.
+	ampPayload []byte
+	// balancesPayloads are the packed balances calls to the pool contract for each coin index.
+	// These are the same for all pools.
+	balancesPayloads [][]byte
+	// poolCache is a cache of the tickers to pool configs. This is used to avoid unmarshalling
+	// the metadata for each ticker.
+	poolCache map[types.ProviderTicker]PoolConfig
+}
+
+// NewPriceFetcher returns a new Curve price fetcher.
+func NewPriceFetcher(
+	ctx context.Context,
+	logger *zap.Logger,
+	apiMetrics metrics.APIMetrics,
+	api config.APIConfig,
+) (*PriceFetcher, error) {
+	if ctx == nil {
+		return nil, fmt.Errorf("context cannot be nil")
+	}
+
+	if logger == nil {
+		return nil, fmt.Errorf("logger cannot be nil")
+	}
+
+	if apiMetrics == nil {
+		return nil, fmt.Errorf("api metrics is nil")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("invalid api config name %s", api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	client, err := ethmulticlient.NewEVMClient(ctx, logger, api, apiMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPriceFetcherWithClient(
+		logger,
+		api,
+		client,
+	)
+}
+
+// NewPriceFetcherWithClient returns a new PriceFetcher.
+// It requires a pre-validated config, and initialized client.
+func NewPriceFetcherWithClient(
+	logger *zap.Logger,
+	api config.APIConfig,
+	client ethmulticlient.EVMClient,
+) (*PriceFetcher, error) {
+	abi, err := curvepool.CurveMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get curve abi: %w", err)
+	}
+
+	ampPayload, err := abi.Pack(AmplificationMethod)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", AmplificationMethod, err)
+	}
+
+	balancesPayloads := make([][]byte, MaxCoins)
+	for i := range balancesPayloads {
+		balancesPayloads[i], err = abi.Pack(BalancesMethod, big.NewInt(int64(i)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack %s: %w", BalancesMethod, err)
+		}
+	}
+
+	return &PriceFetcher{
+		logger:           logger.With(zap.String("fetcher", api.Name)),
+		api:              api,
+		client:           client,
+		abi:              abi,
+		ampPayload:       ampPayload,
+		balancesPayloads: balancesPayloads,
+		poolCache:        make(map[types.ProviderTicker]PoolConfig),
+	}, nil
+}
+
+// Fetch returns the price of a given set of tickers. For each ticker, the amplification
+// coefficient and the balances of all coins of the pool are queried, and the price of the base
+// coin in units of the quote coin is derived from the StableSwap invariant.
+func (f *PriceFetcher) Fetch(
+	ctx context.Context,
+	tickers []types.ProviderTicker,
+) types.PriceResponse {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	// Create the batch elements for each ticker and pool. Tickers with an invalid pool config
+	// are not queried.
+	groups := make([][]rpc.BatchElem, 0, len(tickers))
+	queried := make([]types.ProviderTicker, 0, len(tickers))
+	pools := make([]PoolConfig, 0, len(tickers))
+
+	for _, ticker := range tickers {
+		pool, err := f.GetPool(ticker)
+		if err != nil {
+			f.logger.Debug(
+				"failed to get pool for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					fmt.Errorf("failed to get pool: %w", err),
+					providertypes.ErrorFailedToDecode,
+				),
+			}
+
+			continue
+		}
+
+		groups = append(groups, f.batchElems(pool))
+		queried = append(queried, ticker)
+		pools = append(pools, pool)
+	}
+
+	// Batch call to the EVM.
+	if err := ethmulticlient.BatchCallGroups(ctx, f.client, groups, MaxBatchSize); err != nil {
+		f.logger.Debug(
+			"failed to batch call to ethereum network for all tickers",
+			zap.Error(err),
+		)
+
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorAPIGeneral),
+		)
+	}
+
+	// Parse the results from the batch call for each ticker.
+	for i, ticker := range queried {
+		if err := ethmulticlient.BatchElemsError(groups[i]); err != nil {
+			f.logger.Debug(
+				"failed to batch call to ethereum network for ticker",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorUnknown,
+				),
+			}
+
+			continue
+		}
+
+		price, err := f.parsePrice(pools[i], groups[i])
+		if err != nil {
+			f.logger.Debug(
+				"failed to parse price",
+				zap.String("ticker", ticker.String()),
+				zap.Error(err),
+			)
+
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(
+					err,
+					providertypes.ErrorFailedToParsePrice,
+				),
+			}
+
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	}
+
+	return types.NewPriceResponse(resolved, unResolved)
+}
+
+// GetPool returns the curve pool for the given ticker. This will unmarshal the metadata
+// and validate the pool config which contains all required information to query the EVM.
+func (f *PriceFetcher) GetPool(
+	ticker types.ProviderTicker,
+) (PoolConfig, error) {
+	if pool, ok := f.poolCache[ticker]; ok {
+		return pool, nil
+	}
+
+	var cfg PoolConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal pool config on ticker: %w", err)
+	}
+	if err := cfg.ValidateBasic(); err != nil {
+		return cfg, fmt.Errorf("invalid ticker pool config: %w", err)
+	}
+
+	f.poolCache[ticker] = cfg
+	return cfg, nil
+}
+
+// batchElems returns the batch elements used to query the state of the given pool. The first
+// element is the A call, followed by the balances call for each coin.
+func (f *PriceFetcher) batchElems(pool PoolConfig) []rpc.BatchElem {
+	address := common.HexToAddress(pool.Address)
+
+	elems := make([]rpc.BatchElem, 1+len(pool.CoinDecimals))
+	elems[0] = ethmulticlient.EthCallBatchElem(address, f.ampPayload)
+	for i := range pool.CoinDecimals {
+		elems[1+i] = ethmulticlient.EthCallBatchElem(address, f.balancesPayloads[i])
+	}
+
+	return elems
+}
+
+// parsePrice parses the results of the batch elements of the given pool and returns the price of
+// the base coin in units of the quote coin.
+func (f *PriceFetcher) parsePrice(
+	pool PoolConfig,
+	elems []rpc.BatchElem,
+) (*big.Float, error) {
+	values := make([]*big.Int, len(elems))
+	for i, elem := range elems {
+		method := BalancesMethod
+		if i == 0 {
+			method = AmplificationMethod
+		}
+
+		value, err := f.ParseUint256(method, elem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", method, err)
+		}
+		values[i] = value
+	}
+
+	balances, err := NormalizeBalances(values[1:], pool.CoinDecimals)
+	if err != nil {
+		return nil, err
+	}
+
+	return SpotPrice(values[0], balances, int(pool.BaseIndex), int(pool.QuoteIndex))
+}
+
+// ParseUint256 parses the uint256 returned by the given contract method from the result of a
+// batch element.
+func (f *PriceFetcher) ParseUint256(
+	method string,
+	elem rpc.BatchElem,
+) (*big.Int, error) {
+	bz, err := ethmulticlient.DecodeEthCallResult(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := f.abi.Methods[method].Outputs.UnpackValues(bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack values: %w", err)
+	}
+
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
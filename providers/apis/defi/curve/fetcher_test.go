@@ -0,0 +1,189 @@
+package curve_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/providers/apis/defi/curve"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient/mocks"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+func TestFetch(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tickers  []types.ProviderTicker
+		client   func() ethmulticlient.EVMClient
+		expected types.PriceResponse
+	}{
+		{
+			name:    "no tickers",
+			tickers: []types.ProviderTicker{},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved:   map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+		{
+			name: "fails to retrieve pool for an empty ticker",
+			tickers: []types.ProviderTicker{
+				types.NewProviderTicker("DAI/USDC", ""),
+			},
+			client: func() ethmulticlient.EVMClient {
+				return mocks.NewEVMClient(t)
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					types.NewProviderTicker("DAI/USDC", ""): {},
+				},
+			},
+		},
+		{
+			name: "fails to make a batch call",
+			tickers: []types.ProviderTicker{
+				daiusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(fmt.Errorf("failed to make a batch call"))
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					daiusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request has an error for a single call",
+			tickers: []types.ProviderTicker{
+				daiusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				mockBlockNumber(c)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+					elems := args.Get(1).([]rpc.BatchElem)
+					elems[2].Error = fmt.Errorf("request for balance did not return a result")
+				})
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					daiusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "batch request returns a result that cannot be parsed",
+			tickers: []types.ProviderTicker{
+				daiusdcTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				c := mocks.NewEVMClient(t)
+				mockBlockNumber(c)
+				c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+					for _, elem := range args.Get(1).([]rpc.BatchElem) {
+						*elem.Result.(*string) = "not a valid result"
+					}
+				})
+				return c
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{
+					daiusdcTicker: {},
+				},
+			},
+		},
+		{
+			name: "3pool and steth/eth mainnet results",
+			tickers: []types.ProviderTicker{
+				daiusdcTicker,
+				usdtusdcTicker,
+				stethethTicker,
+			},
+			client: func() ethmulticlient.EVMClient {
+				return createEVMClientWithFixtures(t, "3pool", "steth")
+			},
+			expected: types.PriceResponse{
+				Resolved: map[types.ProviderTicker]providertypes.ResolvedResult[*big.Float]{
+					daiusdcTicker: {
+						Value: big.NewFloat(0.99998479929960548640306388604166932698961203201936877157505269253288420826517978),
+					},
+					usdtusdcTicker: {
+						Value: big.NewFloat(0.99987779238950098233369617740452371081933650398689057229889932855345039478465074),
+					},
+					stethethTicker: {
+						Value: big.NewFloat(0.99997200669465542704719822697777424737372319357595412264614533075580302537202035),
+					},
+				},
+				UnResolved: map[types.ProviderTicker]providertypes.UnresolvedResult{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fetcher := createPriceFetcherWithClient(t, tc.client())
+
+			response := fetcher.Fetch(context.Background(), tc.tickers)
+			require.Equal(t, len(tc.expected.Resolved), len(response.Resolved))
+			require.Equal(t, len(tc.expected.UnResolved), len(response.UnResolved))
+
+			for ticker, result := range tc.expected.Resolved {
+				require.Contains(t, response.Resolved, ticker)
+				require.Equal(t, result.Value.SetPrec(40), response.Resolved[ticker].Value.SetPrec(40))
+			}
+
+			for ticker := range tc.expected.UnResolved {
+				require.Contains(t, response.UnResolved, ticker)
+			}
+		})
+	}
+}
+
+func TestGetPool(t *testing.T) {
+	fetcher := createPriceFetcherWithClient(t, mocks.NewEVMClient(t))
+
+	t.Run("ticker is empty", func(t *testing.T) {
+		ticker := types.NewProviderTicker("", "")
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker does not have valid metadata", func(t *testing.T) {
+		expected := curve.PoolConfig{
+			Address: "0x1234",
+		}
+		ticker := types.NewProviderTicker("DAI/USDC", expected.MustToJSON())
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker is not json formatted", func(t *testing.T) {
+		ticker := types.NewProviderTicker("DAI/USDC", "not json, something else")
+		_, err := fetcher.GetPool(ticker)
+		require.Error(t, err)
+	})
+
+	t.Run("ticker has valid metadata", func(t *testing.T) {
+		pool, err := fetcher.GetPool(daiusdcTicker)
+		require.NoError(t, err)
+		require.Equal(t, threePoolDAIUSDCCfg, pool)
+	})
+}
@@ -0,0 +1,137 @@
+package curve
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const (
+	// precision is the precision, in bits, of the floats used in the StableSwap math.
+	precision = 256
+
+	// maxIterations is the maximum number of Newton iterations used to compute the invariant. This
+	// matches the limit used by the StableSwap contracts.
+	maxIterations = 255
+
+	// convergenceExp is the binary exponent of the relative tolerance at which the invariant is
+	// considered to have converged, i.e. |D - D_prev| <= D * 2^convergenceExp.
+	convergenceExp = -200
+)
+
+func newFloat() *big.Float {
+	return new(big.Float).SetPrec(precision)
+}
+
+// NormalizeBalances converts the raw coin balances of a pool to whole token units using the
+// decimals of each coin. This is equivalent to the rate multipliers that the StableSwap
+// contracts apply before evaluating the invariant.
+func NormalizeBalances(
+	balances []*big.Int,
+	decimals []int64,
+) ([]*big.Float, error) {
+	if len(balances) != len(decimals) {
+		return nil, fmt.Errorf("expected %d balances, got %d", len(decimals), len(balances))
+	}
+
+	normalized := make([]*big.Float, len(balances))
+	for i, balance := range balances {
+		scale := newFloat().SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals[i]), nil))
+		normalized[i] = newFloat().Quo(newFloat().SetInt(balance), scale)
+	}
+
+	return normalized, nil
+}
+
+// ComputeInvariant returns the StableSwap invariant D of a pool with the given amplification
+// coefficient and normalized balances. D is the solution of
+//
+//	Ann * S + D = Ann * D + D^(n+1) / (n^n * prod(x)),
+//
+// where S is the sum of the balances and Ann = A * n. Note that the A() method of the pool
+// contracts returns A * n^(n-1) in terms of the whitepaper's A, so Ann = A * n^n as in the
+// whitepaper. D is computed with Newton's method, in the same way as get_D in the contracts.
+func ComputeInvariant(
+	amp *big.Int,
+	balances []*big.Float,
+) (*big.Float, error) {
+	if amp.Sign() <= 0 {
+		return nil, fmt.Errorf("amplification coefficient must be positive")
+	}
+
+	if len(balances) < 2 {
+		return nil, fmt.Errorf("pool must have at least 2 coins")
+	}
+
+	n := newFloat().SetInt64(int64(len(balances)))
+	sum := newFloat()
+	for i, balance := range balances {
+		if balance.Sign() <= 0 {
+			return nil, fmt.Errorf("balance of coin %d must be positive", i)
+		}
+		sum.Add(sum, balance)
+	}
+
+	ann := newFloat().Mul(newFloat().SetInt(amp), n)
+	annMinusOne := newFloat().Sub(ann, big.NewFloat(1))
+	nPlusOne := newFloat().Add(n, big.NewFloat(1))
+
+	d := newFloat().Set(sum)
+	for range maxIterations {
+		dP := productTerm(d, balances)
+		prev := d
+
+		// D = (Ann * S + D_P * n) * D / ((Ann - 1) * D + (n + 1) * D_P)
+		num := newFloat().Add(newFloat().Mul(ann, sum), newFloat().Mul(dP, n))
+		num.Mul(num, d)
+		den := newFloat().Add(newFloat().Mul(annMinusOne, d), newFloat().Mul(nPlusOne, dP))
+		d = newFloat().Quo(num, den)
+
+		diff := newFloat().Sub(d, prev)
+		tolerance := newFloat().SetMantExp(d, convergenceExp)
+		if diff.Abs(diff).Cmp(tolerance) <= 0 {
+			return d, nil
+		}
+	}
+
+	return nil, fmt.Errorf("invariant did not converge after %d iterations", maxIterations)
+}
+
+// SpotPrice returns the marginal price of coin i in units of coin j of a pool with the given
+// amplification coefficient and normalized balances, excluding the swap fee. The price is the
+// ratio of the partial derivatives of the invariant with respect to the balances of the coins:
+//
+//	price = (Ann + D_P / x_i) / (Ann + D_P / x_j),
+//
+// where D_P = D^(n+1) / (n^n * prod(x)).
+func SpotPrice(
+	amp *big.Int,
+	balances []*big.Float,
+	i, j int,
+) (*big.Float, error) {
+	if i < 0 || i >= len(balances) || j < 0 || j >= len(balances) {
+		return nil, fmt.Errorf("coin indices %d and %d are out of range for a pool with %d coins", i, j, len(balances))
+	}
+
+	d, err := ComputeInvariant(amp, balances)
+	if err != nil {
+		return nil, err
+	}
+
+	ann := newFloat().Mul(newFloat().SetInt(amp), newFloat().SetInt64(int64(len(balances))))
+	dP := productTerm(d, balances)
+
+	num := newFloat().Add(ann, newFloat().Quo(dP, balances[i]))
+	den := newFloat().Add(ann, newFloat().Quo(dP, balances[j]))
+	return newFloat().Quo(num, den), nil
+}
+
+// productTerm returns D^(n+1) / (n^n * prod(x)), computed iteratively as in the contracts.
+func productTerm(d *big.Float, balances []*big.Float) *big.Float {
+	n := newFloat().SetInt64(int64(len(balances)))
+	dP := newFloat().Set(d)
+	for _, balance := range balances {
+		dP.Mul(dP, d)
+		dP.Quo(dP, newFloat().Mul(balance, n))
+	}
+	return dP
+}
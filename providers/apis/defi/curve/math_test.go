@@ -0,0 +1,207 @@
+package curve_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/providers/apis/defi/curve"
+)
+
+func bigInt(t *testing.T, s string) *big.Int {
+	t.Helper()
+
+	v, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return v
+}
+
+func floats(values ...float64) []*big.Float {
+	out := make([]*big.Float, len(values))
+	for i, v := range values {
+		out[i] = big.NewFloat(v).SetPrec(256)
+	}
+	return out
+}
+
+func TestNormalizeBalances(t *testing.T) {
+	t.Run("scales each balance by its decimals", func(t *testing.T) {
+		balances, err := curve.NormalizeBalances(
+			[]*big.Int{bigInt(t, "1500000000000000000"), big.NewInt(2500000)},
+			[]int64{18, 6},
+		)
+		require.NoError(t, err)
+		require.Equal(t, big.NewFloat(1.5).SetPrec(40), balances[0].SetPrec(40))
+		require.Equal(t, big.NewFloat(2.5).SetPrec(40), balances[1].SetPrec(40))
+	})
+
+	t.Run("mismatched balances and decimals", func(t *testing.T) {
+		_, err := curve.NormalizeBalances([]*big.Int{big.NewInt(1)}, []int64{18, 6})
+		require.Error(t, err)
+	})
+}
+
+func TestComputeInvariant(t *testing.T) {
+	t.Run("balanced pool has an invariant equal to the sum of the balances", func(t *testing.T) {
+		d, err := curve.ComputeInvariant(big.NewInt(2000), floats(1e6, 1e6, 1e6))
+		require.NoError(t, err)
+		require.Equal(t, big.NewFloat(3e6).SetPrec(40), d.SetPrec(40))
+	})
+
+	t.Run("imbalanced pool has an invariant below the sum of the balances", func(t *testing.T) {
+		d, err := curve.ComputeInvariant(big.NewInt(100), floats(1e6, 3e6))
+		require.NoError(t, err)
+		require.Equal(t, -1, d.Cmp(big.NewFloat(4e6)))
+		requireApproxEqual(t, d, new(big.Float).Add(d, invariant(big.NewInt(100), floats(1e6, 3e6), d)))
+	})
+
+	t.Run("zero balance", func(t *testing.T) {
+		_, err := curve.ComputeInvariant(big.NewInt(100), floats(1e6, 0))
+		require.Error(t, err)
+	})
+
+	t.Run("zero amplification coefficient", func(t *testing.T) {
+		_, err := curve.ComputeInvariant(big.NewInt(0), floats(1e6, 1e6))
+		require.Error(t, err)
+	})
+
+	t.Run("single coin", func(t *testing.T) {
+		_, err := curve.ComputeInvariant(big.NewInt(100), floats(1e6))
+		require.Error(t, err)
+	})
+}
+
+func TestSpotPrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amp      *big.Int
+		balances []*big.Float
+		i, j     int
+	}{
+		{
+			name:     "balanced two coin pool",
+			amp:      big.NewInt(100),
+			balances: floats(1e6, 1e6),
+			i:        0,
+			j:        1,
+		},
+		{
+			name:     "imbalanced two coin pool",
+			amp:      big.NewInt(1500),
+			balances: floats(35000.12, 36500.98),
+			i:        1,
+			j:        0,
+		},
+		{
+			name:     "heavily imbalanced two coin pool with a low amplification",
+			amp:      big.NewInt(1),
+			balances: floats(1e3, 9e6),
+			i:        0,
+			j:        1,
+		},
+		{
+			name:     "imbalanced three coin pool",
+			amp:      big.NewInt(2000),
+			balances: floats(50123456.78, 48765432.1, 62345678.9),
+			i:        2,
+			j:        1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price, err := curve.SpotPrice(tc.amp, tc.balances, tc.i, tc.j)
+			require.NoError(t, err)
+
+			// The spot price must match the amount of coin j received per unit of a
+			// marginal deposit of coin i that keeps the invariant constant.
+			expected := marginalPrice(t, tc.amp, tc.balances, tc.i, tc.j)
+			requireApproxEqual(t, expected, price)
+
+			// The price of coin j in units of coin i is the inverse.
+			inverse, err := curve.SpotPrice(tc.amp, tc.balances, tc.j, tc.i)
+			require.NoError(t, err)
+			requireApproxEqual(t, big.NewFloat(1), new(big.Float).Mul(price, inverse))
+		})
+	}
+
+	t.Run("balanced pool has a price of 1", func(t *testing.T) {
+		price, err := curve.SpotPrice(big.NewInt(2000), floats(1e6, 1e6, 1e6), 0, 2)
+		require.NoError(t, err)
+		requireApproxEqual(t, big.NewFloat(1), price)
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		_, err := curve.SpotPrice(big.NewInt(2000), floats(1e6, 1e6), 0, 2)
+		require.Error(t, err)
+	})
+}
+
+// requireApproxEqual requires that actual is equal to expected up to a relative error of 2^-60.
+func requireApproxEqual(t *testing.T, expected, actual *big.Float) {
+	t.Helper()
+
+	diff := new(big.Float).SetPrec(256).Sub(expected, actual)
+	tolerance := new(big.Float).SetPrec(256).SetMantExp(expected, -60)
+	require.True(
+		t,
+		diff.Abs(diff).Cmp(tolerance.Abs(tolerance)) <= 0,
+		"expected %s, got %s", expected.Text('g', 30), actual.Text('g', 30),
+	)
+}
+
+// invariant evaluates Ann * S + D - Ann * D - D^(n+1) / (n^n * prod(x)), which is zero for the
+// invariant D of the balances.
+func invariant(amp *big.Int, balances []*big.Float, d *big.Float) *big.Float {
+	n := new(big.Float).SetPrec(256).SetInt64(int64(len(balances)))
+	ann := new(big.Float).SetPrec(256).Mul(new(big.Float).SetInt(amp), n)
+
+	sum := new(big.Float).SetPrec(256)
+	dP := new(big.Float).SetPrec(256).Set(d)
+	for _, balance := range balances {
+		sum.Add(sum, balance)
+		dP.Mul(dP, d)
+		dP.Quo(dP, new(big.Float).SetPrec(256).Mul(balance, n))
+	}
+
+	out := new(big.Float).SetPrec(256).Mul(ann, sum)
+	out.Add(out, d)
+	out.Sub(out, new(big.Float).SetPrec(256).Mul(ann, d))
+	out.Sub(out, dP)
+	return out
+}
+
+// marginalPrice returns the price of coin i in units of coin j by depositing a small amount of
+// coin i and solving for the balance of coin j that keeps the invariant constant by bisection.
+func marginalPrice(t *testing.T, amp *big.Int, balances []*big.Float, i, j int) *big.Float {
+	t.Helper()
+
+	d, err := curve.ComputeInvariant(amp, balances)
+	require.NoError(t, err)
+
+	deposit := new(big.Float).SetPrec(256).SetMantExp(balances[i], -80)
+
+	updated := make([]*big.Float, len(balances))
+	for k, balance := range balances {
+		updated[k] = new(big.Float).SetPrec(256).Set(balance)
+	}
+	updated[i].Add(updated[i], deposit)
+
+	lo := new(big.Float).SetPrec(256).Quo(balances[j], big.NewFloat(2))
+	hi := new(big.Float).SetPrec(256).Set(balances[j])
+	for range 256 {
+		mid := new(big.Float).SetPrec(256).Add(lo, hi)
+		mid.Quo(mid, big.NewFloat(2))
+		updated[j] = mid
+
+		if invariant(amp, updated, d).Sign() > 0 {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	out := new(big.Float).SetPrec(256).Sub(balances[j], lo)
+	return out.Quo(out, deposit)
+}
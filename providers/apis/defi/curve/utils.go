@@ -0,0 +1,141 @@
+package curve
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/oracle/constants"
+)
+
+const (
+	// BaseName is the name of the Curve API.
+	BaseName = "curve_api"
+
+	// NameSeparator is the character used to separate elements of dynamic naming for the provider.
+	NameSeparator = "-"
+
+	// AmplificationMethod is the contract method that returns the amplification coefficient of a pool.
+	AmplificationMethod = "A"
+
+	// BalancesMethod is the contract method that returns the balance of a coin in a pool.
+	BalancesMethod = "balances"
+
+	// MaxCoins is the maximum number of coins in a Curve StableSwap pool.
+	MaxCoins = 8
+
+	// MaxBatchSize is the maximum number of calls sent to the EVM in a single batch call.
+	MaxBatchSize = 10
+
+	// ETH_URL is the URL for the Curve API. This uses a free public RPC provider on Ethereum Mainnet.
+	ETH_URL = "https://eth.public-rpc.com/"
+
+	// BASE_URL is the URL for the Curve API. This uses a free public RPC provider on Base Mainnet.
+	BASE_URL = "https://mainnet.base.org"
+)
+
+// ProviderNames is the set of all supported "dynamic" names mapped by chain.
+var ProviderNames = map[string]string{
+	constants.ETHEREUM: strings.Join([]string{BaseName, constants.ETHEREUM}, NameSeparator),
+	constants.BASE:     strings.Join([]string{BaseName, constants.BASE}, NameSeparator),
+}
+
+// IsValidProviderName returns a bool based on the validity of the passed in name.
+// Dynamic provider naming is supported via `BaseName“NameSeparator“SupportedChain`.
+func IsValidProviderName(name string) bool {
+	for _, providerName := range ProviderNames {
+		if name == providerName {
+			return true
+		}
+	}
+	return false
+}
+
+// PoolConfig is the configuration for a Curve StableSwap pool. This is specific to each pair of
+// tokens in the pool.
+type PoolConfig struct {
+	// Address is the Curve pool address.
+	Address string `json:"address"`
+	// CoinDecimals is the number of decimals of each coin in the pool, in the order of the coins
+	// in the pool contract. This should be derived from the token contracts.
+	CoinDecimals []int64 `json:"coin_decimals"`
+	// BaseIndex is the index of the base token in the pool's coins.
+	BaseIndex int64 `json:"base_index"`
+	// QuoteIndex is the index of the quote token in the pool's coins.
+	QuoteIndex int64 `json:"quote_index"`
+}
+
+// ValidateBasic validates the pool configuration.
+func (pc *PoolConfig) ValidateBasic() error {
+	if !common.IsHexAddress(pc.Address) {
+		return fmt.Errorf("pool address is not a valid ethereum address")
+	}
+
+	if len(pc.CoinDecimals) < 2 || len(pc.CoinDecimals) > MaxCoins {
+		return fmt.Errorf("pool must have between 2 and %d coins, got %d", MaxCoins, len(pc.CoinDecimals))
+	}
+
+	for i, decimals := range pc.CoinDecimals {
+		if decimals < 0 {
+			return fmt.Errorf("decimals of coin %d must be non-negative", i)
+		}
+	}
+
+	coins := int64(len(pc.CoinDecimals))
+	if pc.BaseIndex < 0 || pc.BaseIndex >= coins {
+		return fmt.Errorf("base index %d is out of range for a pool with %d coins", pc.BaseIndex, coins)
+	}
+
+	if pc.QuoteIndex < 0 || pc.QuoteIndex >= coins {
+		return fmt.Errorf("quote index %d is out of range for a pool with %d coins", pc.QuoteIndex, coins)
+	}
+
+	if pc.BaseIndex == pc.QuoteIndex {
+		return fmt.Errorf("base and quote index must be different")
+	}
+
+	return nil
+}
+
+// MustToJSON converts the pool configuration to JSON.
+func (pc *PoolConfig) MustToJSON() string {
+	b, err := json.Marshal(pc)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+var (
+	// DefaultETHAPIConfig is the default configuration for the Curve API. Specifically this is for
+	// Ethereum mainnet.
+	DefaultETHAPIConfig = config.APIConfig{
+		Name:              fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.ETHEREUM),
+		Atomic:            true,
+		Enabled:           true,
+		Timeout:           1000 * time.Millisecond,
+		Interval:          2000 * time.Millisecond,
+		ReconnectTimeout:  2000 * time.Millisecond,
+		MaxQueries:        1,
+		Endpoints:         []config.Endpoint{{URL: ETH_URL}},
+		MaxBlockHeightAge: 30 * time.Second,
+	}
+
+	// DefaultBaseAPIConfig is the default configuration for the Curve API. Specifically this is for
+	// Base mainnet.
+	DefaultBaseAPIConfig = config.APIConfig{
+		Name:              fmt.Sprintf("%s%s%s", BaseName, NameSeparator, constants.BASE),
+		Atomic:            true,
+		Enabled:           true,
+		Timeout:           1000 * time.Millisecond,
+		Interval:          2000 * time.Millisecond,
+		ReconnectTimeout:  2000 * time.Millisecond,
+		MaxQueries:        1,
+		Endpoints:         []config.Endpoint{{URL: BASE_URL}},
+		MaxBlockHeightAge: 30 * time.Second,
+	}
+)
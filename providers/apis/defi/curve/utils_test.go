@@ -0,0 +1,108 @@
+package curve_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/constants"
+	"github.com/1119-Labs/slinky/providers/apis/defi/curve"
+)
+
+func TestPoolConfig(t *testing.T) {
+	testCases := []struct {
+		name string
+		cfg  curve.PoolConfig
+		err  bool
+	}{
+		{
+			name: "empty config",
+			cfg:  curve.PoolConfig{},
+			err:  true,
+		},
+		{
+			name: "invalid address",
+			cfg: curve.PoolConfig{
+				Address:      "invalid",
+				CoinDecimals: []int64{18, 6},
+				QuoteIndex:   1,
+			},
+			err: true,
+		},
+		{
+			name: "too few coins",
+			cfg: curve.PoolConfig{
+				Address:      threePoolDAIUSDCCfg.Address,
+				CoinDecimals: []int64{18},
+			},
+			err: true,
+		},
+		{
+			name: "too many coins",
+			cfg: curve.PoolConfig{
+				Address:      threePoolDAIUSDCCfg.Address,
+				CoinDecimals: []int64{18, 18, 18, 18, 18, 18, 18, 18, 18},
+				QuoteIndex:   1,
+			},
+			err: true,
+		},
+		{
+			name: "negative decimals",
+			cfg: curve.PoolConfig{
+				Address:      threePoolDAIUSDCCfg.Address,
+				CoinDecimals: []int64{18, -1},
+				QuoteIndex:   1,
+			},
+			err: true,
+		},
+		{
+			name: "base index out of range",
+			cfg: curve.PoolConfig{
+				Address:      threePoolDAIUSDCCfg.Address,
+				CoinDecimals: []int64{18, 6},
+				BaseIndex:    2,
+				QuoteIndex:   1,
+			},
+			err: true,
+		},
+		{
+			name: "quote index out of range",
+			cfg: curve.PoolConfig{
+				Address:      threePoolDAIUSDCCfg.Address,
+				CoinDecimals: []int64{18, 6},
+				QuoteIndex:   -1,
+			},
+			err: true,
+		},
+		{
+			name: "same base and quote index",
+			cfg: curve.PoolConfig{
+				Address:      threePoolDAIUSDCCfg.Address,
+				CoinDecimals: []int64{18, 6},
+			},
+			err: true,
+		},
+		{
+			name: "valid config",
+			cfg:  threePoolDAIUSDCCfg,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.ValidateBasic()
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsValidProviderName(t *testing.T) {
+	require.True(t, curve.IsValidProviderName(curve.ProviderNames[constants.ETHEREUM]))
+	require.True(t, curve.IsValidProviderName(curve.ProviderNames[constants.BASE]))
+	require.False(t, curve.IsValidProviderName(curve.BaseName))
+	require.False(t, curve.IsValidProviderName("curve_api-solana"))
+}
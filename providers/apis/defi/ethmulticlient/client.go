@@ -10,6 +10,7 @@ import (
 	"github.com/1119-Labs/slinky/providers/base/api/metrics"
 
 	"github.com/ethereum/go-ethereum/rpc"
+	"go.uber.org/zap"
 )
 
 // EVMClient is an interface that abstracts the evm client.
@@ -84,6 +85,24 @@ func NewGoEthereumClientImpl(
 	}, nil
 }
 
+// NewEVMClient returns the EVMClient for the given API config. A MultiRPCClient is returned if
+// several endpoints are configured, and a GoEthereumClientImpl otherwise.
+func NewEVMClient(
+	ctx context.Context,
+	logger *zap.Logger,
+	api config.APIConfig,
+	apiMetrics metrics.APIMetrics,
+) (EVMClient, error) {
+	switch {
+	case len(api.Endpoints) > 1:
+		return NewMultiRPCClientFromEndpoints(ctx, logger, api, apiMetrics)
+	case len(api.Endpoints) == 1:
+		return NewGoEthereumClientImpl(ctx, apiMetrics, api, 0)
+	default:
+		return nil, fmt.Errorf("no endpoints were provided")
+	}
+}
+
 // BatchCallContext sends all given requests as a single batch and waits for the server
 // to return a response for all of them. The wait duration is bounded by the context's deadline.
 //
@@ -1,6 +1,13 @@
 package ethmulticlient
 
-import "github.com/ethereum/go-ethereum/rpc"
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
 
 // EthBlockNumberBatchElem returns an initialized BatchElem for the eth_blockNumber call.
 func EthBlockNumberBatchElem() rpc.BatchElem {
@@ -10,3 +17,145 @@ func EthBlockNumberBatchElem() rpc.BatchElem {
 		Result: &result,
 	}
 }
+
+// EthCallBatchElem returns an initialized BatchElem for an eth_call to the given contract with the
+// given call data at the latest block. BatchCallGroups pins the call to a single block instead.
+func EthCallBatchElem(to common.Address, data []byte) rpc.BatchElem {
+	var result string
+	return rpc.BatchElem{
+		Method: "eth_call",
+		Args: []interface{}{
+			map[string]interface{}{
+				"to":   to,
+				"data": hexutil.Bytes(data),
+			},
+			"latest", // latest signifies the latest block.
+		},
+		Result: &result,
+	}
+}
+
+// DecodeEthCallResult returns the return data of an eth_call BatchElem that has been sent.
+func DecodeEthCallResult(elem rpc.BatchElem) ([]byte, error) {
+	if elem.Error != nil {
+		return nil, elem.Error
+	}
+
+	r, ok := elem.Result.(*string)
+	if !ok {
+		return nil, fmt.Errorf("expected result to be a string, got %T", elem.Result)
+	}
+
+	if r == nil {
+		return nil, fmt.Errorf("result is nil")
+	}
+
+	bz, err := hexutil.Decode(*r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex result: %w", err)
+	}
+
+	return bz, nil
+}
+
+// BlockNumber returns the latest block number of the EVM.
+func BlockNumber(ctx context.Context, client EVMClient) (uint64, error) {
+	elems := []rpc.BatchElem{EthBlockNumberBatchElem()}
+	if err := client.BatchCallContext(ctx, elems); err != nil {
+		return 0, err
+	}
+
+	if elems[0].Error != nil {
+		return 0, elems[0].Error
+	}
+
+	r, ok := elems[0].Result.(*string)
+	if !ok || r == nil {
+		return 0, fmt.Errorf("expected result of eth_blockNumber to be a string, got %T", elems[0].Result)
+	}
+
+	height, err := hexutil.DecodeUint64(*r)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode hex eth height: %w", err)
+	}
+
+	return height, nil
+}
+
+// BatchElemsError returns the first error of the given BatchElems that have been sent, if any.
+func BatchElemsError(elems []rpc.BatchElem) error {
+	for _, elem := range elems {
+		if elem.Error != nil {
+			return elem.Error
+		}
+	}
+	return nil
+}
+
+// BatchCallGroups sends the given groups of BatchElems to the EVM, packing as many groups as
+// possible into each batch call without exceeding maxBatchSize elements. The elements of a group
+// are always sent in the same batch call, so that related state (e.g. all of the balances of a
+// pool) is read in a single request. The latest block number is read first, and every eth_call
+// of the groups is made at that block, so that the state is read at the same block even if a new
+// block is produced while the batch calls are made, or the batch calls are served by different
+// nodes. The results and errors of each call are written back to the groups.
+func BatchCallGroups(
+	ctx context.Context,
+	client EVMClient,
+	groups [][]rpc.BatchElem,
+	maxBatchSize int,
+) error {
+	if len(groups) == 0 {
+		return nil
+	}
+
+	height, err := BlockNumber(ctx, client)
+	if err != nil {
+		return fmt.Errorf("failed to get the latest block number: %w", err)
+	}
+
+	block := hexutil.EncodeUint64(height)
+	for _, group := range groups {
+		for i := range group {
+			if group[i].Method == "eth_call" && len(group[i].Args) == 2 {
+				group[i].Args[1] = block
+			}
+		}
+	}
+
+	var (
+		batch  []rpc.BatchElem
+		queued [][]rpc.BatchElem
+	)
+
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := client.BatchCallContext(ctx, batch); err != nil {
+			return err
+		}
+
+		offset := 0
+		for _, group := range queued {
+			offset += copy(group, batch[offset:offset+len(group)])
+		}
+
+		batch, queued = nil, nil
+		return nil
+	}
+
+	for _, group := range groups {
+		if len(batch) > 0 && len(batch)+len(group) > maxBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+
+		batch = append(batch, group...)
+		queued = append(queued, group)
+	}
+
+	return flush()
+}
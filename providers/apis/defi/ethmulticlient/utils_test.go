@@ -0,0 +1,136 @@
+package ethmulticlient_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient"
+	"github.com/1119-Labs/slinky/providers/apis/defi/ethmulticlient/mocks"
+)
+
+func TestDecodeEthCallResult(t *testing.T) {
+	to := common.HexToAddress("0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8")
+
+	t.Run("valid result", func(t *testing.T) {
+		elem := ethmulticlient.EthCallBatchElem(to, []byte{0x01})
+		*elem.Result.(*string) = "0x0102"
+
+		bz, err := ethmulticlient.DecodeEthCallResult(elem)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0x01, 0x02}, bz)
+	})
+
+	t.Run("elem has an error", func(t *testing.T) {
+		elem := ethmulticlient.EthCallBatchElem(to, []byte{0x01})
+		elem.Error = fmt.Errorf("execution reverted")
+
+		_, err := ethmulticlient.DecodeEthCallResult(elem)
+		require.Error(t, err)
+	})
+
+	t.Run("result is not a string", func(t *testing.T) {
+		_, err := ethmulticlient.DecodeEthCallResult(rpc.BatchElem{Result: new(int)})
+		require.Error(t, err)
+	})
+
+	t.Run("result is not hex", func(t *testing.T) {
+		elem := ethmulticlient.EthCallBatchElem(to, []byte{0x01})
+		*elem.Result.(*string) = "not hex"
+
+		_, err := ethmulticlient.DecodeEthCallResult(elem)
+		require.Error(t, err)
+	})
+}
+
+func TestBatchCallGroups(t *testing.T) {
+	to := common.HexToAddress("0x8ad599c3A0ff1De082011EFDDc58f1908eb6e6D8")
+	group := func(size int) []rpc.BatchElem {
+		elems := make([]rpc.BatchElem, size)
+		for i := range elems {
+			elems[i] = ethmulticlient.EthCallBatchElem(to, []byte{byte(i)})
+		}
+		return elems
+	}
+	isBlockNumberCall := mock.MatchedBy(func(elems []rpc.BatchElem) bool {
+		return len(elems) == 1 && elems[0].Method == "eth_blockNumber"
+	})
+	blockNumber := func(c *mocks.EVMClient, height string) {
+		c.On("BatchCallContext", mock.Anything, isBlockNumberCall).Return(nil).Run(func(args mock.Arguments) {
+			*args.Get(1).([]rpc.BatchElem)[0].Result.(*string) = height
+		}).Once()
+	}
+
+	t.Run("groups are packed into batches without being split", func(t *testing.T) {
+		groups := [][]rpc.BatchElem{group(4), group(4), group(3), group(12)}
+
+		var sizes []int
+		c := mocks.NewEVMClient(t)
+		blockNumber(c, "0x10")
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			elems := args.Get(1).([]rpc.BatchElem)
+			sizes = append(sizes, len(elems))
+
+			for i := range elems {
+				*elems[i].Result.(*string) = fmt.Sprintf("0x%x", i)
+			}
+			elems[len(elems)-1].Error = fmt.Errorf("last call failed")
+		})
+
+		require.NoError(t, ethmulticlient.BatchCallGroups(context.Background(), c, groups, 10))
+		require.Equal(t, []int{8, 3, 12}, sizes)
+
+		// Results and errors are written back to the groups.
+		require.Equal(t, "0x5", *groups[1][1].Result.(*string))
+		require.NoError(t, groups[0][3].Error)
+		require.Error(t, groups[1][3].Error)
+		require.Error(t, ethmulticlient.BatchElemsError(groups[2]))
+		require.NoError(t, ethmulticlient.BatchElemsError(groups[0]))
+	})
+
+	t.Run("calls are made at the latest block number", func(t *testing.T) {
+		groups := [][]rpc.BatchElem{group(2), group(3)}
+
+		c := mocks.NewEVMClient(t)
+		blockNumber(c, "0x1b4")
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(nil)
+
+		require.NoError(t, ethmulticlient.BatchCallGroups(context.Background(), c, groups, 2))
+		for _, group := range groups {
+			for _, elem := range group {
+				require.Equal(t, "0x1b4", elem.Args[1])
+			}
+		}
+	})
+
+	t.Run("block number call fails", func(t *testing.T) {
+		c := mocks.NewEVMClient(t)
+		c.On("BatchCallContext", mock.Anything, isBlockNumberCall).Return(fmt.Errorf("failed to make a batch call"))
+
+		require.Error(t, ethmulticlient.BatchCallGroups(context.Background(), c, [][]rpc.BatchElem{group(2)}, 10))
+	})
+
+	t.Run("block number is invalid", func(t *testing.T) {
+		c := mocks.NewEVMClient(t)
+		blockNumber(c, "not hex")
+
+		require.Error(t, ethmulticlient.BatchCallGroups(context.Background(), c, [][]rpc.BatchElem{group(2)}, 10))
+	})
+
+	t.Run("batch call fails", func(t *testing.T) {
+		c := mocks.NewEVMClient(t)
+		blockNumber(c, "0x10")
+		c.On("BatchCallContext", mock.Anything, mock.Anything).Return(fmt.Errorf("failed to make a batch call"))
+
+		require.Error(t, ethmulticlient.BatchCallGroups(context.Background(), c, [][]rpc.BatchElem{group(2)}, 10))
+	})
+
+	t.Run("no groups", func(t *testing.T) {
+		require.NoError(t, ethmulticlient.BatchCallGroups(context.Background(), mocks.NewEVMClient(t), nil, 10))
+	})
+}
@@ -318,8 +318,10 @@ func (pf *APIPriceFetcher) Fetch(
 			zap.String("price", price.String()),
 		)
 
-		// return the price
-		resolved[ticker] = oracletypes.NewPriceResult(price, time.Now().UTC())
+		// return the price, along with the pool's liquidity in the base token
+		resolved[ticker] = oracletypes.NewPriceResult(price, time.Now().UTC()).WithLiquidity(
+			calculateLiquidity(baseTokenBalance, metadata.BaseTokenVault.TokenDecimals),
+		)
 	}
 
 	return oracletypes.NewPriceResponse(resolved, unresolved)
@@ -409,3 +411,14 @@ func calculatePrice(
 
 	return new(big.Float).Mul(quo, scalingFactor)
 }
+
+// calculateLiquidity calculates the liquidity of a pool in the base token, i.e. the balance of the
+// base token vault scaled by the base token's decimals.
+func calculateLiquidity(baseTokenBalance *big.Int, baseTokenDecimals uint64) *big.Float {
+	scalingFactor := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(baseTokenDecimals), nil)
+
+	return new(big.Float).Quo(
+		new(big.Float).SetInt(baseTokenBalance),
+		new(big.Float).SetInt(scalingFactor),
+	)
+}
@@ -465,6 +465,7 @@ func TestProviderFetch(t *testing.T) {
 		require.True(t, strings.Contains(resp.UnResolved[tickers[0]].Error(), "solana json-rpc error"))
 		result := resp.Resolved[tickers[1]]
 		require.Equal(t, result.Value.SetPrec(30), big.NewFloat(3).SetPrec(30))
+		require.Equal(t, result.Liquidity.SetPrec(30), big.NewFloat(0.5).SetPrec(30))
 	})
 
 	t.Run("incorrectly encoded accounts are handled gracefully", func(t *testing.T) {
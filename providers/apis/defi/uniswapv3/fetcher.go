@@ -83,28 +83,7 @@ func NewPriceFetcher(
 		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
 	}
 
-	var (
-		client ethmulticlient.EVMClient
-		err    error
-	)
-	switch {
-	case len(api.Endpoints) > 1:
-		client, err = ethmulticlient.NewMultiRPCClientFromEndpoints(
-			ctx,
-			logger,
-			api,
-			apiMetrics,
-		)
-	case len(api.Endpoints) == 1:
-		client, err = ethmulticlient.NewGoEthereumClientImpl(
-			ctx,
-			apiMetrics,
-			api,
-			0,
-		)
-	default:
-		err = fmt.Errorf("no endpoints were provided")
-	}
+	client, err := ethmulticlient.NewEVMClient(ctx, logger, api, apiMetrics)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,135 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/oracle/types"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+var _ types.PriceAPIDataHandler = (*APIHandler)(nil)
+
+// APIHandler implements the PriceAPIDataHandler interface for generic JSON APIs. The URL that is
+// queried is the first endpoint URL of the API config, with the TickerPlaceholder or
+// TickersPlaceholder replaced by the off-chain tickers being queried. The price of each ticker
+// is then extracted from the JSON response using the TickerConfig in its metadata.
+type APIHandler struct {
+	// api is the config for the JSON API.
+	api config.APIConfig
+	// cache maintains the latest set of tickers seen by the handler.
+	cache types.ProviderTickers
+}
+
+// NewAPIHandler returns a new generic JSON API PriceAPIDataHandler.
+func NewAPIHandler(
+	api config.APIConfig,
+) (types.PriceAPIDataHandler, error) {
+	if !IsValidProviderName(api.Name) {
+		return nil, fmt.Errorf("expected api config name %s or with prefix %s%s, got %s", BaseName, BaseName, NameSeparator, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api config for %s is not enabled", api.Name)
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config for %s: %w", api.Name, err)
+	}
+
+	endpoint := api.Endpoints[0].URL
+	if strings.Contains(endpoint, TickerPlaceholder) {
+		if strings.Contains(endpoint, TickersPlaceholder) {
+			return nil, fmt.Errorf("endpoint url for %s cannot contain both %s and %s", api.Name, TickerPlaceholder, TickersPlaceholder)
+		}
+
+		if api.Atomic || api.BatchSize > 1 {
+			return nil, fmt.Errorf("endpoint url for %s with %s must be queried one ticker at a time", api.Name, TickerPlaceholder)
+		}
+	}
+
+	return &APIHandler{
+		api:   api,
+		cache: types.NewProviderTickers(),
+	}, nil
+}
+
+// CreateURL returns the URL that is used to fetch data from the JSON API for the given tickers.
+func (h *APIHandler) CreateURL(
+	tickers []types.ProviderTicker,
+) (string, error) {
+	if len(tickers) == 0 {
+		return "", fmt.Errorf("no tickers provided")
+	}
+
+	offChainTickers := make([]string, len(tickers))
+	for i, ticker := range tickers {
+		offChainTickers[i] = url.QueryEscape(ticker.GetOffChainTicker())
+		h.cache.Add(ticker)
+	}
+
+	endpoint := h.api.Endpoints[0].URL
+	switch {
+	case strings.Contains(endpoint, TickersPlaceholder):
+		return strings.ReplaceAll(endpoint, TickersPlaceholder, strings.Join(offChainTickers, TickersSeparator)), nil
+	case strings.Contains(endpoint, TickerPlaceholder):
+		if len(tickers) != 1 {
+			return "", fmt.Errorf("expected 1 ticker, got %d", len(tickers))
+		}
+
+		return strings.ReplaceAll(endpoint, TickerPlaceholder, offChainTickers[0]), nil
+	default:
+		return endpoint, nil
+	}
+}
+
+// ParseResponse parses the response from the JSON API and returns a GetResponse. Each of the
+// tickers supplied will get a response or an error.
+func (h *APIHandler) ParseResponse(
+	tickers []types.ProviderTicker,
+	resp *http.Response,
+) types.PriceResponse {
+	decoder := json.NewDecoder(resp.Body)
+	decoder.UseNumber()
+
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return types.NewPriceResponseWithErr(
+			tickers,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+	)
+
+	for _, ticker := range tickers {
+		cfg, err := TickerConfigFromTicker(ticker)
+		if err != nil {
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorTickerMetadataNotFound),
+			}
+			continue
+		}
+
+		price, err := cfg.Price(doc, ticker.GetOffChainTicker())
+		if err != nil {
+			wErr := fmt.Errorf("failed to extract price for %s: %w", ticker, err)
+			unresolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+			}
+			continue
+		}
+
+		resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	}
+
+	return types.NewPriceResponse(resolved, unresolved)
+}
@@ -0,0 +1,277 @@
+package jsonapi_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/providers/apis/jsonapi"
+	"github.com/1119-Labs/slinky/providers/base/testutils"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+var (
+	btcusd = types.DefaultProviderTicker{
+		OffChainTicker: "BTC/USD",
+		JSON:           `{"price_path":"[symbol={ticker}].price"}`,
+	}
+	ethusd = types.DefaultProviderTicker{
+		OffChainTicker: "ETH/USD",
+		JSON:           `{"price_path":"[symbol={ticker}].price","price_scale":"0.01"}`,
+	}
+	usdbtc = types.DefaultProviderTicker{
+		OffChainTicker: "BTC/USD",
+		JSON:           `{"price_path":"[symbol={ticker}].price","invert":true}`,
+	}
+	nometadata = types.DefaultProviderTicker{
+		OffChainTicker: "ATOM/USD",
+	}
+
+	apiConfig = config.APIConfig{
+		Name:             "json_api_internal",
+		Atomic:           true,
+		Enabled:          true,
+		Timeout:          time.Second,
+		Interval:         time.Second,
+		ReconnectTimeout: time.Second,
+		MaxQueries:       1,
+		Endpoints:        []config.Endpoint{{URL: "https://prices.internal/v1/prices?symbols={tickers}"}},
+	}
+)
+
+func TestNewAPIHandler(t *testing.T) {
+	testCases := []struct {
+		name   string
+		modify func(cfg *config.APIConfig)
+		err    bool
+	}{
+		{
+			name:   "valid config",
+			modify: func(*config.APIConfig) {},
+		},
+		{
+			name: "valid config with the base name",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Name = jsonapi.BaseName
+			},
+		},
+		{
+			name: "valid config with a ticker placeholder",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Atomic = false
+				cfg.Endpoints[0].URL = "https://prices.internal/v1/prices/{ticker}"
+			},
+		},
+		{
+			name: "invalid name",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Name = "jsonapi"
+			},
+			err: true,
+		},
+		{
+			name: "atomic config with a ticker placeholder",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Endpoints[0].URL = "https://prices.internal/v1/prices/{ticker}"
+			},
+			err: true,
+		},
+		{
+			name: "batched config with a ticker placeholder",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Atomic = false
+				cfg.BatchSize = 2
+				cfg.Endpoints[0].URL = "https://prices.internal/v1/prices/{ticker}"
+			},
+			err: true,
+		},
+		{
+			name: "both placeholders",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Atomic = false
+				cfg.Endpoints[0].URL = "https://prices.internal/v1/{ticker}?symbols={tickers}"
+			},
+			err: true,
+		},
+		{
+			name: "disabled config",
+			modify: func(cfg *config.APIConfig) {
+				cfg.Enabled = false
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := apiConfig
+			cfg.Endpoints = []config.Endpoint{apiConfig.Endpoints[0]}
+			tc.modify(&cfg)
+
+			_, err := jsonapi.NewAPIHandler(cfg)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateURL(t *testing.T) {
+	testCases := []struct {
+		name    string
+		url     string
+		atomic  bool
+		tickers []types.ProviderTicker
+		url2    string
+		err     bool
+	}{
+		{
+			name:    "tickers placeholder",
+			url:     "https://prices.internal/v1/prices?symbols={tickers}",
+			atomic:  true,
+			tickers: []types.ProviderTicker{btcusd, ethusd},
+			url2:    "https://prices.internal/v1/prices?symbols=BTC%2FUSD,ETH%2FUSD",
+		},
+		{
+			name:    "ticker placeholder",
+			url:     "https://prices.internal/v1/prices/{ticker}",
+			tickers: []types.ProviderTicker{btcusd},
+			url2:    "https://prices.internal/v1/prices/BTC%2FUSD",
+		},
+		{
+			name:    "ticker placeholder with several tickers",
+			url:     "https://prices.internal/v1/prices/{ticker}",
+			tickers: []types.ProviderTicker{btcusd, ethusd},
+			err:     true,
+		},
+		{
+			name:    "no placeholder",
+			url:     "https://prices.internal/v1/prices",
+			atomic:  true,
+			tickers: []types.ProviderTicker{btcusd, ethusd},
+			url2:    "https://prices.internal/v1/prices",
+		},
+		{
+			name:    "no tickers",
+			url:     "https://prices.internal/v1/prices",
+			atomic:  true,
+			tickers: []types.ProviderTicker{},
+			err:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := apiConfig
+			cfg.Atomic = tc.atomic
+			cfg.Endpoints = []config.Endpoint{{URL: tc.url}}
+
+			h, err := jsonapi.NewAPIHandler(cfg)
+			require.NoError(t, err)
+
+			url, err := h.CreateURL(tc.tickers)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.url2, url)
+		})
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tickers  []types.ProviderTicker
+		response string
+		expected types.PriceResponse
+	}{
+		{
+			name:     "valid response",
+			tickers:  []types.ProviderTicker{btcusd, ethusd},
+			response: `[{"symbol": "BTC/USD", "price": "100000.5"}, {"symbol": "ETH/USD", "price": 300000}]`,
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {Value: big.NewFloat(100000.5)},
+					ethusd: {Value: big.NewFloat(3000)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name:     "inverted price",
+			tickers:  []types.ProviderTicker{usdbtc},
+			response: `[{"symbol": "BTC/USD", "price": "4"}]`,
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					usdbtc: {Value: big.NewFloat(0.25)},
+				},
+				types.UnResolvedPrices{},
+			),
+		},
+		{
+			name:     "missing price and metadata",
+			tickers:  []types.ProviderTicker{btcusd, ethusd, nometadata},
+			response: `[{"symbol": "BTC/USD", "price": "100000.5"}, {"symbol": "ETH/USD", "price": null}]`,
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{
+					btcusd: {Value: big.NewFloat(100000.5)},
+				},
+				types.UnResolvedPrices{
+					ethusd: {
+						ErrorWithCode: providertypes.NewErrorWithCode(nil, providertypes.ErrorFailedToParsePrice),
+					},
+					nometadata: {
+						ErrorWithCode: providertypes.NewErrorWithCode(nil, providertypes.ErrorTickerMetadataNotFound),
+					},
+				},
+			),
+		},
+		{
+			name:     "invalid response",
+			tickers:  []types.ProviderTicker{btcusd},
+			response: `not json`,
+			expected: types.NewPriceResponse(
+				types.ResolvedPrices{},
+				types.UnResolvedPrices{
+					btcusd: {
+						ErrorWithCode: providertypes.NewErrorWithCode(nil, providertypes.ErrorFailedToDecode),
+					},
+				},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h, err := jsonapi.NewAPIHandler(apiConfig)
+			require.NoError(t, err)
+
+			_, err = h.CreateURL(tc.tickers)
+			require.NoError(t, err)
+
+			resp := h.ParseResponse(tc.tickers, testutils.CreateResponseFromJSON(tc.response))
+
+			require.Len(t, resp.Resolved, len(tc.expected.Resolved))
+			require.Len(t, resp.UnResolved, len(tc.expected.UnResolved))
+
+			for ticker, result := range tc.expected.Resolved {
+				require.Contains(t, resp.Resolved, ticker)
+				require.Zero(t, result.Value.Cmp(resp.Resolved[ticker].Value), "expected %s, got %s", result.Value, resp.Resolved[ticker].Value)
+			}
+
+			for ticker, result := range tc.expected.UnResolved {
+				require.Contains(t, resp.UnResolved, ticker)
+				require.Equal(t, result.Code(), resp.UnResolved[ticker].Code())
+			}
+		})
+	}
+}
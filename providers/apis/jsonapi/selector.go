@@ -0,0 +1,176 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Selector selects a value from a decoded JSON document. Selectors are written in a JSONPath-style
+// syntax of dot separated object keys, each optionally followed by any number of array selectors:
+//
+//   - `[n]` selects the n-th element of an array.
+//   - `[field=value]` selects the first element of an array of objects whose field equals value.
+//
+// The placeholder `{ticker}` may be used in any key or value, and is replaced by the off-chain
+// ticker when the selector is evaluated. For example, `data[symbol={ticker}].price` selects the
+// price of the element for the ticker in `{"data": [{"symbol": "BTCUSD", "price": "1"}]}`, and
+// `{ticker}.usd` selects the price in `{"BTCUSD": {"usd": 1}}`.
+type Selector struct {
+	steps []step
+}
+
+// step is a single step of a selector, i.e. an object key or an array selector.
+type step struct {
+	// key is the object key that is selected, if the step is not an array selector.
+	key string
+
+	// index is the index of the array element that is selected, if the step is an index selector.
+	index int
+
+	// field and value are the field and value that the array element must match, if the step is
+	// a filter selector.
+	field string
+	value string
+
+	kind stepKind
+}
+
+type stepKind int
+
+const (
+	keyStep stepKind = iota
+	indexStep
+	filterStep
+)
+
+// NewSelector parses the given selector.
+func NewSelector(selector string) (Selector, error) {
+	if len(selector) == 0 {
+		return Selector{}, fmt.Errorf("selector cannot be empty")
+	}
+
+	var steps []step
+	for _, segment := range strings.Split(selector, ".") {
+		key, rest, _ := strings.Cut(segment, "[")
+		if len(rest) > 0 {
+			rest = "[" + rest
+		}
+
+		if len(key) > 0 {
+			steps = append(steps, step{kind: keyStep, key: key})
+		} else if len(rest) == 0 {
+			return Selector{}, fmt.Errorf("invalid selector %s: empty key", selector)
+		}
+
+		for len(rest) > 0 {
+			end := strings.Index(rest, "]")
+			if rest[0] != '[' || end < 0 {
+				return Selector{}, fmt.Errorf("invalid selector %s: unterminated array selector", selector)
+			}
+
+			s, err := parseArrayStep(rest[1:end])
+			if err != nil {
+				return Selector{}, fmt.Errorf("invalid selector %s: %w", selector, err)
+			}
+
+			steps = append(steps, s)
+			rest = rest[end+1:]
+		}
+	}
+
+	return Selector{steps: steps}, nil
+}
+
+func parseArrayStep(s string) (step, error) {
+	if field, value, ok := strings.Cut(s, "="); ok {
+		if len(field) == 0 {
+			return step{}, fmt.Errorf("empty filter field")
+		}
+
+		return step{kind: filterStep, field: field, value: value}, nil
+	}
+
+	index, err := strconv.Atoi(s)
+	if err != nil || index < 0 {
+		return step{}, fmt.Errorf("invalid array index %s", s)
+	}
+
+	return step{kind: indexStep, index: index}, nil
+}
+
+// Select returns the value selected from the given document, which must have been decoded with
+// json.Decoder.UseNumber, for the given off-chain ticker.
+func (s Selector) Select(doc any, ticker string) (any, error) {
+	current := doc
+	for _, st := range s.steps {
+		switch st.kind {
+		case keyStep:
+			obj, ok := current.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("expected object at %s", st.key)
+			}
+
+			key := replaceTicker(st.key, ticker)
+			if current, ok = obj[key]; !ok {
+				return nil, fmt.Errorf("key %s not found", key)
+			}
+		case indexStep:
+			arr, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected array at index %d", st.index)
+			}
+
+			if st.index >= len(arr) {
+				return nil, fmt.Errorf("index %d out of range", st.index)
+			}
+			current = arr[st.index]
+		case filterStep:
+			arr, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Errorf("expected array at filter %s=%s", st.field, st.value)
+			}
+
+			field, value := replaceTicker(st.field, ticker), replaceTicker(st.value, ticker)
+			found := false
+			for _, elem := range arr {
+				obj, ok := elem.(map[string]any)
+				if !ok {
+					continue
+				}
+
+				if v, ok := obj[field]; ok && stringify(v) == value {
+					current = elem
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return nil, fmt.Errorf("no element found with %s=%s", field, value)
+			}
+		}
+	}
+
+	return current, nil
+}
+
+func replaceTicker(s, ticker string) string {
+	return strings.ReplaceAll(s, TickerPlaceholder, ticker)
+}
+
+// stringify returns the string representation of a JSON scalar, so that it can be compared with
+// the value of a filter.
+func stringify(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
@@ -0,0 +1,146 @@
+package jsonapi_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/providers/apis/jsonapi"
+)
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var doc any
+	require.NoError(t, decoder.Decode(&doc))
+	return doc
+}
+
+func TestNewSelector(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector string
+		err      bool
+	}{
+		{name: "key", selector: "price"},
+		{name: "nested keys", selector: "data.{ticker}.price"},
+		{name: "index", selector: "data[0].price"},
+		{name: "nested indexes", selector: "data[0][1]"},
+		{name: "top level index", selector: "[0].price"},
+		{name: "filter", selector: "data[symbol={ticker}].price"},
+		{name: "empty", selector: "", err: true},
+		{name: "empty key", selector: "data..price", err: true},
+		{name: "unterminated array selector", selector: "data[0", err: true},
+		{name: "negative index", selector: "data[-1]", err: true},
+		{name: "invalid index", selector: "data[a]", err: true},
+		{name: "empty filter field", selector: "data[=BTC]", err: true},
+		{name: "trailing characters after array selector", selector: "data[0]x", err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := jsonapi.NewSelector(tc.selector)
+			if tc.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSelectorSelect(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector string
+		doc      string
+		ticker   string
+		expected any
+		err      bool
+	}{
+		{
+			name:     "nested keys with a ticker",
+			selector: "data.{ticker}.price",
+			doc:      `{"data": {"BTC.USD": {"price": "100"}}}`,
+			ticker:   "BTC.USD",
+			expected: "100",
+		},
+		{
+			name:     "index",
+			selector: "result[1]",
+			doc:      `{"result": [1, 2.5]}`,
+			expected: json.Number("2.5"),
+		},
+		{
+			name:     "top level array",
+			selector: "[0].price",
+			doc:      `[{"price": 3}]`,
+			expected: json.Number("3"),
+		},
+		{
+			name:     "filter by ticker",
+			selector: "[symbol={ticker}].price",
+			doc:      `[{"symbol": "ETHUSD", "price": "1"}, {"symbol": "BTCUSD", "price": "2"}]`,
+			ticker:   "BTCUSD",
+			expected: "2",
+		},
+		{
+			name:     "filter by number",
+			selector: "data[id=2].price",
+			doc:      `{"data": [{"id": 1, "price": "1"}, {"id": 2, "price": "2"}]}`,
+			expected: "2",
+		},
+		{
+			name:     "missing key",
+			selector: "data.price",
+			doc:      `{"data": {}}`,
+			err:      true,
+		},
+		{
+			name:     "index out of range",
+			selector: "data[2]",
+			doc:      `{"data": [1]}`,
+			err:      true,
+		},
+		{
+			name:     "no element matches the filter",
+			selector: "[symbol={ticker}].price",
+			doc:      `[{"symbol": "ETHUSD", "price": "1"}]`,
+			ticker:   "BTCUSD",
+			err:      true,
+		},
+		{
+			name:     "key on an array",
+			selector: "data.price",
+			doc:      `{"data": [1]}`,
+			err:      true,
+		},
+		{
+			name:     "index on an object",
+			selector: "data[0]",
+			doc:      `{"data": {"0": 1}}`,
+			err:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selector, err := jsonapi.NewSelector(tc.selector)
+			require.NoError(t, err)
+
+			value, err := selector.Select(decode(t, tc.doc), tc.ticker)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, value)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/pkg/math"
+)
+
+const (
+	// BaseName is the base name of the generic JSON API provider. Any number of generic JSON API
+	// providers can be configured, each named either BaseName or `BaseName“NameSeparator“suffix`,
+	// e.g. `json_api_internal`.
+	BaseName = "json_api"
+
+	// NameSeparator is the separator between the base name and the suffix of a provider name.
+	NameSeparator = "_"
+
+	// TickerPlaceholder is the placeholder for the off-chain ticker in the endpoint URL and in
+	// price selectors. An endpoint URL with this placeholder is queried once per ticker, so the
+	// provider must be configured with a batch size of at most 1.
+	TickerPlaceholder = "{ticker}"
+
+	// TickersPlaceholder is the placeholder for the TickersSeparator separated list of off-chain
+	// tickers in the endpoint URL. This is used for endpoints that return prices for several
+	// tickers at once.
+	TickersPlaceholder = "{tickers}"
+
+	// TickersSeparator is the separator between the off-chain tickers in the TickersPlaceholder.
+	TickersSeparator = ","
+)
+
+// IsValidProviderName returns true if the given name is the name of a generic JSON API provider.
+func IsValidProviderName(name string) bool {
+	return name == BaseName || strings.HasPrefix(name, BaseName+NameSeparator)
+}
+
+// TickerConfig is the configuration used to extract the price of a ticker from the response of a
+// generic JSON API provider. It is set in the ProviderConfig.Metadata_JSON of the ticker.
+type TickerConfig struct {
+	// PricePath is the selector of the price in the response. See Selector for the syntax. The
+	// price may either be a JSON number or a decimal string.
+	PricePath string `json:"price_path"`
+
+	// PriceScale is a decimal string that the selected price is multiplied by, e.g. "0.00000001"
+	// for prices with 8 implied decimals. If empty, the price is not scaled.
+	PriceScale string `json:"price_scale,omitempty"`
+
+	// Invert is true if the (scaled) price should be inverted, i.e. if the API returns the price
+	// of the quote in units of the base.
+	Invert bool `json:"invert,omitempty"`
+}
+
+// TickerConfigFromTicker returns the ticker config in the JSON metadata of the given provider
+// ticker.
+func TickerConfigFromTicker(ticker types.ProviderTicker) (TickerConfig, error) {
+	var cfg TickerConfig
+	if err := json.Unmarshal([]byte(ticker.GetJSON()), &cfg); err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal ticker config for %s: %w", ticker, err)
+	}
+
+	return cfg, cfg.ValidateBasic()
+}
+
+// ValidateBasic performs basic validation of the ticker config.
+func (c TickerConfig) ValidateBasic() error {
+	if _, err := NewSelector(c.PricePath); err != nil {
+		return fmt.Errorf("invalid price path: %w", err)
+	}
+
+	if _, err := c.scale(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Price returns the price of the ticker from the given decoded response.
+func (c TickerConfig) Price(doc any, offChainTicker string) (*big.Float, error) {
+	selector, err := NewSelector(c.PricePath)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := selector.Select(doc, offChainTicker)
+	if err != nil {
+		return nil, err
+	}
+
+	var price *big.Float
+	switch v := value.(type) {
+	case json.Number:
+		price, err = math.Float64StringToBigFloat(v.String())
+	case string:
+		price, err = math.Float64StringToBigFloat(v)
+	default:
+		return nil, fmt.Errorf("expected a number or a string at %s, got %T", c.PricePath, value)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	scale, err := c.scale()
+	if err != nil {
+		return nil, err
+	}
+	if scale != nil {
+		price.Mul(price, scale)
+	}
+
+	if c.Invert {
+		if price.Sign() == 0 {
+			return nil, fmt.Errorf("cannot invert a price of zero")
+		}
+		price.Quo(big.NewFloat(1), price)
+	}
+
+	return price, nil
+}
+
+func (c TickerConfig) scale() (*big.Float, error) {
+	if len(c.PriceScale) == 0 {
+		return nil, nil
+	}
+
+	scale, err := math.Float64StringToBigFloat(c.PriceScale)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price scale: %w", err)
+	}
+
+	if scale.Sign() <= 0 {
+		return nil, fmt.Errorf("price scale must be positive")
+	}
+
+	return scale, nil
+}
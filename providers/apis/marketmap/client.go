@@ -29,8 +29,8 @@ func NewGRPCClient(
 	api config.APIConfig,
 	apiMetrics metrics.APIMetrics,
 ) (mmtypes.QueryClient, error) {
-	if api.Name != Name {
-		return nil, fmt.Errorf("invalid api name; expected %s, got %s", Name, api.Name)
+	if api.Name != Name && api.Name != IncrementalName {
+		return nil, fmt.Errorf("invalid api name; expected %s or %s, got %s", Name, IncrementalName, api.Name)
 	}
 
 	// TODO: Do we want to ignore proxy settings?
@@ -98,3 +98,24 @@ func (c *MarketMapClient) MarketMap(
 	c.apiMetrics.AddRPCStatusCode(c.api.Name, metrics.RedactedURL, metrics.RPCCodeOK)
 	return
 }
+
+// MarketMapUpdates wraps the MarketMapClient's query with additional metrics.
+func (c *MarketMapClient) MarketMapUpdates(
+	ctx context.Context,
+	req *mmtypes.MarketMapUpdatesRequest,
+	_ ...grpc.CallOption,
+) (resp *mmtypes.MarketMapUpdatesResponse, err error) {
+	start := time.Now()
+	defer func() {
+		c.apiMetrics.ObserveProviderResponseLatency(c.api.Name, metrics.RedactedURL, time.Since(start))
+	}()
+
+	resp, err = c.QueryClient.MarketMapUpdates(ctx, req)
+	if err != nil {
+		c.apiMetrics.AddRPCStatusCode(c.api.Name, metrics.RedactedURL, metrics.RPCCodeError)
+		return
+	}
+
+	c.apiMetrics.AddRPCStatusCode(c.api.Name, metrics.RedactedURL, metrics.RPCCodeOK)
+	return
+}
@@ -0,0 +1,237 @@
+package marketmap
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"sync"
+	"time"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/providers/base/api/metrics"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+	"github.com/1119-Labs/slinky/service/clients/marketmap/types"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// IncrementalMarketMapFetcher is the incremental x/marketmap fetcher. Instead of querying the full
+// market map on every fetch, this fetcher keeps a copy of the market map and only queries the markets
+// that were created, updated or removed since its last fetch. It falls back to querying the full market
+// map when the x/marketmap module cannot provide a complete set of changes, e.g. on its first fetch.
+type IncrementalMarketMapFetcher struct { //nolint
+	mtx    sync.Mutex
+	logger *zap.Logger
+
+	// client is the QueryClient implementation. This is used to interact with the x/marketmap
+	// module.
+	client mmtypes.QueryClient
+
+	// synced is true if markets is a copy of the market map as of height.
+	synced bool
+	// markets is the fetcher's copy of the market map.
+	markets map[string]mmtypes.Market
+	// height is the block height that markets is synced to.
+	height uint64
+	// chainID is the chain identifier of the market map.
+	chainID string
+}
+
+// NewIncrementalMarketMapFetcher returns a new incremental MarketMap fetcher with the standard grpc client.
+func NewIncrementalMarketMapFetcher(
+	logger *zap.Logger,
+	api config.APIConfig,
+	metrics metrics.APIMetrics,
+) (*IncrementalMarketMapFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if err := api.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid api config: %w", err)
+	}
+
+	if api.Name != IncrementalName {
+		return nil, fmt.Errorf("invalid api name; expected %s, got %s", IncrementalName, api.Name)
+	}
+
+	if !api.Enabled {
+		return nil, fmt.Errorf("api is not enabled")
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics is required")
+	}
+
+	client, err := NewGRPCClient(api, metrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IncrementalMarketMapFetcher{
+		logger: logger.With(zap.String("fetcher", IncrementalName)),
+		client: client,
+	}, nil
+}
+
+// NewIncrementalMarketMapFetcherWithClient returns a new incremental MarketMap fetcher.
+func NewIncrementalMarketMapFetcherWithClient(
+	logger *zap.Logger,
+	client mmtypes.QueryClient,
+) (*IncrementalMarketMapFetcher, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("logger is required")
+	}
+
+	if client == nil {
+		return nil, fmt.Errorf("client is required")
+	}
+
+	return &IncrementalMarketMapFetcher{
+		logger: logger.With(zap.String("fetcher", IncrementalName)),
+		client: client,
+	}, nil
+}
+
+// Fetch returns the latest market map data from the x/marketmap module. It expects only a single
+// chain ID since the current implementation assumes a single connection to one chain.
+func (f *IncrementalMarketMapFetcher) Fetch(
+	ctx context.Context,
+	chains []types.Chain,
+) types.MarketMapResponse {
+	if len(chains) != 1 {
+		f.logger.Info("expected one chain, got multiple chains", zap.Any("chains", chains))
+		return types.NewMarketMapResponseWithErr(
+			chains,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("expected one chain, got %d", len(chains)),
+				providertypes.ErrorInvalidAPIChains,
+			),
+		)
+	}
+
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	// Query the x/marketmap module for the markets that changed since the last fetch.
+	updates, err := f.client.MarketMapUpdates(ctx, &mmtypes.MarketMapUpdatesRequest{SinceHeight: f.height})
+	switch {
+	case err != nil:
+		// The node may not support the query, so the full market map is fetched without a height to
+		// sync from.
+		f.logger.Warn("failed to query market map updates on node; falling back to a full sync", zap.Error(err))
+		f.synced = false
+		return f.fullSync(ctx, chains, nil)
+	case updates == nil:
+		f.logger.Info("nil response from market map updates query")
+		return types.NewMarketMapResponseWithErr(
+			chains,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("nil response from market map updates query"),
+				providertypes.ErrorGRPCGeneral,
+			),
+		)
+	case !f.synced || updates.FullSyncRequired || updates.ChainId != f.chainID:
+		f.logger.Info(
+			"market map updates cannot be applied; performing a full sync",
+			zap.Bool("synced", f.synced),
+			zap.Uint64("since_height", f.height),
+			zap.Bool("full_sync_required", updates.FullSyncRequired),
+		)
+		return f.fullSync(ctx, chains, updates)
+	}
+
+	for _, market := range updates.Markets {
+		f.markets[market.Ticker.String()] = market
+	}
+
+	for _, ticker := range updates.RemovedTickers {
+		delete(f.markets, ticker)
+	}
+
+	f.height = updates.Height
+
+	f.logger.Info(
+		"successfully applied market map updates from module; checking if market map has changed",
+		zap.Int("updated", len(updates.Markets)),
+		zap.Int("removed", len(updates.RemovedTickers)),
+		zap.Uint64("height", f.height),
+	)
+	return f.response(chains, updates.LastUpdated)
+}
+
+// fullSync replaces the fetcher's copy of the market map with the full market map queried from the
+// x/marketmap module. If updates is non-nil, the copy is synced to its height: the full market map is
+// queried at the same height as the updates, so that both queries read the same state even if blocks
+// are committed, or the queries are served by different nodes, in between.
+func (f *IncrementalMarketMapFetcher) fullSync(
+	ctx context.Context,
+	chains []types.Chain,
+	updates *mmtypes.MarketMapUpdatesResponse,
+) types.MarketMapResponse {
+	if updates != nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatUint(updates.Height, 10))
+	}
+
+	resp, err := f.client.MarketMap(ctx, &mmtypes.MarketMapRequest{})
+	if err != nil {
+		f.logger.Error("failed to query market map module on node", zap.Error(err))
+		f.synced = false
+		return types.NewMarketMapResponseWithErr(
+			chains,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("failed to query market map: %w", err),
+				providertypes.ErrorGRPCGeneral,
+			),
+		)
+	}
+
+	if resp == nil {
+		f.logger.Info("nil response from market map module query")
+		f.synced = false
+		return types.NewMarketMapResponseWithErr(
+			chains,
+			providertypes.NewErrorWithCode(
+				fmt.Errorf("nil response from market map query"),
+				providertypes.ErrorGRPCGeneral,
+			),
+		)
+	}
+
+	f.markets = maps.Clone(resp.MarketMap.Markets)
+	if f.markets == nil {
+		f.markets = make(map[string]mmtypes.Market)
+	}
+	f.chainID = resp.ChainId
+
+	f.synced = updates != nil && updates.ChainId == resp.ChainId
+	f.height = 0
+	if f.synced {
+		f.height = updates.Height
+	}
+
+	f.logger.Info(
+		"successfully fetched full market map data from module; checking if market map has changed",
+		zap.Bool("synced", f.synced),
+		zap.Uint64("height", f.height),
+	)
+	return f.response(chains, resp.LastUpdated)
+}
+
+// response returns a copy of the fetcher's market map as the resolved market map of the chain.
+func (f *IncrementalMarketMapFetcher) response(chains []types.Chain, lastUpdated uint64) types.MarketMapResponse {
+	resolved := make(types.ResolvedMarketMap)
+	resolved[chains[0]] = types.NewMarketMapResult(&mmtypes.MarketMapResponse{
+		MarketMap: mmtypes.MarketMap{
+			Markets: maps.Clone(f.markets),
+		},
+		LastUpdated: lastUpdated,
+		ChainId:     f.chainID,
+	}, time.Now())
+
+	return types.NewMarketMapResponse(resolved, nil)
+}
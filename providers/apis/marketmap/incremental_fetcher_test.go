@@ -0,0 +1,208 @@
+package marketmap_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/providers/apis/coinbase"
+	"github.com/1119-Labs/slinky/providers/apis/marketmap"
+	"github.com/1119-Labs/slinky/service/clients/marketmap/types"
+	mmtypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/marketmap/types/mocks"
+)
+
+var ethusdMarket = mmtypes.Market{
+	Ticker: mmtypes.Ticker{
+		CurrencyPair:     slinkytypes.NewCurrencyPair("ETH", "USD"),
+		Decimals:         11,
+		MinProviderCount: 1,
+	},
+	ProviderConfigs: []mmtypes.ProviderConfig{
+		{
+			Name:           coinbase.Name,
+			OffChainTicker: "ETH-USD",
+		},
+	},
+}
+
+// atHeight matches a context whose outgoing gRPC metadata pins the query to the given block height,
+// or that does not pin the query to any height if height is empty.
+func atHeight(height string) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		heights := md.Get(grpctypes.GRPCBlockHeightHeader)
+		if height == "" {
+			return len(heights) == 0
+		}
+
+		return len(heights) == 1 && heights[0] == height
+	})
+}
+
+func TestIncrementalFetch(t *testing.T) {
+	chain := chains[0]
+
+	t.Run("errors when too many chains are inputted", func(t *testing.T) {
+		fetcher, err := marketmap.NewIncrementalMarketMapFetcherWithClient(logger, mocks.NewQueryClient(t))
+		require.NoError(t, err)
+
+		resp := fetcher.Fetch(context.TODO(), chains)
+		require.Empty(t, resp.Resolved)
+		require.Len(t, resp.UnResolved, 2)
+	})
+
+	t.Run("applies updates to the synced market map", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		fetcher, err := marketmap.NewIncrementalMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		// the first fetch performs a full sync at the height of the updates
+		c.On("MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 0}).Return(
+			&mmtypes.MarketMapUpdatesResponse{Height: 10, LastUpdated: 9, ChainId: chain.ChainID, FullSyncRequired: true},
+			nil,
+		).Once()
+		c.On("MarketMap", atHeight("10"), mock.Anything).Return(
+			&mmtypes.MarketMapResponse{MarketMap: goodMarketMap, LastUpdated: 9, ChainId: chain.ChainID},
+			nil,
+		).Once()
+
+		resp := fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Contains(t, resp.Resolved, chain)
+		require.Equal(t, &mmtypes.MarketMapResponse{
+			MarketMap:   goodMarketMap,
+			LastUpdated: 9,
+			ChainId:     chain.ChainID,
+		}, resp.Resolved[chain].Value)
+
+		// the second fetch only queries the changes since the full sync
+		c.On("MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 10}).Return(
+			&mmtypes.MarketMapUpdatesResponse{
+				Markets:        []mmtypes.Market{ethusdMarket},
+				RemovedTickers: []string{btcusd.String()},
+				Height:         12,
+				LastUpdated:    11,
+				ChainId:        chain.ChainID,
+			},
+			nil,
+		).Once()
+
+		resp = fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Contains(t, resp.Resolved, chain)
+		require.Equal(t, &mmtypes.MarketMapResponse{
+			MarketMap: mmtypes.MarketMap{
+				Markets: map[string]mmtypes.Market{
+					ethusdMarket.Ticker.String(): ethusdMarket,
+				},
+			},
+			LastUpdated: 11,
+			ChainId:     chain.ChainID,
+		}, resp.Resolved[chain].Value)
+
+		// the previously returned market map is not modified by later updates
+		c.On("MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 12}).Return(
+			&mmtypes.MarketMapUpdatesResponse{
+				RemovedTickers: []string{ethusdMarket.Ticker.String()},
+				Height:         13,
+				LastUpdated:    13,
+				ChainId:        chain.ChainID,
+			},
+			nil,
+		).Once()
+
+		previous := resp.Resolved[chain].Value
+		resp = fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Contains(t, resp.Resolved, chain)
+		require.Empty(t, resp.Resolved[chain].Value.MarketMap.Markets)
+		require.Len(t, previous.MarketMap.Markets, 1)
+	})
+
+	t.Run("falls back to a full sync on gaps", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		fetcher, err := marketmap.NewIncrementalMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		c.On("MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 0}).Return(
+			&mmtypes.MarketMapUpdatesResponse{Height: 10, ChainId: chain.ChainID, FullSyncRequired: true},
+			nil,
+		).Once()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(
+			&mmtypes.MarketMapResponse{MarketMap: goodMarketMap, ChainId: chain.ChainID},
+			nil,
+		).Once()
+
+		resp := fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Contains(t, resp.Resolved, chain)
+
+		// the changes since height 10 are no longer tracked
+		c.On("MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 10}).Return(
+			&mmtypes.MarketMapUpdatesResponse{Height: 20, ChainId: chain.ChainID, FullSyncRequired: true},
+			nil,
+		).Once()
+		c.On("MarketMap", atHeight("20"), mock.Anything).Return(
+			&mmtypes.MarketMapResponse{MarketMap: badMarketMap, LastUpdated: 15, ChainId: chain.ChainID},
+			nil,
+		).Once()
+
+		resp = fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Contains(t, resp.Resolved, chain)
+		require.Equal(t, &mmtypes.MarketMapResponse{
+			MarketMap:   badMarketMap,
+			LastUpdated: 15,
+			ChainId:     chain.ChainID,
+		}, resp.Resolved[chain].Value)
+
+		// the next fetch continues from the height of the full sync
+		c.On("MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 20}).Return(
+			&mmtypes.MarketMapUpdatesResponse{Height: 21, LastUpdated: 15, ChainId: chain.ChainID},
+			nil,
+		).Once()
+
+		resp = fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Contains(t, resp.Resolved, chain)
+		require.Equal(t, badMarketMap, resp.Resolved[chain].Value.MarketMap)
+	})
+
+	t.Run("falls back to a full sync when updates cannot be queried", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		fetcher, err := marketmap.NewIncrementalMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		c.On("MarketMapUpdates", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("unknown query")).Twice()
+		c.On("MarketMap", atHeight(""), mock.Anything).Return(
+			&mmtypes.MarketMapResponse{MarketMap: goodMarketMap, ChainId: chain.ChainID},
+			nil,
+		).Twice()
+
+		// every fetch is a full sync of the latest market map since no height is known to sync from
+		for i := 0; i < 2; i++ {
+			resp := fetcher.Fetch(context.TODO(), []types.Chain{chain})
+			require.Contains(t, resp.Resolved, chain)
+			require.Equal(t, goodMarketMap, resp.Resolved[chain].Value.MarketMap)
+		}
+
+		c.AssertCalled(t, "MarketMapUpdates", mock.Anything, &mmtypes.MarketMapUpdatesRequest{SinceHeight: 0})
+	})
+
+	t.Run("errors when the full sync fails", func(t *testing.T) {
+		c := mocks.NewQueryClient(t)
+		fetcher, err := marketmap.NewIncrementalMarketMapFetcherWithClient(logger, c)
+		require.NoError(t, err)
+
+		c.On("MarketMapUpdates", mock.Anything, mock.Anything).Return(
+			&mmtypes.MarketMapUpdatesResponse{Height: 10, ChainId: chain.ChainID, FullSyncRequired: true},
+			nil,
+		).Once()
+		c.On("MarketMap", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("could not make request")).Once()
+
+		resp := fetcher.Fetch(context.TODO(), []types.Chain{chain})
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, chain)
+	})
+}
@@ -9,6 +9,10 @@ import (
 const (
 	// Name is the name of the MarketMap provider.
 	Name = "marketmap_api"
+
+	// IncrementalName is the name of the MarketMap provider that only fetches the markets that
+	// changed since its last fetch, and applies them to its copy of the market map.
+	IncrementalName = "marketmap_incremental_api"
 )
 
 // DefaultAPIConfig returns the default configuration for the MarketMap API.
@@ -22,3 +26,15 @@ var DefaultAPIConfig = config.APIConfig{
 	MaxQueries:       1,
 	Endpoints:        []config.Endpoint{{URL: "localhost:9090"}},
 }
+
+// DefaultIncrementalAPIConfig returns the default configuration for the incremental MarketMap API.
+var DefaultIncrementalAPIConfig = config.APIConfig{
+	Name:             IncrementalName,
+	Atomic:           true,
+	Enabled:          true,
+	Timeout:          20 * time.Second,
+	Interval:         10 * time.Second,
+	ReconnectTimeout: 2000 * time.Millisecond,
+	MaxQueries:       1,
+	Endpoints:        []config.Endpoint{{URL: "localhost:9090"}},
+}
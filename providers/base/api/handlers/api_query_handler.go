@@ -31,6 +31,13 @@ type APIQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseValue]
 	)
 }
 
+// RetryDelayer is implemented by APIQueryHandlers that delay their queries after a rate limited or
+// failed request, so that the provider does not restart the handler before the delay has elapsed.
+type RetryDelayer interface {
+	// RetryDelay returns how long until the API can be queried again.
+	RetryDelay() time.Duration
+}
+
 // APIFetcher is an interface that encapsulates fetching data from a provider. This interface
 // is meant to abstract over the various processes of interacting w/ GRPC, JSON-RPC, REST, etc. APIs.
 //
@@ -58,6 +65,9 @@ type APIQueryHandlerImpl[K providertypes.ResponseKey, V providertypes.ResponseVa
 
 	// fetcher is responsible for fetching data from the API.
 	fetcher APIFetcher[K, V]
+
+	// rateLimiter is the rate limiter that the fetcher's requests are made through.
+	rateLimiter *RateLimiter
 }
 
 // NewAPIQueryHandler creates a new APIQueryHandler. It manages querying the data
@@ -75,14 +85,17 @@ func NewAPIQueryHandler[K providertypes.ResponseKey, V providertypes.ResponseVal
 	}
 
 	return &APIQueryHandlerImpl[K, V]{
-		logger:  logger.With(zap.String("api_query_handler", cfg.Name)),
-		config:  cfg,
-		metrics: metrics,
-		fetcher: fetcher,
+		logger:      logger.With(zap.String("api_query_handler", cfg.Name)),
+		config:      cfg,
+		metrics:     metrics,
+		fetcher:     fetcher,
+		rateLimiter: fetcher.RateLimiter(),
 	}, nil
 }
 
-// NewAPIQueryHandlerWithFetcher creates a new APIQueryHandler with a custom api fetcher.
+// NewAPIQueryHandlerWithFetcher creates a new APIQueryHandler with a custom api fetcher. Fetchers that
+// do not implement RateLimitedAPIFetcher are wrapped with a RateLimitedFetcher, so that the rate limit
+// configuration of the API applies to them as well.
 func NewAPIQueryHandlerWithFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue](
 	logger *zap.Logger,
 	cfg config.APIConfig,
@@ -109,14 +122,36 @@ func NewAPIQueryHandlerWithFetcher[K providertypes.ResponseKey, V providertypes.
 		return nil, fmt.Errorf("no fetcher specified for api query handler")
 	}
 
+	rateLimited, ok := fetcher.(RateLimitedAPIFetcher)
+	if !ok {
+		rateLimiter, err := NewRateLimiter(cfg.Name, cfg.RateLimit, metrics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create rate limiter: %w", err)
+		}
+
+		rateLimitedFetcher, err := NewRateLimitedFetcher(fetcher, rateLimiter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create rate limited fetcher: %w", err)
+		}
+
+		fetcher, rateLimited = rateLimitedFetcher, rateLimitedFetcher
+	}
+
 	return &APIQueryHandlerImpl[K, V]{
-		logger:  logger.With(zap.String("api_query_handler", cfg.Name)),
-		config:  cfg,
-		metrics: metrics,
-		fetcher: fetcher,
+		logger:      logger.With(zap.String("api_query_handler", cfg.Name)),
+		config:      cfg,
+		metrics:     metrics,
+		fetcher:     fetcher,
+		rateLimiter: rateLimited.RateLimiter(),
 	}, nil
 }
 
+// RetryDelay returns how long until the API can be queried again after a Retry-After or backoff
+// delay. Zero is returned if queries are not delayed.
+func (h *APIQueryHandlerImpl[K, V]) RetryDelay() time.Duration {
+	return h.rateLimiter.RetryDelay()
+}
+
 // Query is used to query the API data provider for the given IDs. This method blocks
 // until all responses have been sent to the response channel. Query will only
 // make N concurrent requests at a time, where N is the capacity of the response channel.
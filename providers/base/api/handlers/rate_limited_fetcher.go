@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/1119-Labs/slinky/providers/base/api/errors"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+// RateLimitedAPIFetcher is implemented by APIFetchers that keep their requests within the request
+// budget of the API themselves, e.g. the RestAPIFetcher. The APIQueryHandler wraps any other
+// APIFetcher with a RateLimitedFetcher.
+type RateLimitedAPIFetcher interface {
+	// RateLimiter returns the rate limiter that the fetcher's requests are made through.
+	RateLimiter() *RateLimiter
+}
+
+// RateLimitedFetcher keeps the requests of an APIFetcher that is not rate limited, such as one that
+// queries an RPC through a client, within the request budget of the API. Each Fetch is counted as a
+// single request, and is considered failed if none of the IDs are resolved, or if any of them are rate
+// limited.
+type RateLimitedFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue] struct {
+	// fetcher is the APIFetcher that is rate limited.
+	fetcher APIFetcher[K, V]
+
+	// rateLimiter is responsible for keeping the fetches within the request budget of the API, and
+	// backing off from failed fetches.
+	rateLimiter *RateLimiter
+}
+
+// NewRateLimitedFetcher returns a new RateLimitedFetcher that makes the fetches of the given fetcher
+// through the given rate limiter.
+func NewRateLimitedFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	fetcher APIFetcher[K, V],
+	rateLimiter *RateLimiter,
+) (*RateLimitedFetcher[K, V], error) {
+	if fetcher == nil {
+		return nil, fmt.Errorf("fetcher is nil")
+	}
+
+	if rateLimiter == nil {
+		return nil, fmt.Errorf("rate limiter is nil")
+	}
+
+	return &RateLimitedFetcher[K, V]{
+		fetcher:     fetcher,
+		rateLimiter: rateLimiter,
+	}, nil
+}
+
+// Fetch waits until the fetch fits within the rate limit of the API, and then fetches the given IDs.
+func (f *RateLimitedFetcher[K, V]) Fetch(
+	ctx context.Context,
+	ids []K,
+) providertypes.GetResponse[K, V] {
+	if err := f.rateLimiter.Wait(ctx); err != nil {
+		return providertypes.NewGetResponseWithErr[K, V](
+			ids,
+			providertypes.NewErrorWithCode(
+				errors.ErrRateLimit,
+				providertypes.ErrorRateLimitExceeded,
+			),
+		)
+	}
+
+	response := f.fetcher.Fetch(ctx, ids)
+	f.rateLimiter.RecordResult(fetchFailed(response))
+	return response
+}
+
+// RateLimiter returns the rate limiter that the fetches are made through.
+func (f *RateLimitedFetcher[K, V]) RateLimiter() *RateLimiter {
+	return f.rateLimiter
+}
+
+// fetchFailed returns true if none of the IDs of the response were resolved, or if any were rate
+// limited.
+func fetchFailed[K providertypes.ResponseKey, V providertypes.ResponseValue](
+	response providertypes.GetResponse[K, V],
+) bool {
+	if len(response.Resolved) == 0 && len(response.UnResolved) > 0 {
+		return true
+	}
+
+	for _, result := range response.UnResolved {
+		if result.Code() == providertypes.ErrorRateLimitExceeded {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/providers/base/api/metrics"
+)
+
+// MaxRetryAfter is the maximum amount of time that a Retry-After header returned by a provider
+// can delay requests for. This guards against misconfigured APIs stalling a provider indefinitely.
+const MaxRetryAfter = 5 * time.Minute
+
+// RateLimiter enforces the request budget of an API provider and backs off from failed requests.
+// A single RateLimiter is meant to be shared by all of the requests made by a provider, so that the
+// budget holds across concurrent queries.
+type RateLimiter struct {
+	mtx sync.Mutex
+
+	// name is the name of the provider.
+	name string
+
+	// config is the rate limit configuration of the provider.
+	config config.RateLimitConfig
+
+	// metrics is used to record the requests that are throttled.
+	metrics metrics.APIMetrics
+
+	// requests holds the times of the requests made within the budget window, oldest first.
+	requests []time.Time
+
+	// failures is the number of consecutive failed requests.
+	failures int
+
+	// blockedUntil is the time before which no requests can be made, and blockedBy is the
+	// reason why.
+	blockedUntil time.Time
+	blockedBy    metrics.ThrottleReason
+}
+
+// NewRateLimiter returns a new RateLimiter for the provider with the given name.
+func NewRateLimiter(
+	name string,
+	cfg config.RateLimitConfig,
+	metrics metrics.APIMetrics,
+) (*RateLimiter, error) {
+	if err := cfg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if metrics == nil {
+		return nil, fmt.Errorf("metrics is nil")
+	}
+
+	return &RateLimiter{
+		name:    name,
+		config:  cfg,
+		metrics: metrics,
+	}, nil
+}
+
+// Wait blocks until a request can be made within the request budget of the provider, and after any
+// Retry-After or backoff delay has elapsed. The request is counted against the budget once Wait
+// returns. An error is returned if the context is cancelled before the request can be made.
+func (r *RateLimiter) Wait(ctx context.Context) error {
+	throttled := false
+	for {
+		delay, reason := r.reserve(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		// Only record the first reason that a request is throttled for.
+		if !throttled {
+			r.metrics.AddThrottledRequest(r.name, reason)
+			throttled = true
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Record updates the backoff state of the rate limiter given the result of a request. Requests that
+// error, are rate limited, or return a 5XX status code are considered failures. A Retry-After header
+// on a failed response takes precedence over exponential backoff.
+func (r *RateLimiter) Record(resp *http.Response, err error) {
+	failed := err != nil || resp == nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	retryAfter, ok := parseRetryAfter(resp, time.Now())
+	r.record(failed, retryAfter, ok)
+}
+
+// RecordResult updates the backoff state of the rate limiter given whether a request failed. This is
+// used for requests that are not made over HTTP directly, e.g. by JSON-RPC or gRPC clients, and so
+// cannot carry a Retry-After header.
+func (r *RateLimiter) RecordResult(failed bool) {
+	r.record(failed, 0, false)
+}
+
+// RetryDelay returns how long until requests can be made again after a Retry-After or backoff delay.
+// Zero is returned if requests are not delayed.
+func (r *RateLimiter) RetryDelay() time.Duration {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return max(time.Until(r.blockedUntil), 0)
+}
+
+// record updates the backoff state of the rate limiter given whether a request failed, and the delay
+// requested by its Retry-After header, if any.
+func (r *RateLimiter) record(failed bool, retryAfter time.Duration, hasRetryAfter bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if !failed {
+		r.failures = 0
+		return
+	}
+
+	now := time.Now()
+	r.failures++
+	if hasRetryAfter {
+		r.block(now.Add(retryAfter), metrics.ThrottleRetryAfter)
+		return
+	}
+
+	if r.config.InitialBackoff > 0 {
+		r.block(now.Add(r.backoff()), metrics.ThrottleBackoff)
+	}
+}
+
+// reserve counts a request made at the given time against the budget, if it fits. Otherwise, it
+// returns how long until the request should be retried, along with the reason.
+func (r *RateLimiter) reserve(now time.Time) (time.Duration, metrics.ThrottleReason) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if now.Before(r.blockedUntil) {
+		return r.blockedUntil.Sub(now), r.blockedBy
+	}
+
+	if r.config.RequestsPerSecond == 0 && r.config.RequestsPerMinute == 0 {
+		return 0, ""
+	}
+
+	// Drop the requests that have fallen out of the budget window.
+	window := time.Second
+	if r.config.RequestsPerMinute > 0 {
+		window = time.Minute
+	}
+
+	expired := 0
+	for expired < len(r.requests) && !now.Before(r.requests[expired].Add(window)) {
+		expired++
+	}
+	r.requests = r.requests[expired:]
+
+	if limit := r.config.RequestsPerMinute; limit > 0 && len(r.requests) >= limit {
+		return r.requests[len(r.requests)-limit].Add(time.Minute).Sub(now), metrics.ThrottleRequestBudget
+	}
+
+	if limit := r.config.RequestsPerSecond; limit > 0 && len(r.requests) >= limit {
+		if next := r.requests[len(r.requests)-limit].Add(time.Second); now.Before(next) {
+			return next.Sub(now), metrics.ThrottleRequestBudget
+		}
+	}
+
+	r.requests = append(r.requests, now)
+	return 0, ""
+}
+
+// block prevents requests from being made until the given time, unless they are already blocked
+// for longer.
+func (r *RateLimiter) block(until time.Time, reason metrics.ThrottleReason) {
+	if until.After(r.blockedUntil) {
+		r.blockedUntil = until
+		r.blockedBy = reason
+	}
+}
+
+// backoff returns the jittered exponential backoff for the current number of consecutive failures.
+// The backoff is chosen uniformly between half of and the full exponential backoff, which is capped
+// at the max backoff.
+func (r *RateLimiter) backoff() time.Duration {
+	backoff := r.config.InitialBackoff
+	for i := 1; i < r.failures && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, r.config.MaxBackoff)
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int64N(int64(backoff-half)+1))
+}
+
+// parseRetryAfter returns the delay requested by the Retry-After header of a rate limited or
+// unavailable response. The header can either be a number of seconds or an HTTP date.
+func parseRetryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil || (resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable) {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	var delay time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		delay = date.Sub(now)
+	} else {
+		return 0, false
+	}
+
+	if delay < 0 {
+		return 0, false
+	}
+
+	return min(delay, MaxRetryAfter), true
+}
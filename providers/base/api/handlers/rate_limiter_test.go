@@ -0,0 +1,250 @@
+package handlers_test
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/providers/base/api/handlers"
+	"github.com/1119-Labs/slinky/providers/base/api/handlers/mocks"
+	"github.com/1119-Labs/slinky/providers/base/api/metrics"
+	mockmetrics "github.com/1119-Labs/slinky/providers/base/api/metrics/mocks"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+func response(statusCode int, retryAfter string) *http.Response {
+	resp := &http.Response{
+		StatusCode: statusCode,
+		Header:     make(http.Header),
+	}
+	if retryAfter != "" {
+		resp.Header.Set("Retry-After", retryAfter)
+	}
+
+	return resp
+}
+
+// timeWait returns how long the rate limiter blocks before allowing a request.
+func timeWait(t *testing.T, r *handlers.RateLimiter) time.Duration {
+	t.Helper()
+
+	start := time.Now()
+	require.NoError(t, r.Wait(context.Background()))
+	return time.Since(start)
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Run("does not throttle requests without a rate limit", func(t *testing.T) {
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{}, mockmetrics.NewAPIMetrics(t))
+		require.NoError(t, err)
+
+		for i := 0; i < 100; i++ {
+			require.Less(t, timeWait(t, r), 50*time.Millisecond)
+		}
+
+		// failures do not cause backoff if it is disabled
+		r.Record(nil, fmt.Errorf("connection refused"))
+		require.Less(t, timeWait(t, r), 50*time.Millisecond)
+	})
+
+	t.Run("throttles requests that exceed the per-second budget", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleRequestBudget).Once()
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{RequestsPerSecond: 2}, m)
+		require.NoError(t, err)
+
+		require.Less(t, timeWait(t, r), 50*time.Millisecond)
+		require.Less(t, timeWait(t, r), 50*time.Millisecond)
+		require.Greater(t, timeWait(t, r), 900*time.Millisecond)
+	})
+
+	t.Run("throttles requests that exceed the per-minute budget", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleRequestBudget).Once()
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{RequestsPerMinute: 1}, m)
+		require.NoError(t, err)
+		require.Less(t, timeWait(t, r), 50*time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, r.Wait(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("waits for the duration of a Retry-After header in seconds", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleRetryAfter).Once()
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{}, m)
+		require.NoError(t, err)
+
+		r.Record(response(http.StatusTooManyRequests, "1"), nil)
+		require.Greater(t, timeWait(t, r), 900*time.Millisecond)
+	})
+
+	t.Run("waits until the date of a Retry-After header", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleRetryAfter).Once()
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{}, m)
+		require.NoError(t, err)
+
+		r.Record(response(http.StatusServiceUnavailable, time.Now().Add(2*time.Second).UTC().Format(http.TimeFormat)), nil)
+		require.Greater(t, timeWait(t, r), 500*time.Millisecond)
+	})
+
+	t.Run("ignores a Retry-After header on a successful response", func(t *testing.T) {
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{}, mockmetrics.NewAPIMetrics(t))
+		require.NoError(t, err)
+
+		r.Record(response(http.StatusOK, "60"), nil)
+		require.Less(t, timeWait(t, r), 50*time.Millisecond)
+	})
+
+	t.Run("backs off exponentially from consecutive failures", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleBackoff).Times(3)
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     400 * time.Millisecond,
+		}, m)
+		require.NoError(t, err)
+
+		// the backoff is jittered between half of and the full backoff
+		r.Record(nil, fmt.Errorf("connection refused"))
+		wait := timeWait(t, r)
+		require.GreaterOrEqual(t, wait, 50*time.Millisecond)
+		require.Less(t, wait, 200*time.Millisecond)
+
+		r.Record(response(http.StatusInternalServerError, ""), nil)
+		r.Record(response(http.StatusBadGateway, ""), nil)
+		r.Record(response(http.StatusTooManyRequests, ""), nil)
+		wait = timeWait(t, r)
+		require.GreaterOrEqual(t, wait, 200*time.Millisecond)
+		require.Less(t, wait, 500*time.Millisecond)
+
+		// a successful request resets the backoff
+		r.Record(response(http.StatusOK, ""), nil)
+		require.Less(t, timeWait(t, r), 50*time.Millisecond)
+
+		r.Record(nil, fmt.Errorf("connection refused"))
+		wait = timeWait(t, r)
+		require.GreaterOrEqual(t, wait, 50*time.Millisecond)
+		require.Less(t, wait, 200*time.Millisecond)
+	})
+
+	t.Run("rejects an invalid config", func(t *testing.T) {
+		_, err := handlers.NewRateLimiter("test", config.RateLimitConfig{RequestsPerSecond: -1}, mockmetrics.NewAPIMetrics(t))
+		require.Error(t, err)
+	})
+}
+
+func TestRateLimitedFetcher(t *testing.T) {
+	ids := []slinkytypes.CurrencyPair{btcusd, ethusd}
+	resolved := providertypes.NewGetResponse[slinkytypes.CurrencyPair, *big.Int](
+		map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+			btcusd: providertypes.NewResult(big.NewInt(100), time.Now()),
+		},
+		nil,
+	)
+	failed := providertypes.NewGetResponseWithErr[slinkytypes.CurrencyPair, *big.Int](
+		ids,
+		providertypes.NewErrorWithCode(fmt.Errorf("rpc unavailable"), providertypes.ErrorUnknown),
+	)
+
+	t.Run("backs off from fetches that resolve no ids", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleBackoff).Once()
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{
+			InitialBackoff: 500 * time.Millisecond,
+			MaxBackoff:     time.Second,
+		}, m)
+		require.NoError(t, err)
+
+		fetcher := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+		fetcher.On("Fetch", mock.Anything, ids).Return(failed).Once()
+		fetcher.On("Fetch", mock.Anything, ids).Return(resolved).Twice()
+
+		f, err := handlers.NewRateLimitedFetcher[slinkytypes.CurrencyPair, *big.Int](fetcher, r)
+		require.NoError(t, err)
+		require.Equal(t, r, f.RateLimiter())
+
+		require.Equal(t, failed, f.Fetch(context.Background(), ids))
+		require.Greater(t, r.RetryDelay(), 200*time.Millisecond)
+
+		start := time.Now()
+		require.Equal(t, resolved, f.Fetch(context.Background(), ids))
+		require.Greater(t, time.Since(start), 200*time.Millisecond)
+
+		// a fetch that resolves some ids resets the backoff
+		require.Zero(t, r.RetryDelay())
+		start = time.Now()
+		f.Fetch(context.Background(), ids)
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("keeps fetches within the request budget", func(t *testing.T) {
+		m := mockmetrics.NewAPIMetrics(t)
+		m.On("AddThrottledRequest", "test", metrics.ThrottleRequestBudget).Once()
+
+		r, err := handlers.NewRateLimiter("test", config.RateLimitConfig{RequestsPerMinute: 1}, m)
+		require.NoError(t, err)
+
+		fetcher := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+		fetcher.On("Fetch", mock.Anything, ids).Return(resolved).Once()
+
+		f, err := handlers.NewRateLimitedFetcher[slinkytypes.CurrencyPair, *big.Int](fetcher, r)
+		require.NoError(t, err)
+		require.Equal(t, resolved, f.Fetch(context.Background(), ids))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		response := f.Fetch(ctx, ids)
+		require.Len(t, response.UnResolved, len(ids))
+		for _, result := range response.UnResolved {
+			require.Equal(t, providertypes.ErrorRateLimitExceeded, result.Code())
+		}
+	})
+
+	t.Run("the query handler rate limits custom fetchers", func(t *testing.T) {
+		handlerCfg := cfg
+		handlerCfg.RateLimit = config.RateLimitConfig{InitialBackoff: 2 * time.Second, MaxBackoff: 2 * time.Second}
+
+		fetcher := mocks.NewAPIFetcher[slinkytypes.CurrencyPair, *big.Int](t)
+		fetcher.On("Fetch", mock.Anything, ids).Return(failed).Once()
+
+		handler, err := handlers.NewAPIQueryHandlerWithFetcher[slinkytypes.CurrencyPair, *big.Int](
+			zap.NewNop(),
+			handlerCfg,
+			fetcher,
+			metrics.NewNopAPIMetrics(),
+		)
+		require.NoError(t, err)
+
+		// only a single fetch is made within the backoff, even though the interval elapses
+		ctx, cancel := context.WithTimeout(context.Background(), 3*handlerCfg.Interval)
+		defer cancel()
+
+		responseCh := make(chan providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int], 1)
+		handler.Query(ctx, ids, responseCh)
+		fetcher.AssertNumberOfCalls(t, "Fetch", 1)
+
+		delayer, ok := handler.(handlers.RetryDelayer)
+		require.True(t, ok)
+		require.Greater(t, delayer.RetryDelay(), time.Duration(0))
+	})
+}
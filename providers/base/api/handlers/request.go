@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+// APIRequestBuilder is an optional interface that can be implemented by an APIDataHandler
+// that needs to send more than a plain request to a URL, i.e. a POST request with a JSON-RPC
+// or GraphQL body. If the APIDataHandler implements this interface, the RestAPIFetcher uses
+// CreateRequest instead of CreateURL to build the request that is sent to the RequestHandler.
+type APIRequestBuilder[K providertypes.ResponseKey] interface {
+	// CreateRequest is used to create the request to be sent to the http client. The function
+	// should utilize the IDs passed in as references to the data that needs to be fetched.
+	CreateRequest(ids []K) (Request, error)
+}
+
+// Request is a request to be sent to a data provider by a RequestHandler.
+type Request struct {
+	// Method is the HTTP method of the request. If empty, the method of the RequestHandler
+	// is used.
+	Method string
+
+	// URL is the URL of the request.
+	URL string
+
+	// Body is the body of the request. The body may be empty.
+	Body []byte
+
+	// Headers are the HTTP headers of the request. These take precedence over the headers
+	// of the RequestHandler.
+	Headers map[string]string
+}
+
+// NewJSONRequest returns a POST request to the given URL with the JSON encoding of the given
+// body.
+func NewJSONRequest(url string, body any) (Request, error) {
+	bz, err := json.Marshal(body)
+	if err != nil {
+		return Request{}, fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	return Request{
+		Method: http.MethodPost,
+		URL:    url,
+		Body:   bz,
+		Headers: map[string]string{
+			"Content-Type": "application/json",
+		},
+	}, nil
+}
+
+// JSONRPCVersion is the version of the JSON-RPC protocol used in requests.
+const JSONRPCVersion = "2.0"
+
+// JSONRPCRequest is the body of a JSON-RPC 2.0 request.
+type JSONRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+// JSONRPCResponse is the body of a JSON-RPC 2.0 response.
+type JSONRPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      int             `json:"id"`
+	Result  json.RawMessage `json:"result"`
+	Error   *JSONRPCError   `json:"error,omitempty"`
+}
+
+// JSONRPCError is the error returned in a JSON-RPC 2.0 response.
+type JSONRPCError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+// Error implements the error interface.
+func (e *JSONRPCError) Error() string {
+	return fmt.Sprintf("json-rpc error %d: %s", e.Code, e.Message)
+}
+
+// NewJSONRPCRequest returns a POST request to the given URL that calls the given JSON-RPC method
+// with the given params.
+func NewJSONRPCRequest(url, method string, params any) (Request, error) {
+	return NewJSONRequest(url, JSONRPCRequest{
+		JSONRPC: JSONRPCVersion,
+		ID:      1,
+		Method:  method,
+		Params:  params,
+	})
+}
+
+// DecodeJSONRPCResponse decodes the result of a JSON-RPC response into the given value. An error
+// is returned if the response cannot be decoded or if the JSON-RPC call returned an error.
+func DecodeJSONRPCResponse(resp *http.Response, result any) error {
+	var rpcResp JSONRPCResponse
+	if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+		return fmt.Errorf("failed to decode json-rpc response: %w", err)
+	}
+
+	if rpcResp.Error != nil {
+		return rpcResp.Error
+	}
+
+	if err := json.Unmarshal(rpcResp.Result, result); err != nil {
+		return fmt.Errorf("failed to decode json-rpc result: %w", err)
+	}
+
+	return nil
+}
+
+// GraphQLRequest is the body of a GraphQL request.
+type GraphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLResponse is the body of a GraphQL response.
+type GraphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []GraphQLError  `json:"errors,omitempty"`
+}
+
+// GraphQLError is an error returned in a GraphQL response.
+type GraphQLError struct {
+	Message string `json:"message"`
+}
+
+// NewGraphQLRequest returns a POST request to the given URL with the given GraphQL query and
+// variables.
+func NewGraphQLRequest(url, query string, variables map[string]any) (Request, error) {
+	return NewJSONRequest(url, GraphQLRequest{
+		Query:     query,
+		Variables: variables,
+	})
+}
+
+// DecodeGraphQLResponse decodes the data of a GraphQL response into the given value. An error is
+// returned if the response cannot be decoded or if the query returned any errors.
+func DecodeGraphQLResponse(resp *http.Response, data any) error {
+	var gqlResp GraphQLResponse
+	if err := json.NewDecoder(resp.Body).Decode(&gqlResp); err != nil {
+		return fmt.Errorf("failed to decode graphql response: %w", err)
+	}
+
+	if len(gqlResp.Errors) > 0 {
+		return fmt.Errorf("graphql error: %s", gqlResp.Errors[0].Message)
+	}
+
+	if err := json.Unmarshal(gqlResp.Data, data); err != nil {
+		return fmt.Errorf("failed to decode graphql data: %w", err)
+	}
+
+	return nil
+}
@@ -1,8 +1,10 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 )
 
@@ -13,6 +15,11 @@ type RequestHandler interface {
 	// Do is used to send a request with the given URL to the data provider.
 	Do(ctx context.Context, url string) (*http.Response, error)
 
+	// DoRequest is used to send the given request to the data provider. This is used for
+	// requests that need a method, body or headers other than the RequestHandler's defaults,
+	// i.e. JSON-RPC or GraphQL requests.
+	DoRequest(ctx context.Context, req Request) (*http.Response, error)
+
 	// Type defines the type of the RequestHandler based on the type of
 	// HTTP requests it makes  - GET, POST, etc.
 	Type() string
@@ -51,7 +58,24 @@ func NewRequestHandlerImpl(client *http.Client, opts ...Option) (RequestHandler,
 // Do is used to send a request with the given URL to the data provider. It first
 // wraps the request with the given context before sending it to the data provider.
 func (r *RequestHandlerImpl) Do(ctx context.Context, url string) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, r.method, url, nil)
+	return r.DoRequest(ctx, Request{URL: url})
+}
+
+// DoRequest is used to send the given request to the data provider. The request uses the
+// RequestHandler's method if it does not set one, and its headers take precedence over the
+// RequestHandler's headers.
+func (r *RequestHandlerImpl) DoRequest(ctx context.Context, request Request) (*http.Response, error) {
+	method := request.Method
+	if method == "" {
+		method = r.method
+	}
+
+	var body io.Reader
+	if len(request.Body) > 0 {
+		body = bytes.NewReader(request.Body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, request.URL, body)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +84,10 @@ func (r *RequestHandlerImpl) Do(ctx context.Context, url string) (*http.Response
 		req.Header.Set(key, value)
 	}
 
+	for key, value := range request.Headers {
+		req.Header.Set(key, value)
+	}
+
 	return r.client.Do(req)
 }
 
@@ -0,0 +1,166 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/providers/base/api/handlers"
+	"github.com/1119-Labs/slinky/providers/base/api/metrics"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
+)
+
+// jsonRPCDataHandler is an APIDataHandler that fetches the price of each currency pair with a
+// JSON-RPC call.
+type jsonRPCDataHandler struct {
+	url string
+}
+
+var _ handlers.APIRequestBuilder[slinkytypes.CurrencyPair] = (*jsonRPCDataHandler)(nil)
+
+func (h *jsonRPCDataHandler) CreateURL(_ []slinkytypes.CurrencyPair) (string, error) {
+	panic("CreateURL should not be called for data handlers that build requests")
+}
+
+func (h *jsonRPCDataHandler) CreateRequest(ids []slinkytypes.CurrencyPair) (handlers.Request, error) {
+	tickers := make([]string, len(ids))
+	for i, id := range ids {
+		tickers[i] = id.String()
+	}
+
+	return handlers.NewJSONRPCRequest(h.url, "getPrices", tickers)
+}
+
+func (h *jsonRPCDataHandler) ParseResponse(
+	ids []slinkytypes.CurrencyPair,
+	resp *http.Response,
+) providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int] {
+	var prices map[string]int64
+	if err := handlers.DecodeJSONRPCResponse(resp, &prices); err != nil {
+		return providertypes.NewGetResponseWithErr[slinkytypes.CurrencyPair, *big.Int](
+			ids,
+			providertypes.NewErrorWithCode(err, providertypes.ErrorFailedToDecode),
+		)
+	}
+
+	resolved := make(map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int])
+	for _, id := range ids {
+		resolved[id] = providertypes.NewResult(big.NewInt(prices[id.String()]), time.Now())
+	}
+
+	return providertypes.NewGetResponse(resolved, nil)
+}
+
+func TestRequestHandlerDoRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, `{"query":"{ price }"}`, string(body))
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "key", r.Header.Get("X-Api-Key"))
+
+		_, err = w.Write([]byte(`{"data":{"price":"100"}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	h, err := handlers.NewRequestHandlerImpl(server.Client(), handlers.WithHTTPHeaders(map[string]string{
+		"X-Api-Key":    "key",
+		"Content-Type": "text/plain",
+	}))
+	require.NoError(t, err)
+
+	req, err := handlers.NewGraphQLRequest(server.URL, "{ price }", nil)
+	require.NoError(t, err)
+
+	resp, err := h.DoRequest(context.Background(), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var data struct {
+		Price string `json:"price"`
+	}
+	require.NoError(t, handlers.DecodeGraphQLResponse(resp, &data))
+	require.Equal(t, "100", data.Price)
+}
+
+func TestDecodeResponses(t *testing.T) {
+	newResponse := func(body string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	t.Run("returns the json-rpc error", func(t *testing.T) {
+		var result string
+		err := handlers.DecodeJSONRPCResponse(
+			newResponse(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`),
+			&result,
+		)
+		require.EqualError(t, err, "json-rpc error -32601: method not found")
+	})
+
+	t.Run("returns an error for an invalid json-rpc response", func(t *testing.T) {
+		var result string
+		require.Error(t, handlers.DecodeJSONRPCResponse(newResponse(`not json`), &result))
+	})
+
+	t.Run("returns the graphql errors", func(t *testing.T) {
+		var data map[string]any
+		err := handlers.DecodeGraphQLResponse(
+			newResponse(`{"errors":[{"message":"unknown field"}]}`),
+			&data,
+		)
+		require.EqualError(t, err, "graphql error: unknown field")
+	})
+}
+
+func TestRestAPIFetcherCreateRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req handlers.JSONRPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, handlers.JSONRPCVersion, req.JSONRPC)
+		require.Equal(t, "getPrices", req.Method)
+		require.Equal(t, []any{btcusd.String(), ethusd.String()}, req.Params)
+
+		_, err := w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"BTC/USD":100,"ETH/USD":10}}`))
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	fetcherCfg := cfg
+	fetcherCfg.Endpoints = []config.Endpoint{{URL: server.URL}}
+
+	requestHandler, err := handlers.NewRequestHandlerImpl(server.Client())
+	require.NoError(t, err)
+
+	fetcher, err := handlers.NewRestAPIFetcher[slinkytypes.CurrencyPair, *big.Int](
+		requestHandler,
+		&jsonRPCDataHandler{url: server.URL},
+		metrics.NewNopAPIMetrics(),
+		fetcherCfg,
+		logger,
+	)
+	require.NoError(t, err)
+
+	resp := fetcher.Fetch(context.Background(), []slinkytypes.CurrencyPair{btcusd, ethusd})
+	require.Empty(t, resp.UnResolved)
+	require.Len(t, resp.Resolved, 2)
+	require.Equal(t, big.NewInt(100), resp.Resolved[btcusd].Value)
+	require.Equal(t, big.NewInt(10), resp.Resolved[ethusd].Value)
+}
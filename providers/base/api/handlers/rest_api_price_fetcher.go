@@ -26,6 +26,10 @@ type RestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValue]
 	// metrics is responsible for tracking metrics related to the API.
 	metrics metrics.APIMetrics
 
+	// rateLimiter is responsible for keeping outgoing requests within the request budget of the
+	// API, and backing off from failed requests.
+	rateLimiter *RateLimiter
+
 	// config is the configuration for the API. Specifically configuring the timeouts
 	// for outgoing requests
 	config config.APIConfig
@@ -62,10 +66,16 @@ func NewRestAPIFetcher[K providertypes.ResponseKey, V providertypes.ResponseValu
 		return nil, fmt.Errorf("metrics is nil")
 	}
 
+	rateLimiter, err := NewRateLimiter(config.Name, config.RateLimit, metrics)
+	if err != nil {
+		return nil, err
+	}
+
 	return &RestAPIFetcher[K, V]{
 		requestHandler: requestHandler,
 		apiDataHandler: apiDataHandler,
 		metrics:        metrics,
+		rateLimiter:    rateLimiter,
 		config:         config,
 		logger:         logger.With(zap.String("fetcher", config.Name)),
 	}, nil
@@ -77,14 +87,26 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	ctx context.Context,
 	ids []K,
 ) providertypes.GetResponse[K, V] {
+	// Wait until the request fits within the rate limit of the API.
+	if err := pf.rateLimiter.Wait(ctx); err != nil {
+		return providertypes.NewGetResponseWithErr[K, V](
+			ids,
+			providertypes.NewErrorWithCode(
+				errors.ErrRateLimit,
+				providertypes.ErrorRateLimitExceeded,
+			),
+		)
+	}
+
 	// Observe the latency of the request.
 	start := time.Now()
 	defer func() {
 		pf.metrics.ObserveProviderResponseLatency(pf.config.Name, metrics.RedactedURL, time.Since(start))
 	}()
 
-	// Create the URL for the request.
-	url, err := pf.apiDataHandler.CreateURL(ids)
+	// Create the request. Data handlers that implement APIRequestBuilder create the full
+	// request, otherwise a request is made to the URL created by the data handler.
+	request, err := pf.createRequest(ids)
 	if err != nil {
 		return providertypes.NewGetResponseWithErr[K, V](
 			ids,
@@ -95,6 +117,7 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 		)
 	}
 
+	url := request.URL
 	pf.logger.Debug("created url", zap.String("url", url))
 
 	// Make the request.
@@ -104,8 +127,14 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 	pf.logger.Debug("making request", zap.String("url", url))
 
 	// Record the status code in the metrics.
-	resp, err := pf.requestHandler.Do(apiCtx, url)
+	var resp *http.Response
+	if _, ok := pf.apiDataHandler.(APIRequestBuilder[K]); ok {
+		resp, err = pf.requestHandler.DoRequest(apiCtx, request)
+	} else {
+		resp, err = pf.requestHandler.Do(apiCtx, url)
+	}
 	pf.metrics.AddHTTPStatusCode(pf.config.Name, resp)
+	pf.rateLimiter.Record(resp, err)
 	if err != nil {
 		status := providertypes.ErrorUnknown
 		if resp != nil {
@@ -163,3 +192,22 @@ func (pf *RestAPIFetcher[K, V]) Fetch(
 
 	return response
 }
+
+// RateLimiter returns the rate limiter that the fetcher's requests are made through.
+func (pf *RestAPIFetcher[K, V]) RateLimiter() *RateLimiter {
+	return pf.rateLimiter
+}
+
+// createRequest creates the request for the given IDs using the data handler.
+func (pf *RestAPIFetcher[K, V]) createRequest(ids []K) (Request, error) {
+	if builder, ok := pf.apiDataHandler.(APIRequestBuilder[K]); ok {
+		return builder.CreateRequest(ids)
+	}
+
+	url, err := pf.apiDataHandler.CreateURL(ids)
+	if err != nil {
+		return Request{}, err
+	}
+
+	return Request{URL: url}, nil
+}
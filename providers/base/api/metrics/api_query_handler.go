@@ -36,6 +36,10 @@ type APIMetrics interface {
 	// within a single interval. Note that if the provider is not atomic, this will be the
 	// time it took for all the requests to complete.
 	ObserveProviderResponseLatency(providerName, endpoint string, duration time.Duration)
+
+	// AddThrottledRequest increments the number of requests by provider that were delayed by
+	// rate limiting, along with the reason for the delay.
+	AddThrottledRequest(providerName string, reason ThrottleReason)
 }
 
 // APIMetricsImpl contains metrics exposed by this package.
@@ -51,6 +55,9 @@ type APIMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	apiResponseTimePerProvider *prometheus.HistogramVec
+
+	// Number of provider requests delayed by rate limiting, by reason.
+	apiThrottledRequestsPerProvider *prometheus.CounterVec
 }
 
 // NewAPIMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -85,6 +92,11 @@ func NewAPIMetrics() APIMetrics {
 			Help:      "Response time per API provider. URL may be redacted but will correspond to indices in the oracle config.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
+		apiThrottledRequestsPerProvider: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "api_throttled_requests",
+			Help:      "Number of API provider requests delayed by rate limiting, by reason (request budget, Retry-After or backoff).",
+		}, []string{providermetrics.ProviderLabel, ThrottleReasonLabel}),
 	}
 
 	// register the above metrics
@@ -92,6 +104,7 @@ func NewAPIMetrics() APIMetrics {
 	prometheus.MustRegister(m.apiHTTPStatusCodePerProvider)
 	prometheus.MustRegister(m.apiRPCStatusCodePerProvider)
 	prometheus.MustRegister(m.apiResponseTimePerProvider)
+	prometheus.MustRegister(m.apiThrottledRequestsPerProvider)
 
 	return m
 }
@@ -107,6 +120,7 @@ func (m *noOpAPIMetricsImpl) AddProviderResponse(_ string, _ string, _ providert
 func (m *noOpAPIMetricsImpl) AddHTTPStatusCode(_ string, _ *http.Response)                      {}
 func (m *noOpAPIMetricsImpl) AddRPCStatusCode(_, _ string, _ RPCCode)                           {}
 func (m *noOpAPIMetricsImpl) ObserveProviderResponseLatency(_, _ string, _ time.Duration)       {}
+func (m *noOpAPIMetricsImpl) AddThrottledRequest(_ string, _ ThrottleReason)                    {}
 
 // AddProviderResponse increments the number of requests by provider and status.
 func (m *APIMetricsImpl) AddProviderResponse(providerName string, id string, err providertypes.ErrorCode) {
@@ -172,3 +186,11 @@ func (m *APIMetricsImpl) ObserveProviderResponseLatency(providerName, endpoint s
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// AddThrottledRequest increments the number of throttled requests by provider and reason.
+func (m *APIMetricsImpl) AddThrottledRequest(providerName string, reason ThrottleReason) {
+	m.apiThrottledRequestsPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: providerName,
+		ThrottleReasonLabel:           string(reason),
+	}).Add(1)
+}
@@ -13,6 +13,22 @@ const (
 	EndpointLabel = "endpoint"
 	// RedactedURL is a label for the redacted URL of a provider API response.
 	RedactedURL = "redacted_url"
+	// ThrottleReasonLabel is a label for the reason a provider API request was throttled.
+	ThrottleReasonLabel = "reason"
+)
+
+type (
+	// ThrottleReason is the reason a provider API request was delayed.
+	ThrottleReason string
+)
+
+const (
+	// ThrottleRequestBudget is the reason for requests delayed by the request budget of the provider.
+	ThrottleRequestBudget ThrottleReason = "request_budget"
+	// ThrottleRetryAfter is the reason for requests delayed by a Retry-After header returned by the provider.
+	ThrottleRetryAfter ThrottleReason = "retry_after"
+	// ThrottleBackoff is the reason for requests delayed by backoff after failed requests.
+	ThrottleBackoff ThrottleReason = "backoff"
 )
 
 type (
@@ -34,6 +34,11 @@ func (_m *APIMetrics) AddRPCStatusCode(providerName string, endpoint string, cod
 	_m.Called(providerName, endpoint, code)
 }
 
+// AddThrottledRequest provides a mock function with given fields: providerName, reason
+func (_m *APIMetrics) AddThrottledRequest(providerName string, reason metrics.ThrottleReason) {
+	_m.Called(providerName, reason)
+}
+
 // ObserveProviderResponseLatency provides a mock function with given fields: providerName, endpoint, duration
 func (_m *APIMetrics) ObserveProviderResponseLatency(providerName string, endpoint string, duration time.Duration) {
 	_m.Called(providerName, endpoint, duration)
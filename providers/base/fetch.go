@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/1119-Labs/slinky/pkg/slices"
+	apihandlers "github.com/1119-Labs/slinky/providers/base/api/handlers"
 	providermetrics "github.com/1119-Labs/slinky/providers/base/metrics"
 	providertypes "github.com/1119-Labs/slinky/providers/types"
 )
@@ -52,8 +53,19 @@ func (p *Provider[K, V]) startAPI(ctx context.Context) error {
 				p.logger.Debug("restarting api query handler", zap.Int("num_restarts", restarts))
 
 				// If the API query handler returns, then the connection was closed. Wait for
-				// a bit before trying to reconnect.
-				time.Sleep(p.apiCfg.ReconnectTimeout)
+				// a bit before trying to reconnect, or for longer if the API asked us to back off
+				// (e.g. with a Retry-After header).
+				delay := p.apiCfg.ReconnectTimeout
+				if delayer, ok := handler.(apihandlers.RetryDelayer); ok {
+					delay = max(delay, delayer.RetryDelay())
+				}
+
+				select {
+				case <-ctx.Done():
+					p.logger.Debug("api stopped via context")
+					return ctx.Err()
+				case <-time.After(delay):
+				}
 			}
 
 			p.logger.Debug(
@@ -157,6 +169,7 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 				)
 
 				p.updateData(id, result)
+				p.recordSuccess()
 
 				// Update the metrics.
 				strID := strings.ToLower(id.String())
@@ -177,6 +190,7 @@ func (p *Provider[K, V]) recv(ctx context.Context) {
 				strID := strings.ToLower(id.String())
 				p.metrics.AddProviderResponseByID(p.name, strID, providermetrics.Failure, result.Code(), p.Type())
 				p.metrics.AddProviderResponse(p.name, providermetrics.Failure, result.Code(), p.Type())
+				p.recordError(strID, result.Code())
 			}
 		}
 	}
@@ -229,12 +243,20 @@ func (p *Provider[K, V]) updateData(id K, result providertypes.ResolvedResult[V]
 		current.Timestamp = result.Timestamp
 		p.data[id] = current
 	default:
-		// Otherwise, update the data.
+		// Otherwise, update the data. Volume and liquidity are typically reported less often
+		// than prices (e.g. ticker vs. trade streams), so they are retained from the current
+		// result if the new result does not include them.
 		p.logger.Debug(
 			"updating base provider data",
 			zap.String("id", fmt.Sprint(id)),
 			zap.String("result", result.String()),
 		)
+		if result.Volume == nil {
+			result.Volume = current.Volume
+		}
+		if result.Liquidity == nil {
+			result.Liquidity = current.Liquidity
+		}
 		p.data[id] = result
 	}
 }
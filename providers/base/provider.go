@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
 	"sync"
 
 	"go.uber.org/zap"
@@ -65,6 +66,9 @@ type Provider[K providertypes.ResponseKey, V providertypes.ResponseValue] struct
 
 	// responseCh is the channel that is used to receive the response(s) from the query handler.
 	responseCh chan providertypes.GetResponse[K, V]
+
+	// health tracks the recent responses received by the provider.
+	health providertypes.ProviderHealth
 }
 
 // NewProvider returns a new Base provider.
@@ -229,6 +233,18 @@ func (p *Provider[K, V]) GetData() map[K]providertypes.ResolvedResult[V] {
 	return cpy
 }
 
+// GetHealth returns the time of the provider's last successful response and its most recent
+// failed responses.
+func (p *Provider[K, V]) GetHealth() providertypes.ProviderHealth {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	health := p.health
+	health.RecentErrors = slices.Clone(p.health.RecentErrors)
+
+	return health
+}
+
 // Type returns the type of data handler the provider uses.
 func (p *Provider[K, V]) Type() providertypes.ProviderType {
 	switch {
@@ -94,6 +94,17 @@ var (
 	respTime = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
 )
 
+// retryDelayQueryHandler is an api query handler that asks to not be restarted for the given delay.
+type retryDelayQueryHandler struct {
+	*apihandlermocks.QueryHandler[slinkytypes.CurrencyPair, *big.Int]
+
+	delay time.Duration
+}
+
+func (h retryDelayQueryHandler) RetryDelay() time.Duration {
+	return h.delay
+}
+
 func TestStart(t *testing.T) {
 	t.Parallel()
 
@@ -142,6 +153,33 @@ func TestStart(t *testing.T) {
 		require.Equal(t, context.DeadlineExceeded, err)
 	})
 
+	t.Run("waits for the retry delay of the api query handler before restarting it", func(t *testing.T) {
+		t.Parallel()
+
+		handler := retryDelayQueryHandler{
+			QueryHandler: apihandlermocks.NewQueryHandler[slinkytypes.CurrencyPair, *big.Int](t),
+			delay:        time.Minute,
+		}
+		handler.On("Query", mock.Anything, mock.Anything, mock.Anything).Return().Once()
+
+		provider, err := base.NewProvider(
+			base.WithName[slinkytypes.CurrencyPair, *big.Int](apiCfg.Name),
+			base.WithAPIQueryHandler[slinkytypes.CurrencyPair, *big.Int](handler),
+			base.WithAPIConfig[slinkytypes.CurrencyPair, *big.Int](apiCfg),
+			base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+			base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs),
+		)
+		require.NoError(t, err)
+
+		// the handler would be restarted after the reconnect timeout if not for its retry delay
+		ctx, cancel := context.WithTimeout(context.Background(), apiCfg.ReconnectTimeout*3)
+		defer cancel()
+
+		err = provider.Start(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+		handler.AssertNumberOfCalls(t, "Query", 1)
+	})
+
 	t.Run("closes on cancel with websocket", func(t *testing.T) {
 		t.Parallel()
 
@@ -826,6 +864,101 @@ func TestAPIProviderLoop(t *testing.T) {
 	}
 }
 
+func TestProviderHealth(t *testing.T) {
+	resolved := map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+		pairs[0]: {
+			Value:     big.NewInt(100),
+			Timestamp: respTime,
+		},
+	}
+	unResolved := map[slinkytypes.CurrencyPair]providertypes.UnresolvedResult{
+		pairs[1]: {
+			ErrorWithCode: providertypes.NewErrorWithCode(apierrors.ErrRateLimit, providertypes.ErrorRateLimitExceeded),
+		},
+	}
+	responses := []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+		providertypes.NewGetResponse(resolved, unResolved),
+	}
+
+	provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+		base.WithName[slinkytypes.CurrencyPair, *big.Int](apiCfg.Name),
+		base.WithAPIQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			testutils.CreateAPIQueryHandlerWithGetResponses[slinkytypes.CurrencyPair, *big.Int](
+				t,
+				logger,
+				responses,
+				200*time.Millisecond,
+			),
+		),
+		base.WithAPIConfig[slinkytypes.CurrencyPair, *big.Int](apiCfg),
+		base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+		base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs[:2]),
+	)
+	require.NoError(t, err)
+
+	// no responses have been received before the provider is started
+	health := provider.GetHealth()
+	require.True(t, health.LastSuccess.IsZero())
+	require.Empty(t, health.RecentErrors)
+
+	now := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Interval*5)
+	defer cancel()
+
+	err = provider.Start(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	health = provider.GetHealth()
+	require.True(t, health.LastSuccess.After(now))
+	require.NotEmpty(t, health.RecentErrors)
+	require.LessOrEqual(t, len(health.RecentErrors), providertypes.MaxRecentErrors)
+	for _, providerErr := range health.RecentErrors {
+		require.Equal(t, strings.ToLower(pairs[1].String()), providerErr.ID)
+		require.Equal(t, providertypes.ErrorRateLimitExceeded, providerErr.Code)
+		require.True(t, providerErr.Timestamp.After(now))
+	}
+}
+
+func TestProviderRetainsVolume(t *testing.T) {
+	volume := big.NewFloat(250)
+	responses := []providertypes.GetResponse[slinkytypes.CurrencyPair, *big.Int]{
+		providertypes.NewGetResponse(map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+			pairs[0]: providertypes.NewResult(big.NewInt(100), respTime).WithVolume(volume),
+		}, nil),
+		// the latest result does not include a volume, so the previous volume is retained
+		providertypes.NewGetResponse(map[slinkytypes.CurrencyPair]providertypes.ResolvedResult[*big.Int]{
+			pairs[0]: providertypes.NewResult(big.NewInt(200), respTime.Add(time.Second)),
+		}, nil),
+	}
+
+	provider, err := base.NewProvider[slinkytypes.CurrencyPair, *big.Int](
+		base.WithName[slinkytypes.CurrencyPair, *big.Int](apiCfg.Name),
+		base.WithAPIQueryHandler[slinkytypes.CurrencyPair, *big.Int](
+			testutils.CreateAPIQueryHandlerWithGetResponses[slinkytypes.CurrencyPair, *big.Int](
+				t,
+				logger,
+				responses,
+				200*time.Millisecond,
+			),
+		),
+		base.WithAPIConfig[slinkytypes.CurrencyPair, *big.Int](apiCfg),
+		base.WithLogger[slinkytypes.CurrencyPair, *big.Int](logger),
+		base.WithIDs[slinkytypes.CurrencyPair, *big.Int](pairs[:1]),
+	)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiCfg.Interval*5)
+	defer cancel()
+
+	err = provider.Start(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+
+	data := provider.GetData()
+	require.Len(t, data, 1)
+	require.Equal(t, big.NewInt(200), data[pairs[0]].Value)
+	require.Equal(t, volume, data[pairs[0]].Volume)
+}
+
 func TestMetrics(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -2,9 +2,14 @@ package testutils
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -121,3 +126,56 @@ func CreateWebSocketProviderWithResponseFn[K providertypes.ResponseKey, V provid
 
 	return p
 }
+
+// WebSocketServer is a local stand-in for a websocket data provider's endpoint.
+type WebSocketServer struct {
+	server *httptest.Server
+
+	// down is set while the server rejects websocket handshakes.
+	down atomic.Bool
+	// connections is the number of connections the server has accepted.
+	connections atomic.Int64
+}
+
+// NewWebSocketServer starts a local websocket server that invokes the given handler with every
+// connection it accepts. The connection is closed once the handler returns, and the server is closed
+// when the test finishes.
+func NewWebSocketServer(t *testing.T, handler func(*websocket.Conn)) *WebSocketServer {
+	t.Helper()
+
+	s := &WebSocketServer{}
+	upgrader := websocket.Upgrader{}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.down.Load() {
+			http.Error(w, "endpoint is down", http.StatusServiceUnavailable)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		s.connections.Add(1)
+		handler(conn)
+	}))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// URL returns the websocket URL of the server.
+func (s *WebSocketServer) URL() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http")
+}
+
+// SetDown sets whether the server rejects websocket handshakes.
+func (s *WebSocketServer) SetDown(down bool) {
+	s.down.Store(down)
+}
+
+// Connections returns the number of connections the server has accepted.
+func (s *WebSocketServer) Connections() int {
+	return int(s.connections.Load())
+}
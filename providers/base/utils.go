@@ -3,6 +3,8 @@ package base
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	providertypes "github.com/1119-Labs/slinky/providers/types"
 )
@@ -57,3 +59,28 @@ func (p *Provider[K, V]) getFetchCtx() (context.Context, context.CancelFunc) {
 
 	return p.fetchCtx, p.cancelFetchFn
 }
+
+// recordSuccess records that the provider received a successful response.
+func (p *Provider[K, V]) recordSuccess() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.health.LastSuccess = time.Now().UTC()
+}
+
+// recordError records a failed response of the provider for the given ID, only keeping the most
+// recent MaxRecentErrors failed responses.
+func (p *Provider[K, V]) recordError(id string, code providertypes.ErrorCode) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.health.RecentErrors = append(p.health.RecentErrors, providertypes.ProviderError{
+		ID:        id,
+		Code:      code,
+		Timestamp: time.Now().UTC(),
+	})
+
+	if n := len(p.health.RecentErrors); n > providertypes.MaxRecentErrors {
+		p.health.RecentErrors = slices.Clone(p.health.RecentErrors[n-providertypes.MaxRecentErrors:])
+	}
+}
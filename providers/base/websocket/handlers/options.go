@@ -1,5 +1,11 @@
 package handlers
 
+import (
+	"time"
+
+	"github.com/1119-Labs/slinky/providers/base/websocket/metrics"
+)
+
 // Option is a function that is used to configure a WebSocketConnHandler.
 type Option func(*WebSocketConnHandlerImpl)
 
@@ -13,3 +19,28 @@ func WithPreDialHook(hook PreDialHook) Option {
 		r.preDialHook = hook
 	}
 }
+
+// WithMetrics is an option that is used to set the metrics that the connection handler reports
+// its active endpoint to.
+func WithMetrics(m metrics.WebSocketMetrics) Option {
+	return func(r *WebSocketConnHandlerImpl) {
+		if m == nil {
+			panic("metrics cannot be nil")
+		}
+
+		r.metrics = m
+	}
+}
+
+// WithEndpointBackoff is an option that is used to set the initial and maximum duration for which
+// a failed endpoint is not dialed.
+func WithEndpointBackoff(backoff, maxBackoff time.Duration) Option {
+	return func(r *WebSocketConnHandlerImpl) {
+		if backoff <= 0 || maxBackoff < backoff {
+			panic("endpoint backoff must be positive and at most the max endpoint backoff")
+		}
+
+		r.backoff = backoff
+		r.maxBackoff = maxBackoff
+	}
+}
@@ -1,14 +1,27 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 
 	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/providers/base/websocket/metrics"
+)
+
+const (
+	// DefaultEndpointBackoff is the default duration for which an endpoint is not dialed after
+	// it first fails. The backoff doubles with each consecutive failure of the endpoint.
+	DefaultEndpointBackoff = 5 * time.Second
+
+	// DefaultMaxEndpointBackoff is the default maximum duration for which an endpoint is not
+	// dialed after it fails.
+	DefaultMaxEndpointBackoff = 5 * time.Minute
 )
 
 type (
@@ -49,6 +62,9 @@ type WebSocketConnHandler interface {
 }
 
 // WebSocketConnHandlerImpl is a struct that implements the WebSocketConnHandler interface.
+// The connection handler dials the configured endpoints in order, failing over to the next
+// endpoint when an endpoint cannot be dialed or repeatedly fails to be read from. Failed
+// endpoints are backed off exponentially before they are dialed again.
 type WebSocketConnHandlerImpl struct {
 	sync.Mutex
 	cfg config.WebSocketConfig
@@ -58,6 +74,27 @@ type WebSocketConnHandlerImpl struct {
 
 	// preDialHook is a function that is called before the connection is established.
 	preDialHook PreDialHook
+
+	// metrics is used to report the active endpoint of the connection.
+	metrics metrics.WebSocketMetrics
+
+	// active is the index of the endpoint that is (or was last) connected to.
+	active int
+	// health tracks the health of each configured endpoint, indexed by endpoint.
+	health []endpointHealth
+	// backoff and maxBackoff bound how long a failed endpoint is not dialed for.
+	backoff    time.Duration
+	maxBackoff time.Duration
+}
+
+// endpointHealth tracks the failures of a single websocket endpoint.
+type endpointHealth struct {
+	// failures is the number of consecutive dial or read failures of the endpoint.
+	failures int
+	// readErrs is the number of consecutive read errors on the current connection to the endpoint.
+	readErrs int
+	// backoffUntil is the time until which the endpoint should not be dialed.
+	backoffUntil time.Time
 }
 
 // NewWebSocketHandlerImpl returns a new WebSocketConnHandlerImpl.
@@ -67,7 +104,11 @@ func NewWebSocketHandlerImpl(cfg config.WebSocketConfig, opts ...Option) (*WebSo
 	}
 
 	h := &WebSocketConnHandlerImpl{
-		cfg: cfg,
+		cfg:        cfg,
+		metrics:    metrics.NewNopWebSocketMetrics(),
+		health:     make([]endpointHealth, len(cfg.Endpoints)),
+		backoff:    DefaultEndpointBackoff,
+		maxBackoff: DefaultMaxEndpointBackoff,
 	}
 
 	for _, opt := range opts {
@@ -88,7 +129,10 @@ func (h *WebSocketConnHandlerImpl) CreateDialer() *websocket.Dialer {
 	}
 }
 
-// Dial is used to create a new connection to the data provider with the given URL.
+// Dial is used to create a new connection to the data provider. The endpoints are dialed in
+// order starting from the active endpoint, skipping endpoints that are backed off after failing,
+// until a connection is established. If every endpoint is backed off, the endpoint whose backoff
+// expires first is dialed.
 func (h *WebSocketConnHandlerImpl) Dial() error {
 	if h.preDialHook != nil {
 		if err := h.preDialHook(h); err != nil {
@@ -96,13 +140,91 @@ func (h *WebSocketConnHandlerImpl) Dial() error {
 		}
 	}
 
+	h.Lock()
+	defer h.Unlock()
+
 	if len(h.cfg.Endpoints) == 0 {
 		return fmt.Errorf("no endpoints provided")
 	}
 
-	var err error
-	h.conn, _, err = h.CreateDialer().Dial(h.cfg.Endpoints[0].URL, nil)
-	return err
+	// The pre-dial hook may have updated the endpoints.
+	if len(h.health) != len(h.cfg.Endpoints) {
+		h.health = make([]endpointHealth, len(h.cfg.Endpoints))
+		h.active = 0
+	}
+
+	var (
+		now      = time.Now()
+		attempts []int
+		earliest = h.active
+	)
+	for i := range h.cfg.Endpoints {
+		index := (h.active + i) % len(h.cfg.Endpoints)
+		if h.health[index].backoffUntil.After(now) {
+			if h.health[index].backoffUntil.Before(h.health[earliest].backoffUntil) {
+				earliest = index
+			}
+			continue
+		}
+
+		attempts = append(attempts, index)
+	}
+	if len(attempts) == 0 {
+		attempts = append(attempts, earliest)
+	}
+
+	var errs []error
+	for _, index := range attempts {
+		endpoint := h.cfg.Endpoints[index].URL
+
+		conn, _, err := h.CreateDialer().Dial(endpoint, nil)
+		if err != nil {
+			h.recordFailure(index)
+			errs = append(errs, fmt.Errorf("%s: %w", redactURL(endpoint), err))
+			continue
+		}
+
+		if index != h.active {
+			h.metrics.AddWebSocketConnectionStatus(h.cfg.Name, metrics.EndpointFailover)
+		}
+
+		h.conn = conn
+		h.active = index
+		h.health[index].readErrs = 0
+		h.metrics.SetWebSocketActiveEndpoint(h.cfg.Name, redactURL(endpoint))
+		return nil
+	}
+
+	return fmt.Errorf("failed to dial any endpoint: %w", errors.Join(errs...))
+}
+
+// recordFailure records a dial or read failure of the endpoint at the given index, backing it off
+// exponentially. The lock must be held.
+func (h *WebSocketConnHandlerImpl) recordFailure(index int) {
+	health := &h.health[index]
+	health.failures++
+
+	backoff := h.backoff
+	for i := 1; i < health.failures && backoff < h.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > h.maxBackoff {
+		backoff = h.maxBackoff
+	}
+
+	health.backoffUntil = time.Now().Add(backoff)
+}
+
+// ActiveEndpoint returns the URL of the endpoint that is (or was last) connected to.
+func (h *WebSocketConnHandlerImpl) ActiveEndpoint() string {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.active >= len(h.cfg.Endpoints) {
+		return ""
+	}
+
+	return h.cfg.Endpoints[h.active].URL
 }
 
 // Read is used to read data from the data provider. Each websocket data handler is responsible
@@ -121,6 +243,22 @@ func (h *WebSocketConnHandlerImpl) Read() ([]byte, error) {
 	}
 
 	_, message, err := h.conn.ReadMessage()
+
+	// Track consecutive read errors so that an endpoint that repeatedly fails to be read from is
+	// backed off, and the next dial fails over to a different endpoint.
+	if h.active < len(h.health) {
+		health := &h.health[h.active]
+		if err != nil {
+			health.readErrs++
+			if health.readErrs == max(1, h.cfg.MaxReadErrorCount) {
+				h.recordFailure(h.active)
+			}
+		} else {
+			health.readErrs = 0
+			health.failures = 0
+		}
+	}
+
 	return message, err
 }
 
@@ -175,6 +313,10 @@ func (h *WebSocketConnHandlerImpl) Copy() WebSocketConnHandler {
 	return &WebSocketConnHandlerImpl{
 		cfg:         h.cfg,
 		preDialHook: h.preDialHook,
+		metrics:     h.metrics,
+		health:      make([]endpointHealth, len(h.cfg.Endpoints)),
+		backoff:     h.backoff,
+		maxBackoff:  h.maxBackoff,
 	}
 }
 
@@ -193,3 +335,18 @@ func (h *WebSocketConnHandlerImpl) SetConfig(cfg config.WebSocketConfig) {
 
 	h.cfg = cfg
 }
+
+// redactURL returns the given URL without any credentials or query parameters, which may contain
+// access tokens, so that it can be logged and reported in metrics.
+func redactURL(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return ""
+	}
+
+	u.User = nil
+	u.RawQuery = ""
+	u.Fragment = ""
+
+	return u.String()
+}
@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/config"
+	"github.com/1119-Labs/slinky/providers/base/testutils"
+	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
+	"github.com/1119-Labs/slinky/providers/base/websocket/metrics"
+	mockmetrics "github.com/1119-Labs/slinky/providers/base/websocket/metrics/mocks"
+)
+
+// echo writes every message it reads back to the client.
+func echo(conn *websocket.Conn) {
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		if err := conn.WriteMessage(messageType, message); err != nil {
+			return
+		}
+	}
+}
+
+// hangUp closes every connection as soon as it is accepted.
+func hangUp(*websocket.Conn) {}
+
+func endpointsConfig(servers ...*testutils.WebSocketServer) config.WebSocketConfig {
+	endpointsCfg := cfg
+	endpointsCfg.HandshakeTimeout = time.Second
+	endpointsCfg.ReadTimeout = time.Second
+
+	endpointsCfg.Endpoints = make([]config.Endpoint, len(servers))
+	for i, server := range servers {
+		endpointsCfg.Endpoints[i] = config.Endpoint{URL: server.URL()}
+	}
+
+	return endpointsCfg
+}
+
+func TestWebSocketConnHandlerEndpoints(t *testing.T) {
+	t.Run("dials the first endpoint if it is healthy", func(t *testing.T) {
+		primary := testutils.NewWebSocketServer(t, echo)
+		secondary := testutils.NewWebSocketServer(t, echo)
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("SetWebSocketActiveEndpoint", name, primary.URL()).Once()
+
+		h, err := handlers.NewWebSocketHandlerImpl(endpointsConfig(primary, secondary), handlers.WithMetrics(m))
+		require.NoError(t, err)
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, primary.URL(), h.ActiveEndpoint())
+
+		require.NoError(t, h.Write(testMessage))
+		message, err := h.Read()
+		require.NoError(t, err)
+		require.Equal(t, testMessage, message)
+		require.NoError(t, h.Close())
+
+		require.Equal(t, 1, primary.Connections())
+		require.Equal(t, 0, secondary.Connections())
+	})
+
+	t.Run("fails over to the next endpoint if the active endpoint cannot be dialed", func(t *testing.T) {
+		primary := testutils.NewWebSocketServer(t, echo)
+		secondary := testutils.NewWebSocketServer(t, echo)
+		primary.SetDown(true)
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("AddWebSocketConnectionStatus", name, metrics.EndpointFailover).Once()
+		m.On("SetWebSocketActiveEndpoint", name, secondary.URL()).Twice()
+
+		h, err := handlers.NewWebSocketHandlerImpl(
+			endpointsConfig(primary, secondary),
+			handlers.WithMetrics(m),
+			handlers.WithEndpointBackoff(time.Hour, time.Hour),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, secondary.URL(), h.ActiveEndpoint())
+		require.NoError(t, h.Close())
+
+		// the primary endpoint is backed off, so it is not dialed again even once it recovers
+		primary.SetDown(false)
+		require.NoError(t, h.Dial())
+		require.Equal(t, secondary.URL(), h.ActiveEndpoint())
+		require.NoError(t, h.Close())
+
+		require.Equal(t, 0, primary.Connections())
+		require.Equal(t, 2, secondary.Connections())
+	})
+
+	t.Run("fails over to the next endpoint after repeated read failures", func(t *testing.T) {
+		primary := testutils.NewWebSocketServer(t, hangUp)
+		secondary := testutils.NewWebSocketServer(t, echo)
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("SetWebSocketActiveEndpoint", name, primary.URL()).Once()
+		m.On("AddWebSocketConnectionStatus", name, metrics.EndpointFailover).Once()
+		m.On("SetWebSocketActiveEndpoint", name, secondary.URL()).Once()
+
+		h, err := handlers.NewWebSocketHandlerImpl(
+			endpointsConfig(primary, secondary),
+			handlers.WithMetrics(m),
+			handlers.WithEndpointBackoff(time.Hour, time.Hour),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, primary.URL(), h.ActiveEndpoint())
+		for i := 0; i < cfg.MaxReadErrorCount; i++ {
+			_, err := h.Read()
+			require.Error(t, err)
+		}
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, secondary.URL(), h.ActiveEndpoint())
+	})
+
+	t.Run("rotates back to a recovered endpoint once its backoff expires", func(t *testing.T) {
+		primary := testutils.NewWebSocketServer(t, echo)
+		secondary := testutils.NewWebSocketServer(t, echo)
+		primary.SetDown(true)
+
+		m := mockmetrics.NewWebSocketMetrics(t)
+		m.On("AddWebSocketConnectionStatus", name, metrics.EndpointFailover).Twice()
+		m.On("SetWebSocketActiveEndpoint", name, mock.Anything).Twice()
+
+		h, err := handlers.NewWebSocketHandlerImpl(
+			endpointsConfig(primary, secondary),
+			handlers.WithMetrics(m),
+			handlers.WithEndpointBackoff(50*time.Millisecond, 50*time.Millisecond),
+		)
+		require.NoError(t, err)
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, secondary.URL(), h.ActiveEndpoint())
+		require.NoError(t, h.Close())
+
+		// the secondary endpoint degrades while the primary endpoint recovers
+		primary.SetDown(false)
+		secondary.SetDown(true)
+		time.Sleep(100 * time.Millisecond)
+
+		require.NoError(t, h.Dial())
+		require.Equal(t, primary.URL(), h.ActiveEndpoint())
+		require.NoError(t, h.Close())
+	})
+
+	t.Run("returns an error if no endpoint can be dialed", func(t *testing.T) {
+		primary := testutils.NewWebSocketServer(t, echo)
+		secondary := testutils.NewWebSocketServer(t, echo)
+		primary.SetDown(true)
+		secondary.SetDown(true)
+
+		h, err := handlers.NewWebSocketHandlerImpl(endpointsConfig(primary, secondary))
+		require.NoError(t, err)
+
+		require.Error(t, h.Dial())
+
+		// every endpoint is backed off, so the endpoint whose backoff expires first is dialed
+		primary.SetDown(false)
+		require.NoError(t, h.Dial())
+		require.Equal(t, primary.URL(), h.ActiveEndpoint())
+		require.NoError(t, h.Close())
+	})
+}
@@ -30,6 +30,11 @@ func (_m *WebSocketMetrics) ObserveWebSocketLatency(provider string, duration ti
 	_m.Called(provider, duration)
 }
 
+// SetWebSocketActiveEndpoint provides a mock function with given fields: provider, endpoint
+func (_m *WebSocketMetrics) SetWebSocketActiveEndpoint(provider string, endpoint string) {
+	_m.Called(provider, endpoint)
+}
+
 // NewWebSocketMetrics creates a new instance of WebSocketMetrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketMetrics(t interface {
@@ -13,6 +13,8 @@ import (
 const (
 	// StatusLabel is the label used for the status of a provider response.
 	StatusLabel = "status"
+	// EndpointLabel is the label used for the endpoint of a web socket connection.
+	EndpointLabel = "endpoint"
 )
 
 // WebSocketMetrics is an interface that defines the API for metrics collection for providers
@@ -31,6 +33,9 @@ type WebSocketMetrics interface {
 	// ObserveWebSocketLatency adds a latency observation to the metrics collector for the
 	// given provider.
 	ObserveWebSocketLatency(provider string, duration time.Duration)
+
+	// SetWebSocketActiveEndpoint sets the endpoint that the given provider most recently connected to.
+	SetWebSocketActiveEndpoint(provider, endpoint string)
 }
 
 // WebSocketMetricsImpl contains metrics exposed by this package.
@@ -43,6 +48,9 @@ type WebSocketMetricsImpl struct {
 
 	// Histogram paginated by provider, measuring the latency between invocation and collection.
 	responseTimePerProvider *prometheus.HistogramVec
+
+	// Gauge set to 1 for the endpoint that each provider most recently connected to.
+	activeEndpointPerProvider *prometheus.GaugeVec
 }
 
 // NewWebSocketMetricsFromConfig returns a new Metrics struct given the main oracle metrics config.
@@ -72,12 +80,18 @@ func NewWebSocketMetrics() WebSocketMetrics {
 			Help:      "Response time per web socket provider.",
 			Buckets:   []float64{50, 100, 250, 500, 1000, 2000},
 		}, []string{providermetrics.ProviderLabel}),
+		activeEndpointPerProvider: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: oraclemetrics.OracleSubsystem,
+			Name:      "web_socket_active_endpoint",
+			Help:      "The endpoint that each web socket provider most recently connected to.",
+		}, []string{providermetrics.ProviderLabel, EndpointLabel}),
 	}
 
 	// register the above metrics
 	prometheus.MustRegister(m.connectionStatusPerProvider)
 	prometheus.MustRegister(m.dataHandlerStatusPerProvider)
 	prometheus.MustRegister(m.responseTimePerProvider)
+	prometheus.MustRegister(m.activeEndpointPerProvider)
 
 	return m
 }
@@ -98,6 +112,9 @@ func (m *noOpWebSocketMetricsImpl) AddWebSocketDataHandlerStatus(_ string, _ Han
 func (m *noOpWebSocketMetricsImpl) ObserveWebSocketLatency(_ string, _ time.Duration) {
 }
 
+func (m *noOpWebSocketMetricsImpl) SetWebSocketActiveEndpoint(_, _ string) {
+}
+
 // AddWebSocketConnectionStatus adds a method / status response to the metrics collector for the
 // given provider. Specifically, this tracks various connection related errors.
 func (m *WebSocketMetricsImpl) AddWebSocketConnectionStatus(provider string, status ConnectionStatus) {
@@ -125,3 +142,15 @@ func (m *WebSocketMetricsImpl) ObserveWebSocketLatency(provider string, duration
 	},
 	).Observe(float64(duration.Milliseconds()))
 }
+
+// SetWebSocketActiveEndpoint sets the endpoint that the given provider most recently connected to.
+func (m *WebSocketMetricsImpl) SetWebSocketActiveEndpoint(provider, endpoint string) {
+	m.activeEndpointPerProvider.DeletePartialMatch(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+	})
+	m.activeEndpointPerProvider.With(prometheus.Labels{
+		providermetrics.ProviderLabel: provider,
+		EndpointLabel:                 endpoint,
+	},
+	).Set(1)
+}
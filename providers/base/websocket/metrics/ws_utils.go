@@ -29,6 +29,9 @@ const (
 	Healthy
 	// Unhealthy indicates that the provider is unhealthy.
 	Unhealthy
+	// EndpointFailover indicates that the provider connected to a different endpoint than the one
+	// it was previously connected to.
+	EndpointFailover
 )
 
 const (
@@ -77,6 +80,8 @@ func (s ConnectionStatus) String() string {
 		return "healthy"
 	case Unhealthy:
 		return "unhealthy"
+	case EndpointFailover:
+		return "endpoint_failover"
 	default:
 		return "unknown_status"
 	}
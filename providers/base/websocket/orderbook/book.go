@@ -0,0 +1,266 @@
+package orderbook
+
+import (
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+
+	"github.com/1119-Labs/slinky/pkg/math"
+)
+
+// Level is a price level of an order book.
+type Level struct {
+	// Price is the price of the level.
+	Price *big.Float
+
+	// Size is the total size, in units of the base currency, of the orders at the price. A size
+	// of zero in an update removes the level from the book.
+	Size *big.Float
+
+	// RawPrice and RawSize are the decimal price and size strings sent by the provider, which
+	// some providers compute the checksums of their order books from. They are set by NewLevel.
+	RawPrice string
+	RawSize  string
+}
+
+// NewLevel returns a new Level from the decimal price and size strings sent by a provider.
+func NewLevel(price, size string) (Level, error) {
+	p, err := math.Float64StringToBigFloat(price)
+	if err != nil {
+		return Level{}, fmt.Errorf("invalid price level price: %w", err)
+	}
+
+	s, err := math.Float64StringToBigFloat(size)
+	if err != nil {
+		return Level{}, fmt.Errorf("invalid price level size: %w", err)
+	}
+
+	if p.Sign() <= 0 || s.Sign() < 0 {
+		return Level{}, fmt.Errorf("invalid price level %s @ %s", size, price)
+	}
+
+	return Level{Price: p, Size: s, RawPrice: price, RawSize: size}, nil
+}
+
+// DefaultMaxLevels is the default maximum number of levels kept on each side of a Book.
+const DefaultMaxLevels = 500
+
+// Book is a local L2 order book that is built from a snapshot of the provider's order book and
+// kept up to date by applying the subsequent updates (diffs) to it. Each side of the book is kept
+// sorted from the best to the worst price, and is capped at a maximum number of levels, beyond
+// which the worst levels are dropped. Book is not thread safe.
+type Book struct {
+	// bids are sorted from the best (highest) to the worst price, and asks from the best (lowest)
+	// to the worst price.
+	bids []Level
+	asks []Level
+
+	// maxLevels is the maximum number of levels kept on each side of the book.
+	maxLevels int
+
+	// sequence is the sequence number of the last snapshot or update applied to the book, for
+	// providers that sequence their order book updates.
+	sequence int64
+
+	// synced is true once a snapshot has been applied to the book.
+	synced bool
+}
+
+// NewBook returns a new empty Book that keeps at most maxLevels levels on each side. If maxLevels
+// is not positive, DefaultMaxLevels is used. The book must be synced with a snapshot before updates
+// can be applied to it.
+func NewBook(maxLevels int) *Book {
+	if maxLevels <= 0 {
+		maxLevels = DefaultMaxLevels
+	}
+
+	return &Book{
+		maxLevels: maxLevels,
+	}
+}
+
+// Synced returns true if a snapshot has been applied to the book.
+func (b *Book) Synced() bool {
+	return b.synced
+}
+
+// Sequence returns the sequence number of the last snapshot or update applied to the book.
+func (b *Book) Sequence() int64 {
+	return b.sequence
+}
+
+// MaxLevels returns the maximum number of levels kept on each side of the book.
+func (b *Book) MaxLevels() int {
+	return b.maxLevels
+}
+
+// Bids returns the bids of the book, sorted from the best (highest) to the worst price.
+func (b *Book) Bids() []Level {
+	return slices.Clone(b.bids)
+}
+
+// Asks returns the asks of the book, sorted from the best (lowest) to the worst price.
+func (b *Book) Asks() []Level {
+	return slices.Clone(b.asks)
+}
+
+// Reset clears the book. The book must be synced with a new snapshot before updates can be
+// applied to it again.
+func (b *Book) Reset() {
+	b.bids = nil
+	b.asks = nil
+	b.sequence = 0
+	b.synced = false
+}
+
+// ApplySnapshot replaces the contents of the book with the given snapshot.
+func (b *Book) ApplySnapshot(bids, asks []Level, sequence int64) {
+	b.Reset()
+	b.bids = b.setLevels(b.bids, bids, true)
+	b.asks = b.setLevels(b.asks, asks, false)
+	b.sequence = sequence
+	b.synced = true
+}
+
+// ApplyUpdate applies the given update to the book. Levels with a size of zero are removed, and
+// all other levels replace the level at the same price. An error is returned if the book has not
+// been synced with a snapshot.
+func (b *Book) ApplyUpdate(bids, asks []Level, sequence int64) error {
+	if !b.synced {
+		return fmt.Errorf("cannot apply update to an order book that has not been synced")
+	}
+
+	b.bids = b.setLevels(b.bids, bids, true)
+	b.asks = b.setLevels(b.asks, asks, false)
+	b.sequence = sequence
+	return nil
+}
+
+// Price returns the price of the book using the given pricing method.
+func (b *Book) Price(cfg Config) (*big.Float, error) {
+	switch cfg.Pricing {
+	case PricingMid:
+		return b.MidPrice()
+	case PricingDepthWeighted:
+		return b.DepthWeightedPrice(cfg.DepthNotional)
+	default:
+		return nil, fmt.Errorf("order book cannot be priced with %s pricing", cfg.Pricing)
+	}
+}
+
+// MidPrice returns the mid-price of the best bid and best ask of the book.
+func (b *Book) MidPrice() (*big.Float, error) {
+	bids, asks, err := b.sides()
+	if err != nil {
+		return nil, err
+	}
+
+	return mid(bids[0].Price, asks[0].Price), nil
+}
+
+// DepthWeightedPrice returns the mid-price of the average price at which the given notional, in
+// units of the quote currency, could be bought from the asks and the average price at which it
+// could be sold into the bids. An error is returned if either side of the book does not have
+// enough depth to fill the notional.
+func (b *Book) DepthWeightedPrice(notional *big.Float) (*big.Float, error) {
+	if notional == nil || notional.Sign() <= 0 {
+		return nil, fmt.Errorf("depth notional must be positive")
+	}
+
+	bids, asks, err := b.sides()
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := averageFillPrice(bids, notional)
+	if err != nil {
+		return nil, fmt.Errorf("bids: %w", err)
+	}
+
+	ask, err := averageFillPrice(asks, notional)
+	if err != nil {
+		return nil, fmt.Errorf("asks: %w", err)
+	}
+
+	return mid(bid, ask), nil
+}
+
+// sides returns the bids sorted from best (highest) to worst, and the asks sorted from best
+// (lowest) to worst. An error is returned if the book is not synced, either side is empty, or
+// the book is crossed.
+func (b *Book) sides() ([]Level, []Level, error) {
+	if !b.synced {
+		return nil, nil, fmt.Errorf("order book has not been synced")
+	}
+
+	if len(b.bids) == 0 || len(b.asks) == 0 {
+		return nil, nil, fmt.Errorf("order book has no bids or no asks")
+	}
+
+	if b.bids[0].Price.Cmp(b.asks[0].Price) >= 0 {
+		return nil, nil, fmt.Errorf("order book is crossed: best bid %s >= best ask %s", b.bids[0].Price, b.asks[0].Price)
+	}
+
+	return b.bids, b.asks, nil
+}
+
+// averageFillPrice returns the average price at which the given notional is filled by walking the
+// given levels in order.
+func averageFillPrice(levels []Level, notional *big.Float) (*big.Float, error) {
+	var (
+		remaining = new(big.Float).Set(notional)
+		filled    = new(big.Float)
+	)
+	for _, level := range levels {
+		levelNotional := new(big.Float).Mul(level.Price, level.Size)
+		if levelNotional.Cmp(remaining) >= 0 {
+			filled.Add(filled, new(big.Float).Quo(remaining, level.Price))
+			return new(big.Float).Quo(notional, filled), nil
+		}
+
+		filled.Add(filled, level.Size)
+		remaining.Sub(remaining, levelNotional)
+	}
+
+	return nil, fmt.Errorf("insufficient depth to fill notional %s", notional)
+}
+
+// setLevels applies the given levels to the given side of the book, which is sorted from the best to
+// the worst price, i.e. in descending order for bids. Levels with a size of zero are removed, and the
+// worst levels beyond the maximum number of levels are dropped.
+func (b *Book) setLevels(side []Level, levels []Level, descending bool) []Level {
+	for _, level := range levels {
+		i, found := sort.Find(len(side), func(i int) int {
+			if descending {
+				return side[i].Price.Cmp(level.Price)
+			}
+			return level.Price.Cmp(side[i].Price)
+		})
+
+		switch {
+		case found && level.Size.Sign() == 0:
+			side = append(side[:i], side[i+1:]...)
+		case found:
+			side[i] = level
+		case level.Size.Sign() == 0, i >= b.maxLevels:
+			// The level is not in the book, or is worse than every level kept in the book.
+			continue
+		default:
+			side = append(side, Level{})
+			copy(side[i+1:], side[i:])
+			side[i] = level
+		}
+
+		if len(side) > b.maxLevels {
+			side = side[:b.maxLevels]
+		}
+	}
+
+	return side
+}
+
+func mid(a, b *big.Float) *big.Float {
+	sum := new(big.Float).Add(a, b)
+	return sum.Quo(sum, big.NewFloat(2))
+}
@@ -0,0 +1,257 @@
+package orderbook_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
+)
+
+func levels(t *testing.T, priceSizes ...string) []orderbook.Level {
+	t.Helper()
+
+	require.Zero(t, len(priceSizes)%2)
+	out := make([]orderbook.Level, 0, len(priceSizes)/2)
+	for i := 0; i < len(priceSizes); i += 2 {
+		level, err := orderbook.NewLevel(priceSizes[i], priceSizes[i+1])
+		require.NoError(t, err)
+		out = append(out, level)
+	}
+
+	return out
+}
+
+// requireLevels requires that the given levels have the given prices and sizes, in order.
+func requireLevels(t *testing.T, actual []orderbook.Level, priceSizes ...string) {
+	t.Helper()
+
+	formatted := make([]string, 0, 2*len(actual))
+	for _, level := range actual {
+		formatted = append(formatted, level.Price.Text('f', -1), level.Size.Text('f', -1))
+	}
+	require.Equal(t, priceSizes, formatted)
+}
+
+func requirePrice(t *testing.T, expected float64, actual *big.Float) {
+	t.Helper()
+
+	f, _ := actual.Float64()
+	require.InDelta(t, expected, f, 1e-9)
+}
+
+func TestBook(t *testing.T) {
+	t.Run("cannot price or update a book that has not been synced", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		require.False(t, book.Synced())
+
+		_, err := book.MidPrice()
+		require.Error(t, err)
+
+		require.Error(t, book.ApplyUpdate(levels(t, "99", "1"), nil, 1))
+	})
+
+	t.Run("prices a snapshot at the mid-price", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		book.ApplySnapshot(
+			levels(t, "98", "1", "99", "1"),
+			levels(t, "102", "1", "101", "1"),
+			10,
+		)
+		require.True(t, book.Synced())
+		require.Equal(t, int64(10), book.Sequence())
+
+		price, err := book.MidPrice()
+		require.NoError(t, err)
+		requirePrice(t, 100, price)
+	})
+
+	t.Run("applies updates to the book", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		book.ApplySnapshot(
+			levels(t, "98", "1", "99", "1"),
+			levels(t, "101", "1", "102", "1"),
+			10,
+		)
+
+		// remove the best bid and add a new best ask
+		require.NoError(t, book.ApplyUpdate(levels(t, "99", "0"), levels(t, "100", "2"), 11))
+		require.Equal(t, int64(11), book.Sequence())
+
+		price, err := book.MidPrice()
+		require.NoError(t, err)
+		requirePrice(t, 99, price)
+	})
+
+	t.Run("a snapshot replaces the book", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		book.ApplySnapshot(levels(t, "1", "1"), levels(t, "3", "1"), 1)
+		book.ApplySnapshot(levels(t, "10", "1"), levels(t, "30", "1"), 2)
+
+		price, err := book.MidPrice()
+		require.NoError(t, err)
+		requirePrice(t, 20, price)
+	})
+
+	t.Run("returns an error for an empty or crossed book", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		book.ApplySnapshot(levels(t, "99", "1"), nil, 1)
+		_, err := book.MidPrice()
+		require.Error(t, err)
+
+		book.ApplySnapshot(levels(t, "101", "1"), levels(t, "100", "1"), 1)
+		_, err = book.MidPrice()
+		require.Error(t, err)
+	})
+
+	t.Run("prices the book at the depth weighted price", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		book.ApplySnapshot(
+			levels(t, "100", "1", "90", "10"),
+			levels(t, "110", "1", "120", "10"),
+			1,
+		)
+
+		// 280 is filled by 1 @ 100 and 2 @ 90 on the bids, and 1 @ 110 and 1.4167 @ 120 on the asks
+		price, err := book.DepthWeightedPrice(big.NewFloat(280))
+		require.NoError(t, err)
+		requirePrice(t, (280.0/3+280.0/(1+170.0/120))/2, price)
+
+		// a notional within the top of the book is priced at the mid-price
+		price, err = book.DepthWeightedPrice(big.NewFloat(50))
+		require.NoError(t, err)
+		requirePrice(t, 105, price)
+
+		_, err = book.DepthWeightedPrice(big.NewFloat(10000))
+		require.Error(t, err)
+	})
+
+	t.Run("keeps each side sorted and capped at the max levels", func(t *testing.T) {
+		book := orderbook.NewBook(3)
+		require.Equal(t, 3, book.MaxLevels())
+
+		book.ApplySnapshot(
+			levels(t, "96", "1", "99", "1", "97", "1", "98", "1"),
+			levels(t, "104", "1", "101", "1", "103", "1", "102", "1"),
+			1,
+		)
+		requireLevels(t, book.Bids(), "99", "1", "98", "1", "97", "1")
+		requireLevels(t, book.Asks(), "101", "1", "102", "1", "103", "1")
+
+		// levels worse than every kept level are ignored, better levels push out the worst level
+		// (which is dropped for good), and removing a level frees up space for the next update
+		require.NoError(t, book.ApplyUpdate(
+			levels(t, "95", "1", "99.5", "2", "98", "0"),
+			levels(t, "105", "1", "100", "2", "102", "3"),
+			2,
+		))
+		requireLevels(t, book.Bids(), "99.5", "2", "99", "1")
+		requireLevels(t, book.Asks(), "100", "2", "101", "1", "102", "3")
+
+		require.NoError(t, book.ApplyUpdate(levels(t, "99", "0", "96", "4", "97", "1"), nil, 3))
+		requireLevels(t, book.Bids(), "99.5", "2", "97", "1", "96", "4")
+	})
+
+	t.Run("uses the default max levels if none is given", func(t *testing.T) {
+		require.Equal(t, orderbook.DefaultMaxLevels, orderbook.NewBook(0).MaxLevels())
+	})
+
+	t.Run("resetting the book requires a new snapshot", func(t *testing.T) {
+		book := orderbook.NewBook(orderbook.DefaultMaxLevels)
+		book.ApplySnapshot(levels(t, "99", "1"), levels(t, "101", "1"), 1)
+		book.Reset()
+
+		require.False(t, book.Synced())
+		_, err := book.MidPrice()
+		require.Error(t, err)
+	})
+}
+
+func TestNewLevel(t *testing.T) {
+	_, err := orderbook.NewLevel("abc", "1")
+	require.Error(t, err)
+
+	_, err = orderbook.NewLevel("0", "1")
+	require.Error(t, err)
+
+	_, err = orderbook.NewLevel("1", "-1")
+	require.Error(t, err)
+
+	level, err := orderbook.NewLevel("1.5", "0")
+	require.NoError(t, err)
+	require.Zero(t, level.Size.Sign())
+}
+
+func TestConfigFromTicker(t *testing.T) {
+	testCases := []struct {
+		name     string
+		json     string
+		expected orderbook.Config
+		err      bool
+	}{
+		{
+			name:     "no metadata",
+			json:     "",
+			expected: orderbook.Config{Pricing: orderbook.PricingLastTrade},
+		},
+		{
+			name:     "metadata without order book pricing",
+			json:     `{"address":"0x1"}`,
+			expected: orderbook.Config{Pricing: orderbook.PricingLastTrade},
+		},
+		{
+			name:     "mid pricing",
+			json:     `{"order_book_pricing":"mid"}`,
+			expected: orderbook.Config{Pricing: orderbook.PricingMid},
+		},
+		{
+			name: "depth weighted pricing",
+			json: `{"order_book_pricing":"depth_weighted","order_book_depth_notional":"10000"}`,
+			expected: orderbook.Config{
+				Pricing:       orderbook.PricingDepthWeighted,
+				DepthNotional: big.NewFloat(10000),
+			},
+		},
+		{
+			name: "depth weighted pricing without a notional",
+			json: `{"order_book_pricing":"depth_weighted"}`,
+			err:  true,
+		},
+		{
+			name: "depth weighted pricing with a negative notional",
+			json: `{"order_book_pricing":"depth_weighted","order_book_depth_notional":"-1"}`,
+			err:  true,
+		},
+		{
+			name: "unknown pricing",
+			json: `{"order_book_pricing":"vwap"}`,
+			err:  true,
+		},
+		{
+			name: "invalid metadata",
+			json: `{`,
+			err:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := orderbook.ConfigFromTicker(types.NewProviderTicker("BTC-USDT", tc.json))
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected.Pricing, cfg.Pricing)
+			if tc.expected.DepthNotional == nil {
+				require.Nil(t, cfg.DepthNotional)
+			} else {
+				require.Zero(t, tc.expected.DepthNotional.Cmp(cfg.DepthNotional))
+			}
+			require.Equal(t, tc.expected.UsesOrderBook(), cfg.UsesOrderBook())
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package orderbook
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/1119-Labs/slinky/oracle/types"
+	"github.com/1119-Labs/slinky/pkg/math"
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+// Pricing is the method used to price a ticker.
+type Pricing string
+
+const (
+	// PricingLastTrade prices a ticker at the last trade (or ticker) price reported by the provider.
+	// This does not require an order book.
+	PricingLastTrade Pricing = "last_trade"
+	// PricingMid prices a ticker at the mid-price of the best bid and best ask of the order book.
+	PricingMid Pricing = "mid"
+	// PricingDepthWeighted prices a ticker at the mid-price of the average prices at which the
+	// configured notional could be bought from the asks and sold into the bids of the order book.
+	PricingDepthWeighted Pricing = "depth_weighted"
+)
+
+// Config is the order book pricing configuration of a provider ticker.
+type Config struct {
+	// Pricing is the method used to price the ticker.
+	Pricing Pricing
+
+	// DepthNotional is the notional, in units of the quote currency, that the depth weighted
+	// price is calculated at.
+	DepthNotional *big.Float
+}
+
+// UsesOrderBook returns true if the ticker is priced using an order book.
+func (c Config) UsesOrderBook() bool {
+	return c.Pricing != PricingLastTrade
+}
+
+// ConfigFromTicker returns the order book pricing configuration in the JSON metadata of the given
+// provider ticker. Tickers without an order book configuration are priced at the last trade price.
+func ConfigFromTicker(ticker types.ProviderTicker) (Config, error) {
+	cfg := Config{Pricing: PricingLastTrade}
+	if len(ticker.GetJSON()) == 0 {
+		return cfg, nil
+	}
+
+	metadata, err := tickermetadata.OrderBookFromJSONString(ticker.GetJSON())
+	if err != nil {
+		return cfg, fmt.Errorf("failed to unmarshal order book metadata for %s: %w", ticker, err)
+	}
+
+	switch pricing := Pricing(metadata.Pricing); pricing {
+	case "", PricingLastTrade:
+		return cfg, nil
+	case PricingMid:
+		cfg.Pricing = pricing
+		return cfg, nil
+	case PricingDepthWeighted:
+		notional, err := math.Float64StringToBigFloat(metadata.DepthNotional)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid order book depth notional for %s: %w", ticker, err)
+		}
+
+		if notional.Sign() <= 0 {
+			return cfg, fmt.Errorf("order book depth notional for %s must be positive", ticker)
+		}
+
+		cfg.Pricing = pricing
+		cfg.DepthNotional = notional
+		return cfg, nil
+	default:
+		return cfg, fmt.Errorf("unknown order book pricing %s for %s", metadata.Pricing, ticker)
+	}
+}
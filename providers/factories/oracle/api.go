@@ -15,10 +15,13 @@ import (
 	coinbaseapi "github.com/1119-Labs/slinky/providers/apis/coinbase"
 	"github.com/1119-Labs/slinky/providers/apis/coingecko"
 	"github.com/1119-Labs/slinky/providers/apis/coinmarketcap"
+	"github.com/1119-Labs/slinky/providers/apis/defi/balancer"
+	"github.com/1119-Labs/slinky/providers/apis/defi/curve"
 	"github.com/1119-Labs/slinky/providers/apis/defi/osmosis"
 	"github.com/1119-Labs/slinky/providers/apis/defi/raydium"
 	"github.com/1119-Labs/slinky/providers/apis/defi/uniswapv3"
 	"github.com/1119-Labs/slinky/providers/apis/geckoterminal"
+	"github.com/1119-Labs/slinky/providers/apis/jsonapi"
 	"github.com/1119-Labs/slinky/providers/apis/kraken"
 	"github.com/1119-Labs/slinky/providers/apis/polymarket"
 	apihandlers "github.com/1119-Labs/slinky/providers/base/api/handlers"
@@ -86,6 +89,10 @@ func APIQueryHandlerFactory(
 		apiDataHandler, err = kraken.NewAPIHandler(cfg.API)
 	case strings.HasPrefix(providerName, uniswapv3.BaseName):
 		apiPriceFetcher, err = uniswapv3.NewPriceFetcher(ctx, logger, metrics, cfg.API)
+	case curve.IsValidProviderName(providerName):
+		apiPriceFetcher, err = curve.NewPriceFetcher(ctx, logger, metrics, cfg.API)
+	case balancer.IsValidProviderName(providerName):
+		apiPriceFetcher, err = balancer.NewPriceFetcher(ctx, logger, metrics, cfg.API)
 	case providerName == static.Name:
 		apiDataHandler = static.NewAPIHandler()
 		requestHandler = static.NewStaticMockClient()
@@ -98,6 +105,8 @@ func APIQueryHandlerFactory(
 		apiPriceFetcher, err = osmosis.NewAPIPriceFetcher(logger, cfg.API, metrics)
 	case providerName == polymarket.Name:
 		apiDataHandler, err = polymarket.NewAPIHandler(cfg.API)
+	case jsonapi.IsValidProviderName(providerName):
+		apiDataHandler, err = jsonapi.NewAPIHandler(cfg.API)
 	default:
 		return nil, fmt.Errorf("unknown provider: %s", cfg.Name)
 	}
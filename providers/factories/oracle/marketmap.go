@@ -69,6 +69,13 @@ func MarketMapProviderFactory(
 			logger,
 		)
 		ids = []types.Chain{{ChainID: perpx.ChainID}}
+	case marketmap.IncrementalName:
+		marketMapFetcher, err = marketmap.NewIncrementalMarketMapFetcher(
+			logger,
+			cfg.API,
+			apiMetrics,
+		)
+		ids = []types.Chain{{ChainID: "local-node"}}
 	default:
 		marketMapFetcher, err = marketmap.NewMarketMapFetcher(
 			logger,
@@ -40,6 +40,10 @@ func WebSocketQueryHandlerFactory(
 		return nil, err
 	}
 
+	if wsMetrics == nil {
+		return nil, fmt.Errorf("websocket metrics is nil")
+	}
+
 	// Create the underlying client that can be utilized by websocket providers that need to
 	// interact with an API.
 	client := &http.Client{
@@ -58,7 +62,18 @@ func WebSocketQueryHandlerFactory(
 
 	switch cfg.Name {
 	case binance.Name:
-		wsDataHandler, err = binance.NewWebSocketDataHandler(logger, cfg.WebSocket)
+		// The request handler is used to fetch the order book snapshots of tickers that are priced
+		// using the order book.
+		requestHandler, err = apihandlers.NewRequestHandlerImpl(client)
+		if err != nil {
+			return nil, err
+		}
+
+		wsDataHandler, err = binance.NewWebSocketDataHandler(
+			logger,
+			cfg.WebSocket,
+			binance.WithDepthSnapshots(cfg.API, requestHandler),
+		)
 	case bitfinex.Name:
 		wsDataHandler, err = bitfinex.NewWebSocketDataHandler(logger, cfg.WebSocket)
 	case bitstamp.Name:
@@ -95,6 +110,7 @@ func WebSocketQueryHandlerFactory(
 		connHandler, err = wshandlers.NewWebSocketHandlerImpl(
 			cfg.WebSocket,
 			wshandlers.WithPreDialHook(kucoin.PreDialHook(cfg.API, requestHandler)),
+			wshandlers.WithMetrics(wsMetrics),
 		)
 	case mexc.Name:
 		wsDataHandler, err = mexc.NewWebSocketDataHandler(logger, cfg.WebSocket)
@@ -109,7 +125,7 @@ func WebSocketQueryHandlerFactory(
 
 	// If a custom request handler is not provided, create a new default one.
 	if connHandler == nil {
-		connHandler, err = wshandlers.NewWebSocketHandlerImpl(cfg.WebSocket)
+		connHandler, err = wshandlers.NewWebSocketHandlerImpl(cfg.WebSocket, wshandlers.WithMetrics(wsMetrics))
 		if err != nil {
 			return nil, err
 		}
@@ -17,6 +17,7 @@ func FilterMarketMapToProviders(mm mmtypes.MarketMap) map[string]mmtypes.MarketM
 		for _, pc := range market.ProviderConfigs {
 			// remove normalizations to isolate markets
 			pc.NormalizeByPair = nil
+			pc.NormalizeByPairs = nil
 
 			// create a market from the given provider config
 			isolatedMarket := mmtypes.Market{
@@ -28,6 +29,9 @@ func FilterMarketMapToProviders(mm mmtypes.MarketMap) map[string]mmtypes.MarketM
 
 			// always enable and set minprovider count to 1 so that it can be run isolated
 			isolatedMarket.Ticker.Enabled = true
+			if isolatedMarket.Ticker.State != "" && !mmtypes.IsEnabledMarketState(isolatedMarket.Ticker.State) {
+				isolatedMarket.Ticker.State = mmtypes.MarketStateActive
+			}
 			isolatedMarket.Ticker.MinProviderCount = 1
 
 			// init mm if necessary
@@ -27,6 +27,11 @@ func (s *MockClient) Do(_ context.Context, _ string) (*http.Response, error) {
 	}, nil
 }
 
+// DoRequest is a no-op.
+func (s *MockClient) DoRequest(ctx context.Context, _ handlers.Request) (*http.Response, error) {
+	return s.Do(ctx, "")
+}
+
 // Type returns the HTTP method used to send requests.
 func (s *MockClient) Type() string {
 	return http.MethodGet
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"time"
+)
+
+// MaxRecentErrors is the maximum number of recent failed responses tracked for each provider.
+const MaxRecentErrors = 10
+
+type (
+	// ProviderError is a failed response of a provider for a given ID.
+	ProviderError struct {
+		// ID is the ID that the provider failed to fetch data for.
+		ID string
+		// Code is the error code of the failed response.
+		Code ErrorCode
+		// Timestamp is the time at which the failed response was received.
+		Timestamp time.Time
+	}
+
+	// ProviderHealth describes the recent responses of a provider.
+	ProviderHealth struct {
+		// LastSuccess is the time at which the provider last received a successful response. It is
+		// zero if the provider has not received a successful response.
+		LastSuccess time.Time
+		// RecentErrors are the most recent failed responses of the provider (at most MaxRecentErrors),
+		// ordered from oldest to newest.
+		RecentErrors []ProviderError
+	}
+)
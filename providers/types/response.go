@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 )
 
@@ -56,6 +57,12 @@ type ResolvedResult[V ResponseValue] struct {
 	// ResponseCode is an optional code that can be attached to responses to provide
 	// additional context.
 	ResponseCode ResponseCode
+	// Volume is the optional trading volume of the requested ID over the provider's
+	// reporting window (typically 24h), denominated in the base asset.
+	Volume *big.Float
+	// Liquidity is the optional liquidity available for the requested ID, denominated
+	// in the base asset. This is typically reported by DeFi pools.
+	Liquidity *big.Float
 }
 
 // UnresolvedResult is an unresolved (failed) result of a single requested ID.
@@ -122,6 +129,18 @@ func NewResultWithCode[V ResponseValue](value V, timestamp time.Time, code Respo
 	}
 }
 
+// WithVolume returns a copy of the ResolvedResult with the given volume.
+func (r ResolvedResult[V]) WithVolume(volume *big.Float) ResolvedResult[V] {
+	r.Volume = volume
+	return r
+}
+
+// WithLiquidity returns a copy of the ResolvedResult with the given liquidity.
+func (r ResolvedResult[V]) WithLiquidity(liquidity *big.Float) ResolvedResult[V] {
+	r.Liquidity = liquidity
+	return r
+}
+
 // String returns a string representation of the ResolvedResult. This is mostly used for logging
 // and testing purposes.
 func (r ResolvedResult[V]) String() string {
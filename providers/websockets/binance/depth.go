@@ -0,0 +1,131 @@
+package binance
+
+import (
+	"sync"
+	"time"
+
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
+)
+
+// depthUpdate is a parsed diff. depth update of a symbol.
+type depthUpdate struct {
+	// firstUpdateID and finalUpdateID are the IDs of the first and final updates in the event.
+	firstUpdateID int64
+	finalUpdateID int64
+
+	bids []orderbook.Level
+	asks []orderbook.Level
+}
+
+// depthBook is the local order book of a symbol that is priced using the order book, along with
+// the state of syncing it with a snapshot from the REST API. The snapshot is fetched off of the
+// websocket read path, and the updates received in the meantime are buffered so that they can be
+// applied on top of it.
+type depthBook struct {
+	book *orderbook.Book
+	cfg  orderbook.Config
+
+	// buffered holds the updates received while the book is being synced, oldest first.
+	buffered []depthUpdate
+
+	// gaps is the number of consecutive re-syncs due to missed updates, where re-syncs are
+	// consecutive if they are less than DepthResyncMaxBackoff apart, and lastGap is the time of
+	// the last one.
+	gaps    int
+	lastGap time.Time
+
+	// mtx guards the fields below, which are shared with the goroutine fetching the snapshot.
+	mtx sync.Mutex
+
+	// fetching is true while a snapshot is being fetched.
+	fetching bool
+	// snapshot and err hold the result of the last snapshot fetch, until it is consumed.
+	snapshot *DepthSnapshotResponse
+	err      error
+	// resyncAt is the time before which a new snapshot is not fetched.
+	resyncAt time.Time
+}
+
+// newDepthBook returns a new unsynced depthBook that is priced with the given config.
+func newDepthBook(cfg orderbook.Config) *depthBook {
+	return &depthBook{
+		book: orderbook.NewBook(DepthSnapshotLimit),
+		cfg:  cfg,
+	}
+}
+
+// buffer buffers the given update until the book is synced. The oldest updates are dropped beyond
+// MaxBufferedDepthUpdates, in which case a snapshot that predates them cannot be used.
+func (b *depthBook) buffer(update depthUpdate) {
+	b.buffered = append(b.buffered, update)
+	if len(b.buffered) > MaxBufferedDepthUpdates {
+		b.buffered = b.buffered[len(b.buffered)-MaxBufferedDepthUpdates:]
+	}
+}
+
+// startFetch marks the book as fetching a snapshot and returns true, unless a snapshot is already
+// being fetched, a fetched snapshot has not been consumed yet, or the book is backing off from
+// re-syncs.
+func (b *depthBook) startFetch(now time.Time) bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.fetching || b.snapshot != nil || b.err != nil || now.Before(b.resyncAt) {
+		return false
+	}
+
+	b.fetching = true
+	return true
+}
+
+// finishFetch records the result of a snapshot fetch.
+func (b *depthBook) finishFetch(snapshot DepthSnapshotResponse, err error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.fetching = false
+	if err != nil {
+		b.err = err
+		return
+	}
+
+	b.snapshot = &snapshot
+}
+
+// result consumes the result of the last snapshot fetch. False is returned if there is no result.
+func (b *depthBook) result() (*DepthSnapshotResponse, bool, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	snapshot, err := b.snapshot, b.err
+	b.snapshot, b.err = nil, nil
+	return snapshot, snapshot != nil || err != nil, err
+}
+
+// resync discards the order book after an update was missed, so that it is re-synced with a new
+// snapshot. The first re-sync is immediate, but consecutive re-syncs back off exponentially from
+// DepthResyncInitialBackoff up to DepthResyncMaxBackoff.
+func (b *depthBook) resync(now time.Time) {
+	b.book.Reset()
+	b.buffered = nil
+
+	if now.Sub(b.lastGap) > DepthResyncMaxBackoff {
+		b.gaps = 0
+	}
+	b.gaps++
+	b.lastGap = now
+
+	var backoff time.Duration
+	if b.gaps > 1 {
+		backoff = DepthResyncInitialBackoff
+		for i := 2; i < b.gaps && backoff < DepthResyncMaxBackoff; i++ {
+			backoff *= 2
+		}
+		backoff = min(backoff, DepthResyncMaxBackoff)
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.resyncAt = now.Add(backoff)
+}
@@ -38,6 +38,16 @@ const (
 	// ref: https://developers.binance.com/docs/binance-spot-api-docs/web-socket-streams#individual-symbol-ticker-streams
 	TickerStream StreamType = "ticker"
 
+	// DepthStream represents the diff. depth stream. This provides the changes to the order book
+	// of a single symbol, which are applied to a local order book that is synced with a snapshot
+	// from the REST API.
+	//
+	// ref: https://developers.binance.com/docs/binance-spot-api-docs/web-socket-streams#diff-depth-stream
+	DepthStream StreamType = "depth"
+
+	// DepthStreamSpeed is the update speed of the diff. depth stream.
+	DepthStreamSpeed = "100ms"
+
 	// Separator is the separator used to separate the instrument and the stream type.
 	Separator = "@"
 )
@@ -124,10 +134,11 @@ type StreamMessageResponse struct {
 	Stream string `json:"stream"`
 }
 
-// GetStreamType returns the stream type from the stream message response.
+// GetStreamType returns the stream type from the stream message response. Any options of the
+// stream, such as the update speed of the diff. depth stream, are ignored.
 func (m *StreamMessageResponse) GetStreamType() StreamType {
 	stream := strings.Split(m.Stream, Separator)
-	if len(stream) != 2 {
+	if len(stream) < 2 {
 		return ""
 	}
 	return StreamType(stream[1])
@@ -200,6 +211,8 @@ type TickerMessageResponse struct {
 		Ticker string `json:"s"`
 		// LastPrice is the last price.
 		LastPrice string `json:"c"`
+		// Volume is the total traded base asset volume over the last 24 hours.
+		Volume string `json:"v"`
 		// StatisticsCloseTime is the statistics close time.
 		//
 		// Note: This is unused but is included since json.Unmarshal requires all fields with same character but different casing
@@ -208,8 +221,81 @@ type TickerMessageResponse struct {
 	} `json:"data"`
 }
 
+// DepthUpdateMessageResponse represents a diff. depth message response. This is used to represent
+// the changes to the order book of a symbol that are received from the Binance websocket.
+//
+// # Response
+//
+//	{
+//	  	"e": "depthUpdate", // Event type
+//	  	"E": 1672515782136, // Event time
+//	  	"s": "BNBBTC",      // Symbol
+//	  	"U": 157,           // First update ID in event
+//	  	"u": 160,           // Final update ID in event
+//	  	"b": [              // Bids to be updated
+//	  	  [
+//	  	    "0.0024",       // Price level to be updated
+//	  	    "10"            // Quantity
+//	  	  ]
+//	  	],
+//	  	"a": [              // Asks to be updated
+//	  	  [
+//	  	    "0.0026",       // Price level to be updated
+//	  	    "100"           // Quantity
+//	  	  ]
+//	  	]
+//	}
+//
+// ref: https://developers.binance.com/docs/binance-spot-api-docs/web-socket-streams#diff-depth-stream
+type DepthUpdateMessageResponse struct {
+	Data struct {
+		// Ticker is the symbol.
+		Ticker string `json:"s"`
+		// FirstUpdateID is the ID of the first update in the event.
+		FirstUpdateID int64 `json:"U"`
+		// FinalUpdateID is the ID of the final update in the event.
+		FinalUpdateID int64 `json:"u"`
+		// Bids are the bid levels to be updated, formatted as [price, quantity].
+		Bids [][]string `json:"b"`
+		// Asks are the ask levels to be updated, formatted as [price, quantity].
+		Asks [][]string `json:"a"`
+	} `json:"data"`
+}
+
+// DepthSnapshotResponse represents the order book snapshot of a symbol that is returned by the
+// Binance REST API.
+//
+// # Response
+//
+//	{
+//	  	"lastUpdateId": 1027024,
+//	  	"bids": [
+//	  	  [
+//	  	    "4.00000000",     // Price
+//	  	    "431.00000000"    // Quantity
+//	  	  ]
+//	  	],
+//	  	"asks": [
+//	  	  [
+//	  	    "4.00000200",
+//	  	    "12.00000000"
+//	  	  ]
+//	  	]
+//	}
+//
+// ref: https://developers.binance.com/docs/binance-spot-api-docs/rest-api/market-data-endpoints#order-book
+type DepthSnapshotResponse struct {
+	// LastUpdateID is the ID of the last update included in the snapshot.
+	LastUpdateID int64 `json:"lastUpdateId"`
+	// Bids are the bid levels of the snapshot, formatted as [price, quantity].
+	Bids [][]string `json:"bids"`
+	// Asks are the ask levels of the snapshot, formatted as [price, quantity].
+	Asks [][]string `json:"asks"`
+}
+
 // NewSubscribeRequestMessage returns a set of messages to subscribe to the Binance websocket. This will
-// subscribe each instrument to the aggregate trade and ticker streams.
+// subscribe each instrument to the aggregate trade and ticker streams, or to the diff. depth stream if
+// the instrument is priced using the order book.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]handlers.WebsocketEncodedMessage, error) {
 	numInstruments := len(instruments)
 	if numInstruments == 0 {
@@ -227,6 +313,11 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(instruments []string) ([]h
 		// Create the subscriptions for the instruments.
 		params := make([]string, 0)
 		for _, instrument := range batch {
+			if _, ok := h.books[instrument]; ok {
+				params = append(params, strings.Join([]string{strings.ToLower(instrument), string(DepthStream), DepthStreamSpeed}, Separator))
+				continue
+			}
+
 			params = append(params, fmt.Sprintf("%s%s%s", strings.ToLower(instrument), Separator, string(AggregateTradeStream)))
 			params = append(params, fmt.Sprintf("%s%s%s", strings.ToLower(instrument), Separator, string(TickerStream)))
 		}
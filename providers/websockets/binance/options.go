@@ -0,0 +1,31 @@
+package binance
+
+import (
+	"github.com/1119-Labs/slinky/oracle/config"
+	apihandlers "github.com/1119-Labs/slinky/providers/base/api/handlers"
+	apimetrics "github.com/1119-Labs/slinky/providers/base/api/metrics"
+)
+
+// Option is a function that is used to configure the Binance WebSocketHandler.
+type Option func(*WebSocketHandler)
+
+// WithDepthSnapshots is an option that is used to set the API config and request handler that are
+// used to fetch the order book snapshots of tickers that are priced using the order book. The
+// snapshots are requested within the rate limit of the API config. Without it, such tickers are not
+// subscribed to.
+func WithDepthSnapshots(api config.APIConfig, requestHandler apihandlers.RequestHandler) Option {
+	return func(h *WebSocketHandler) {
+		if requestHandler == nil {
+			panic("request handler cannot be nil")
+		}
+
+		rateLimiter, err := apihandlers.NewRateLimiter(api.Name, api.RateLimit, apimetrics.NewNopAPIMetrics())
+		if err != nil {
+			panic(err)
+		}
+
+		h.api = api
+		h.requestHandler = requestHandler
+		h.rateLimiter = rateLimiter
+	}
+}
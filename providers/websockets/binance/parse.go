@@ -1,18 +1,25 @@
 package binance
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
+	"go.uber.org/zap"
+
 	providertypes "github.com/1119-Labs/slinky/providers/types"
 
 	"github.com/1119-Labs/slinky/oracle/types"
 	"github.com/1119-Labs/slinky/pkg/math"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
 )
 
 // parsePriceUpdateMessage parses a price update message from the Binance websocket feed.
-// This is repurposed for ticker and aggregate trade messages.
-func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price string) (types.PriceResponse, error) {
+// This is repurposed for ticker and aggregate trade messages. The volume is optional, as it
+// is only included in ticker messages.
+func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker, price, volume string) (types.PriceResponse, error) {
 	var (
 		resolved   = make(types.ResolvedPrices)
 		unResolved = make(types.UnResolvedPrices)
@@ -33,6 +40,229 @@ func (h *WebSocketHandler) parsePriceUpdateMessage(offChainTicker string, price
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
-	resolved[ticker] = types.NewPriceResult(priceFloat, time.Now().UTC())
+	result := types.NewPriceResult(priceFloat, time.Now().UTC())
+	if volumeFloat, err := math.Float64StringToBigFloat(volume); err == nil {
+		result = result.WithVolume(volumeFloat)
+	}
+
+	resolved[ticker] = result
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
+
+// parseDepthUpdateMessage parses a diff. depth message from the Binance websocket feed, applies it
+// to the local order book of the ticker, and prices the ticker using the order book. Following the
+// Binance procedure, the order book is synced with a snapshot from the REST API, which is fetched in
+// the background while the updates are buffered. Once the snapshot is available, it is applied along
+// with the buffered updates that it does not include. The order book is re-synced if an update is
+// missed. The ticker is not priced while the order book is being synced.
+//
+// ref: https://developers.binance.com/docs/binance-spot-api-docs/web-socket-streams#how-to-manage-a-local-order-book-correctly
+func (h *WebSocketHandler) parseDepthUpdateMessage(msg DepthUpdateMessageResponse) (types.PriceResponse, error) {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+		data       = msg.Data
+	)
+
+	ticker, ok := h.cache.FromOffChainTicker(data.Ticker)
+	if !ok {
+		return types.NewPriceResponse(resolved, unResolved),
+			fmt.Errorf("got response for an unsupported market %s", data.Ticker)
+	}
+
+	book, ok := h.books[data.Ticker]
+	if !ok {
+		return types.NewPriceResponse(resolved, unResolved),
+			fmt.Errorf("no order book for market %s", data.Ticker)
+	}
+
+	bids, err := parseBookLevels(data.Bids)
+	if err != nil {
+		return types.NewPriceResponse(resolved, unResolved), fmt.Errorf("failed to parse bids: %w", err)
+	}
+
+	asks, err := parseBookLevels(data.Asks)
+	if err != nil {
+		return types.NewPriceResponse(resolved, unResolved), fmt.Errorf("failed to parse asks: %w", err)
+	}
+
+	synced, err := h.applyDepthUpdate(book, data.Ticker, depthUpdate{
+		firstUpdateID: data.FirstUpdateID,
+		finalUpdateID: data.FinalUpdateID,
+		bids:          bids,
+		asks:          asks,
+	})
+	if err != nil {
+		unResolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(err, providertypes.ErrorInvalidResponse),
+		}
+		return types.NewPriceResponse(resolved, unResolved), nil
+	}
+
+	if !synced {
+		h.logger.Debug("awaiting order book snapshot", zap.String("ticker", data.Ticker))
+		return types.NewPriceResponse(resolved, unResolved), nil
+	}
+
+	price, err := book.book.Price(book.cfg)
+	if err != nil {
+		wErr := fmt.Errorf("failed to price order book for %s: %w", data.Ticker, err)
+		unResolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+		}
+		return types.NewPriceResponse(resolved, unResolved), nil
+	}
+
+	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	return types.NewPriceResponse(resolved, unResolved), nil
+}
+
+// applyDepthUpdate applies the given update to the order book of the symbol. If the order book is
+// not synced, the update is buffered and the order book is synced if its snapshot is available.
+// Returns true if the order book is synced.
+func (h *WebSocketHandler) applyDepthUpdate(book *depthBook, symbol string, update depthUpdate) (bool, error) {
+	if !book.book.Synced() {
+		book.buffer(update)
+		return h.syncBook(book, symbol)
+	}
+
+	return true, h.applySyncedDepthUpdate(book, symbol, update)
+}
+
+// applySyncedDepthUpdate applies the given update to the synced order book of the symbol. Updates
+// that are already included in the order book are ignored. If an update was missed, the order book
+// is discarded so that it is re-synced.
+func (h *WebSocketHandler) applySyncedDepthUpdate(book *depthBook, symbol string, update depthUpdate) error {
+	// The update is already included in the order book.
+	if update.finalUpdateID <= book.book.Sequence() {
+		return nil
+	}
+
+	if update.firstUpdateID > book.book.Sequence()+1 {
+		err := fmt.Errorf(
+			"missed updates %d to %d of the order book for %s; awaiting a new snapshot",
+			book.book.Sequence()+1, update.firstUpdateID-1, symbol,
+		)
+		book.resync(time.Now())
+		return err
+	}
+
+	return book.book.ApplyUpdate(update.bids, update.asks, update.finalUpdateID)
+}
+
+// syncBook syncs the order book of the given symbol with its snapshot, if the snapshot has been
+// fetched, and applies the buffered updates on top of it. Otherwise, the snapshot is fetched in the
+// background. Returns true if the order book is synced.
+func (h *WebSocketHandler) syncBook(book *depthBook, symbol string) (bool, error) {
+	snapshot, ok, err := book.result()
+	if !ok {
+		h.fetchDepthSnapshot(book, symbol)
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch order book snapshot for %s: %w", symbol, err)
+	}
+
+	// The snapshot predates the buffered updates, so the updates in between are missing.
+	if first := book.buffered[0].firstUpdateID; snapshot.LastUpdateID+1 < first {
+		h.logger.Debug(
+			"order book snapshot predates the buffered updates; fetching a new snapshot",
+			zap.String("ticker", symbol),
+			zap.Int64("last_update_id", snapshot.LastUpdateID),
+			zap.Int64("first_update_id", first),
+		)
+		h.fetchDepthSnapshot(book, symbol)
+		return false, nil
+	}
+
+	bids, err := parseBookLevels(snapshot.Bids)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse order book snapshot bids for %s: %w", symbol, err)
+	}
+
+	asks, err := parseBookLevels(snapshot.Asks)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse order book snapshot asks for %s: %w", symbol, err)
+	}
+
+	book.book.ApplySnapshot(bids, asks, snapshot.LastUpdateID)
+
+	buffered := book.buffered
+	book.buffered = nil
+	for _, update := range buffered {
+		if err := h.applySyncedDepthUpdate(book, symbol, update); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// fetchDepthSnapshot fetches the order book snapshot of the given symbol from the REST API in the
+// background, unless it is already being fetched or the order book is backing off from re-syncs.
+// The request is made within the request budget of the REST API.
+func (h *WebSocketHandler) fetchDepthSnapshot(book *depthBook, symbol string) {
+	if !book.startFetch(time.Now()) {
+		return
+	}
+
+	go func() {
+		snapshot, err := h.requestDepthSnapshot(symbol)
+		book.finishFetch(snapshot, err)
+	}()
+}
+
+// requestDepthSnapshot requests the order book snapshot of the given symbol from the REST API.
+func (h *WebSocketHandler) requestDepthSnapshot(symbol string) (DepthSnapshotResponse, error) {
+	if len(h.api.Endpoints) == 0 {
+		return DepthSnapshotResponse{}, fmt.Errorf("no endpoints provided")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DepthSnapshotMaxWait)
+	defer cancel()
+
+	if err := h.rateLimiter.Wait(ctx); err != nil {
+		return DepthSnapshotResponse{}, fmt.Errorf("failed to wait for the request budget: %w", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), h.api.Timeout)
+	defer cancel()
+
+	endpoint := fmt.Sprintf(DepthSnapshotEndpoint, h.api.Endpoints[0].URL, symbol, DepthSnapshotLimit)
+	httpResp, err := h.requestHandler.Do(ctx, endpoint)
+	h.rateLimiter.Record(httpResp, err)
+	if err != nil {
+		return DepthSnapshotResponse{}, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return DepthSnapshotResponse{}, fmt.Errorf("http request for order book snapshot failed with status %s", httpResp.Status)
+	}
+
+	var resp DepthSnapshotResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return DepthSnapshotResponse{}, err
+	}
+
+	return resp, nil
+}
+
+// parseBookLevels parses the given order book levels, formatted as [price, quantity].
+func parseBookLevels(levels [][]string) ([]orderbook.Level, error) {
+	parsed := make([]orderbook.Level, len(levels))
+	for i, level := range levels {
+		if len(level) < 2 {
+			return nil, fmt.Errorf("invalid order book level %v", level)
+		}
+
+		l, err := orderbook.NewLevel(level[0], level[1])
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = l
+	}
+
+	return parsed, nil
+}
@@ -11,6 +11,27 @@ var (
 	Name = "binance_ws"
 	// WSS is the WSS for the Binance exchange WebSocket API.
 	WSS = "wss://stream.binance.com/stream"
+	// URL is the URL of the Binance exchange REST API. This is used to fetch the order book
+	// snapshots of tickers that are priced using the order book.
+	URL = "https://api.binance.com"
+	// DepthSnapshotEndpoint is the endpoint of the Binance exchange REST API that returns the
+	// order book snapshot of a symbol, formatted with the URL, the symbol and the limit.
+	//
+	// ref: https://developers.binance.com/docs/binance-spot-api-docs/rest-api/market-data-endpoints#order-book
+	DepthSnapshotEndpoint = "%s/api/v3/depth?symbol=%s&limit=%d"
+	// DepthSnapshotLimit is the number of levels on each side of the order book snapshot. The
+	// local order book is capped at the same number of levels.
+	DepthSnapshotLimit = 1000
+	// DepthSnapshotMaxWait is the maximum amount of time that an order book snapshot request waits
+	// for the request budget of the REST API before it is abandoned.
+	DepthSnapshotMaxWait = 30 * time.Second
+	// MaxBufferedDepthUpdates is the maximum number of diff. depth updates of a symbol that are
+	// buffered while its order book snapshot is fetched.
+	MaxBufferedDepthUpdates = 1000
+	// DepthResyncInitialBackoff and DepthResyncMaxBackoff bound how long the order book of a symbol
+	// waits before it is re-synced after consecutive missed updates.
+	DepthResyncInitialBackoff = 1 * time.Second
+	DepthResyncMaxBackoff     = 1 * time.Minute
 	// DefaultMaxSubscriptionsPerConnection is the default maximum number of subscriptions
 	// per connection. By default, Binance accepts up to 1024 subscriptions per connection.
 	// However, we limit this to 40 to prevent overloading the connection.
@@ -46,3 +67,21 @@ var DefaultWebSocketConfig = config.WebSocketConfig{
 	MaxSubscriptionsPerConnection: DefaultMaxSubscriptionsPerConnection,
 	MaxSubscriptionsPerBatch:      config.DefaultMaxSubscriptionsPerBatch,
 }
+
+// DefaultAPIConfig is the default API config for the Binance exchange WebSocket. This is only
+// utilized to fetch the order book snapshots of tickers that are priced using the order book.
+var DefaultAPIConfig = config.APIConfig{
+	Enabled:    false,
+	Timeout:    5 * time.Second,
+	Interval:   1 * time.Minute, // This is not used.
+	MaxQueries: 1,               // This is not used.
+	Endpoints:  []config.Endpoint{{URL: URL}},
+	Name:       Name,
+	// A snapshot of 1000 levels has a request weight of 50, out of the 6000 that Binance allows
+	// per minute and IP, so snapshots are limited to half of the budget.
+	RateLimit: config.RateLimitConfig{
+		RequestsPerMinute: 60,
+		InitialBackoff:    1 * time.Second,
+		MaxBackoff:        1 * time.Minute,
+	},
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/1119-Labs/slinky/oracle/config"
 	"github.com/1119-Labs/slinky/oracle/types"
+	apihandlers "github.com/1119-Labs/slinky/providers/base/api/handlers"
 	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
 )
 
 var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
@@ -27,12 +29,24 @@ type WebSocketHandler struct {
 	messageIDs map[int64][]string
 	// nextID is the next message ID to use for the Binance websocket API.
 	nextID int64
+
+	// api is the config for the Binance REST API, which is used to fetch order book snapshots.
+	api config.APIConfig
+	// requestHandler is used to fetch order book snapshots from the Binance REST API.
+	requestHandler apihandlers.RequestHandler
+	// rateLimiter enforces the request budget of the Binance REST API across the order book
+	// snapshots of every connection.
+	rateLimiter *apihandlers.RateLimiter
+	// books maintains the local order books, and their pricing configuration, of the tickers
+	// that are priced using the order book, keyed by symbol.
+	books map[string]*depthBook
 }
 
 // NewWebSocketDataHandler returns a new Binance PriceWebSocketDataHandler.
 func NewWebSocketDataHandler(
 	logger *zap.Logger,
 	ws config.WebSocketConfig,
+	opts ...Option,
 ) (types.PriceWebSocketDataHandler, error) {
 	if ws.Name != Name {
 		return nil, fmt.Errorf("expected websocket config name %s, got %s", Name, ws.Name)
@@ -46,13 +60,20 @@ func NewWebSocketDataHandler(
 		return nil, fmt.Errorf("invalid websocket config for %s: %w", Name, err)
 	}
 
-	return &WebSocketHandler{
+	h := &WebSocketHandler{
 		logger:     logger,
 		ws:         ws,
 		cache:      types.NewProviderTickers(),
 		messageIDs: make(map[int64][]string),
 		nextID:     rand.Int63() + 1,
-	}, nil
+		books:      make(map[string]*depthBook),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h, nil
 }
 
 // HandleMessage is used to handle a message received from the data provider. The Binance websocket
@@ -63,6 +84,10 @@ func NewWebSocketDataHandler(
 //  2. StreamMessageResponse: This is a response to a stream message. The stream message contains
 //     the latest price of a ticker - either received when a trade is made or an automated price
 //     update is received.
+//  3. DepthUpdateMessageResponse: This is a stream message that contains the changes to the order
+//     book of a ticker that is priced using the order book. The changes are applied to the local
+//     order book, which is synced with a snapshot from the REST API that is fetched in the
+//     background upon the first message, and re-synced whenever an update is missed.
 //
 // Heartbeat messages are handled by default by the gorilla websocket library. The Binance websocket
 // API does not require any additional heartbeat messages to be sent. The pong frames are sent
@@ -113,7 +138,7 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		h.logger.Debug("received ticker message", zap.String("ticker", tickerResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice)
+		resp, err := h.parsePriceUpdateMessage(tickerResp.Data.Ticker, tickerResp.Data.LastPrice, tickerResp.Data.Volume)
 		return resp, nil, err
 	case AggregateTradeStream:
 		// Aggregate trade stream is sent when a trade is executed on the Binance exchange.
@@ -123,7 +148,17 @@ func (h *WebSocketHandler) HandleMessage(
 		}
 
 		h.logger.Debug("received aggregate trade message", zap.String("ticker", aggTradeResp.Data.Ticker))
-		resp, err := h.parsePriceUpdateMessage(aggTradeResp.Data.Ticker, aggTradeResp.Data.Price)
+		resp, err := h.parsePriceUpdateMessage(aggTradeResp.Data.Ticker, aggTradeResp.Data.Price, "")
+		return resp, nil, err
+	case DepthStream:
+		// Diff. depth stream is sent every 100ms and contains the changes to the order book.
+		var depthResp DepthUpdateMessageResponse
+		if err := json.Unmarshal(message, &depthResp); err != nil {
+			return resp, nil, fmt.Errorf("failed to unmarshal depth update message %w", err)
+		}
+
+		h.logger.Debug("received depth update message", zap.String("ticker", depthResp.Data.Ticker))
+		resp, err := h.parseDepthUpdateMessage(depthResp)
 		return resp, nil, err
 	default:
 		return resp, nil, fmt.Errorf("unknown stream type %s", streamMsg.Stream)
@@ -133,13 +168,33 @@ func (h *WebSocketHandler) HandleMessage(
 // CreateMessages is used to create a message to send to Binance. This is used to subscribe to
 // the given tickers. This is called when the connection to the data provider is first established.
 // Notably, the tickers have a unique identifier that is used to identify the messages going back
-// and forth. This unique identifier is the same one sent in the initial subscription.
+// and forth. This unique identifier is the same one sent in the initial subscription. Tickers
+// whose metadata configures order book pricing are subscribed to on the diff. depth stream, if
+// order book snapshots are configured, and are skipped otherwise.
 func (h *WebSocketHandler) CreateMessages(
 	tickers []types.ProviderTicker,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	instruments := make([]string, 0)
 
 	for _, ticker := range tickers {
+		cfg, err := orderbook.ConfigFromTicker(ticker)
+		if err != nil {
+			h.logger.Error("invalid order book configuration; skipping ticker", zap.Error(err))
+			continue
+		}
+
+		if cfg.UsesOrderBook() {
+			if h.requestHandler == nil {
+				h.logger.Error(
+					"order book snapshots are not configured; skipping ticker",
+					zap.String("ticker", ticker.GetOffChainTicker()),
+				)
+				continue
+			}
+
+			h.books[ticker.GetOffChainTicker()] = newDepthBook(cfg)
+		}
+
 		instruments = append(instruments, ticker.GetOffChainTicker())
 		h.cache.Add(ticker)
 	}
@@ -158,10 +213,14 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger:     h.logger,
-		ws:         h.ws,
-		cache:      types.NewProviderTickers(),
-		messageIDs: make(map[int64][]string),
-		nextID:     rand.Int63() + 1,
+		logger:         h.logger,
+		ws:             h.ws,
+		cache:          types.NewProviderTickers(),
+		messageIDs:     make(map[int64][]string),
+		nextID:         rand.Int63() + 1,
+		api:            h.api,
+		requestHandler: h.requestHandler,
+		rateLimiter:    h.rateLimiter,
+		books:          make(map[string]*depthBook),
 	}
 }
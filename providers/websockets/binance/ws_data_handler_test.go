@@ -4,13 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"github.com/1119-Labs/slinky/oracle/config"
 	"github.com/1119-Labs/slinky/oracle/types"
+	apimocks "github.com/1119-Labs/slinky/providers/base/api/handlers/mocks"
+	"github.com/1119-Labs/slinky/providers/base/testutils"
 	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
 	providertypes "github.com/1119-Labs/slinky/providers/types"
 	"github.com/1119-Labs/slinky/providers/websockets/binance"
@@ -21,6 +26,8 @@ var (
 	btcusdt = types.NewProviderTicker("BTCUSDT", "")
 	ethusdt = types.NewProviderTicker("ETHUSDT", "")
 	mogusdt = types.NewProviderTicker("MOGUSDT", "")
+
+	btcusdtMid = types.NewProviderTicker("BTCUSDT", `{"order_book_pricing":"mid"}`)
 )
 
 func TestHandleMessage(t *testing.T) {
@@ -111,6 +118,7 @@ func TestHandleMessage(t *testing.T) {
 					"data": {
 						"s": "btcusdt",
 						"c": "10000.00000000",
+						"v": "250.50000000",
 						"C": 1600000000000
 						}
 				}`
@@ -120,7 +128,8 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusdt: {
-						Value: big.NewFloat(10000.0),
+						Value:  big.NewFloat(10000.0),
+						Volume: big.NewFloat(250.5),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -292,6 +301,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume == nil {
+					require.Nil(t, resp.Resolved[cp].Volume)
+				} else {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
@@ -310,6 +324,7 @@ func TestCreateMessages(t *testing.T) {
 		name        string
 		ticker      []types.ProviderTicker
 		cfg         config.WebSocketConfig
+		opts        []binance.Option
 		expected    func() []binance.SubscribeMessageRequest
 		expectedErr bool
 	}{
@@ -426,11 +441,55 @@ func TestCreateMessages(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "tickers priced using the order book are subscribed to on the diff. depth stream",
+			ticker: []types.ProviderTicker{
+				btcusdtMid,
+				ethusdt,
+			},
+			cfg:  batchCfg,
+			opts: []binance.Option{binance.WithDepthSnapshots(binance.DefaultAPIConfig, apimocks.NewRequestHandler(t))},
+			expected: func() []binance.SubscribeMessageRequest {
+				return []binance.SubscribeMessageRequest{
+					{
+						Method: string(binance.SubscribeMethod),
+						Params: []string{
+							"btcusdt@depth@100ms",
+							"ethusdt@aggTrade",
+							"ethusdt@ticker",
+						},
+						ID: 1,
+					},
+				}
+			},
+			expectedErr: false,
+		},
+		{
+			name: "tickers priced using the order book are skipped without order book snapshots",
+			ticker: []types.ProviderTicker{
+				btcusdtMid,
+				ethusdt,
+			},
+			cfg: binance.DefaultWebSocketConfig,
+			expected: func() []binance.SubscribeMessageRequest {
+				return []binance.SubscribeMessageRequest{
+					{
+						Method: string(binance.SubscribeMethod),
+						Params: []string{
+							"ethusdt@aggTrade",
+							"ethusdt@ticker",
+						},
+						ID: 1,
+					},
+				}
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			handler, err := binance.NewWebSocketDataHandler(logger, tc.cfg)
+			handler, err := binance.NewWebSocketDataHandler(logger, tc.cfg, tc.opts...)
 			require.NoError(t, err)
 
 			actual, err := handler.CreateMessages(tc.ticker)
@@ -457,3 +516,153 @@ func TestCreateMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleDepthMessage(t *testing.T) {
+	snapshotURL := fmt.Sprintf(binance.DepthSnapshotEndpoint, binance.URL, "BTCUSDT", binance.DepthSnapshotLimit)
+	snapshot := func(lastUpdateID int64, bids, asks [][]string) *http.Response {
+		bz, err := json.Marshal(binance.DepthSnapshotResponse{
+			LastUpdateID: lastUpdateID,
+			Bids:         bids,
+			Asks:         asks,
+		})
+		require.NoError(t, err)
+
+		resp := testutils.CreateResponseFromJSON(string(bz))
+		resp.StatusCode = http.StatusOK
+		return resp
+	}
+	depthUpdate := func(firstUpdateID, finalUpdateID int64, bids, asks [][]string) []byte {
+		var msg binance.DepthUpdateMessageResponse
+		msg.Data.Ticker = "BTCUSDT"
+		msg.Data.FirstUpdateID = firstUpdateID
+		msg.Data.FinalUpdateID = finalUpdateID
+		msg.Data.Bids = bids
+		msg.Data.Asks = asks
+
+		bz, err := json.Marshal(struct {
+			Stream string `json:"stream"`
+			binance.DepthUpdateMessageResponse
+		}{
+			Stream:                     "btcusdt@depth@100ms",
+			DepthUpdateMessageResponse: msg,
+		})
+		require.NoError(t, err)
+		return bz
+	}
+
+	newHandler := func(api config.APIConfig) (types.PriceWebSocketDataHandler, *apimocks.RequestHandler) {
+		requestHandler := apimocks.NewRequestHandler(t)
+		wsHandler, err := binance.NewWebSocketDataHandler(
+			logger,
+			binance.DefaultWebSocketConfig,
+			binance.WithDepthSnapshots(api, requestHandler),
+		)
+		require.NoError(t, err)
+
+		_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdtMid})
+		require.NoError(t, err)
+
+		return wsHandler, requestHandler
+	}
+
+	// awaitPrice handles the given message until the order book is priced, as the snapshot is
+	// fetched in the background.
+	awaitPrice := func(wsHandler types.PriceWebSocketDataHandler, msg []byte) types.PriceResponse {
+		var resp types.PriceResponse
+		require.Eventually(t, func() bool {
+			var err error
+			resp, _, err = wsHandler.HandleMessage(msg)
+			require.NoError(t, err)
+			return len(resp.Resolved) > 0 || len(resp.UnResolved) > 0
+		}, 5*time.Second, 10*time.Millisecond)
+
+		return resp
+	}
+
+	t.Run("does not price the order book if the snapshot cannot be fetched", func(t *testing.T) {
+		// back off for longer than a snapshot request waits for the request budget, so that the
+		// snapshot is not requested again
+		api := binance.DefaultAPIConfig
+		api.RateLimit = config.RateLimitConfig{InitialBackoff: time.Hour, MaxBackoff: time.Hour}
+		wsHandler, requestHandler := newHandler(api)
+		requestHandler.On("Do", mock.Anything, snapshotURL).Return(nil, fmt.Errorf("error")).Once()
+
+		resp := awaitPrice(wsHandler, depthUpdate(95, 100, nil, nil))
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdtMid)
+	})
+
+	wsHandler, requestHandler := newHandler(binance.DefaultAPIConfig)
+
+	t.Run("buffers updates while the snapshot is fetched, and ignores updates included in it", func(t *testing.T) {
+		fetched := make(chan time.Time)
+		requestHandler.On("Do", mock.Anything, snapshotURL).Return(snapshot(
+			100,
+			[][]string{{"99", "1"}, {"98", "2"}},
+			[][]string{{"101", "1"}, {"102", "2"}},
+		), nil).WaitUntil(fetched).Once()
+
+		// the snapshot is fetched without blocking the updates
+		for _, msg := range [][]byte{
+			depthUpdate(95, 100, [][]string{{"97", "1"}}, nil),
+			depthUpdate(101, 101, [][]string{{"99", "0"}}, nil),
+		} {
+			resp, updateMsg, err := wsHandler.HandleMessage(msg)
+			require.NoError(t, err)
+			require.Nil(t, updateMsg)
+			require.Empty(t, resp.Resolved)
+			require.Empty(t, resp.UnResolved)
+		}
+		close(fetched)
+
+		resp := awaitPrice(wsHandler, depthUpdate(102, 102, nil, [][]string{{"100", "1"}}))
+		require.Equal(t, big.NewFloat(99).SetPrec(18), resp.Resolved[btcusdtMid].Value.SetPrec(18))
+	})
+
+	t.Run("applies an update that follows the snapshot", func(t *testing.T) {
+		resp, _, err := wsHandler.HandleMessage(depthUpdate(
+			103, 104,
+			[][]string{{"98", "0"}, {"97", "0"}, {"96", "1"}},
+			nil,
+		))
+		require.NoError(t, err)
+		require.Equal(t, big.NewFloat(98).SetPrec(18), resp.Resolved[btcusdtMid].Value.SetPrec(18))
+	})
+
+	t.Run("resets the order book if an update is missed", func(t *testing.T) {
+		resp, _, err := wsHandler.HandleMessage(depthUpdate(106, 106, [][]string{{"98.5", "1"}}, nil))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdtMid)
+	})
+
+	t.Run("re-syncs the order book with a new snapshot", func(t *testing.T) {
+		requestHandler.On("Do", mock.Anything, snapshotURL).Return(snapshot(
+			110,
+			[][]string{{"97", "1"}},
+			[][]string{{"99", "1"}},
+		), nil).Once()
+
+		resp := awaitPrice(wsHandler, depthUpdate(107, 111, nil, [][]string{{"98", "1"}}))
+		require.Equal(t, big.NewFloat(97.5).SetPrec(18), resp.Resolved[btcusdtMid].Value.SetPrec(18))
+	})
+
+	t.Run("backs off from re-syncing after consecutive missed updates", func(t *testing.T) {
+		resp, _, err := wsHandler.HandleMessage(depthUpdate(120, 120, nil, nil))
+		require.NoError(t, err)
+		require.Contains(t, resp.UnResolved, btcusdtMid)
+
+		// no snapshot is requested while backing off
+		resp, _, err = wsHandler.HandleMessage(depthUpdate(121, 121, nil, nil))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Empty(t, resp.UnResolved)
+		time.Sleep(100 * time.Millisecond)
+		requestHandler.AssertNumberOfCalls(t, "Do", 2)
+	})
+
+	t.Run("returns an error for an invalid level", func(t *testing.T) {
+		_, _, err := wsHandler.HandleMessage(depthUpdate(122, 122, [][]string{{"abc", "1"}}, nil))
+		require.Error(t, err)
+	})
+}
@@ -67,6 +67,11 @@ func (h *WebSocketHandler) parseTickerUpdate(
 		return types.NewPriceResponse(resolved, unresolved), nil
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	result := types.NewPriceResult(price, time.Now().UTC())
+	if volume, err := math.Float64StringToBigFloat(data.Volume24H); err == nil {
+		result = result.WithVolume(volume)
+	}
+
+	resolved[ticker] = result
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
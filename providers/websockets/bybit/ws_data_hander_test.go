@@ -68,6 +68,7 @@ func TestHandlerMessage(t *testing.T) {
 					Data: bybit.TickerUpdateData{
 						Symbol:    "BTCUSDT",
 						LastPrice: "1",
+						Volume24H: "6780.5",
 					},
 				}
 
@@ -79,7 +80,8 @@ func TestHandlerMessage(t *testing.T) {
 			resp: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusdt: {
-						Value: big.NewFloat(1.0),
+						Value:  big.NewFloat(1.0),
+						Volume: big.NewFloat(6780.5),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -187,6 +189,11 @@ func TestHandlerMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume == nil {
+					require.Nil(t, resp.Resolved[cp].Volume)
+				} else {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
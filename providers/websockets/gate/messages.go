@@ -189,4 +189,6 @@ type TickerResult struct {
 	CurrencyPair string `json:"currency_pair"`
 	// Last is the last price of the pair.
 	Last string `json:"last"`
+	// BaseVolume is the base asset volume of the pair over the last 24 hours.
+	BaseVolume string `json:"base_volume"`
 }
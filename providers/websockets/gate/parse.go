@@ -60,6 +60,11 @@ func (h *WebSocketHandler) parseTickerStream(
 		return types.NewPriceResponse(resolved, unresolved), unresolved[ticker]
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	result := types.NewPriceResult(price, time.Now().UTC())
+	if volume, err := math.Float64StringToBigFloat(stream.Result.BaseVolume); err == nil {
+		result = result.WithVolume(volume)
+	}
+
+	resolved[ticker] = result
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
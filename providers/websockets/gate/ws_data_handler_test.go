@@ -97,6 +97,7 @@ func TestHandleMessage(t *testing.T) {
 					Result: gate.TickerResult{
 						CurrencyPair: "BTC_USDT",
 						Last:         "1",
+						BaseVolume:   "9110.5",
 					},
 				}
 
@@ -108,7 +109,8 @@ func TestHandleMessage(t *testing.T) {
 			resp: types.NewPriceResponse(
 				types.ResolvedPrices{
 					btcusdt: {
-						Value: big.NewFloat(1.00),
+						Value:  big.NewFloat(1.00),
+						Volume: big.NewFloat(9110.5),
 					},
 				},
 				types.UnResolvedPrices{},
@@ -226,6 +228,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume == nil {
+					require.Nil(t, resp.Resolved[cp].Volume)
+				} else {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
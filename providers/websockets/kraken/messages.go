@@ -46,6 +46,12 @@ const (
 	//
 	// https://docs.kraken.com/websockets/#message-subscribe
 	SubscribeEvent Event = "subscribe"
+
+	// UnsubscribeEvent is the event name that is used to send an unsubscribe
+	// message to the server.
+	//
+	// https://docs.kraken.com/websockets/#message-unsubscribe
+	UnsubscribeEvent Event = "unsubscribe"
 )
 
 const (
@@ -63,6 +69,10 @@ const (
 	// has received the subscription request.
 	SubscribedStatus Status = "subscribed"
 
+	// UnsubscribedStatus is the status that is sent to the client when the server
+	// has received the unsubscription request.
+	UnsubscribedStatus Status = "unsubscribed"
+
 	// ErrorStatus is the status that is sent to the client when the server has
 	// received the subscription request.
 	ErrorStatus Status = "error"
@@ -73,8 +83,26 @@ const (
 	//
 	// https://docs.kraken.com/websockets/#message-ticker
 	TickerChannel Channel = "ticker"
+
+	// BookChannel is the channel name for the order book channel. This sends a snapshot of the
+	// order book on subscription, followed by incremental updates. The channel name of the
+	// messages includes the subscribed depth, e.g. "book-100".
+	//
+	// https://docs.kraken.com/websockets/#message-book
+	BookChannel Channel = "book"
 )
 
+// BookDepth is the number of levels on each side of the order book that are subscribed to on
+// the book channel. Kraken only sends updates within this depth, so the local order book must
+// be truncated to it.
+const BookDepth = 100
+
+// BookChecksumDepth is the number of levels on each side of the order book that the checksum of
+// an order book update is computed from.
+//
+// ref: https://docs.kraken.com/websockets/#book-checksum
+const BookChecksumDepth = 10
+
 // BaseMessage is the template used to determine the type of message that is
 // received from the server.
 type BaseMessage struct {
@@ -156,18 +184,71 @@ type SubscribeRequestMessage struct {
 type Subscription struct {
 	// Name is the name of the subscription.
 	Name string `json:"name"`
+
+	// Depth is the depth of the order book subscription. This is only used by the book channel.
+	Depth int `json:"depth,omitempty"`
 }
 
 // NewSubscribeRequestMessage returns a new SubscribeRequestMessage with the
-// given asset pairs.
+// given asset pairs. Pairs that are priced using the order book are subscribed
+// to on the book channel, and all other pairs on the ticker channel.
 func (h *WebSocketHandler) NewSubscribeRequestMessage(
 	instruments []string,
 ) ([]handlers.WebsocketEncodedMessage, error) {
-	numInstruments := len(instruments)
-	if numInstruments == 0 {
+	if len(instruments) == 0 {
 		return nil, fmt.Errorf("no instruments specified")
 	}
 
+	var tickerInstruments, bookInstruments []string
+	for _, instrument := range instruments {
+		if _, ok := h.books[instrument]; ok {
+			bookInstruments = append(bookInstruments, instrument)
+		} else {
+			tickerInstruments = append(tickerInstruments, instrument)
+		}
+	}
+
+	msgs, err := h.newSubscribeRequestMessages(tickerInstruments, Subscription{Name: string(TickerChannel)})
+	if err != nil {
+		return msgs, err
+	}
+
+	bookMsgs, err := h.newSubscribeRequestMessages(bookInstruments, Subscription{Name: string(BookChannel), Depth: BookDepth})
+	return append(msgs, bookMsgs...), err
+}
+
+// NewResubscribeBookRequestMessages returns the messages to unsubscribe from and subscribe to the
+// book channel again for the given asset pair, so that a new snapshot of its order book is sent.
+func (h *WebSocketHandler) NewResubscribeBookRequestMessages(
+	instrument string,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	subscription := Subscription{Name: string(BookChannel), Depth: BookDepth}
+	unsubscribe, err := json.Marshal(
+		SubscribeRequestMessage{
+			Event:        string(UnsubscribeEvent),
+			Pair:         []string{instrument},
+			Subscription: subscription,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	subscribe, err := h.newSubscribeRequestMessages([]string{instrument}, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]handlers.WebsocketEncodedMessage{unsubscribe}, subscribe...), nil
+}
+
+// newSubscribeRequestMessages returns the batched messages to subscribe to the given subscription
+// for the given asset pairs.
+func (h *WebSocketHandler) newSubscribeRequestMessages(
+	instruments []string,
+	subscription Subscription,
+) ([]handlers.WebsocketEncodedMessage, error) {
+	numInstruments := len(instruments)
 	numBatches := int(math.Ceil(float64(numInstruments) / float64(h.ws.MaxSubscriptionsPerBatch)))
 	msgs := make([]handlers.WebsocketEncodedMessage, numBatches)
 	for i := 0; i < numBatches; i++ {
@@ -177,11 +258,9 @@ func (h *WebSocketHandler) NewSubscribeRequestMessage(
 
 		bz, err := json.Marshal(
 			SubscribeRequestMessage{
-				Event: string(SubscribeEvent),
-				Pair:  instruments[start:end],
-				Subscription: Subscription{
-					Name: string(TickerChannel),
-				},
+				Event:        string(SubscribeEvent),
+				Pair:         instruments[start:end],
+				Subscription: subscription,
 			},
 		)
 		if err != nil {
@@ -350,3 +429,84 @@ const (
 	// VolumeWeightedAveragePrice array.
 	ExpectedVolumeWeightedAveragePriceLength = 2
 )
+
+// BookResponseMessage is the message that is sent to the client when the server
+// has an order book snapshot or update for the subscribed asset pair. This is
+// specific to the book subscription. The first message after subscribing is a
+// snapshot of the order book:
+//
+//	[
+//		0,								// ChannelID
+//		{
+//			"as": [						// Ask levels
+//				[
+//					"5541.30000",		// Price
+//					"2.50700000",		// Volume
+//					"1534614248.123678"	// Timestamp
+//				]
+//			],
+//			"bs": [						// Bid levels
+//				[
+//					"5541.20000",
+//					"1.52900000",
+//					"1534614248.765567"
+//				]
+//			]
+//		},
+//		"book-100",						// Channel name
+//		"XBT/USD"						// Asset pair
+//	]
+//
+// All following messages are updates, which contain the changed ask ("a") and/or
+// bid ("b") levels, along with a checksum ("c"). An update to both sides is sent
+// as two objects:
+//
+//	[
+//		1234,
+//		{"a": [["5541.30000", "2.50700000", "1534614248.456738"]]},
+//		{"b": [["5541.30000", "0.00000000", "1534614335.345903"]], "c": "974942666"},
+//		"book-100",
+//		"XBT/USD"
+//	]
+//
+// A level with a volume of zero in an update is removed from the book. The checksum is the
+// CRC32 of the top BookChecksumDepth asks, from the lowest price, followed by the top bids, from
+// the highest price, where each level is written as its price and then its volume, without the
+// decimal point and leading zeros.
+//
+// ref: https://docs.kraken.com/websockets/#message-book
+type BookResponseMessage struct {
+	// ChannelID is the channel ID.
+	ChannelID int
+
+	// Snapshot is true if the message is a snapshot of the order book.
+	Snapshot bool
+
+	// Asks and Bids are the ask and bid levels, formatted as [price, volume, timestamp, ...].
+	Asks [][]string
+	Bids [][]string
+
+	// Checksum is the CRC32 checksum of the order book after an update is applied. This is
+	// empty for snapshots.
+	Checksum string
+
+	// ChannelName is the channel name.
+	ChannelName string
+
+	// Pair is the asset pair that was subscribed to.
+	Pair string
+}
+
+// BookData is the order book data of a book response message.
+type BookData struct {
+	// SnapshotAsks and SnapshotBids are the levels of a snapshot.
+	SnapshotAsks [][]string `json:"as"`
+	SnapshotBids [][]string `json:"bs"`
+
+	// Asks and Bids are the levels of an update.
+	Asks [][]string `json:"a"`
+	Bids [][]string `json:"b"`
+
+	// Checksum is the checksum of the order book after the update is applied.
+	Checksum string `json:"c"`
+}
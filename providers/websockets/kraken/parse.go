@@ -3,6 +3,9 @@ package kraken
 import (
 	"encoding/json"
 	"fmt"
+	"hash/crc32"
+	"strconv"
+	"strings"
 	"time"
 
 	providertypes "github.com/1119-Labs/slinky/providers/types"
@@ -12,6 +15,7 @@ import (
 	"github.com/1119-Labs/slinky/oracle/types"
 	"github.com/1119-Labs/slinky/pkg/math"
 	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
 )
 
 // parseBaseMessage will parse message responses from the Kraken websocket API that are
@@ -58,6 +62,10 @@ func (h *WebSocketHandler) parseBaseMessage(message []byte, event Event) ([]hand
 		case SubscribedStatus:
 			h.logger.Debug("received successful subscription status response message", zap.String("ticker", resp.Pair))
 			return nil, nil
+		case UnsubscribedStatus:
+			// Order books are unsubscribed from before they are subscribed to again.
+			h.logger.Debug("received successful unsubscription status response message", zap.String("ticker", resp.Pair))
+			return nil, nil
 		case ErrorStatus:
 			h.logger.Debug(
 				"could not successfully subscribe to ticker; attempting to resubscribe",
@@ -152,3 +160,191 @@ func DecodeTickerResponseMessage(message []byte) (TickerResponseMessage, error)
 
 	return response, nil
 }
+
+// parseBookMessage will parse order book messages from the Kraken websocket API. Snapshots
+// replace the local order book of the asset pair, and updates are applied to it. The local order
+// book is truncated to the subscribed depth, as Kraken only sends updates within it. The checksum
+// of each update is verified against the local order book. If it does not match, the local order
+// book has drifted from Kraken's, so it is discarded and the asset pair is resubscribed to, which
+// sends a new snapshot. The ticker is then priced using the local order book.
+func (h *WebSocketHandler) parseBookMessage(
+	resp BookResponseMessage,
+) (types.PriceResponse, []handlers.WebsocketEncodedMessage, error) {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unResolved = make(types.UnResolvedPrices)
+	)
+
+	ticker, ok := h.cache.FromOffChainTicker(resp.Pair)
+	if !ok {
+		return types.NewPriceResponse(resolved, unResolved), nil,
+			fmt.Errorf("no ticker found for instrument %s", resp.Pair)
+	}
+
+	book, ok := h.books[resp.Pair]
+	if !ok {
+		return types.NewPriceResponse(resolved, unResolved), nil,
+			fmt.Errorf("no order book for instrument %s", resp.Pair)
+	}
+
+	bids, err := parseBookLevels(resp.Bids)
+	if err != nil {
+		return types.NewPriceResponse(resolved, unResolved), nil, fmt.Errorf("failed to parse bids: %w", err)
+	}
+
+	asks, err := parseBookLevels(resp.Asks)
+	if err != nil {
+		return types.NewPriceResponse(resolved, unResolved), nil, fmt.Errorf("failed to parse asks: %w", err)
+	}
+
+	if resp.Snapshot {
+		book.ApplySnapshot(bids, asks, 0)
+	} else if err := book.ApplyUpdate(bids, asks, 0); err != nil {
+		// Kraken sends a snapshot upon subscription, so the book can only be unsynced if the
+		// snapshot has not been received yet.
+		wErr := fmt.Errorf("order book for %s is awaiting a snapshot: %w", resp.Pair, err)
+		unResolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorInvalidResponse),
+		}
+		return types.NewPriceResponse(resolved, unResolved), nil, nil
+	}
+
+	if resp.Checksum != "" {
+		if checksum := bookChecksum(book); strconv.FormatUint(uint64(checksum), 10) != resp.Checksum {
+			h.logger.Debug(
+				"order book checksum mismatch; resubscribing",
+				zap.String("ticker", resp.Pair),
+				zap.String("expected_checksum", resp.Checksum),
+				zap.Uint32("checksum", checksum),
+			)
+
+			book.Reset()
+			wErr := fmt.Errorf("order book checksum mismatch for %s; awaiting a new snapshot", resp.Pair)
+			unResolved[ticker] = providertypes.UnresolvedResult{
+				ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorInvalidResponse),
+			}
+
+			resubscribeMsgs, err := h.NewResubscribeBookRequestMessages(resp.Pair)
+			return types.NewPriceResponse(resolved, unResolved), resubscribeMsgs, err
+		}
+	}
+
+	price, err := book.Price(h.bookConfigs[resp.Pair])
+	if err != nil {
+		wErr := fmt.Errorf("failed to price order book for %s: %w", resp.Pair, err)
+		unResolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+		}
+		return types.NewPriceResponse(resolved, unResolved), nil, nil
+	}
+
+	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	return types.NewPriceResponse(resolved, unResolved), nil, nil
+}
+
+// bookChecksum returns the checksum of the given order book, as computed by Kraken from the top
+// BookChecksumDepth levels of each side.
+func bookChecksum(book *orderbook.Book) uint32 {
+	var sb strings.Builder
+	for _, side := range [][]orderbook.Level{book.Asks(), book.Bids()} {
+		for _, level := range side[:min(len(side), BookChecksumDepth)] {
+			sb.WriteString(checksumField(level.RawPrice))
+			sb.WriteString(checksumField(level.RawSize))
+		}
+	}
+
+	return crc32.ChecksumIEEE([]byte(sb.String()))
+}
+
+// checksumField formats the given decimal string as it is included in a book checksum, i.e.
+// without the decimal point and leading zeros.
+func checksumField(decimal string) string {
+	return strings.TrimLeft(strings.ReplaceAll(decimal, ".", ""), "0")
+}
+
+// parseBookLevels parses the given order book levels, formatted as [price, volume, ...].
+func parseBookLevels(levels [][]string) ([]orderbook.Level, error) {
+	parsed := make([]orderbook.Level, len(levels))
+	for i, level := range levels {
+		if len(level) < 2 {
+			return nil, fmt.Errorf("invalid order book level %v", level)
+		}
+
+		l, err := orderbook.NewLevel(level[0], level[1])
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = l
+	}
+
+	return parsed, nil
+}
+
+// IsBookResponseMessage returns true if the given message, a JSON array, is a message from the
+// book channel. The channel name is the second to last element of the array.
+func IsBookResponseMessage(message []byte) bool {
+	var rawResponse []json.RawMessage
+	if err := json.Unmarshal(message, &rawResponse); err != nil || len(rawResponse) < 2 {
+		return false
+	}
+
+	var channelName string
+	if err := json.Unmarshal(rawResponse[len(rawResponse)-2], &channelName); err != nil {
+		return false
+	}
+
+	return strings.HasPrefix(channelName, string(BookChannel)+"-")
+}
+
+// DecodeBookResponseMessage decodes a book response message. The message contains either a
+// single snapshot object, or one or two update objects.
+func DecodeBookResponseMessage(message []byte) (BookResponseMessage, error) {
+	var rawResponse []json.RawMessage
+	if err := json.Unmarshal(message, &rawResponse); err != nil {
+		return BookResponseMessage{}, err
+	}
+
+	if len(rawResponse) != ExpectedTickerResponseMessageLength && len(rawResponse) != ExpectedTickerResponseMessageLength+1 {
+		return BookResponseMessage{}, fmt.Errorf(
+			"invalid book response message; expected length %d or %d, got %d",
+			ExpectedTickerResponseMessageLength, ExpectedTickerResponseMessageLength+1, len(rawResponse),
+		)
+	}
+
+	var response BookResponseMessage
+	if err := json.Unmarshal(rawResponse[ChannelIDIndex], &response.ChannelID); err != nil {
+		return BookResponseMessage{}, err
+	}
+
+	last := len(rawResponse) - 1
+	if err := json.Unmarshal(rawResponse[last-1], &response.ChannelName); err != nil {
+		return BookResponseMessage{}, err
+	}
+
+	if err := json.Unmarshal(rawResponse[last], &response.Pair); err != nil {
+		return BookResponseMessage{}, err
+	}
+
+	for _, raw := range rawResponse[ChannelIDIndex+1 : last-1] {
+		var data BookData
+		if err := json.Unmarshal(raw, &data); err != nil {
+			return BookResponseMessage{}, err
+		}
+
+		if data.Checksum != "" {
+			response.Checksum = data.Checksum
+		}
+
+		if data.SnapshotAsks != nil || data.SnapshotBids != nil {
+			response.Snapshot = true
+			response.Asks = append(response.Asks, data.SnapshotAsks...)
+			response.Bids = append(response.Bids, data.SnapshotBids...)
+			continue
+		}
+
+		response.Asks = append(response.Asks, data.Asks...)
+		response.Bids = append(response.Bids, data.Bids...)
+	}
+
+	return response, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/1119-Labs/slinky/oracle/config"
 	"github.com/1119-Labs/slinky/oracle/types"
 	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
 )
 
 var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
@@ -22,6 +23,12 @@ type WebSocketHandler struct {
 	ws config.WebSocketConfig
 	// cache maintains the latest set of tickers seen by the handler.
 	cache types.ProviderTickers
+	// books maintains the local order books of the tickers that are priced using the order
+	// book, keyed by asset pair.
+	books map[string]*orderbook.Book
+	// bookConfigs maintains the order book pricing configuration of the tickers that are priced
+	// using the order book, keyed by asset pair.
+	bookConfigs map[string]orderbook.Config
 }
 
 // NewWebSocketDataHandler returns a new Kraken PriceWebSocketDataHandler.
@@ -42,17 +49,23 @@ func NewWebSocketDataHandler(
 	}
 
 	return &WebSocketHandler{
-		logger: logger,
-		ws:     ws,
-		cache:  types.NewProviderTickers(),
+		logger:      logger,
+		ws:          ws,
+		cache:       types.NewProviderTickers(),
+		books:       make(map[string]*orderbook.Book),
+		bookConfigs: make(map[string]orderbook.Config),
 	}, nil
 }
 
-// HandleMessage is used to handle a message received from the data provider. There are two
+// HandleMessage is used to handle a message received from the data provider. There are three
 // types of messages that are handled by this function:
 //  1. Price update messages. This is used to update the price of the given ticker. This
 //     is formatted as a JSON array.
-//  2. General response messages. This is used to check if the subscription request was successful,
+//  2. Order book messages. This is used to update the local order book of a ticker that is
+//     priced using the order book, which is then priced. This is formatted as a JSON array. If
+//     the checksum of an update does not match the local order book, messages to resubscribe
+//     to the order book are returned.
+//  3. General response messages. This is used to check if the subscription request was successful,
 //     heartbeats, and system status updates.
 func (h *WebSocketHandler) HandleMessage(
 	message []byte,
@@ -69,6 +82,20 @@ func (h *WebSocketHandler) HandleMessage(
 		return resp, updateMessage, err
 	}
 
+	if IsBookResponseMessage(message) {
+		bookResponse, err := DecodeBookResponseMessage(message)
+		if err != nil {
+			return resp, nil, fmt.Errorf("failed to decode book response message: %w", err)
+		}
+
+		resp, updateMessages, err := h.parseBookMessage(bookResponse)
+		if err != nil {
+			return resp, updateMessages, fmt.Errorf("failed to parse book message: %w", err)
+		}
+
+		return resp, updateMessages, nil
+	}
+
 	// If the response cannot be decoded into a ticker response message, then it is likely
 	// an unknown message type.
 	tickerResponse, err := DecodeTickerResponseMessage(message)
@@ -89,13 +116,25 @@ func (h *WebSocketHandler) HandleMessage(
 
 // CreateMessages is used to create a message to send to the data provider. This is used to
 // subscribe to the given tickers. This is called when the connection to the data provider
-// is first established.
+// is first established. Tickers are subscribed to on the ticker channel, unless their metadata
+// configures order book pricing, in which case they are subscribed to on the book channel.
 func (h *WebSocketHandler) CreateMessages(
 	tickers []types.ProviderTicker,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	instruments := make([]string, 0)
 
 	for _, ticker := range tickers {
+		cfg, err := orderbook.ConfigFromTicker(ticker)
+		if err != nil {
+			h.logger.Error("invalid order book configuration; skipping ticker", zap.Error(err))
+			continue
+		}
+
+		if cfg.UsesOrderBook() {
+			h.books[ticker.GetOffChainTicker()] = orderbook.NewBook(BookDepth)
+			h.bookConfigs[ticker.GetOffChainTicker()] = cfg
+		}
+
 		instruments = append(instruments, ticker.GetOffChainTicker())
 		h.cache.Add(ticker)
 	}
@@ -111,8 +150,10 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger: h.logger,
-		ws:     h.ws,
-		cache:  types.NewProviderTickers(),
+		logger:      h.logger,
+		ws:          h.ws,
+		cache:       types.NewProviderTickers(),
+		books:       make(map[string]*orderbook.Book),
+		bookConfigs: make(map[string]orderbook.Config),
 	}
 }
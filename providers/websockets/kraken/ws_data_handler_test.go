@@ -24,6 +24,10 @@ var (
 	mogusd = types.DefaultProviderTicker{
 		OffChainTicker: "MOG/USD",
 	}
+	btcusdMid = types.DefaultProviderTicker{
+		OffChainTicker: "XBT/USD",
+		JSON:           `{"order_book_pricing":"mid"}`,
+	}
 	logger = zap.NewExample()
 )
 
@@ -366,6 +370,37 @@ func TestCreateMessage(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "tickers priced using the order book are subscribed to on the book channel",
+			cps: []types.ProviderTicker{
+				btcusdMid,
+				ethusd,
+			},
+			cfg: kraken.DefaultWebSocketConfig,
+			expected: func() []handlers.WebsocketEncodedMessage {
+				ticker, err := json.Marshal(kraken.SubscribeRequestMessage{
+					Event: string(kraken.SubscribeEvent),
+					Pair:  []string{"ETH/USD"},
+					Subscription: kraken.Subscription{
+						Name: string(kraken.TickerChannel),
+					},
+				})
+				require.NoError(t, err)
+
+				book, err := json.Marshal(kraken.SubscribeRequestMessage{
+					Event: string(kraken.SubscribeEvent),
+					Pair:  []string{"XBT/USD"},
+					Subscription: kraken.Subscription{
+						Name:  string(kraken.BookChannel),
+						Depth: kraken.BookDepth,
+					},
+				})
+				require.NoError(t, err)
+
+				return []handlers.WebsocketEncodedMessage{ticker, book}
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -442,3 +477,143 @@ func TestDecodeTickerResponseMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleBookMessage(t *testing.T) {
+	wsHandler, err := kraken.NewWebSocketDataHandler(logger, kraken.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdMid, ethusd})
+	require.NoError(t, err)
+
+	t.Run("does not apply an update before the snapshot", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage([]byte(
+			`[336,{"a":[["101.00000","1.00000000","1534614248.456738"]],"c":"974942666"},"book-100","XBT/USD"]`,
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdMid)
+	})
+
+	t.Run("prices a snapshot at the mid-price", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage([]byte(
+			`[336,{"as":[["101.00000","1.00000000","1534614248.123678"],["102.00000","2.00000000","1534614248.765567"]],"bs":[["99.00000","1.00000000","1534614248.123678"],["98.00000","2.00000000","1534614248.765567"]]},"book-100","XBT/USD"]`,
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Equal(t, big.NewFloat(100).SetPrec(18), resp.Resolved[btcusdMid].Value.SetPrec(18))
+	})
+
+	t.Run("applies an update to both sides", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage([]byte(
+			`[336,{"a":[["101.00000","0.00000000","1534614335.345903"],["101.50000","1.00000000","1534614335.345903"]]},{"b":[["99.50000","1.00000000","1534614335.345903","r"]],"c":"905986675"},"book-100","XBT/USD"]`,
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Equal(t, big.NewFloat(100.5).SetPrec(18), resp.Resolved[btcusdMid].Value.SetPrec(18))
+	})
+
+	t.Run("resubscribes if the checksum of an update does not match", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage([]byte(
+			`[336,{"b":[["99.60000","1.00000000","1534614335.345903"]],"c":"905986675"},"book-100","XBT/USD"]`,
+		))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdMid)
+
+		subscription := kraken.Subscription{Name: string(kraken.BookChannel), Depth: kraken.BookDepth}
+		unsubscribe, err := json.Marshal(kraken.SubscribeRequestMessage{
+			Event:        string(kraken.UnsubscribeEvent),
+			Pair:         []string{"XBT/USD"},
+			Subscription: subscription,
+		})
+		require.NoError(t, err)
+		subscribe, err := json.Marshal(kraken.SubscribeRequestMessage{
+			Event:        string(kraken.SubscribeEvent),
+			Pair:         []string{"XBT/USD"},
+			Subscription: subscription,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []handlers.WebsocketEncodedMessage{unsubscribe, subscribe}, updateMsg)
+	})
+
+	t.Run("does not apply an update until the order book is resubscribed to", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage([]byte(
+			`[336,{"b":[["99.60000","0.00000000","1534614335.345903"]],"c":"905986675"},"book-100","XBT/USD"]`,
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdMid)
+	})
+
+	t.Run("returns an error for an invalid level", func(t *testing.T) {
+		_, _, err := wsHandler.HandleMessage([]byte(
+			`[336,{"b":[["abc","1.00000000","1534614335.345903"]],"c":"974942666"},"book-100","XBT/USD"]`,
+		))
+		require.Error(t, err)
+	})
+
+	t.Run("returns an error for an asset pair without an order book", func(t *testing.T) {
+		_, _, err := wsHandler.HandleMessage([]byte(
+			`[336,{"b":[["99.00000","1.00000000","1534614335.345903"]],"c":"974942666"},"book-100","ETH/USD"]`,
+		))
+		require.Error(t, err)
+	})
+}
+
+func TestDecodeBookResponseMessage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		response string
+		expected kraken.BookResponseMessage
+		expErr   bool
+	}{
+		{
+			name:     "valid snapshot",
+			response: `[0,{"as":[["5541.30000","2.50700000","1534614248.123678"]],"bs":[["5541.20000","1.52900000","1534614248.765567"]]},"book-100","XBT/USD"]`,
+			expected: kraken.BookResponseMessage{
+				ChannelID:   0,
+				Snapshot:    true,
+				Asks:        [][]string{{"5541.30000", "2.50700000", "1534614248.123678"}},
+				Bids:        [][]string{{"5541.20000", "1.52900000", "1534614248.765567"}},
+				ChannelName: "book-100",
+				Pair:        "XBT/USD",
+			},
+		},
+		{
+			name:     "valid update of both sides",
+			response: `[1234,{"a":[["5541.30000","2.50700000","1534614248.456738"]]},{"b":[["5541.30000","0.00000000","1534614335.345903"]],"c":"974942666"},"book-10","XBT/USD"]`,
+			expected: kraken.BookResponseMessage{
+				ChannelID:   1234,
+				Asks:        [][]string{{"5541.30000", "2.50700000", "1534614248.456738"}},
+				Bids:        [][]string{{"5541.30000", "0.00000000", "1534614335.345903"}},
+				Checksum:    "974942666",
+				ChannelName: "book-10",
+				Pair:        "XBT/USD",
+			},
+		},
+		{
+			name:     "invalid response with missing book data",
+			response: `[1234,"book-10","XBT/USD"]`,
+			expErr:   true,
+		},
+		{
+			name:     "invalid response with missing pair",
+			response: `[1234,{"a":[["5541.30000","2.50700000","1534614248.456738"]]},"book-10"]`,
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := kraken.DecodeBookResponseMessage([]byte(tc.response))
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
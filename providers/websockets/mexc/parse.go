@@ -33,6 +33,12 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 		return types.NewPriceResponse(resolved, unResolved), err
 	}
 
-	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	// The quantity is the 24h volume in the base asset, whereas the volume is in the quote asset.
+	result := types.NewPriceResult(price, time.Now().UTC())
+	if volume, err := math.Float64StringToBigFloat(px.Quantity); err == nil {
+		result = result.WithVolume(volume)
+	}
+
+	resolved[ticker] = result
 	return types.NewPriceResponse(resolved, unResolved), nil
 }
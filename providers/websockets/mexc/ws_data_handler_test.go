@@ -84,6 +84,32 @@ func TestHandleMessage(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "price update message with volume",
+			msg: func() []byte {
+				px := pb.PublicMiniTickerV3Api{
+					Symbol:   "BTCUSDT",
+					Price:    "100000.00",
+					Volume:   "375173478.65",
+					Quantity: "10557.5",
+				}
+				bz, err := proto.Marshal(&px)
+				require.NoError(t, err)
+				return bz
+			},
+			resp: types.PriceResponse{
+				Resolved: types.ResolvedPrices{
+					btcusdt: {
+						Value:  big.NewFloat(100000.00),
+						Volume: big.NewFloat(10557.5),
+					},
+				},
+			},
+			updateMessage: func() []handlers.WebsocketEncodedMessage {
+				return nil
+			},
+			expErr: false,
+		},
 		{
 			name: "price update message",
 			msg:  getPriceFactory(t, "BTCUSDT", "100000.00"),
@@ -154,6 +180,11 @@ func TestHandleMessage(t *testing.T) {
 			for cp, result := range tc.resp.Resolved {
 				require.Contains(t, resp.Resolved, cp)
 				require.Equal(t, result.Value.SetPrec(18), resp.Resolved[cp].Value.SetPrec(18))
+				if result.Volume == nil {
+					require.Nil(t, resp.Resolved[cp].Volume)
+				} else {
+					require.Equal(t, result.Volume.SetPrec(18), resp.Resolved[cp].Volume.SetPrec(18))
+				}
 			}
 
 			for cp := range tc.resp.UnResolved {
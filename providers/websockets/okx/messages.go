@@ -26,6 +26,8 @@ type (
 const (
 	// OperationSubscribe is the operation to subscribe to a channel.
 	OperationSubscribe Operation = "subscribe"
+	// OperationUnsubscribe is the operation to unsubscribe from a channel.
+	OperationUnsubscribe Operation = "unsubscribe"
 )
 
 const (
@@ -33,11 +35,36 @@ const (
 	//
 	// ref: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-tickers-channel
 	TickersChannel Channel = "tickers"
+
+	// BooksChannel is the channel for the order book. This pushes a snapshot of the 400 best
+	// levels of the order book on subscription, followed by incremental updates every 100ms.
+	//
+	// ref: https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-order-book-channel
+	BooksChannel Channel = "books"
+)
+
+// BooksDepth is the number of levels on each side of the order book that are pushed on the
+// books channel.
+const BooksDepth = 400
+
+type (
+	// BookAction is the action of an order book message, i.e. whether the message is a snapshot
+	// of the order book or an incremental update to it.
+	BookAction string
+)
+
+const (
+	// BookActionSnapshot is the action of the initial full order book message.
+	BookActionSnapshot BookAction = "snapshot"
+	// BookActionUpdate is the action of an incremental order book message.
+	BookActionUpdate BookAction = "update"
 )
 
 const (
 	// EventSubscribe is the event denoting that we have successfully subscribed to a channel.
 	EventSubscribe EventType = "subscribe"
+	// EventUnsubscribe is the event denoting that we have successfully unsubscribed from a channel.
+	EventUnsubscribe EventType = "unsubscribe"
 	// EventTickers is the event for tickers. By default, this field will not be populated
 	// in a properly formatted message. So we set the default value to an empty string.
 	EventTickers EventType = ""
@@ -94,7 +121,7 @@ type SubscriptionTopic struct {
 }
 
 // NewSubscribeToTickersRequestMessage returns a new SubscribeRequestMessage for subscribing
-// to the tickers channel.
+// to the given channels. Despite the name, this is used for both the tickers and books channels.
 func (h *WebSocketHandler) NewSubscribeToTickersRequestMessage(
 	instruments []SubscriptionTopic,
 ) ([]handlers.WebsocketEncodedMessage, error) {
@@ -215,3 +242,74 @@ type IndexTicker struct {
 	// LastPrice is the last price.
 	LastPrice string `json:"last" validate:"required"`
 }
+
+// DataMessage is utilized to determine the channel of a data message, i.e. a ticker or an
+// order book message.
+type DataMessage struct {
+	// Arguments is the channel and instrument of the message.
+	Arguments SubscriptionTopic `json:"arg" validate:"required"`
+}
+
+// BooksResponseMessage is the response message for order book updates. The first message
+// after subscribing is a snapshot of the order book, and all following messages are
+// incremental updates. A level with a size of "0" in an update is removed from the book. The
+// format of the message is:
+//
+//	{
+//		"arg": {
+//		  "channel": "books",
+//		  "instId": "BTC-USDT"
+//		},
+//		"action": "snapshot",
+//		"data": [
+//		  {
+//			"asks": [["8476.98", "415", "0", "13"], ["8477", "7", "0", "2"]],
+//			"bids": [["8476.97", "256", "0", "12"], ["8475.55", "101", "0", "1"]],
+//			"ts": "1597026383085",
+//			"checksum": -855196043,
+//			"prevSeqId": -1,
+//			"seqId": 123456
+//		  }
+//		]
+//	}
+//
+// For more information, see https://www.okx.com/docs-v5/en/#order-book-trading-market-data-ws-order-book-channel
+type BooksResponseMessage struct {
+	// Arguments is the channel and instrument of the message.
+	Arguments SubscriptionTopic `json:"arg" validate:"required"`
+
+	// Action is either a snapshot or an update.
+	Action string `json:"action" validate:"required"`
+
+	// Data is the list of order book data.
+	Data []BookData `json:"data" validate:"required"`
+}
+
+// BookData is the order book data. Each level is formatted as [price, size, deprecated,
+// number of orders].
+type BookData struct {
+	// Asks is the list of ask levels.
+	Asks [][]string `json:"asks"`
+
+	// Bids is the list of bid levels.
+	Bids [][]string `json:"bids"`
+
+	// SequenceID is the sequence ID of the message.
+	SequenceID int64 `json:"seqId"`
+
+	// PrevSequenceID is the sequence ID of the previous message. This is -1 for snapshots.
+	PrevSequenceID int64 `json:"prevSeqId"`
+}
+
+// NewUnsubscribeRequestMessage returns a new request message for unsubscribing from the given
+// channels.
+func NewUnsubscribeRequestMessage(
+	instruments []SubscriptionTopic,
+) (handlers.WebsocketEncodedMessage, error) {
+	return json.Marshal(
+		SubscribeRequestMessage{
+			Operation: string(OperationUnsubscribe),
+			Arguments: instruments,
+		},
+	)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/1119-Labs/slinky/oracle/types"
 	"github.com/1119-Labs/slinky/pkg/math"
 	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
 )
 
 const (
@@ -28,13 +29,17 @@ const (
 // parseSubscribeResponseMessage parses a subscribe response message. The format of the message
 // is defined in the messages.go file. There are two cases that are handled:
 //
-// 1. Successfully subscribed to the channel. In this case, no further action is required.
+// 1. Successfully (un)subscribed to the channel. In this case, no further action is required.
 // 2. Error message. In this case, we attempt to re-subscribe to the channel.
 func (h *WebSocketHandler) parseSubscribeResponseMessage(resp SubscribeResponseMessage) ([]handlers.WebsocketEncodedMessage, error) {
 	// A response with an event type of subscribe means that we have successfully subscribed to the channel.
-	if t := EventType(resp.Event); t == EventSubscribe {
+	switch EventType(resp.Event) {
+	case EventSubscribe:
 		h.logger.Debug("successfully subscribed to channel", zap.String("instrument", resp.Arguments.InstrumentID))
 		return nil, nil
+	case EventUnsubscribe:
+		h.logger.Debug("successfully unsubscribed from channel", zap.String("instrument", resp.Arguments.InstrumentID))
+		return nil, nil
 	}
 
 	// Attempt to re-subscribe to the channel.
@@ -105,3 +110,129 @@ func (h *WebSocketHandler) parseTickerResponseMessage(
 
 	return types.NewPriceResponse(resolved, unresolved), nil
 }
+
+// parseBooksResponseMessage parses a books response message. The format of the message is defined
+// in the messages.go file. Snapshots replace the local order book of the instrument, and updates are
+// applied to it. If an update is out of sequence, the local order book is reset and the instrument
+// is re-subscribed to in order to receive a new snapshot. The ticker is then priced using the
+// local order book.
+func (h *WebSocketHandler) parseBooksResponseMessage(
+	resp BooksResponseMessage,
+) (types.PriceResponse, []handlers.WebsocketEncodedMessage, error) {
+	var (
+		resolved   = make(types.ResolvedPrices)
+		unresolved = make(types.UnResolvedPrices)
+		instrument = resp.Arguments.InstrumentID
+	)
+
+	ticker, ok := h.cache.FromOffChainTicker(instrument)
+	if !ok {
+		h.logger.Debug("ticker not found for instrument ID", zap.String("instrument_id", instrument))
+		return types.NewPriceResponse(resolved, unresolved), nil, nil
+	}
+
+	book, ok := h.books[instrument]
+	if !ok {
+		return types.NewPriceResponse(resolved, unresolved),
+			nil, fmt.Errorf("no order book for instrument %s", instrument)
+	}
+
+	for _, data := range resp.Data {
+		bids, err := parseBookLevels(data.Bids)
+		if err != nil {
+			return types.NewPriceResponse(resolved, unresolved), nil, fmt.Errorf("failed to parse bids: %w", err)
+		}
+
+		asks, err := parseBookLevels(data.Asks)
+		if err != nil {
+			return types.NewPriceResponse(resolved, unresolved), nil, fmt.Errorf("failed to parse asks: %w", err)
+		}
+
+		switch BookAction(resp.Action) {
+		case BookActionSnapshot:
+			book.ApplySnapshot(bids, asks, data.SequenceID)
+		case BookActionUpdate:
+			if !book.Synced() {
+				// The order book has been re-subscribed to, and is awaiting a new snapshot.
+				wErr := fmt.Errorf("order book for %s is awaiting a snapshot", instrument)
+				unresolved[ticker] = providertypes.UnresolvedResult{
+					ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorInvalidResponse),
+				}
+				return types.NewPriceResponse(resolved, unresolved), nil, nil
+			}
+
+			if data.PrevSequenceID != book.Sequence() {
+				// The local order book is missing updates, so it must be re-synced from a new snapshot.
+				h.logger.Debug(
+					"order book update out of sequence; re-subscribing",
+					zap.String("instrument_id", instrument),
+					zap.Int64("sequence", book.Sequence()),
+					zap.Int64("prev_seq_id", data.PrevSequenceID),
+				)
+				book.Reset()
+
+				msgs, err := h.resubscribeToBook(instrument)
+				wErr := fmt.Errorf("order book update out of sequence for %s", instrument)
+				unresolved[ticker] = providertypes.UnresolvedResult{
+					ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorInvalidResponse),
+				}
+				return types.NewPriceResponse(resolved, unresolved), msgs, err
+			}
+
+			if err := book.ApplyUpdate(bids, asks, data.SequenceID); err != nil {
+				return types.NewPriceResponse(resolved, unresolved), nil, err
+			}
+		default:
+			return types.NewPriceResponse(resolved, unresolved),
+				nil, fmt.Errorf("unknown order book action %s", resp.Action)
+		}
+	}
+
+	price, err := book.Price(h.bookConfigs[instrument])
+	if err != nil {
+		wErr := fmt.Errorf("failed to price order book for %s: %w", instrument, err)
+		unresolved[ticker] = providertypes.UnresolvedResult{
+			ErrorWithCode: providertypes.NewErrorWithCode(wErr, providertypes.ErrorFailedToParsePrice),
+		}
+		return types.NewPriceResponse(resolved, unresolved), nil, nil
+	}
+
+	resolved[ticker] = types.NewPriceResult(price, time.Now().UTC())
+	return types.NewPriceResponse(resolved, unresolved), nil, nil
+}
+
+// resubscribeToBook returns the messages to unsubscribe from and re-subscribe to the books channel
+// of the given instrument. OKX sends a new order book snapshot upon subscription.
+func (h *WebSocketHandler) resubscribeToBook(instrument string) ([]handlers.WebsocketEncodedMessage, error) {
+	topic := []SubscriptionTopic{{Channel: string(BooksChannel), InstrumentID: instrument}}
+
+	unsubscribe, err := NewUnsubscribeRequestMessage(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	subscribe, err := h.NewSubscribeToTickersRequestMessage(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]handlers.WebsocketEncodedMessage{unsubscribe}, subscribe...), nil
+}
+
+// parseBookLevels parses the given order book levels, formatted as [price, size, ...].
+func parseBookLevels(levels [][]string) ([]orderbook.Level, error) {
+	parsed := make([]orderbook.Level, len(levels))
+	for i, level := range levels {
+		if len(level) < 2 {
+			return nil, fmt.Errorf("invalid order book level %v", level)
+		}
+
+		l, err := orderbook.NewLevel(level[0], level[1])
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = l
+	}
+
+	return parsed, nil
+}
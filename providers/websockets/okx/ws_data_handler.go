@@ -9,6 +9,7 @@ import (
 	"github.com/1119-Labs/slinky/oracle/config"
 	"github.com/1119-Labs/slinky/oracle/types"
 	"github.com/1119-Labs/slinky/providers/base/websocket/handlers"
+	"github.com/1119-Labs/slinky/providers/base/websocket/orderbook"
 )
 
 var _ types.PriceWebSocketDataHandler = (*WebSocketHandler)(nil)
@@ -22,6 +23,12 @@ type WebSocketHandler struct {
 	ws config.WebSocketConfig
 	// cache maintains the latest set of tickers seen by the handler.
 	cache types.ProviderTickers
+	// books maintains the local order books of the tickers that are priced using the order
+	// book, keyed by instrument ID.
+	books map[string]*orderbook.Book
+	// bookConfigs maintains the order book pricing configuration of the tickers that are priced
+	// using the order book, keyed by instrument ID.
+	bookConfigs map[string]orderbook.Config
 }
 
 // NewWebSocketDataHandler returns a new OKX PriceWebSocketDataHandler.
@@ -42,19 +49,23 @@ func NewWebSocketDataHandler(
 	}
 
 	return &WebSocketHandler{
-		logger: logger,
-		ws:     ws,
-		cache:  types.NewProviderTickers(),
+		logger:      logger,
+		ws:          ws,
+		cache:       types.NewProviderTickers(),
+		books:       make(map[string]*orderbook.Book),
+		bookConfigs: make(map[string]orderbook.Config),
 	}, nil
 }
 
 // HandleMessage is used to handle a message received from the data provider. The OKX
-// provider sends two types of messages:
+// provider sends three types of messages:
 //
 //  1. Subscribe response message. The subscribe response message is used to determine if
 //     the subscription was successful.
 //  2. Ticker response message. This is sent when a ticker update is received from the
 //     OKX websocket API.
+//  3. Books response message. This is sent when an order book snapshot or update is received
+//     for a ticker that is priced using the order book.
 //
 // Heartbeat messages are NOT sent by the OKX websocket. The connection is only closed
 // iff no data is received within a 30-second interval or if all subscriptions
@@ -76,7 +87,7 @@ func (h *WebSocketHandler) HandleMessage(
 
 	eventType := EventType(baseMessage.Event)
 	switch {
-	case eventType == EventSubscribe || eventType == EventError:
+	case eventType == EventSubscribe || eventType == EventUnsubscribe || eventType == EventError:
 		h.logger.Debug("received subscribe response message")
 
 		var subscribeMessage SubscribeResponseMessage
@@ -91,6 +102,22 @@ func (h *WebSocketHandler) HandleMessage(
 
 		return resp, updateMessage, nil
 	case eventType == EventTickers:
+		var dataMessage DataMessage
+		if err := json.Unmarshal(message, &dataMessage); err != nil {
+			return resp, nil, fmt.Errorf("failed to unmarshal data message: %w", err)
+		}
+
+		if Channel(dataMessage.Arguments.Channel) == BooksChannel {
+			h.logger.Debug("received books response message")
+
+			var booksMessage BooksResponseMessage
+			if err := json.Unmarshal(message, &booksMessage); err != nil {
+				return resp, nil, fmt.Errorf("failed to unmarshal books response message: %w", err)
+			}
+
+			return h.parseBooksResponseMessage(booksMessage)
+		}
+
 		h.logger.Debug("received ticker response message")
 
 		var tickerMessage TickersResponseMessage
@@ -110,15 +137,29 @@ func (h *WebSocketHandler) HandleMessage(
 }
 
 // CreateMessages is used to create an initial subscription message to send to the data provider.
-// Only the currency pairs that are specified in the config are subscribed to. The only channel
-// that is subscribed to is the index tickers channel - which supports spot markets.
+// Only the currency pairs that are specified in the config are subscribed to. Tickers are
+// subscribed to on the tickers channel - which supports spot markets - unless their metadata
+// configures order book pricing, in which case they are subscribed to on the books channel.
 func (h *WebSocketHandler) CreateMessages(
 	tickers []types.ProviderTicker,
 ) ([]handlers.WebsocketEncodedMessage, error) {
 	instruments := make([]SubscriptionTopic, 0)
 	for _, ticker := range tickers {
+		cfg, err := orderbook.ConfigFromTicker(ticker)
+		if err != nil {
+			h.logger.Error("invalid order book configuration; skipping ticker", zap.Error(err))
+			continue
+		}
+
+		channel := TickersChannel
+		if cfg.UsesOrderBook() {
+			channel = BooksChannel
+			h.books[ticker.GetOffChainTicker()] = orderbook.NewBook(BooksDepth)
+			h.bookConfigs[ticker.GetOffChainTicker()] = cfg
+		}
+
 		instruments = append(instruments, SubscriptionTopic{
-			Channel:      string(TickersChannel),
+			Channel:      string(channel),
 			InstrumentID: ticker.GetOffChainTicker(),
 		})
 		h.cache.Add(ticker)
@@ -135,8 +176,10 @@ func (h *WebSocketHandler) HeartBeatMessages() ([]handlers.WebsocketEncodedMessa
 // Copy is used to create a copy of the WebSocketHandler.
 func (h *WebSocketHandler) Copy() types.PriceWebSocketDataHandler {
 	return &WebSocketHandler{
-		logger: h.logger,
-		ws:     h.ws,
-		cache:  types.NewProviderTickers(),
+		logger:      h.logger,
+		ws:          h.ws,
+		cache:       types.NewProviderTickers(),
+		books:       make(map[string]*orderbook.Book),
+		bookConfigs: make(map[string]orderbook.Config),
 	}
 }
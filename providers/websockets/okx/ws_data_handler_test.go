@@ -25,6 +25,14 @@ var (
 	mogusdt = types.DefaultProviderTicker{
 		OffChainTicker: "MOG-USDT",
 	}
+	btcusdtMid = types.DefaultProviderTicker{
+		OffChainTicker: "BTC-USDT",
+		JSON:           `{"order_book_pricing":"mid"}`,
+	}
+	ethusdtDepth = types.DefaultProviderTicker{
+		OffChainTicker: "ETH-USDT",
+		JSON:           `{"order_book_pricing":"depth_weighted","order_book_depth_notional":"300"}`,
+	}
 	logger = zap.NewExample()
 )
 
@@ -475,6 +483,39 @@ func TestCreateMessage(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name: "tickers priced using the order book are subscribed to on the books channel",
+			cps: []types.ProviderTicker{
+				btcusdtMid,
+				ethusdt,
+				types.DefaultProviderTicker{
+					OffChainTicker: "MOG-USDT",
+					JSON:           `{"order_book_pricing":"unknown"}`,
+				},
+			},
+			cfg: batchCfg,
+			expected: func() []handlers.WebsocketEncodedMessage {
+				msg := okx.SubscribeRequestMessage{
+					Operation: string(okx.OperationSubscribe),
+					Arguments: []okx.SubscriptionTopic{
+						{
+							Channel:      string(okx.BooksChannel),
+							InstrumentID: "BTC-USDT",
+						},
+						{
+							Channel:      string(okx.TickersChannel),
+							InstrumentID: "ETH-USDT",
+						},
+					},
+				}
+
+				bz, err := json.Marshal(msg)
+				require.NoError(t, err)
+
+				return []handlers.WebsocketEncodedMessage{bz}
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -492,3 +533,123 @@ func TestCreateMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleBooksMessage(t *testing.T) {
+	booksMessage := func(instrument string, action okx.BookAction, prevSeqID, seqID int64, bids, asks [][]string) []byte {
+		bz, err := json.Marshal(okx.BooksResponseMessage{
+			Arguments: okx.SubscriptionTopic{
+				Channel:      string(okx.BooksChannel),
+				InstrumentID: instrument,
+			},
+			Action: string(action),
+			Data: []okx.BookData{
+				{
+					Bids:           bids,
+					Asks:           asks,
+					PrevSequenceID: prevSeqID,
+					SequenceID:     seqID,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	wsHandler, err := okx.NewWebSocketDataHandler(logger, okx.DefaultWebSocketConfig)
+	require.NoError(t, err)
+
+	_, err = wsHandler.CreateMessages([]types.ProviderTicker{btcusdtMid, ethusdtDepth})
+	require.NoError(t, err)
+
+	t.Run("prices a snapshot at the mid-price", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage(booksMessage(
+			"BTC-USDT", okx.BookActionSnapshot, -1, 10,
+			[][]string{{"99", "1", "0", "1"}, {"98", "2", "0", "1"}},
+			[][]string{{"101", "1", "0", "1"}, {"102", "2", "0", "1"}},
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Equal(t, big.NewFloat(100).SetPrec(18), resp.Resolved[btcusdtMid].Value.SetPrec(18))
+	})
+
+	t.Run("applies an update in sequence", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage(booksMessage(
+			"BTC-USDT", okx.BookActionUpdate, 10, 11,
+			[][]string{{"99", "0", "0", "0"}},
+			[][]string{{"100", "1", "0", "1"}},
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Equal(t, big.NewFloat(99).SetPrec(18), resp.Resolved[btcusdtMid].Value.SetPrec(18))
+	})
+
+	t.Run("re-subscribes if an update is out of sequence", func(t *testing.T) {
+		resp, updateMsg, err := wsHandler.HandleMessage(booksMessage(
+			"BTC-USDT", okx.BookActionUpdate, 12, 13,
+			[][]string{{"97", "1", "0", "1"}},
+			nil,
+		))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdtMid)
+
+		topic := []okx.SubscriptionTopic{{Channel: string(okx.BooksChannel), InstrumentID: "BTC-USDT"}}
+		unsubscribe, err := json.Marshal(okx.SubscribeRequestMessage{
+			Operation: string(okx.OperationUnsubscribe),
+			Arguments: topic,
+		})
+		require.NoError(t, err)
+		subscribe, err := json.Marshal(okx.SubscribeRequestMessage{
+			Operation: string(okx.OperationSubscribe),
+			Arguments: topic,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []handlers.WebsocketEncodedMessage{unsubscribe, subscribe}, updateMsg)
+
+		// updates are not applied, nor re-subscribed to again, until a new snapshot is received
+		resp, updateMsg, err = wsHandler.HandleMessage(booksMessage(
+			"BTC-USDT", okx.BookActionUpdate, 13, 14,
+			[][]string{{"97", "1", "0", "1"}},
+			nil,
+		))
+		require.NoError(t, err)
+		require.Nil(t, updateMsg)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, btcusdtMid)
+	})
+
+	t.Run("prices a snapshot at the depth weighted price", func(t *testing.T) {
+		resp, _, err := wsHandler.HandleMessage(booksMessage(
+			"ETH-USDT", okx.BookActionSnapshot, -1, 1,
+			[][]string{{"100", "1", "0", "1"}, {"50", "10", "0", "1"}},
+			[][]string{{"200", "1", "0", "1"}, {"300", "10", "0", "1"}},
+		))
+		require.NoError(t, err)
+
+		// 300 is sold into 1 @ 100 and 4 @ 50, and bought from 1 @ 200 and 1/3 @ 300
+		expected := (300.0/5 + 300.0/(1+1.0/3)) / 2
+		price, _ := resp.Resolved[ethusdtDepth].Value.Float64()
+		require.InDelta(t, expected, price, 1e-9)
+	})
+
+	t.Run("does not price a book without enough depth", func(t *testing.T) {
+		resp, _, err := wsHandler.HandleMessage(booksMessage(
+			"ETH-USDT", okx.BookActionUpdate, 1, 2,
+			[][]string{{"50", "0", "0", "0"}},
+			nil,
+		))
+		require.NoError(t, err)
+		require.Empty(t, resp.Resolved)
+		require.Contains(t, resp.UnResolved, ethusdtDepth)
+	})
+
+	t.Run("returns an error for an invalid level", func(t *testing.T) {
+		_, _, err := wsHandler.HandleMessage(booksMessage(
+			"ETH-USDT", okx.BookActionUpdate, 2, 3,
+			[][]string{{"abc", "1", "0", "1"}},
+			nil,
+		))
+		require.Error(t, err)
+	})
+}
@@ -206,6 +206,9 @@ func filterToOnlyCMCMarkets(marketmap mmtypes.MarketMap) mmtypes.MarketMap {
 func enableAllMarkets(marketmap mmtypes.MarketMap) mmtypes.MarketMap {
 	for name, market := range marketmap.Markets {
 		market.Ticker.Enabled = true
+		if market.Ticker.State != "" && !mmtypes.IsEnabledMarketState(market.Ticker.State) {
+			market.Ticker.State = mmtypes.MarketStateActive
+		}
 		marketmap.Markets[name] = market
 	}
 	return marketmap
@@ -220,6 +220,92 @@ func (c *GRPCClient) Prices(
 	return c.client.Prices(ctx, req, grpc.WaitForReady(true))
 }
 
+// StreamPrices opens a stream of prices from the remote oracle service, which receives the latest prices
+// every time the oracle aggregates new prices. Unlike the other methods, the client's timeout is only applied
+// to opening the stream; the stream stays open until ctx is cancelled or the connection is closed.
+func (c *GRPCClient) StreamPrices(
+	ctx context.Context,
+	req *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (stream types.Oracle_StreamPricesClient, err error) {
+	c.mutex.Lock()
+	client := c.client
+	c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of opening the stream as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	if client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	// the stream is cancelled if it cannot be opened in time
+	streamCtx, cancelStream := context.WithCancel(ctx)
+	stream, err = client.StreamPrices(streamCtx, req, grpc.WaitForReady(true))
+	if err != nil {
+		cancelStream()
+		return nil, err
+	}
+
+	// wait for the headers to be received, so that the stream is known to be open
+	done := make(chan error, 1)
+	go func() {
+		_, err := stream.Header()
+		done <- err
+	}()
+
+	select {
+	case <-time.After(c.timeout):
+		cancelStream()
+		return nil, fmt.Errorf("timed out opening price stream after %s", c.timeout)
+	case err := <-done:
+		if err != nil {
+			cancelStream()
+			return nil, err
+		}
+	}
+
+	// release the stream's context once the stream is finished
+	go func() {
+		<-stream.Context().Done()
+		cancelStream()
+	}()
+
+	return stream, nil
+}
+
+// PriceDetails returns how the latest prices of the remote oracle service were derived from the prices of
+// each provider.
+func (c *GRPCClient) PriceDetails(
+	ctx context.Context,
+	req *types.QueryPriceDetailsRequest,
+	_ ...grpc.CallOption,
+) (resp *types.QueryPriceDetailsResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.PriceDetails(ctx, req, grpc.WaitForReady(true))
+}
+
 func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapRequest, _ ...grpc.CallOption) (res *types.QueryMarketMapResponse, err error) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -242,6 +328,29 @@ func (c *GRPCClient) MarketMap(ctx context.Context, req *types.QueryMarketMapReq
 	return c.client.MarketMap(ctx, req, grpc.WaitForReady(true))
 }
 
+// Health returns the status of the oracle service and each of its providers.
+func (c *GRPCClient) Health(ctx context.Context, req *types.QueryHealthRequest, _ ...grpc.CallOption) (res *types.QueryHealthResponse, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	start := time.Now()
+	defer func() {
+		// Observe the duration of the call as well as the error.
+		c.metrics.ObserveOracleResponseLatency(time.Since(start))
+		c.metrics.AddOracleResponse(metrics.StatusFromError(err))
+	}()
+
+	// set deadline on the context
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	if c.client == nil {
+		return nil, fmt.Errorf("oracle client not started")
+	}
+
+	return c.client.Health(ctx, req, grpc.WaitForReady(true))
+}
+
 // Version returns the version of the oracle service.
 func (c *GRPCClient) Version(ctx context.Context, req *types.QueryVersionRequest, _ ...grpc.CallOption) (res *types.QueryVersionResponse, err error) {
 	c.mutex.Lock()
@@ -9,6 +9,8 @@ import (
 
 	"cosmossdk.io/log"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/1119-Labs/slinky/oracle/config"
 	"github.com/1119-Labs/slinky/service/servers/oracle/types"
@@ -21,6 +23,9 @@ type PriceDaemon struct {
 
 	// isRunning is an atomic boolean that indicates whether the daemon is running.
 	isRunning atomic.Bool
+	// isStreaming is an atomic boolean that indicates whether the daemon is receiving prices
+	// from a price stream, in which case prices are not polled.
+	isStreaming atomic.Bool
 	// config is the configuration of the daemon.
 	config config.AppConfig
 	// client is the underlying oracle client used to fetch prices.
@@ -71,6 +76,11 @@ func (d *PriceDaemon) Start(ctx context.Context) error {
 	d.isRunning.Store(true)
 	defer d.isRunning.Store(false)
 
+	// stream prices in the background, polling for prices only while no stream is open
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go d.streamPrices(streamCtx)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -80,7 +90,9 @@ func (d *PriceDaemon) Start(ctx context.Context) error {
 			d.logger.Info("price daemon stopped")
 			return nil
 		case <-ticker.C:
-			d.fetchPrices(ctx)
+			if !d.isStreaming.Load() {
+				d.fetchPrices(ctx)
+			}
 		}
 	}
 }
@@ -114,6 +126,84 @@ func (d *PriceDaemon) fetchPrices(ctx context.Context) {
 	d.resp.Update(resp)
 }
 
+// streamPrices opens a price stream to the oracle and stores every price response received, re-opening
+// the stream after an interval if it fails or goes idle. If the oracle does not support streaming, the
+// daemon falls back to polling for prices.
+func (d *PriceDaemon) streamPrices(ctx context.Context) {
+	for {
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream, err := d.OracleClient.StreamPrices(streamCtx, &types.StreamPricesRequest{})
+		switch {
+		case status.Code(err) == codes.Unimplemented:
+			d.logger.Info("oracle does not support streaming prices; polling for prices instead")
+			cancel()
+			return
+		case err != nil:
+			d.logger.Error(
+				"failed to open price stream to sidecar; polling for prices instead",
+				"err", err,
+				"address", d.config.OracleAddress,
+			)
+		default:
+			d.logger.Info("streaming prices from sidecar", "address", d.config.OracleAddress)
+
+			d.isStreaming.Store(true)
+			d.receivePrices(stream, cancel)
+			d.isStreaming.Store(false)
+		}
+		cancel()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.config.Interval):
+		}
+	}
+}
+
+// receivePrices stores the price responses received from the given stream until the stream fails. If no
+// prices are received within the stream idle timeout, the stream is closed via cancel, so that the daemon
+// polls for prices instead of serving increasingly stale ones.
+func (d *PriceDaemon) receivePrices(stream types.Oracle_StreamPricesClient, cancel context.CancelFunc) {
+	idleTimeout := d.streamIdleTimeout()
+	idle := time.AfterFunc(idleTimeout, func() {
+		d.logger.Error(
+			"price stream from sidecar is idle; polling for prices instead",
+			"idle_timeout", idleTimeout.String(),
+			"address", d.config.OracleAddress,
+		)
+
+		d.isStreaming.Store(false)
+		cancel()
+	})
+	defer idle.Stop()
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if status.Code(err) != codes.Canceled {
+				d.logger.Error(
+					"price stream from sidecar closed",
+					"err", err,
+					"address", d.config.OracleAddress,
+				)
+			}
+
+			return
+		}
+
+		d.logger.Debug("received prices from stream", "prices", resp.Prices)
+		d.resp.Update(resp)
+		idle.Reset(idleTimeout)
+	}
+}
+
+// streamIdleTimeout returns the time after which a price stream that has not received any prices is
+// considered idle. This is the time in which polling would have fetched a new price response.
+func (d *PriceDaemon) streamIdleTimeout() time.Duration {
+	return d.config.Interval + d.config.ClientTimeout
+}
+
 // Prices returns the latest price response fetched by the daemon. If the latest response
 // is too stale, an error is returned.
 func (d *PriceDaemon) Prices(
@@ -3,12 +3,16 @@ package oracle_test
 import (
 	"context"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
 	"cosmossdk.io/log"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/1119-Labs/slinky/oracle/config"
 	"github.com/1119-Labs/slinky/service/clients/oracle"
@@ -16,6 +20,36 @@ import (
 	"github.com/1119-Labs/slinky/service/servers/oracle/types"
 )
 
+var errStreamUnimplemented = status.Error(codes.Unimplemented, "unknown method StreamPrices")
+
+// priceStream is a fake price stream that returns the responses sent on its channel, and an
+// io.EOF error once the channel is closed. If ctx is set, a Canceled error is returned once it
+// is done.
+type priceStream struct {
+	grpc.ClientStream
+
+	ctx       context.Context
+	responses chan *types.QueryPricesResponse
+}
+
+func (s *priceStream) Recv() (*types.QueryPricesResponse, error) {
+	var done <-chan struct{}
+	if s.ctx != nil {
+		done = s.ctx.Done()
+	}
+
+	select {
+	case <-done:
+		return nil, status.Error(codes.Canceled, s.ctx.Err().Error())
+	case resp, ok := <-s.responses:
+		if !ok {
+			return nil, io.EOF
+		}
+
+		return resp, nil
+	}
+}
+
 func TestNewPriceDaemon(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -100,6 +134,7 @@ func TestPriceDaemon_Start(t *testing.T) {
 		client := mocks.NewOracleClient(t)
 		client.On("Start", mock.Anything).Return(nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{}, nil).Maybe()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, errStreamUnimplemented).Maybe()
 		client.On("Stop").Return(nil).Once()
 
 		d, err := oracle.NewPriceDaemon(logger, cfg, client)
@@ -125,6 +160,7 @@ func TestPriceDaemon_Start(t *testing.T) {
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{
 			Prices: prices,
 		}, nil).Maybe()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, errStreamUnimplemented).Maybe()
 		client.On("Stop").Return(nil).Once()
 
 		d, err := oracle.NewPriceDaemon(logger, cfg, client)
@@ -161,6 +197,7 @@ func TestPriceDaemon_Start(t *testing.T) {
 		client.On("Start", mock.Anything).Return(nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: prices}, nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{}, fmt.Errorf("failed to make request")).Maybe()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, errStreamUnimplemented).Maybe()
 		client.On("Stop").Return(nil).Once()
 
 		d, err := oracle.NewPriceDaemon(logger, cfg, client)
@@ -199,6 +236,7 @@ func TestPriceDaemon_Start(t *testing.T) {
 		client := mocks.NewOracleClient(t)
 		client.On("Start", mock.Anything).Return(nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{}, nil).Maybe()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, errStreamUnimplemented).Maybe()
 		client.On("Stop").Return(nil).Once()
 
 		d, err := oracle.NewPriceDaemon(logger, cfg, client)
@@ -219,6 +257,7 @@ func TestPriceDaemon_Start(t *testing.T) {
 		client := mocks.NewOracleClient(t)
 		client.On("Start", mock.Anything).Return(nil).Once()
 		client.On("Prices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("failed to make request")).Maybe()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, errStreamUnimplemented).Maybe()
 		client.On("Stop").Return(nil).Once()
 
 		d, err := oracle.NewPriceDaemon(logger, cfg, client)
@@ -237,4 +276,107 @@ func TestPriceDaemon_Start(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, resp)
 	})
+	t.Run("prices are streamed instead of polled", func(t *testing.T) {
+		prices := map[string]string{
+			"btc/usd": "10000",
+		}
+
+		stream := &priceStream{responses: make(chan *types.QueryPricesResponse, 1)}
+		stream.responses <- &types.QueryPricesResponse{Prices: prices}
+
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(stream, nil).Once()
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(time.Millisecond * 300)
+			cancel()
+		}()
+
+		// Prices is never called, as the daemon is streaming
+		err = d.Start(ctx)
+		require.Equal(t, err, context.Canceled)
+
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, prices, resp.Prices)
+	})
+
+	t.Run("falls back to polling while the stream is idle", func(t *testing.T) {
+		streamed := map[string]string{
+			"btc/usd": "10000",
+		}
+		polled := map[string]string{
+			"btc/usd": "11000",
+		}
+
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(
+			func(ctx context.Context, _ *types.StreamPricesRequest, _ ...grpc.CallOption) (types.Oracle_StreamPricesClient, error) {
+				// the stream sends a single response, and then goes idle until it is closed
+				stream := &priceStream{ctx: ctx, responses: make(chan *types.QueryPricesResponse, 1)}
+				stream.responses <- &types.QueryPricesResponse{Prices: streamed}
+				return stream, nil
+			},
+		).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("connection refused")).Maybe()
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: polled}, nil)
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		// the stream is idle after the interval plus the client timeout
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(cfg.Interval + cfg.ClientTimeout + time.Millisecond*300)
+			cancel()
+		}()
+
+		err = d.Start(ctx)
+		require.Equal(t, err, context.Canceled)
+
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, polled, resp.Prices)
+	})
+
+	t.Run("falls back to polling while the stream is closed", func(t *testing.T) {
+		prices := map[string]string{
+			"btc/usd": "10000",
+		}
+
+		stream := &priceStream{responses: make(chan *types.QueryPricesResponse)}
+		close(stream.responses)
+
+		client := mocks.NewOracleClient(t)
+		client.On("Start", mock.Anything).Return(nil).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(stream, nil).Once()
+		client.On("StreamPrices", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("connection refused")).Maybe()
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: prices}, nil).Once()
+		client.On("Prices", mock.Anything, mock.Anything).Return(&types.QueryPricesResponse{Prices: prices}, nil).Maybe()
+		client.On("Stop").Return(nil).Once()
+
+		d, err := oracle.NewPriceDaemon(logger, cfg, client)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(time.Millisecond * 300)
+			cancel()
+		}()
+
+		err = d.Start(ctx)
+		require.Equal(t, err, context.Canceled)
+
+		resp, err := d.Prices(context.Background(), &types.QueryPricesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, prices, resp.Prices)
+	})
 }
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"context"
+	"fmt"
 
 	"google.golang.org/grpc"
 
@@ -47,6 +48,24 @@ func (NoOpClient) Prices(
 	return nil, nil
 }
 
+// StreamPrices returns an error, as there is no oracle to stream prices from.
+func (NoOpClient) StreamPrices(
+	_ context.Context,
+	_ *types.StreamPricesRequest,
+	_ ...grpc.CallOption,
+) (types.Oracle_StreamPricesClient, error) {
+	return nil, fmt.Errorf("oracle client is disabled")
+}
+
+// PriceDetails is a no-op.
+func (NoOpClient) PriceDetails(
+	_ context.Context,
+	_ *types.QueryPriceDetailsRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryPriceDetailsResponse, error) {
+	return nil, nil
+}
+
 func (c NoOpClient) MarketMap(
 	_ context.Context,
 	_ *types.QueryMarketMapRequest,
@@ -55,6 +74,15 @@ func (c NoOpClient) MarketMap(
 	return nil, nil
 }
 
+// Health is a no-op.
+func (NoOpClient) Health(
+	_ context.Context,
+	_ *types.QueryHealthRequest,
+	_ ...grpc.CallOption,
+) (*types.QueryHealthResponse, error) {
+	return nil, nil
+}
+
 func (c NoOpClient) Version(
 	_ context.Context,
 	_ *types.QueryVersionRequest,
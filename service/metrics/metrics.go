@@ -37,6 +37,10 @@ type Metrics interface {
 	// AddValidatorReportForTicker updates a counter per validator + status. This counter represents the number of times a validator
 	// for a ticker with a price, w/o a price, or w/ an absent.
 	AddValidatorReportForTicker(validator string, ticker slinkytypes.CurrencyPair, status ReportStatus)
+
+	// AddCircuitBreakerTrip updates a counter per ticker + action. This counter represents the number of times the circuit breaker
+	// tripped on a newly aggregated price for a ticker, and whether the price was clamped or rejected.
+	AddCircuitBreakerTrip(ticker slinkytypes.CurrencyPair, action CircuitBreakerAction)
 }
 
 type nopMetricsImpl struct{}
@@ -58,6 +62,8 @@ func (m *nopMetricsImpl) AddValidatorReportForTicker(_ string, _ slinkytypes.Cur
 func (m *nopMetricsImpl) AddValidatorPriceForTicker(_ string, _ slinkytypes.CurrencyPair, _ float64) {
 }
 
+func (m *nopMetricsImpl) AddCircuitBreakerTrip(_ slinkytypes.CurrencyPair, _ CircuitBreakerAction) {}
+
 func NewMetrics(chainID string) Metrics {
 	m := &metricsImpl{
 		oracleResponseLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
@@ -103,6 +109,11 @@ func NewMetrics(chainID string) Metrics {
 			Name:      "report_status_per_validator",
 			Help:      "The status of the report for a specific validator and ticker",
 		}, []string{ChainIDLabel, ValidatorLabel, TickerLabel, StatusLabel}),
+		circuitBreakerTrips: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: AppNamespace,
+			Name:      "circuit_breaker_trips",
+			Help:      "The number of times the circuit breaker tripped for a specific ticker, by action taken",
+		}, []string{ChainIDLabel, TickerLabel, ActionLabel}),
 	}
 
 	// register the above metrics
@@ -114,6 +125,7 @@ func NewMetrics(chainID string) Metrics {
 	prometheus.MustRegister(m.prices)
 	prometheus.MustRegister(m.reportsPerValidator)
 	prometheus.MustRegister(m.reportStatusPerValidator)
+	prometheus.MustRegister(m.circuitBreakerTrips)
 
 	m.chainID = chainID
 
@@ -129,6 +141,7 @@ type metricsImpl struct {
 	abciRequests             *prometheus.GaugeVec
 	messageSize              *prometheus.HistogramVec
 	prices                   *prometheus.GaugeVec
+	circuitBreakerTrips      *prometheus.GaugeVec
 	chainID                  string
 }
 
@@ -191,6 +204,14 @@ func (m *metricsImpl) AddValidatorReportForTicker(validator string, ticker slink
 	}).Inc()
 }
 
+func (m *metricsImpl) AddCircuitBreakerTrip(ticker slinkytypes.CurrencyPair, action CircuitBreakerAction) {
+	m.circuitBreakerTrips.With(prometheus.Labels{
+		ChainIDLabel: m.chainID,
+		TickerLabel:  strings.ToLower(ticker.String()),
+		ActionLabel:  action.String(),
+	}).Inc()
+}
+
 // NewMetricsFromConfig returns a new Metrics implementation based on the config. The Metrics
 // returned is safe to be used in the client, and in the Oracle used by the PreBlocker.
 // If the metrics are not enabled, a nop implementation is returned.
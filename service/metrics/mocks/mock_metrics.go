@@ -22,6 +22,11 @@ func (_m *Metrics) AddABCIRequest(method metrics.ABCIMethod, status metrics.Labe
 	_m.Called(method, status)
 }
 
+// AddCircuitBreakerTrip provides a mock function with given fields: ticker, action
+func (_m *Metrics) AddCircuitBreakerTrip(ticker types.CurrencyPair, action metrics.CircuitBreakerAction) {
+	_m.Called(ticker, action)
+}
+
 // AddOracleResponse provides a mock function with given fields: status
 func (_m *Metrics) AddOracleResponse(status metrics.Labeller) {
 	_m.Called(status)
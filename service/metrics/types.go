@@ -15,6 +15,7 @@ const (
 	ABCIMethodStatusLabel = "abci_method_status"
 	MessageTypeLabel      = "message_type"
 	ValidatorLabel        = "validator"
+	ActionLabel           = "action"
 
 	// helpful constants.
 	notImplemented = "not_implemented"
@@ -101,6 +102,29 @@ func (rs ReportStatus) String() string {
 	}
 }
 
+// CircuitBreakerAction is an identifier for the action taken by the circuit breaker when it trips, i.e.
+// clamped, rejected, or accepted after too many consecutive rejections.
+type CircuitBreakerAction int
+
+const (
+	Clamped CircuitBreakerAction = iota
+	Rejected
+	Accepted
+)
+
+func (a CircuitBreakerAction) String() string {
+	switch a {
+	case Clamped:
+		return "clamped"
+	case Rejected:
+		return "rejected"
+	case Accepted:
+		return "accepted"
+	default:
+		return notImplemented
+	}
+}
+
 // Labeller is an interface that can be implemented by errors to provide a label for prometheus metrics.
 type Labeller interface {
 	Label() string
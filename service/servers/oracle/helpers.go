@@ -1,7 +1,11 @@
 package oracle
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/1119-Labs/slinky/oracle/types"
+	servicetypes "github.com/1119-Labs/slinky/service/servers/oracle/types"
 )
 
 func ToReqPrices(prices types.Prices) map[string]string {
@@ -14,3 +18,115 @@ func ToReqPrices(prices types.Prices) map[string]string {
 
 	return reqPrices
 }
+
+// ToReqPriceDetails converts the price details of the oracle to their response type. Prices that are not
+// set are returned as empty strings.
+func ToReqPriceDetails(details types.PriceDetails) map[string]servicetypes.MarketPriceDetails {
+	reqDetails := make(map[string]servicetypes.MarketPriceDetails, len(details))
+
+	for ticker, market := range details {
+		reqMarket := servicetypes.MarketPriceDetails{
+			UnscaledPrice:    floatString(market.Price),
+			MinProviderCount: market.MinProviderCount,
+			Providers:        make([]servicetypes.ProviderPriceDetails, len(market.Providers)),
+			Aggregation:      market.Aggregation,
+		}
+
+		if market.ScaledPrice != nil {
+			intPrice, _ := market.ScaledPrice.Int(nil)
+			reqMarket.Price = intPrice.String()
+		}
+
+		for i, provider := range market.Providers {
+			reqProvider := servicetypes.ProviderPriceDetails{
+				Provider:         provider.Provider,
+				OffChainTicker:   provider.OffChainTicker,
+				Invert:           provider.Invert,
+				Price:            floatString(provider.Price),
+				Timestamp:        provider.Timestamp,
+				NormalizeByPrice: floatString(provider.NormalizeByPrice),
+				ConvertedPrice:   floatString(provider.ConvertedPrice),
+				Status:           string(provider.Status),
+				Weight:           floatString(provider.Weight),
+			}
+
+			if provider.NormalizeByPair != nil {
+				reqProvider.NormalizeByPair = provider.NormalizeByPair.String()
+			}
+
+			for _, step := range provider.NormalizeByPairs {
+				reqProvider.NormalizeByPairs = append(reqProvider.NormalizeByPairs, step.Converted().String())
+			}
+
+			reqMarket.Providers[i] = reqProvider
+		}
+
+		reqDetails[ticker] = reqMarket
+	}
+
+	return reqDetails
+}
+
+// ToReqHealth converts the health of the oracle to its response type.
+func ToReqHealth(health types.Health) *servicetypes.QueryHealthResponse {
+	resp := &servicetypes.QueryHealthResponse{
+		Running:        health.Running,
+		Ready:          health.Ready,
+		NotReadyReason: health.NotReadyReason,
+		LastSyncTime:   health.LastSyncTime,
+		Providers:      make([]servicetypes.ProviderHealth, len(health.Providers)),
+	}
+
+	if !health.LastSyncTime.IsZero() {
+		resp.LastSyncAge = time.Since(health.LastSyncTime)
+	}
+
+	for i, provider := range health.Providers {
+		resp.Providers[i] = toReqProviderHealth(provider)
+	}
+
+	if health.MarketMap != nil {
+		resp.MarketMapProvider = &servicetypes.MarketMapProviderHealth{
+			Provider:    toReqProviderHealth(health.MarketMap.ProviderHealth),
+			LastUpdated: health.MarketMap.LastUpdated,
+		}
+	}
+
+	return resp
+}
+
+// toReqProviderHealth converts the health of a provider to its response type.
+func toReqProviderHealth(health types.ProviderHealth) servicetypes.ProviderHealth {
+	resp := servicetypes.ProviderHealth{
+		Name:         health.Name,
+		Type:         string(health.Type),
+		Running:      health.Running,
+		NumTickers:   uint64(health.NumTickers), //nolint:gosec
+		RecentErrors: make([]servicetypes.ProviderError, len(health.RecentErrors)),
+	}
+
+	if !health.LastSuccess.IsZero() {
+		lastSuccess := health.LastSuccess
+		resp.LastSuccessfulResponse = &lastSuccess
+	}
+
+	for i, providerErr := range health.RecentErrors {
+		resp.RecentErrors[i] = servicetypes.ProviderError{
+			Id:        providerErr.ID,
+			Code:      int64(providerErr.Code),
+			Error:     providerErr.Code.Error().Error(),
+			Timestamp: providerErr.Timestamp,
+		}
+	}
+
+	return resp
+}
+
+// floatString returns the decimal representation of the given price, or an empty string if it is nil.
+func floatString(price *big.Float) string {
+	if price == nil {
+		return ""
+	}
+
+	return price.Text('f', -1)
+}
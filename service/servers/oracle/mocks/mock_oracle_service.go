@@ -15,6 +15,36 @@ type OracleService struct {
 	mock.Mock
 }
 
+// Health provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) Health(_a0 context.Context, _a1 *types.QueryHealthRequest) (*types.QueryHealthResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 *types.QueryHealthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryHealthRequest) (*types.QueryHealthResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryHealthRequest) *types.QueryHealthResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryHealthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryHealthRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // MarketMap provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) MarketMap(_a0 context.Context, _a1 *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -45,6 +75,36 @@ func (_m *OracleService) MarketMap(_a0 context.Context, _a1 *types.QueryMarketMa
 	return r0, r1
 }
 
+// PriceDetails provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) PriceDetails(_a0 context.Context, _a1 *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PriceDetails")
+	}
+
+	var r0 *types.QueryPriceDetailsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryPriceDetailsRequest) *types.QueryPriceDetailsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryPriceDetailsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryPriceDetailsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Prices provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Prices(_a0 context.Context, _a1 *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -111,6 +171,24 @@ func (_m *OracleService) Stop(_a0 context.Context) error {
 	return r0
 }
 
+// StreamPrices provides a mock function with given fields: _a0, _a1
+func (_m *OracleService) StreamPrices(_a0 *types.StreamPricesRequest, _a1 types.Oracle_StreamPricesServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamPrices")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*types.StreamPricesRequest, types.Oracle_StreamPricesServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Version provides a mock function with given fields: _a0, _a1
 func (_m *OracleService) Version(_a0 context.Context, _a1 *types.QueryVersionRequest) (*types.QueryVersionResponse, error) {
 	ret := _m.Called(_a0, _a1)
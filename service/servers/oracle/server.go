@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -18,11 +19,26 @@ import (
 
 	"github.com/1119-Labs/slinky/cmd/build"
 	"github.com/1119-Labs/slinky/oracle"
+	oracletypes "github.com/1119-Labs/slinky/oracle/types"
 	"github.com/1119-Labs/slinky/pkg/sync"
 	"github.com/1119-Labs/slinky/service/servers/oracle/types"
 )
 
-const DefaultServerShutdownTimeout = 3 * time.Second
+const (
+	DefaultServerShutdownTimeout = 3 * time.Second
+
+	// StreamPricesPath is the HTTP path of the StreamPrices method. Requests to this path that accept
+	// text/event-stream are served as server-sent events, otherwise they are served by the grpc-gateway
+	// as newline-delimited JSON.
+	StreamPricesPath = "/slinky/oracle/v1/prices/stream"
+
+	// LivenessPath is the HTTP path of the liveness probe, which succeeds if the oracle is running.
+	LivenessPath = "/healthz"
+
+	// ReadinessPath is the HTTP path of the readiness probe, which succeeds if the oracle is ready
+	// to serve fresh prices, as reported by the Health method.
+	ReadinessPath = "/readyz"
+)
 
 // OracleServer is the base implementation of the service.OracleServer interface, this is meant to
 // serve requests from a remote OracleClient.
@@ -38,6 +54,9 @@ type OracleServer struct { //nolint
 	// grpc-gateway mux -- serves all http grpc proxy requests
 	gatewayMux *runtime.ServeMux
 
+	// marshaler used to encode responses served over http
+	marshaler runtime.Marshaler
+
 	// underlying http server
 	httpSrv *http.Server
 
@@ -55,6 +74,11 @@ func NewOracleServer(o oracle.Oracle, logger *zap.Logger) *OracleServer {
 	os := &OracleServer{
 		o:      o,
 		logger: logger,
+		marshaler: &gateway.JSONPb{
+			EmitDefaults: true,
+			Indent:       "",
+			OrigName:     true,
+		},
 	}
 	os.Closer = sync.NewCloser().WithCallback(func() {
 		// if the server has been started, close it
@@ -75,6 +99,8 @@ func (os *OracleServer) routeRequest(w http.ResponseWriter, r *http.Request) {
 		r.Header.Get("Content-Type"), "application/grpc") {
 
 		os.grpcSrv.ServeHTTP(w, r)
+	} else if r.URL.Path == StreamPricesPath && strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		os.streamPricesSSE(w, r)
 	} else {
 		os.gatewayMux.ServeHTTP(w, r)
 	}
@@ -97,11 +123,7 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	// register the grpc-gateway
 	// it handles the http request and dials the server endpoint with the grpc request
 	os.gatewayMux = runtime.NewServeMux(
-		runtime.WithMarshalerOption(runtime.MIMEWildcard, &gateway.JSONPb{
-			EmitDefaults: true,
-			Indent:       "",
-			OrigName:     true,
-		}),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, os.marshaler),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithNoProxy()}
 	err := types.RegisterOracleHandlerFromEndpoint(ctx, os.gatewayMux, serverEndpoint, opts)
@@ -110,6 +132,8 @@ func (os *OracleServer) StartServer(ctx context.Context, host, port string) erro
 	}
 
 	router := http.NewServeMux()
+	router.HandleFunc(LivenessPath, os.livenessProbe)
+	router.HandleFunc(ReadinessPath, os.readinessProbe)
 	router.HandleFunc("/", os.routeRequest)
 	os.httpSrv.Handler = h2c.NewHandler(router, &http2.Server{})
 
@@ -166,17 +190,7 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 
 	// run the request in a goroutine, to unblock server + ctx cancellation
 	go func() {
-		// get the prices
-		prices := os.o.GetPrices()
-
-		// get the latest timestamp of the latest update from the oracle
-		timestamp := os.o.GetLastSyncTime()
-
-		resCh <- &types.QueryPricesResponse{
-			Prices:    ToReqPrices(prices),
-			Timestamp: timestamp,
-			Version:   build.Build,
-		}
+		resCh <- os.pricesResponse(os.o.GetPrices())
 	}()
 
 	// defer to context closure
@@ -189,6 +203,164 @@ func (os *OracleServer) Prices(ctx context.Context, req *types.QueryPricesReques
 	}
 }
 
+// StreamPrices streams the prices of the underlying oracle. The current prices are sent immediately, and
+// the latest prices are then sent every time the oracle aggregates new prices, until the stream's context
+// is cancelled or the server is closed.
+func (os *OracleServer) StreamPrices(req *types.StreamPricesRequest, stream types.Oracle_StreamPricesServer) error {
+	// check that the request is non-nil
+	if req == nil {
+		return ErrNilRequest
+	}
+
+	os.logger.Debug("received request to stream prices")
+
+	return os.streamPrices(stream.Context(), stream.Send)
+}
+
+// streamPricesSSE serves the StreamPrices method as server-sent events, where the data of each event is
+// a JSON encoded QueryPricesResponse.
+func (os *OracleServer) streamPricesSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	os.logger.Debug("received request to stream prices over server-sent events")
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	err := os.streamPrices(r.Context(), func(resp *types.QueryPricesResponse) error {
+		bz, err := os.marshaler.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(w, "data: %s\n\n", bz); err != nil {
+			return err
+		}
+
+		flusher.Flush()
+		return nil
+	})
+	if err != nil && !errors.Is(err, context.Canceled) {
+		os.logger.Error("failed to stream prices over server-sent events", zap.Error(err))
+
+		// the status code can no longer be changed, so report the error as an event instead
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", err)
+		flusher.Flush()
+	}
+}
+
+// streamPrices sends the current prices of the oracle, and then the latest prices on every update, to the
+// given send function until ctx is done, the server is closed, or send returns an error.
+func (os *OracleServer) streamPrices(ctx context.Context, send func(*types.QueryPricesResponse) error) error {
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return ErrOracleNotRunning
+	}
+
+	// subscribe before reading the current prices, so that no update is missed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	updates := os.o.SubscribePrices(ctx)
+
+	if err := send(os.pricesResponse(os.o.GetPrices())); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			os.logger.Debug("price stream closed by client")
+			return ctx.Err()
+		case <-os.Done():
+			os.logger.Debug("price stream closed by server")
+			return nil
+		case prices, ok := <-updates:
+			if !ok {
+				return ctx.Err()
+			}
+
+			if err := send(os.pricesResponse(prices)); err != nil {
+				os.logger.Error("failed to send prices to stream", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// pricesResponse returns a QueryPricesResponse for the given prices, timestamped with the latest
+// update of the oracle.
+func (os *OracleServer) pricesResponse(prices oracletypes.Prices) *types.QueryPricesResponse {
+	return &types.QueryPricesResponse{
+		Prices:    ToReqPrices(prices),
+		Timestamp: os.o.GetLastSyncTime(),
+		Version:   build.Build,
+	}
+}
+
+// PriceDetails returns how each of the underlying oracle's latest prices was derived from the prices of
+// each provider.
+func (os *OracleServer) PriceDetails(_ context.Context, req *types.QueryPriceDetailsRequest) (*types.QueryPriceDetailsResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	os.logger.Debug("received request for price details")
+
+	// check that oracle is running
+	if !os.o.IsRunning() {
+		os.logger.Error("oracle not running")
+		return nil, ErrOracleNotRunning
+	}
+
+	return &types.QueryPriceDetailsResponse{
+		Markets:   ToReqPriceDetails(os.o.GetPriceDetails()),
+		Timestamp: os.o.GetLastSyncTime(),
+		Version:   build.Build,
+	}, nil
+}
+
+// Health returns the status of the underlying oracle and each of its providers.
+func (os *OracleServer) Health(_ context.Context, req *types.QueryHealthRequest) (*types.QueryHealthResponse, error) {
+	// check that the request is non-nil
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	resp := ToReqHealth(os.o.GetHealth())
+	resp.Version = build.Build
+
+	return resp, nil
+}
+
+// livenessProbe responds with 200 if the oracle is running, and 503 otherwise.
+func (os *OracleServer) livenessProbe(w http.ResponseWriter, _ *http.Request) {
+	if !os.o.IsRunning() {
+		http.Error(w, ErrOracleNotRunning.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	fmt.Fprintln(w, "ok")
+}
+
+// readinessProbe responds with 200 if the oracle is ready to serve fresh prices, and 503 with the reason
+// it is not ready otherwise.
+func (os *OracleServer) readinessProbe(w http.ResponseWriter, _ *http.Request) {
+	health := os.o.GetHealth()
+	if !health.Ready {
+		http.Error(w, health.NotReadyReason, http.StatusServiceUnavailable)
+		return
+	}
+
+	fmt.Fprintln(w, "ok")
+}
+
 // MarketMap returns the current market map from the Oracle.
 func (os *OracleServer) MarketMap(_ context.Context, _ *types.QueryMarketMapRequest) (*types.QueryMarketMapResponse, error) {
 	mm := os.o.GetMarketMap()
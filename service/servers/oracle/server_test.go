@@ -1,11 +1,13 @@
 package oracle_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/1119-Labs/slinky/oracle/mocks"
 	"github.com/1119-Labs/slinky/oracle/types"
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	providertypes "github.com/1119-Labs/slinky/providers/types"
 	client "github.com/1119-Labs/slinky/service/clients/oracle"
 	"github.com/1119-Labs/slinky/service/metrics"
 	server "github.com/1119-Labs/slinky/service/servers/oracle"
@@ -167,6 +170,262 @@ func (s *ServerTestSuite) TestOracleServerPrices() {
 	s.Require().Contains(string(respBz), fmt.Sprintf(`{"prices":{"%s":"100","%s":"200"},"timestamp":`, cp1.String(), cp2.String()))
 }
 
+func (s *ServerTestSuite) TestOracleServerPriceDetails() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	usdt := slinkytypes.NewCurrencyPair("USDT", "USD")
+	ts := time.Now()
+
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+	s.mockOracle.On("GetPriceDetails").Return(types.PriceDetails{
+		cp.String(): {
+			Price:            big.NewFloat(70_000.5),
+			ScaledPrice:      big.NewFloat(7_000_050),
+			MinProviderCount: 1,
+			Aggregation:      "vwap",
+			Providers: []types.ProviderPriceDetails{
+				{
+					Provider:         "coinbase",
+					OffChainTicker:   "BTC-USDT",
+					NormalizeByPair:  &usdt,
+					Price:            big.NewFloat(70_000.5),
+					Timestamp:        ts,
+					NormalizeByPrice: big.NewFloat(1),
+					ConvertedPrice:   big.NewFloat(70_000.5),
+					Weight:           big.NewFloat(250.5),
+					Status:           types.PriceInputIncluded,
+				},
+				{
+					Provider:       "binance",
+					OffChainTicker: "BTCUSDT",
+					Status:         types.PriceInputMissing,
+				},
+			},
+		},
+	})
+
+	resp, err := s.client.PriceDetails(context.Background(), &stypes.QueryPriceDetailsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(ts.UTC(), resp.Timestamp)
+
+	details, ok := resp.Markets[cp.String()]
+	s.Require().True(ok)
+	s.Require().Equal("7000050", details.Price)
+	s.Require().Equal("70000.5", details.UnscaledPrice)
+	s.Require().Equal(uint64(1), details.MinProviderCount)
+	s.Require().Equal("vwap", details.Aggregation)
+	s.Require().Equal([]stypes.ProviderPriceDetails{
+		{
+			Provider:         "coinbase",
+			OffChainTicker:   "BTC-USDT",
+			NormalizeByPair:  usdt.String(),
+			Price:            "70000.5",
+			Timestamp:        ts.UTC(),
+			NormalizeByPrice: "1",
+			ConvertedPrice:   "70000.5",
+			Weight:           "250.5",
+			Status:           string(types.PriceInputIncluded),
+		},
+		{
+			Provider:       "binance",
+			OffChainTicker: "BTCUSDT",
+			Timestamp:      time.Time{}.UTC(),
+			Status:         string(types.PriceInputMissing),
+		},
+	}, details.Providers)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/price_details", localhost, port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"status":"missing"`)
+}
+
+func (s *ServerTestSuite) TestOracleServerHealth() {
+	ts := time.Now()
+
+	s.mockOracle.On("GetHealth").Return(types.Health{
+		Running:      true,
+		Ready:        true,
+		LastSyncTime: ts,
+		MarketMap: &types.MarketMapHealth{
+			ProviderHealth: types.ProviderHealth{
+				Name:       "marketmap_api",
+				Type:       providertypes.API,
+				Running:    true,
+				NumTickers: 1,
+			},
+			LastUpdated: 10,
+		},
+		Providers: []types.ProviderHealth{
+			{
+				Name:       "coinbase_api",
+				Type:       providertypes.API,
+				Running:    true,
+				NumTickers: 2,
+				ProviderHealth: providertypes.ProviderHealth{
+					LastSuccess: ts,
+					RecentErrors: []providertypes.ProviderError{
+						{
+							ID:        "btc/usd",
+							Code:      providertypes.ErrorRateLimitExceeded,
+							Timestamp: ts,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	resp, err := s.client.Health(context.Background(), &stypes.QueryHealthRequest{})
+	s.Require().NoError(err)
+	s.Require().True(resp.Running)
+	s.Require().True(resp.Ready)
+	s.Require().Equal(ts.UTC(), resp.LastSyncTime)
+	s.Require().Positive(resp.LastSyncAge)
+	s.Require().NotNil(resp.MarketMapProvider)
+	s.Require().Equal(uint64(10), resp.MarketMapProvider.LastUpdated)
+	s.Require().Equal("marketmap_api", resp.MarketMapProvider.Provider.Name)
+	s.Require().Nil(resp.MarketMapProvider.Provider.LastSuccessfulResponse)
+
+	s.Require().Len(resp.Providers, 1)
+	provider := resp.Providers[0]
+	s.Require().Equal("coinbase_api", provider.Name)
+	s.Require().Equal(string(providertypes.API), provider.Type)
+	s.Require().Equal(uint64(2), provider.NumTickers)
+	s.Require().NotNil(provider.LastSuccessfulResponse)
+	s.Require().Equal(ts.UTC(), *provider.LastSuccessfulResponse)
+	s.Require().Equal([]stypes.ProviderError{
+		{
+			Id:        "btc/usd",
+			Code:      int64(providertypes.ErrorRateLimitExceeded),
+			Error:     providertypes.ErrorRateLimitExceeded.Error().Error(),
+			Timestamp: ts.UTC(),
+		},
+	}, provider.RecentErrors)
+
+	// call from http client
+	httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s/slinky/oracle/v1/health", localhost, port))
+	s.Require().NoError(err)
+	s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+	respBz, err := io.ReadAll(httpResp.Body)
+	s.Require().NoError(err)
+	s.Require().Contains(string(respBz), `"ready":true`)
+}
+
+func (s *ServerTestSuite) TestOracleServerProbes() {
+	probe := func(path string) int {
+		httpResp, err := s.httpClient.Get(fmt.Sprintf("http://%s:%s%s", localhost, port, path))
+		s.Require().NoError(err)
+		defer httpResp.Body.Close()
+		return httpResp.StatusCode
+	}
+
+	s.Run("oracle is running and ready", func() {
+		s.mockOracle.On("IsRunning").Return(true).Once()
+		s.mockOracle.On("GetHealth").Return(types.Health{Running: true, Ready: true}).Once()
+
+		s.Require().Equal(http.StatusOK, probe(server.LivenessPath))
+		s.Require().Equal(http.StatusOK, probe(server.ReadinessPath))
+	})
+
+	s.Run("oracle is running but not ready", func() {
+		s.mockOracle.On("IsRunning").Return(true).Once()
+		s.mockOracle.On("GetHealth").Return(types.Health{Running: true, NotReadyReason: "oracle has not synced prices"}).Once()
+
+		s.Require().Equal(http.StatusOK, probe(server.LivenessPath))
+		s.Require().Equal(http.StatusServiceUnavailable, probe(server.ReadinessPath))
+	})
+
+	s.Run("oracle is not running", func() {
+		s.mockOracle.On("IsRunning").Return(false).Once()
+		s.mockOracle.On("GetHealth").Return(types.Health{NotReadyReason: "oracle is not running"}).Once()
+
+		s.Require().Equal(http.StatusServiceUnavailable, probe(server.LivenessPath))
+		s.Require().Equal(http.StatusServiceUnavailable, probe(server.ReadinessPath))
+	})
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPrices() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	ts := time.Now()
+
+	s.mockOracle.On("IsRunning").Return(true)
+	s.mockOracle.On("GetPrices").Return(types.Prices{
+		cp.String(): big.NewFloat(100.1),
+	})
+	s.mockOracle.On("GetLastSyncTime").Return(ts)
+
+	s.Run("grpc stream", func() {
+		updates := make(chan types.Prices, 1)
+		s.mockOracle.On("SubscribePrices", mock.Anything).Return((<-chan types.Prices)(updates)).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		stream, err := s.client.StreamPrices(ctx, &stypes.StreamPricesRequest{})
+		s.Require().NoError(err)
+
+		// the current prices are sent first
+		resp, err := stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(big.NewInt(100).String(), resp.Prices[cp.String()])
+		s.Require().Equal(ts.UTC(), resp.Timestamp)
+
+		// followed by every update
+		updates <- types.Prices{cp.String(): big.NewFloat(200.1)}
+		resp, err = stream.Recv()
+		s.Require().NoError(err)
+		s.Require().Equal(big.NewInt(200).String(), resp.Prices[cp.String()])
+	})
+
+	s.Run("server-sent events", func() {
+		updates := make(chan types.Prices, 1)
+		s.mockOracle.On("SubscribePrices", mock.Anything).Return((<-chan types.Prices)(updates)).Once()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s:%s%s", localhost, port, server.StreamPricesPath), nil)
+		s.Require().NoError(err)
+		req.Header.Set("Accept", "text/event-stream")
+
+		httpResp, err := s.httpClient.Do(req)
+		s.Require().NoError(err)
+		defer httpResp.Body.Close()
+
+		s.Require().Equal(http.StatusOK, httpResp.StatusCode)
+		s.Require().Equal("text/event-stream", httpResp.Header.Get("Content-Type"))
+
+		reader := bufio.NewReader(httpResp.Body)
+		line, err := reader.ReadString('\n')
+		s.Require().NoError(err)
+		s.Require().True(strings.HasPrefix(line, fmt.Sprintf(`data: {"prices":{"%s":"100"},"timestamp":`, cp.String())))
+
+		updates <- types.Prices{cp.String(): big.NewFloat(300.1)}
+
+		// skip the blank line terminating the first event
+		_, err = reader.ReadString('\n')
+		s.Require().NoError(err)
+		line, err = reader.ReadString('\n')
+		s.Require().NoError(err)
+		s.Require().True(strings.HasPrefix(line, fmt.Sprintf(`data: {"prices":{"%s":"300"},"timestamp":`, cp.String())))
+	})
+}
+
+func (s *ServerTestSuite) TestOracleServerStreamPricesNotRunning() {
+	s.mockOracle.On("IsRunning").Return(false)
+
+	stream, err := s.client.StreamPrices(context.Background(), &stypes.StreamPricesRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+
+	s.Require().ErrorContains(err, server.ErrOracleNotRunning.Error())
+}
+
 func (s *ServerTestSuite) TestOracleMarketMap() {
 	dummyMarketMap := mmtypes.MarketMap{Markets: map[string]mmtypes.Market{
 		"foo": {
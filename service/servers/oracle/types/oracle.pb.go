@@ -16,6 +16,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -136,6 +137,383 @@ func (m *QueryPricesResponse) GetVersion() string {
 	return ""
 }
 
+// StreamPricesRequest defines the request type for the StreamPrices method.
+type StreamPricesRequest struct {
+}
+
+func (m *StreamPricesRequest) Reset()         { *m = StreamPricesRequest{} }
+func (m *StreamPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPricesRequest) ProtoMessage()    {}
+func (*StreamPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{2}
+}
+func (m *StreamPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPricesRequest.Merge(m, src)
+}
+func (m *StreamPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPricesRequest proto.InternalMessageInfo
+
+// QueryPriceDetailsRequest defines the request type for the PriceDetails
+// method.
+type QueryPriceDetailsRequest struct {
+}
+
+func (m *QueryPriceDetailsRequest) Reset()         { *m = QueryPriceDetailsRequest{} }
+func (m *QueryPriceDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceDetailsRequest) ProtoMessage()    {}
+func (*QueryPriceDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{3}
+}
+func (m *QueryPriceDetailsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceDetailsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceDetailsRequest.Merge(m, src)
+}
+func (m *QueryPriceDetailsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceDetailsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceDetailsRequest proto.InternalMessageInfo
+
+// QueryPriceDetailsResponse defines the response type for the PriceDetails
+// method.
+type QueryPriceDetailsResponse struct {
+	// Markets defines the price details of each market, keyed by ticker.
+	Markets map[string]MarketPriceDetails `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Timestamp defines the timestamp of the prices.
+	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Version defines the version of the oracle service that provided the
+	// prices.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryPriceDetailsResponse) Reset()         { *m = QueryPriceDetailsResponse{} }
+func (m *QueryPriceDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceDetailsResponse) ProtoMessage()    {}
+func (*QueryPriceDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{4}
+}
+func (m *QueryPriceDetailsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceDetailsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceDetailsResponse.Merge(m, src)
+}
+func (m *QueryPriceDetailsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceDetailsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceDetailsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceDetailsResponse) GetMarkets() map[string]MarketPriceDetails {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+func (m *QueryPriceDetailsResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *QueryPriceDetailsResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// MarketPriceDetails defines how the price of a market was derived from the
+// prices of each provider.
+type MarketPriceDetails struct {
+	// Price defines the aggregated price scaled by the ticker's decimals, as
+	// returned by the Prices method. It is empty if no price was aggregated.
+	Price string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	// UnscaledPrice defines the unscaled aggregated price. It is empty if no
+	// price was aggregated.
+	UnscaledPrice string `protobuf:"bytes,2,opt,name=unscaled_price,json=unscaledPrice,proto3" json:"unscaled_price,omitempty"`
+	// MinProviderCount defines the minimum number of converted provider prices
+	// required to aggregate a price.
+	MinProviderCount uint64 `protobuf:"varint,3,opt,name=min_provider_count,json=minProviderCount,proto3" json:"min_provider_count,omitempty"`
+	// Providers defines the details of each provider price configured for the
+	// market.
+	Providers []ProviderPriceDetails `protobuf:"bytes,4,rep,name=providers,proto3" json:"providers"`
+	// Aggregation defines the method used to aggregate the converted provider
+	// prices: one of median, volume_weighted_median or vwap.
+	Aggregation string `protobuf:"bytes,5,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+}
+
+func (m *MarketPriceDetails) Reset()         { *m = MarketPriceDetails{} }
+func (m *MarketPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*MarketPriceDetails) ProtoMessage()    {}
+func (*MarketPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{5}
+}
+func (m *MarketPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketPriceDetails.Merge(m, src)
+}
+func (m *MarketPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketPriceDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketPriceDetails proto.InternalMessageInfo
+
+func (m *MarketPriceDetails) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *MarketPriceDetails) GetUnscaledPrice() string {
+	if m != nil {
+		return m.UnscaledPrice
+	}
+	return ""
+}
+
+func (m *MarketPriceDetails) GetMinProviderCount() uint64 {
+	if m != nil {
+		return m.MinProviderCount
+	}
+	return 0
+}
+
+func (m *MarketPriceDetails) GetProviders() []ProviderPriceDetails {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *MarketPriceDetails) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+// ProviderPriceDetails defines how the price of a single provider was used to
+// derive the price of a market.
+type ProviderPriceDetails struct {
+	// Provider defines the name of the provider.
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// OffChainTicker defines the provider's ticker for the market.
+	OffChainTicker string `protobuf:"bytes,2,opt,name=off_chain_ticker,json=offChainTicker,proto3" json:"off_chain_ticker,omitempty"`
+	// Invert defines whether the provider price is inverted before conversion.
+	Invert bool `protobuf:"varint,3,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizeByPair defines the pair whose index price the provider price is
+	// converted by. It is empty if the price is not converted.
+	NormalizeByPair string `protobuf:"bytes,4,opt,name=normalize_by_pair,json=normalizeByPair,proto3" json:"normalize_by_pair,omitempty"`
+	// Price defines the raw price reported by the provider. It is empty if the
+	// provider did not report a price.
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	// Timestamp defines the time at which the provider reported the price.
+	Timestamp time.Time `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// NormalizeByPrice defines the index price of the normalization pair used to
+	// convert the price. It is empty if the price is not converted.
+	NormalizeByPrice string `protobuf:"bytes,7,opt,name=normalize_by_price,json=normalizeByPrice,proto3" json:"normalize_by_price,omitempty"`
+	// ConvertedPrice defines the provider price converted to the market's
+	// ticker. It is empty if the price could not be converted.
+	ConvertedPrice string `protobuf:"bytes,8,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	// Status defines whether the price was included in the aggregated price, or
+	// why it was dropped: one of included, missing, stale, missing_index_price,
+	// outlier or below_min_provider_count.
+	Status string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	// Weight defines the weight of the converted price in a volume weighted
+	// aggregation, i.e. the volume or liquidity reported by the provider. It is
+	// empty if the provider did not report either.
+	Weight string `protobuf:"bytes,10,opt,name=weight,proto3" json:"weight,omitempty"`
+	// NormalizeByPairs defines the ordered conversion path the provider price is
+	// converted by, if it is converted by more than one pair. Inverted steps are
+	// given as the inverted pair, i.e. QUOTE/BASE. In this case NormalizeByPrice
+	// is the product of the conversion path.
+	NormalizeByPairs []string `protobuf:"bytes,11,rep,name=normalize_by_pairs,json=normalizeByPairs,proto3" json:"normalize_by_pairs,omitempty"`
+}
+
+func (m *ProviderPriceDetails) Reset()         { *m = ProviderPriceDetails{} }
+func (m *ProviderPriceDetails) String() string { return proto.CompactTextString(m) }
+func (*ProviderPriceDetails) ProtoMessage()    {}
+func (*ProviderPriceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{6}
+}
+func (m *ProviderPriceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderPriceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderPriceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderPriceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderPriceDetails.Merge(m, src)
+}
+func (m *ProviderPriceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderPriceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderPriceDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderPriceDetails proto.InternalMessageInfo
+
+func (m *ProviderPriceDetails) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetOffChainTicker() string {
+	if m != nil {
+		return m.OffChainTicker
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
+func (m *ProviderPriceDetails) GetNormalizeByPair() string {
+	if m != nil {
+		return m.NormalizeByPair
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *ProviderPriceDetails) GetNormalizeByPrice() string {
+	if m != nil {
+		return m.NormalizeByPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetConvertedPrice() string {
+	if m != nil {
+		return m.ConvertedPrice
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+func (m *ProviderPriceDetails) GetNormalizeByPairs() []string {
+	if m != nil {
+		return m.NormalizeByPairs
+	}
+	return nil
+}
+
 // QueryMarketMapRequest defines the request type for the MarketMap method.
 type QueryMarketMapRequest struct {
 }
@@ -144,7 +522,7 @@ func (m *QueryMarketMapRequest) Reset()         { *m = QueryMarketMapRequest{} }
 func (m *QueryMarketMapRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapRequest) ProtoMessage()    {}
 func (*QueryMarketMapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{2}
+	return fileDescriptor_e88883d464f0f25b, []int{7}
 }
 func (m *QueryMarketMapRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,7 +561,7 @@ func (m *QueryMarketMapResponse) Reset()         { *m = QueryMarketMapResponse{}
 func (m *QueryMarketMapResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMarketMapResponse) ProtoMessage()    {}
 func (*QueryMarketMapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{3}
+	return fileDescriptor_e88883d464f0f25b, []int{8}
 }
 func (m *QueryMarketMapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,7 +605,7 @@ func (m *QueryVersionRequest) Reset()         { *m = QueryVersionRequest{} }
 func (m *QueryVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionRequest) ProtoMessage()    {}
 func (*QueryVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{4}
+	return fileDescriptor_e88883d464f0f25b, []int{9}
 }
 func (m *QueryVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -266,7 +644,7 @@ func (m *QueryVersionResponse) Reset()         { *m = QueryVersionResponse{} }
 func (m *QueryVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVersionResponse) ProtoMessage()    {}
 func (*QueryVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e88883d464f0f25b, []int{5}
+	return fileDescriptor_e88883d464f0f25b, []int{10}
 }
 func (m *QueryVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,75 +680,514 @@ func (m *QueryVersionResponse) GetVersion() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
-	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
-	proto.RegisterType((*QueryMarketMapRequest)(nil), "slinky.service.v1.QueryMarketMapRequest")
-	proto.RegisterType((*QueryMarketMapResponse)(nil), "slinky.service.v1.QueryMarketMapResponse")
-	proto.RegisterType((*QueryVersionRequest)(nil), "slinky.service.v1.QueryVersionRequest")
-	proto.RegisterType((*QueryVersionResponse)(nil), "slinky.service.v1.QueryVersionResponse")
+// QueryHealthRequest defines the request type for the Health method.
+type QueryHealthRequest struct {
 }
 
-func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
-
-var fileDescriptor_e88883d464f0f25b = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xae, 0x5b, 0xe8, 0xa8, 0x7b, 0x01, 0xd3, 0x41, 0x96, 0x41, 0x5a, 0x8a, 0x80, 0x72, 0xc0,
-	0xa6, 0xe1, 0xb2, 0x21, 0x71, 0xa9, 0xc4, 0x8d, 0x89, 0x11, 0x21, 0x90, 0xb8, 0x4c, 0x6e, 0x64,
-	0x42, 0xd4, 0x26, 0x0e, 0xb1, 0x13, 0x29, 0x07, 0x24, 0x84, 0xc4, 0x85, 0xd3, 0x24, 0xfe, 0xd4,
-	0x8e, 0x93, 0xb8, 0x70, 0x02, 0xd4, 0xf2, 0x43, 0x50, 0x6c, 0xa7, 0x5b, 0x5b, 0xa6, 0xed, 0x54,
-	0x3f, 0xbf, 0xcf, 0xef, 0x7d, 0xdf, 0xf7, 0x5e, 0x03, 0x1d, 0x31, 0x0d, 0xe3, 0x49, 0x41, 0x04,
-	0x4b, 0xf3, 0xd0, 0x67, 0x24, 0x1f, 0x12, 0x9e, 0x52, 0x7f, 0xca, 0x70, 0x92, 0x72, 0xc9, 0xd1,
-	0x35, 0x9d, 0xc7, 0x26, 0x8f, 0xf3, 0xa1, 0xdd, 0x09, 0x78, 0xc0, 0x55, 0x96, 0x94, 0x27, 0x0d,
-	0xb4, 0x6f, 0x05, 0x9c, 0x07, 0x53, 0x46, 0x68, 0x12, 0x12, 0x1a, 0xc7, 0x5c, 0x52, 0x19, 0xf2,
-	0x58, 0x98, 0x6c, 0xd7, 0x64, 0x55, 0x34, 0xce, 0xde, 0x13, 0x19, 0x46, 0x4c, 0x48, 0x1a, 0x25,
-	0x06, 0xb0, 0xe5, 0x73, 0x11, 0x71, 0x71, 0xa0, 0xeb, 0xea, 0xc0, 0xa4, 0x7a, 0x86, 0x62, 0x44,
-	0xd3, 0x09, 0x93, 0x11, 0x4d, 0x4a, 0x92, 0x3a, 0xd0, 0x88, 0x7e, 0x07, 0xa2, 0x57, 0x19, 0x4b,
-	0x8b, 0xfd, 0x34, 0xf4, 0x99, 0xf0, 0xd8, 0xc7, 0x8c, 0x09, 0xd9, 0xff, 0x5c, 0x87, 0xd7, 0x97,
-	0xae, 0x45, 0xc2, 0x63, 0xc1, 0xd0, 0x3e, 0x6c, 0x26, 0xea, 0xc6, 0x02, 0xbd, 0xc6, 0xa0, 0xed,
-	0xba, 0x78, 0x4d, 0x23, 0xfe, 0xcf, 0x3b, 0xac, 0xc3, 0xe7, 0xb1, 0x4c, 0x8b, 0xd1, 0xa5, 0xa3,
-	0x5f, 0xdd, 0x9a, 0x67, 0xea, 0xa0, 0x11, 0x6c, 0x2d, 0xf4, 0x58, 0xf5, 0x1e, 0x18, 0xb4, 0x5d,
-	0x1b, 0x6b, 0xc5, 0xb8, 0x52, 0x8c, 0x5f, 0x57, 0x88, 0xd1, 0x95, 0xf2, 0xf1, 0xe1, 0xef, 0x2e,
-	0xf0, 0x4e, 0x9e, 0x21, 0x0b, 0x6e, 0xe4, 0x2c, 0x15, 0x21, 0x8f, 0xad, 0x46, 0x0f, 0x0c, 0x5a,
-	0x5e, 0x15, 0xda, 0xbb, 0xb0, 0x7d, 0xaa, 0x35, 0xba, 0x0a, 0x1b, 0x13, 0x56, 0x58, 0x40, 0x81,
-	0xca, 0x23, 0xea, 0xc0, 0xcb, 0x39, 0x9d, 0x66, 0x4c, 0xb5, 0x6e, 0x79, 0x3a, 0x78, 0x5a, 0xdf,
-	0x01, 0xfd, 0x9b, 0x70, 0x53, 0x29, 0xd9, 0x53, 0x6e, 0xed, 0xd1, 0xa4, 0xf2, 0xe6, 0x2d, 0xbc,
-	0xb1, 0x9a, 0x30, 0xee, 0x3c, 0x83, 0x50, 0x7b, 0x7b, 0x10, 0xd1, 0x44, 0x75, 0x69, 0xbb, 0x4e,
-	0xe5, 0xd0, 0x62, 0x04, 0xa5, 0x47, 0x27, 0x6f, 0x5b, 0x51, 0x75, 0xec, 0x6f, 0x1a, 0xcf, 0xdf,
-	0x68, 0xf2, 0x55, 0xbf, 0xc7, 0xb0, 0xb3, 0x7c, 0x6d, 0xba, 0x9d, 0x52, 0x0d, 0x96, 0x54, 0xbb,
-	0xdf, 0x1a, 0xb0, 0xf9, 0x52, 0x6d, 0x22, 0x2a, 0x60, 0x53, 0x1b, 0x80, 0xee, 0x9d, 0x37, 0x2a,
-	0xd5, 0xcd, 0xbe, 0x7f, 0xb1, 0x89, 0xf6, 0x7b, 0x5f, 0x7e, 0xfc, 0xfd, 0x5e, 0xb7, 0x91, 0x45,
-	0xcc, 0x8a, 0xe9, 0xd5, 0x2f, 0xf7, 0xcb, 0x4c, 0xf6, 0x2b, 0x80, 0xad, 0x85, 0x4e, 0x34, 0x38,
-	0xab, 0xee, 0xaa, 0xbf, 0xf6, 0xc3, 0x0b, 0x20, 0x0d, 0x89, 0xbb, 0x8a, 0xc4, 0x6d, 0xb4, 0xbd,
-	0x4e, 0x62, 0x61, 0x37, 0xfa, 0x04, 0x37, 0x8c, 0x75, 0xe8, 0x4c, 0x71, 0xcb, 0x96, 0xdb, 0x0f,
-	0xce, 0xc5, 0x19, 0x02, 0x77, 0x14, 0x81, 0x6d, 0xb4, 0xb5, 0x4e, 0xc0, 0x0c, 0x63, 0xe4, 0x1d,
-	0xcd, 0x1c, 0x70, 0x3c, 0x73, 0xc0, 0x9f, 0x99, 0x03, 0x0e, 0xe7, 0x4e, 0xed, 0x78, 0xee, 0xd4,
-	0x7e, 0xce, 0x9d, 0xda, 0xbb, 0x9d, 0x20, 0x94, 0x1f, 0xb2, 0x31, 0xf6, 0x79, 0x44, 0x86, 0xc3,
-	0xe1, 0xee, 0xa3, 0x17, 0x74, 0x2c, 0xc8, 0xca, 0x47, 0xa5, 0xfc, 0x65, 0xa9, 0xa8, 0x0a, 0xcb,
-	0x22, 0x61, 0x62, 0xdc, 0x54, 0xff, 0x8c, 0x27, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x60,
-	0xb4, 0xa7, 0x82, 0x04, 0x00, 0x00,
+func (m *QueryHealthRequest) Reset()         { *m = QueryHealthRequest{} }
+func (m *QueryHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthRequest) ProtoMessage()    {}
+func (*QueryHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{11}
+}
+func (m *QueryHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthRequest.Merge(m, src)
+}
+func (m *QueryHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryHealthRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryHealthResponse defines the response type for the Health method.
+type QueryHealthResponse struct {
+	// Running defines whether the oracle is running.
+	Running bool `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	// Ready defines whether the oracle is serving fresh prices, i.e. it is
+	// running, it has aggregated prices within the maximum price age, and at
+	// least one of its price providers is running.
+	Ready bool `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	// NotReadyReason defines why the oracle is not ready, if it is not.
+	NotReadyReason string `protobuf:"bytes,3,opt,name=not_ready_reason,json=notReadyReason,proto3" json:"not_ready_reason,omitempty"`
+	// LastSyncTime defines the last time the oracle aggregated prices.
+	LastSyncTime time.Time `protobuf:"bytes,4,opt,name=last_sync_time,json=lastSyncTime,proto3,stdtime" json:"last_sync_time"`
+	// LastSyncAge defines the time elapsed since the oracle last aggregated
+	// prices.
+	LastSyncAge time.Duration `protobuf:"bytes,5,opt,name=last_sync_age,json=lastSyncAge,proto3,stdduration" json:"last_sync_age"`
+	// MarketMapProvider defines the status of the market map provider, if one
+	// is configured.
+	MarketMapProvider *MarketMapProviderHealth `protobuf:"bytes,6,opt,name=market_map_provider,json=marketMapProvider,proto3" json:"market_map_provider,omitempty"`
+	// Providers defines the status of each price provider.
+	Providers []ProviderHealth `protobuf:"bytes,7,rep,name=providers,proto3" json:"providers"`
+	// Version defines the version of the oracle service.
+	Version string `protobuf:"bytes,8,opt,name=version,proto3" json:"version,omitempty"`
+}
 
-// OracleClient is the client API for Oracle service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type OracleClient interface {
-	// Prices defines a method for fetching the latest prices.
-	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
-	// MarketMap defines a method for fetching the latest market map
-	// configuration.
-	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
-	// Version defines a method for fetching the current version of the oracle
+func (m *QueryHealthResponse) Reset()         { *m = QueryHealthResponse{} }
+func (m *QueryHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHealthResponse) ProtoMessage()    {}
+func (*QueryHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{12}
+}
+func (m *QueryHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHealthResponse.Merge(m, src)
+}
+func (m *QueryHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHealthResponse proto.InternalMessageInfo
+
+func (m *QueryHealthResponse) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *QueryHealthResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *QueryHealthResponse) GetNotReadyReason() string {
+	if m != nil {
+		return m.NotReadyReason
+	}
+	return ""
+}
+
+func (m *QueryHealthResponse) GetLastSyncTime() time.Time {
+	if m != nil {
+		return m.LastSyncTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryHealthResponse) GetLastSyncAge() time.Duration {
+	if m != nil {
+		return m.LastSyncAge
+	}
+	return 0
+}
+
+func (m *QueryHealthResponse) GetMarketMapProvider() *MarketMapProviderHealth {
+	if m != nil {
+		return m.MarketMapProvider
+	}
+	return nil
+}
+
+func (m *QueryHealthResponse) GetProviders() []ProviderHealth {
+	if m != nil {
+		return m.Providers
+	}
+	return nil
+}
+
+func (m *QueryHealthResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// MarketMapProviderHealth defines the status of the market map provider.
+type MarketMapProviderHealth struct {
+	// Provider defines the status of the provider.
+	Provider ProviderHealth `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider"`
+	// LastUpdated defines the height at which the market map used by the oracle
+	// was last updated.
+	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+}
+
+func (m *MarketMapProviderHealth) Reset()         { *m = MarketMapProviderHealth{} }
+func (m *MarketMapProviderHealth) String() string { return proto.CompactTextString(m) }
+func (*MarketMapProviderHealth) ProtoMessage()    {}
+func (*MarketMapProviderHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{13}
+}
+func (m *MarketMapProviderHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapProviderHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapProviderHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketMapProviderHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapProviderHealth.Merge(m, src)
+}
+func (m *MarketMapProviderHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapProviderHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapProviderHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketMapProviderHealth proto.InternalMessageInfo
+
+func (m *MarketMapProviderHealth) GetProvider() ProviderHealth {
+	if m != nil {
+		return m.Provider
+	}
+	return ProviderHealth{}
+}
+
+func (m *MarketMapProviderHealth) GetLastUpdated() uint64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+// ProviderHealth defines the status of a single provider.
+type ProviderHealth struct {
+	// Name defines the name of the provider.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Type defines the type of the provider's data handler.
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// Running defines whether the provider is running.
+	Running bool `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	// NumTickers defines the number of tickers the provider is fetching data
+	// for.
+	NumTickers uint64 `protobuf:"varint,4,opt,name=num_tickers,json=numTickers,proto3" json:"num_tickers,omitempty"`
+	// LastSuccessfulResponse defines the last time the provider received a
+	// successful response. It is unset if the provider has not received one.
+	LastSuccessfulResponse *time.Time `protobuf:"bytes,5,opt,name=last_successful_response,json=lastSuccessfulResponse,proto3,stdtime" json:"last_successful_response,omitempty"`
+	// RecentErrors defines the most recent failed responses of the provider.
+	RecentErrors []ProviderError `protobuf:"bytes,6,rep,name=recent_errors,json=recentErrors,proto3" json:"recent_errors"`
+}
+
+func (m *ProviderHealth) Reset()         { *m = ProviderHealth{} }
+func (m *ProviderHealth) String() string { return proto.CompactTextString(m) }
+func (*ProviderHealth) ProtoMessage()    {}
+func (*ProviderHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{14}
+}
+func (m *ProviderHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderHealth.Merge(m, src)
+}
+func (m *ProviderHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderHealth proto.InternalMessageInfo
+
+func (m *ProviderHealth) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProviderHealth) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ProviderHealth) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *ProviderHealth) GetNumTickers() uint64 {
+	if m != nil {
+		return m.NumTickers
+	}
+	return 0
+}
+
+func (m *ProviderHealth) GetLastSuccessfulResponse() *time.Time {
+	if m != nil {
+		return m.LastSuccessfulResponse
+	}
+	return nil
+}
+
+func (m *ProviderHealth) GetRecentErrors() []ProviderError {
+	if m != nil {
+		return m.RecentErrors
+	}
+	return nil
+}
+
+// ProviderError defines a failed response of a provider.
+type ProviderError struct {
+	// Id defines the ID that the provider failed to fetch data for.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Code defines the error code of the failed response.
+	Code int64 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Error defines the description of the error code.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Timestamp defines the time at which the failed response was received.
+	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+}
+
+func (m *ProviderError) Reset()         { *m = ProviderError{} }
+func (m *ProviderError) String() string { return proto.CompactTextString(m) }
+func (*ProviderError) ProtoMessage()    {}
+func (*ProviderError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e88883d464f0f25b, []int{15}
+}
+func (m *ProviderError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderError.Merge(m, src)
+}
+func (m *ProviderError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderError proto.InternalMessageInfo
+
+func (m *ProviderError) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ProviderError) GetCode() int64 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ProviderError) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ProviderError) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*QueryPricesRequest)(nil), "slinky.service.v1.QueryPricesRequest")
+	proto.RegisterType((*QueryPricesResponse)(nil), "slinky.service.v1.QueryPricesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "slinky.service.v1.QueryPricesResponse.PricesEntry")
+	proto.RegisterType((*StreamPricesRequest)(nil), "slinky.service.v1.StreamPricesRequest")
+	proto.RegisterType((*QueryPriceDetailsRequest)(nil), "slinky.service.v1.QueryPriceDetailsRequest")
+	proto.RegisterType((*QueryPriceDetailsResponse)(nil), "slinky.service.v1.QueryPriceDetailsResponse")
+	proto.RegisterMapType((map[string]MarketPriceDetails)(nil), "slinky.service.v1.QueryPriceDetailsResponse.MarketsEntry")
+	proto.RegisterType((*MarketPriceDetails)(nil), "slinky.service.v1.MarketPriceDetails")
+	proto.RegisterType((*ProviderPriceDetails)(nil), "slinky.service.v1.ProviderPriceDetails")
+	proto.RegisterType((*QueryMarketMapRequest)(nil), "slinky.service.v1.QueryMarketMapRequest")
+	proto.RegisterType((*QueryMarketMapResponse)(nil), "slinky.service.v1.QueryMarketMapResponse")
+	proto.RegisterType((*QueryVersionRequest)(nil), "slinky.service.v1.QueryVersionRequest")
+	proto.RegisterType((*QueryVersionResponse)(nil), "slinky.service.v1.QueryVersionResponse")
+	proto.RegisterType((*QueryHealthRequest)(nil), "slinky.service.v1.QueryHealthRequest")
+	proto.RegisterType((*QueryHealthResponse)(nil), "slinky.service.v1.QueryHealthResponse")
+	proto.RegisterType((*MarketMapProviderHealth)(nil), "slinky.service.v1.MarketMapProviderHealth")
+	proto.RegisterType((*ProviderHealth)(nil), "slinky.service.v1.ProviderHealth")
+	proto.RegisterType((*ProviderError)(nil), "slinky.service.v1.ProviderError")
+}
+
+func init() { proto.RegisterFile("slinky/service/v1/oracle.proto", fileDescriptor_e88883d464f0f25b) }
+
+var fileDescriptor_e88883d464f0f25b = []byte{
+	// 1311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0x8e, 0x63, 0x3f, 0x27, 0x69, 0x3a, 0x49, 0xd3, 0x8d, 0x0b, 0x8e, 0x63, 0xd4,
+	0x26, 0x94, 0x62, 0x37, 0xe6, 0xd2, 0x82, 0x38, 0x90, 0xb6, 0x02, 0x51, 0x2a, 0xc2, 0xb6, 0x80,
+	0xe8, 0x65, 0x35, 0x59, 0x4f, 0x36, 0xab, 0x78, 0x67, 0x97, 0x99, 0x5d, 0x23, 0x23, 0x21, 0x01,
+	0x12, 0x07, 0x0e, 0x48, 0x15, 0x5c, 0x38, 0x70, 0xe6, 0xca, 0x91, 0x33, 0xb7, 0x1e, 0x2b, 0x71,
+	0xe1, 0x04, 0xa8, 0xe5, 0xcc, 0xdf, 0x80, 0xe6, 0x63, 0xd7, 0xbb, 0xb6, 0x53, 0xa7, 0x95, 0xb8,
+	0x24, 0xfb, 0x3e, 0xe6, 0x7d, 0xfe, 0xde, 0x9b, 0x31, 0x34, 0x78, 0xdf, 0xa3, 0xc7, 0xc3, 0x0e,
+	0x27, 0x6c, 0xe0, 0x39, 0xa4, 0x33, 0xd8, 0xed, 0x04, 0x0c, 0x3b, 0x7d, 0xd2, 0x0e, 0x59, 0x10,
+	0x05, 0xe8, 0xac, 0x92, 0xb7, 0xb5, 0xbc, 0x3d, 0xd8, 0xad, 0xaf, 0xb9, 0x81, 0x1b, 0x48, 0x69,
+	0x47, 0x7c, 0x29, 0xc5, 0xfa, 0x0b, 0x6e, 0x10, 0xb8, 0x7d, 0xd2, 0xc1, 0xa1, 0xd7, 0xc1, 0x94,
+	0x06, 0x11, 0x8e, 0xbc, 0x80, 0x72, 0x2d, 0x6d, 0x68, 0xa9, 0xa4, 0x0e, 0xe2, 0xc3, 0x4e, 0x2f,
+	0x66, 0x52, 0x41, 0xcb, 0x37, 0xc7, 0xe5, 0x91, 0xe7, 0x13, 0x1e, 0x61, 0x3f, 0xd4, 0x0a, 0x1b,
+	0x4e, 0xc0, 0xfd, 0x80, 0xdb, 0xca, 0xaf, 0x22, 0xb4, 0xa8, 0xa9, 0x53, 0xf0, 0x31, 0x3b, 0x26,
+	0x91, 0x8f, 0x43, 0x91, 0x84, 0x22, 0x94, 0x46, 0x6b, 0x0d, 0xd0, 0x07, 0x31, 0x61, 0xc3, 0x7d,
+	0xe6, 0x39, 0x84, 0x5b, 0xe4, 0xd3, 0x98, 0xf0, 0xa8, 0xf5, 0x65, 0x01, 0x56, 0x73, 0x6c, 0x1e,
+	0x06, 0x94, 0x13, 0xb4, 0x0f, 0xe5, 0x50, 0x72, 0x4c, 0xa3, 0x59, 0xdc, 0xa9, 0x75, 0xbb, 0xed,
+	0x89, 0x1a, 0xb4, 0xa7, 0x9c, 0x6b, 0x2b, 0xf2, 0x16, 0x8d, 0xd8, 0x70, 0xaf, 0xf4, 0xf0, 0xcf,
+	0xcd, 0x39, 0x4b, 0xdb, 0x41, 0x7b, 0x50, 0x4d, 0xf3, 0x31, 0x0b, 0x4d, 0x63, 0xa7, 0xd6, 0xad,
+	0xb7, 0x55, 0xc6, 0xed, 0x24, 0xe3, 0xf6, 0xbd, 0x44, 0x63, 0xaf, 0x22, 0x0e, 0x3f, 0xf8, 0x6b,
+	0xd3, 0xb0, 0x46, 0xc7, 0x90, 0x09, 0x0b, 0x03, 0xc2, 0xb8, 0x17, 0x50, 0xb3, 0xd8, 0x34, 0x76,
+	0xaa, 0x56, 0x42, 0xd6, 0xaf, 0x43, 0x2d, 0xe3, 0x1a, 0xad, 0x40, 0xf1, 0x98, 0x0c, 0x4d, 0x43,
+	0x2a, 0x89, 0x4f, 0xb4, 0x06, 0xf3, 0x03, 0xdc, 0x8f, 0x89, 0x74, 0x5d, 0xb5, 0x14, 0xf1, 0x7a,
+	0xe1, 0x9a, 0xd1, 0x3a, 0x07, 0xab, 0x77, 0x23, 0x46, 0xb0, 0x9f, 0xaf, 0x4c, 0x1d, 0xcc, 0x51,
+	0x82, 0x37, 0x49, 0x84, 0xbd, 0x7e, 0x2a, 0xfb, 0xb5, 0x00, 0x1b, 0x53, 0x84, 0xba, 0x76, 0x9f,
+	0xc0, 0x82, 0xaa, 0x7c, 0x52, 0xbc, 0xeb, 0x4f, 0x2d, 0xde, 0xd8, 0xf1, 0xf6, 0x1d, 0x75, 0x36,
+	0x5b, 0xc3, 0xc4, 0xde, 0xff, 0x5c, 0x44, 0x0c, 0x8b, 0x59, 0xe7, 0x53, 0xaa, 0xf8, 0x46, 0xb6,
+	0x8a, 0xb5, 0xee, 0xc5, 0x29, 0x89, 0x29, 0x0b, 0xb9, 0xcc, 0x32, 0xc5, 0xfe, 0xd7, 0x00, 0x34,
+	0xa9, 0x21, 0xba, 0x23, 0x61, 0xa2, 0x7d, 0x29, 0x02, 0x5d, 0x84, 0xe5, 0x98, 0x72, 0x07, 0xf7,
+	0x49, 0xcf, 0x56, 0x62, 0xd5, 0xbc, 0xa5, 0x84, 0x2b, 0x6d, 0xa0, 0x2b, 0x80, 0x7c, 0x8f, 0x8a,
+	0xa9, 0x18, 0x78, 0x3d, 0xc2, 0x6c, 0x27, 0x88, 0x69, 0x24, 0x73, 0x2b, 0x59, 0x2b, 0xbe, 0x47,
+	0xf7, 0xb5, 0xe0, 0x86, 0xe0, 0xa3, 0xdb, 0x50, 0x4d, 0x34, 0xb9, 0x59, 0x92, 0xfd, 0xd9, 0x9e,
+	0x92, 0x46, 0x72, 0x28, 0x1b, 0xa6, 0xee, 0xc6, 0xe8, 0x3c, 0x6a, 0x42, 0x0d, 0xbb, 0x2e, 0x23,
+	0xae, 0x9c, 0x63, 0x73, 0x5e, 0x86, 0x97, 0x65, 0xb5, 0x7e, 0x29, 0xc2, 0xda, 0x34, 0x5b, 0xa8,
+	0x0e, 0x95, 0xc4, 0x8e, 0xce, 0x3a, 0xa5, 0xd1, 0x0e, 0xac, 0x04, 0x87, 0x87, 0xb6, 0x73, 0x84,
+	0x3d, 0x6a, 0x47, 0x9e, 0x73, 0x4c, 0x98, 0x4e, 0x7d, 0x39, 0x38, 0x3c, 0xbc, 0x21, 0xd8, 0xf7,
+	0x24, 0x17, 0xad, 0x43, 0xd9, 0xa3, 0x03, 0xc2, 0x54, 0xbe, 0x15, 0x4b, 0x53, 0xe8, 0x32, 0x9c,
+	0xa5, 0x01, 0xf3, 0x71, 0xdf, 0xfb, 0x9c, 0xd8, 0x07, 0x43, 0x3b, 0xc4, 0x1e, 0x33, 0x4b, 0xd2,
+	0xc4, 0x99, 0x54, 0xb0, 0x37, 0xdc, 0xc7, 0x1e, 0x1b, 0x15, 0x7f, 0x3e, 0x5b, 0xfc, 0x1c, 0xd4,
+	0xca, 0xcf, 0x07, 0xb5, 0x2b, 0x80, 0xf2, 0x51, 0x48, 0x37, 0x0b, 0xd2, 0xcd, 0x4a, 0x36, 0x0c,
+	0xe9, 0x71, 0x1b, 0xce, 0x38, 0x81, 0x0c, 0x3f, 0xed, 0x77, 0x45, 0x25, 0x9d, 0xb2, 0x95, 0xe2,
+	0x3a, 0x94, 0x79, 0x84, 0xa3, 0x98, 0x9b, 0x55, 0x29, 0xd7, 0x94, 0xe0, 0x7f, 0x46, 0x3c, 0xf7,
+	0x28, 0x32, 0x41, 0xf1, 0x15, 0x35, 0x19, 0x06, 0xf6, 0x18, 0x37, 0x6b, 0xcd, 0xe2, 0x78, 0x18,
+	0x82, 0xdf, 0x3a, 0x0f, 0xe7, 0xe4, 0x70, 0x2a, 0x98, 0xde, 0xc1, 0x61, 0x32, 0xf5, 0x1f, 0xc3,
+	0xfa, 0xb8, 0x40, 0x4f, 0xfc, 0x9b, 0x00, 0x6a, 0x42, 0x6d, 0x1f, 0x87, 0xb2, 0x9b, 0xb5, 0x6e,
+	0x23, 0x01, 0x55, 0xba, 0x92, 0x47, 0xd3, 0x21, 0xce, 0x56, 0xfd, 0xe4, 0x53, 0x6c, 0x20, 0x69,
+	0xf8, 0x23, 0x35, 0x87, 0x89, 0xbf, 0xab, 0xb0, 0x96, 0x67, 0x6b, 0x6f, 0x99, 0x01, 0x36, 0x72,
+	0x03, 0x9c, 0xee, 0xf8, 0x77, 0x08, 0xee, 0x47, 0x47, 0x89, 0x9d, 0xdf, 0x8a, 0xb0, 0x9a, 0x63,
+	0x8f, 0xec, 0xb0, 0x98, 0x52, 0x8f, 0xba, 0xd2, 0x4e, 0xc5, 0x4a, 0x48, 0x81, 0x08, 0x46, 0x70,
+	0x6f, 0x28, 0x41, 0x57, 0xb1, 0x14, 0x21, 0x50, 0x49, 0x83, 0xc8, 0x96, 0x84, 0xf8, 0xcb, 0xd3,
+	0x0d, 0xb2, 0x4c, 0x83, 0xc8, 0x12, 0x6c, 0x4b, 0x72, 0xd1, 0xbb, 0xb0, 0xdc, 0xc7, 0x3c, 0xb2,
+	0xf9, 0x90, 0x3a, 0xb6, 0x80, 0x83, 0x59, 0x7a, 0x06, 0x00, 0x2d, 0x8a, 0xb3, 0x77, 0x87, 0xd4,
+	0x11, 0x42, 0xf4, 0x36, 0x2c, 0x8d, 0x6c, 0x61, 0x57, 0xa1, 0xb4, 0xd6, 0xdd, 0x98, 0x30, 0x75,
+	0x53, 0xdf, 0xa6, 0xca, 0xd2, 0x8f, 0xc2, 0x52, 0x2d, 0xb1, 0xf4, 0x96, 0x4b, 0xd0, 0x7d, 0x58,
+	0x1d, 0x35, 0x29, 0xdd, 0x16, 0x1a, 0xda, 0x97, 0x4f, 0xdc, 0x64, 0x77, 0x70, 0x98, 0xcc, 0xaf,
+	0xae, 0xdf, 0x59, 0x7f, 0x5c, 0x80, 0x6e, 0x65, 0x97, 0xca, 0x82, 0x5c, 0x2a, 0x5b, 0x4f, 0x59,
+	0x2a, 0xca, 0xd0, 0xe4, 0x3a, 0xc9, 0x74, 0xb6, 0x92, 0xef, 0xec, 0x57, 0x06, 0x9c, 0x3f, 0x21,
+	0x1e, 0x74, 0x63, 0x6c, 0x93, 0x3c, 0x83, 0xef, 0xd1, 0xca, 0xd9, 0x02, 0x59, 0x76, 0x3b, 0x0e,
+	0x7b, 0x38, 0x22, 0x3d, 0xd9, 0xf9, 0x92, 0x2a, 0xe0, 0x87, 0x8a, 0xd5, 0xfa, 0xa9, 0x00, 0xcb,
+	0x63, 0xae, 0x11, 0x94, 0x28, 0xf6, 0x93, 0xb5, 0x2d, 0xbf, 0x05, 0x2f, 0x1a, 0x86, 0xc9, 0xae,
+	0x96, 0xdf, 0x59, 0xa8, 0x15, 0xf3, 0x50, 0xdb, 0x84, 0x1a, 0x8d, 0x7d, 0xbd, 0xe4, 0xb8, 0xc4,
+	0x49, 0xc9, 0x02, 0x1a, 0xfb, 0x6a, 0xc1, 0x71, 0x74, 0x1f, 0x4c, 0xd5, 0xff, 0xd8, 0x71, 0x08,
+	0xe7, 0x87, 0x71, 0xdf, 0x66, 0x1a, 0xc1, 0xe6, 0xfc, 0x4c, 0x54, 0x95, 0x24, 0xa2, 0xd6, 0x25,
+	0x0e, 0x52, 0x03, 0xe9, 0x04, 0xdc, 0x86, 0x25, 0x46, 0x1c, 0x42, 0x23, 0x9b, 0x30, 0x16, 0x30,
+	0x6e, 0x96, 0x65, 0xeb, 0x9a, 0x4f, 0x29, 0xdf, 0x2d, 0xa1, 0xa8, 0xab, 0xb7, 0xa8, 0x0e, 0x4b,
+	0x16, 0x6f, 0x7d, 0x67, 0xc0, 0x52, 0x4e, 0x0b, 0x2d, 0x43, 0xc1, 0xeb, 0xe9, 0xda, 0x14, 0xbc,
+	0x9e, 0xa8, 0x8c, 0x13, 0xf4, 0x54, 0x65, 0x8a, 0x96, 0xfc, 0x16, 0xa3, 0x26, 0x7d, 0xeb, 0x49,
+	0x52, 0x44, 0x7e, 0xf9, 0x96, 0x9e, 0x6b, 0xf9, 0x76, 0x7f, 0x2e, 0x43, 0xf9, 0x7d, 0xf9, 0x8c,
+	0x45, 0x43, 0x28, 0xab, 0xc7, 0x0d, 0xba, 0x38, 0xeb, 0x1d, 0x27, 0x57, 0x46, 0xfd, 0xd2, 0xe9,
+	0x9e, 0x7b, 0xad, 0xe6, 0xd7, 0xbf, 0xff, 0xf3, 0x43, 0xa1, 0x8e, 0xcc, 0x8e, 0xd2, 0xd7, 0xef,
+	0x66, 0xf1, 0xf8, 0xd4, 0xcf, 0xbe, 0x6f, 0x0d, 0x58, 0xcc, 0x3e, 0xaf, 0xd0, 0x34, 0xd3, 0x53,
+	0xde, 0x5f, 0xa7, 0x0e, 0x61, 0x5b, 0x86, 0xb0, 0x85, 0x36, 0x4f, 0x0a, 0xa1, 0xc3, 0xa5, 0xf5,
+	0xab, 0x06, 0xfa, 0xde, 0x80, 0xc5, 0xdc, 0x1d, 0xfc, 0xca, 0xe9, 0x1e, 0x66, 0x2a, 0xa0, 0x2b,
+	0xcf, 0xf2, 0x8a, 0x9b, 0x19, 0x96, 0xdd, 0xd3, 0x31, 0x7c, 0x63, 0x40, 0x35, 0x9d, 0x6c, 0xb4,
+	0x73, 0x92, 0x93, 0xf1, 0xdb, 0xa8, 0xfe, 0xf2, 0x29, 0x34, 0x75, 0x2c, 0x2f, 0xc9, 0x58, 0x5e,
+	0x44, 0x17, 0x26, 0x63, 0x49, 0x2f, 0x27, 0x81, 0x11, 0x3d, 0xd4, 0x27, 0x62, 0x24, 0x77, 0xad,
+	0xd4, 0x2f, 0xcd, 0x52, 0x9b, 0x8d, 0x91, 0x23, 0xe5, 0xf0, 0x0b, 0x58, 0xd0, 0x77, 0x1c, 0x3a,
+	0xd1, 0x68, 0xfe, 0x6e, 0xac, 0x6f, 0xcf, 0xd4, 0xd3, 0xde, 0xb7, 0xa4, 0xf7, 0x0b, 0x68, 0x63,
+	0xd2, 0xbb, 0xde, 0xad, 0x7b, 0xd6, 0xc3, 0xc7, 0x0d, 0xe3, 0xd1, 0xe3, 0x86, 0xf1, 0xf7, 0xe3,
+	0x86, 0xf1, 0xe0, 0x49, 0x63, 0xee, 0xd1, 0x93, 0xc6, 0xdc, 0x1f, 0x4f, 0x1a, 0x73, 0xf7, 0xaf,
+	0xb9, 0x5e, 0x74, 0x14, 0x1f, 0xb4, 0x9d, 0xc0, 0xef, 0xec, 0xee, 0xee, 0x5e, 0x7f, 0xf5, 0x3d,
+	0x7c, 0xc0, 0x3b, 0x63, 0xbf, 0x16, 0xc5, 0x7f, 0xc2, 0x78, 0x62, 0x58, 0xec, 0x3b, 0x7e, 0x50,
+	0x96, 0x53, 0xfa, 0xda, 0x7f, 0x03, 0x00, 0x3b, 0x85, 0xe4, 0x31, 0x5b, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// OracleClient is the client API for Oracle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OracleClient interface {
+	// Prices defines a method for fetching the latest prices.
+	Prices(ctx context.Context, in *QueryPricesRequest, opts ...grpc.CallOption) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A response
+	// is sent every time the oracle aggregates a new set of prices.
+	StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error)
+	// PriceDetails defines a method for fetching how the latest prices were
+	// derived from the prices of each provider.
+	PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error)
+	// MarketMap defines a method for fetching the latest market map
+	// configuration.
+	MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error)
+	// Health defines a method for fetching the status of the oracle service and
+	// each of its providers.
+	Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error)
+	// Version defines a method for fetching the current version of the oracle
 	// service.
 	Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error)
 }
@@ -392,6 +1209,47 @@ func (c *oracleClient) Prices(ctx context.Context, in *QueryPricesRequest, opts
 	return out, nil
 }
 
+func (c *oracleClient) StreamPrices(ctx context.Context, in *StreamPricesRequest, opts ...grpc.CallOption) (Oracle_StreamPricesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Oracle_serviceDesc.Streams[0], "/slinky.service.v1.Oracle/StreamPrices", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &oracleStreamPricesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Oracle_StreamPricesClient interface {
+	Recv() (*QueryPricesResponse, error)
+	grpc.ClientStream
+}
+
+type oracleStreamPricesClient struct {
+	grpc.ClientStream
+}
+
+func (x *oracleStreamPricesClient) Recv() (*QueryPricesResponse, error) {
+	m := new(QueryPricesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *oracleClient) PriceDetails(ctx context.Context, in *QueryPriceDetailsRequest, opts ...grpc.CallOption) (*QueryPriceDetailsResponse, error) {
+	out := new(QueryPriceDetailsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/PriceDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest, opts ...grpc.CallOption) (*QueryMarketMapResponse, error) {
 	out := new(QueryMarketMapResponse)
 	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/MarketMap", in, out, opts...)
@@ -401,6 +1259,15 @@ func (c *oracleClient) MarketMap(ctx context.Context, in *QueryMarketMapRequest,
 	return out, nil
 }
 
+func (c *oracleClient) Health(ctx context.Context, in *QueryHealthRequest, opts ...grpc.CallOption) (*QueryHealthResponse, error) {
+	out := new(QueryHealthResponse)
+	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *oracleClient) Version(ctx context.Context, in *QueryVersionRequest, opts ...grpc.CallOption) (*QueryVersionResponse, error) {
 	out := new(QueryVersionResponse)
 	err := c.cc.Invoke(ctx, "/slinky.service.v1.Oracle/Version", in, out, opts...)
@@ -414,9 +1281,18 @@ func (c *oracleClient) Version(ctx context.Context, in *QueryVersionRequest, opt
 type OracleServer interface {
 	// Prices defines a method for fetching the latest prices.
 	Prices(context.Context, *QueryPricesRequest) (*QueryPricesResponse, error)
+	// StreamPrices defines a method for streaming the latest prices. A response
+	// is sent every time the oracle aggregates a new set of prices.
+	StreamPrices(*StreamPricesRequest, Oracle_StreamPricesServer) error
+	// PriceDetails defines a method for fetching how the latest prices were
+	// derived from the prices of each provider.
+	PriceDetails(context.Context, *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error)
 	// MarketMap defines a method for fetching the latest market map
 	// configuration.
 	MarketMap(context.Context, *QueryMarketMapRequest) (*QueryMarketMapResponse, error)
+	// Health defines a method for fetching the status of the oracle service and
+	// each of its providers.
+	Health(context.Context, *QueryHealthRequest) (*QueryHealthResponse, error)
 	// Version defines a method for fetching the current version of the oracle
 	// service.
 	Version(context.Context, *QueryVersionRequest) (*QueryVersionResponse, error)
@@ -429,9 +1305,18 @@ type UnimplementedOracleServer struct {
 func (*UnimplementedOracleServer) Prices(ctx context.Context, req *QueryPricesRequest) (*QueryPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prices not implemented")
 }
+func (*UnimplementedOracleServer) StreamPrices(req *StreamPricesRequest, srv Oracle_StreamPricesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamPrices not implemented")
+}
+func (*UnimplementedOracleServer) PriceDetails(ctx context.Context, req *QueryPriceDetailsRequest) (*QueryPriceDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceDetails not implemented")
+}
 func (*UnimplementedOracleServer) MarketMap(ctx context.Context, req *QueryMarketMapRequest) (*QueryMarketMapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
 }
+func (*UnimplementedOracleServer) Health(ctx context.Context, req *QueryHealthRequest) (*QueryHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (*UnimplementedOracleServer) Version(ctx context.Context, req *QueryVersionRequest) (*QueryVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
 }
@@ -458,25 +1343,82 @@ func _Oracle_Prices_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMarketMapRequest)
+func _Oracle_StreamPrices_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPricesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OracleServer).StreamPrices(m, &oracleStreamPricesServer{stream})
+}
+
+type Oracle_StreamPricesServer interface {
+	Send(*QueryPricesResponse) error
+	grpc.ServerStream
+}
+
+type oracleStreamPricesServer struct {
+	grpc.ServerStream
+}
+
+func (x *oracleStreamPricesServer) Send(m *QueryPricesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Oracle_PriceDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OracleServer).MarketMap(ctx, in)
+		return srv.(OracleServer).PriceDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/slinky.service.v1.Oracle/MarketMap",
+		FullMethod: "/slinky.service.v1.Oracle/PriceDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OracleServer).MarketMap(ctx, req.(*QueryMarketMapRequest))
+		return srv.(OracleServer).PriceDetails(ctx, req.(*QueryPriceDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Oracle_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Oracle_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).MarketMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/MarketMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).MarketMap(ctx, req.(*QueryMarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OracleServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.service.v1.Oracle/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OracleServer).Health(ctx, req.(*QueryHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Oracle_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -503,16 +1445,30 @@ var _Oracle_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Prices",
 			Handler:    _Oracle_Prices_Handler,
 		},
+		{
+			MethodName: "PriceDetails",
+			Handler:    _Oracle_PriceDetails_Handler,
+		},
 		{
 			MethodName: "MarketMap",
 			Handler:    _Oracle_MarketMap_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Oracle_Health_Handler,
+		},
 		{
 			MethodName: "Version",
 			Handler:    _Oracle_Version_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamPrices",
+			Handler:       _Oracle_StreamPrices_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "slinky/service/v1/oracle.proto",
 }
 
@@ -596,6 +1552,283 @@ func (m *QueryPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Markets) > 0 {
+		for k := range m.Markets {
+			v := m.Markets[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintOracle(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintOracle(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MinProviderCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinProviderCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UnscaledPrice) > 0 {
+		i -= len(m.UnscaledPrice)
+		copy(dAtA[i:], m.UnscaledPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.UnscaledPrice)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderPriceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderPriceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderPriceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NormalizeByPairs) > 0 {
+		for iNdEx := len(m.NormalizeByPairs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NormalizeByPairs[iNdEx])
+			copy(dAtA[i:], m.NormalizeByPairs[iNdEx])
+			i = encodeVarintOracle(dAtA, i, uint64(len(m.NormalizeByPairs[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ConvertedPrice) > 0 {
+		i -= len(m.ConvertedPrice)
+		copy(dAtA[i:], m.ConvertedPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ConvertedPrice)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.NormalizeByPrice) > 0 {
+		i -= len(m.NormalizeByPrice)
+		copy(dAtA[i:], m.NormalizeByPrice)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NormalizeByPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NormalizeByPair) > 0 {
+		i -= len(m.NormalizeByPair)
+		copy(dAtA[i:], m.NormalizeByPair)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NormalizeByPair)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OffChainTicker) > 0 {
+		i -= len(m.OffChainTicker)
+		copy(dAtA[i:], m.OffChainTicker)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OffChainTicker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryMarketMapRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -707,39 +1940,325 @@ func (m *QueryVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Prices) > 0 {
-		for k, v := range m.Prices {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
-			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
-		}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MarketMapProvider != nil {
+		{
+			size, err := m.MarketMapProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.LastSyncAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LastSyncAge):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintOracle(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x2a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastSyncTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSyncTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintOracle(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NotReadyReason) > 0 {
+		i -= len(m.NotReadyReason)
+		copy(dAtA[i:], m.NotReadyReason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.NotReadyReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Ready {
+		i--
+		if m.Ready {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Running {
+		i--
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapProviderHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapProviderHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapProviderHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecentErrors) > 0 {
+		for iNdEx := len(m.RecentErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastSuccessfulResponse != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastSuccessfulResponse, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSuccessfulResponse):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintOracle(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NumTickers != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.NumTickers))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Running {
+		i--
+		if m.Running {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintOracle(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Code != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for k, v := range m.Prices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + len(v) + sovOracle(uint64(len(v)))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovOracle(uint64(l))
@@ -750,7 +2269,7 @@ func (m *QueryPricesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryMarketMapRequest) Size() (n int) {
+func (m *StreamPricesRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -759,52 +2278,291 @@ func (m *QueryMarketMapRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryMarketMapResponse) Size() (n int) {
+func (m *QueryPriceDetailsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MarketMap != nil {
-		l = m.MarketMap.Size()
+	return n
+}
+
+func (m *QueryPriceDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for k, v := range m.Markets {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovOracle(uint64(len(k))) + 1 + l + sovOracle(uint64(l))
+			n += mapEntrySize + 1 + sovOracle(uint64(mapEntrySize))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Version)
+	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryVersionRequest) Size() (n int) {
+func (m *MarketPriceDetails) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.UnscaledPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.MinProviderCount != 0 {
+		n += 1 + sovOracle(uint64(m.MinProviderCount))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryVersionResponse) Size() (n int) {
+func (m *ProviderPriceDetails) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Version)
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OffChainTicker)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Invert {
+		n += 2
+	}
+	l = len(m.NormalizeByPair)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.NormalizeByPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.ConvertedPrice)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Weight)
 	if l > 0 {
 		n += 1 + l + sovOracle(uint64(l))
 	}
+	if len(m.NormalizeByPairs) > 0 {
+		for _, s := range m.NormalizeByPairs {
+			l = len(s)
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryMarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryMarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketMap != nil {
+		l = m.MarketMap.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
 }
-func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *QueryVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Running {
+		n += 2
+	}
+	if m.Ready {
+		n += 2
+	}
+	l = len(m.NotReadyReason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastSyncTime)
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.LastSyncAge)
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MarketMapProvider != nil {
+		l = m.MarketMapProvider.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketMapProviderHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Provider.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.LastUpdated != 0 {
+		n += 1 + sovOracle(uint64(m.LastUpdated))
+	}
+	return n
+}
+
+func (m *ProviderHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Running {
+		n += 2
+	}
+	if m.NumTickers != 0 {
+		n += 1 + sovOracle(uint64(m.NumTickers))
+	}
+	if m.LastSuccessfulResponse != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastSuccessfulResponse)
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if len(m.RecentErrors) > 0 {
+		for _, e := range m.RecentErrors {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProviderError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovOracle(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -835,53 +2593,1652 @@ func (m *QueryPricesRequest) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Prices[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Markets == nil {
+				m.Markets = make(map[string]MarketPriceDetails)
+			}
+			var mapkey string
+			mapvalue := &MarketPriceDetails{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthOracle
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MarketPriceDetails{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipOracle(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthOracle
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Markets[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnscaledPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnscaledPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProviderCount", wireType)
+			}
+			m.MinProviderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinProviderCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, ProviderPriceDetails{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderPriceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderPriceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderPriceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffChainTicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffChainTicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvertedPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConvertedPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPairs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPairs = append(m.NormalizeByPairs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketMapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketMapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketMap == nil {
+				m.MarketMap = &types.MarketMap{}
+			}
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Running = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ready = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotReadyReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			m.NotReadyReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTime", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastSyncTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPricesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -908,107 +4265,49 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prices == nil {
-				m.Prices = make(map[string]string)
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.LastSyncAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOracle
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMapProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthOracle
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthOracle
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOracle
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthOracle
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthOracle
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipOracle(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthOracle
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Prices[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MarketMapProvider == nil {
+				m.MarketMapProvider = &MarketMapProviderHealth{}
+			}
+			if err := m.MarketMapProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1035,11 +4334,12 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			m.Providers = append(m.Providers, ProviderHealth{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -1092,7 +4392,7 @@ func (m *QueryPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketMapProviderHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1111,16 +4411,68 @@ func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketMapRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapProviderHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapProviderHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1142,7 +4494,7 @@ func (m *QueryMarketMapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
+func (m *ProviderHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1165,15 +4517,118 @@ func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMarketMapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Running = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTickers", wireType)
+			}
+			m.NumTickers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTickers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSuccessfulResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1200,63 +4655,47 @@ func (m *QueryMarketMapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MarketMap == nil {
-				m.MarketMap = &types.MarketMap{}
+			if m.LastSuccessfulResponse == nil {
+				m.LastSuccessfulResponse = new(time.Time)
 			}
-			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastSuccessfulResponse, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOracle(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentErrors", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOracle
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVersionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOracle
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecentErrors = append(m.RecentErrors, ProviderError{})
+			if err := m.RecentErrors[len(m.RecentErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1278,7 +4717,7 @@ func (m *QueryVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *ProviderError) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1301,15 +4740,15 @@ func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderError: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderError: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1337,7 +4776,91 @@ func (m *QueryVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -330,11 +330,38 @@ func NewSimApp(
 	app.SetProcessProposal(proposalHandler.ProcessProposalHandler())
 
 	// Create the aggregation function that will be used to aggregate oracle data
-	// from each validator.
-	aggregatorFn := voteweighted.MedianFromContext(
+	// from each validator. The power threshold is read from the x/oracle params, and
+	// the aggregation strategy of each market from its metadata or the x/oracle params.
+	aggregatorFn := aggregator.AggregateFromThresholdContext(
+		app.Logger(),
+		app.StakingKeeper,
+		app.OracleKeeper.GetVotePowerThreshold,
+		app.OracleKeeper.GetAggregationStrategy,
+		aggregator.DefaultAggregationStrategies(),
+	)
+
+	// Create the circuit breaker that guards against abnormal price moves before
+	// aggregated prices are written to state. It is configured via the x/oracle params.
+	priceGuard := aggregator.NewCircuitBreakerPriceGuard(
+		app.OracleKeeper,
+		oracleMetrics,
+		app.Logger(),
+	)
+
+	// ExtendVote applies prices in a branch of state that is discarded, so the circuit breaker used
+	// there does not log or report trips. Otherwise every trip would be reported twice on the proposer,
+	// and trips that never land on chain would be reported as well.
+	extendVotePriceGuard := aggregator.NewCircuitBreakerPriceGuard(
+		app.OracleKeeper,
+		servicemetrics.NewNopMetrics(),
+		log.NewNopLogger(),
+	)
+
+	// Create the function that computes the dispersion of the validator prices, which
+	// is stored alongside each aggregated price.
+	dispersionFn := voteweighted.PriceDispersion(
 		app.Logger(),
 		app.StakingKeeper,
-		voteweighted.DefaultPowerThreshold,
 	)
 
 	// Create the pre-finalize block hook that will be used to apply oracle data
@@ -353,6 +380,8 @@ func NewSimApp(
 			compression.NewDefaultExtendedCommitCodec(),
 			compression.NewZStdCompressor(),
 		),
+		aggregator.WithPriceGuard(priceGuard),
+		aggregator.WithPriceDispersion(dispersionFn, app.OracleKeeper),
 	)
 
 	app.SetPreBlocker(oraclePreBlockHandler.WrappedPreBlocker(app.ModuleManager))
@@ -386,6 +415,7 @@ func NewSimApp(
 			veCodec,
 			extCommitCodec,
 			app.Logger(),
+			aggregator.WithPriceGuard(extendVotePriceGuard),
 		),
 		oracleMetrics,
 	)
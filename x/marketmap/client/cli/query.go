@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -24,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryMarketMap(),
 		CmdQueryLastUpdated(),
 		CmdQueryMarket(),
+		CmdQueryMarketAuthorityGrants(),
+		CmdQueryScheduledMarketUpdates(),
+		CmdQueryMarketRevisions(),
+		CmdQueryMarketRevisionsByHeight(),
+		CmdQueryMarketMapUpdates(),
 	)
 
 	return cmd
@@ -133,3 +141,165 @@ func CmdQueryLastUpdated() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdQueryMarketAuthorityGrants returns the command for querying the grants of all market authorities, or
+// of the given market authority.
+func CmdQueryMarketAuthorityGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-authority-grants [authority]",
+		Short: "Query the actions and markets each market authority is permitted to act on",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.MarketAuthorityGrantsRequest{}
+			if len(args) == 1 {
+				req.Authority = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketAuthorityGrants(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryScheduledMarketUpdates returns the command for querying the market updates that are queued to be
+// applied at a future block height.
+func CmdQueryScheduledMarketUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-market-updates",
+		Short: "Query the market updates that are scheduled for a future block height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScheduledMarketUpdates(cmd.Context(), &types.ScheduledMarketUpdatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketRevisions returns the command for querying the retained history of changes made to a market.
+func CmdQueryMarketRevisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-revisions [base] [quote]",
+		Short: "Query the history of changes made to the market of the given currency pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketRevisions(cmd.Context(), &types.MarketRevisionsRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketRevisionsByHeight returns the command for querying the retained changes made to any market within
+// a range of block heights.
+func CmdQueryMarketRevisionsByHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-revisions-by-height [start-height] [end-height]",
+		Short: "Query the changes made to any market between the given block heights (inclusive)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketRevisionsByHeight(cmd.Context(), &types.MarketRevisionsByHeightRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryMarketMapUpdates returns the command for querying the markets that were changed after a block height.
+func CmdQueryMarketMapUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "marketmap-updates [since-height]",
+		Short: "Query the markets that were created, updated or removed after the given block height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sinceHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid since height: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MarketMapUpdates(cmd.Context(), &types.MarketMapUpdatesRequest{
+				SinceHeight: sinceHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
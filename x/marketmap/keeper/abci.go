@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker is called at the beginning of every block. It starts tracking market changes if they
+// are not yet tracked, prunes the changes that fell out of the retention window, prunes the revisions
+// of every market to the configured maximum, and applies every scheduled market update that is due at
+// the current block height.
+func (k *Keeper) BeginBlocker(goCtx context.Context) error {
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.trackMarketChanges(ctx); err != nil {
+		return err
+	}
+
+	if err := k.pruneMarketChanges(ctx); err != nil {
+		return err
+	}
+
+	if err := k.PruneMarketRevisions(ctx); err != nil {
+		return err
+	}
+
+	return k.ApplyScheduledMarketUpdates(ctx)
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/collections"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/marketmap/keeper"
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestBeginBlockerScheduledMarketUpdates() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	height := uint64(s.ctx.BlockHeight())
+
+	// create the market that the scheduled markets are normalized by
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{usdtusd},
+	})
+	s.Require().NoError(err)
+
+	btcusd := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     slinkytypes.NewCurrencyPair("BITCOIN", "USD"),
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "btc-usdt",
+				NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+			},
+		},
+	}
+
+	// this update is invalid at activation since the normalize by pair has been removed
+	invalid := btcusd
+	invalid.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdc",
+			NormalizeByPair: &usdcusd.Ticker.CurrencyPair,
+		},
+	}
+
+	invalidID, err := s.keeper.ScheduleMarketUpdate(s.ctx, types.ScheduledMarketUpdate{
+		Authority: s.marketAuthorities[0],
+		Height:    height + 1,
+		Markets:   []types.Market{invalid},
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+		Authority: s.marketAuthorities[1],
+		Height:    height + 2,
+		Markets:   []types.Market{btcusd},
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+		Authority: s.marketAuthorities[2],
+		Height:    height + 2,
+		Markets:   []types.Market{ethusdt},
+	})
+	s.Require().NoError(err)
+
+	s.Run("nothing is applied before the scheduled height", func() {
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		updates, err := s.keeper.GetAllScheduledMarketUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(updates, 3)
+	})
+
+	s.Run("an update that is invalid at activation is dropped", func() {
+		ctx := s.ctx.WithBlockHeight(int64(height) + 1) //nolint:gosec
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		exists, err := s.keeper.HasMarket(ctx, btcusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+
+		updates, err := s.keeper.GetAllScheduledMarketUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(updates, 2)
+
+		// the dropped update is removed from the index by ID
+		_, err = s.keeper.GetScheduledMarketUpdate(ctx, invalidID)
+		s.Require().ErrorIs(err, collections.ErrNotFound)
+
+		update, err := s.keeper.GetScheduledMarketUpdate(ctx, updates[0].Id)
+		s.Require().NoError(err)
+		s.Require().Equal(updates[0], update)
+
+		var failed bool
+		for _, event := range ctx.EventManager().Events() {
+			failed = failed || event.Type == types.EventTypeScheduledMarketUpdatesFailed
+		}
+		s.Require().True(failed)
+	})
+
+	s.Run("updates are applied at the scheduled height with the permissions at activation", func() {
+		// revoke the permissions of the authority of the second update before activation
+		_, err := msgServer.RemoveMarketAuthorities(s.ctx, &types.MsgRemoveMarketAuthorities{
+			Admin:           s.admin,
+			RemoveAddresses: []string{s.marketAuthorities[2]},
+		})
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithBlockHeight(int64(height) + 2) //nolint:gosec
+		s.Require().NoError(s.keeper.BeginBlocker(ctx))
+
+		market, err := s.keeper.GetMarket(ctx, btcusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(btcusd, market)
+
+		// the oracle hooks are run at activation
+		s.Require().Contains(s.oracleKeeper.GetAllCurrencyPairs(ctx), btcusd.Ticker.CurrencyPair)
+
+		exists, err := s.keeper.HasMarket(ctx, ethusdt.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+
+		lastUpdated, err := s.keeper.GetLastUpdated(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(height+2, lastUpdated)
+
+		updates, err := s.keeper.GetAllScheduledMarketUpdates(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(updates)
+	})
+}
@@ -22,6 +22,11 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		}
 	}
 
+	// changes are tracked from genesis, since the change heights of the markets are not exported
+	if err := k.changesTrackedSince.Set(ctx, uint64(ctx.BlockHeight())); err != nil { //nolint:gosec
+		panic(err)
+	}
+
 	if err := k.SetLastUpdated(ctx, gs.LastUpdated); err != nil {
 		panic(err)
 	}
@@ -30,6 +35,26 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	for _, update := range gs.ScheduledMarketUpdates {
+		if err := k.setScheduledMarketUpdate(ctx, update); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.nextScheduledUpdateID.Set(ctx, gs.NextScheduledMarketUpdateId); err != nil {
+		panic(err)
+	}
+
+	for _, revision := range gs.MarketRevisions {
+		if err := k.setMarketRevision(ctx, revision); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.nextRevisionID.Set(ctx, gs.NextMarketRevisionId); err != nil {
+		panic(err)
+	}
+
 	if k.hooks != nil {
 		if err := k.hooks.AfterMarketGenesis(ctx, gs.MarketMap.Markets); err != nil {
 			panic(err)
@@ -54,12 +79,36 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	scheduledUpdates, err := k.GetAllScheduledMarketUpdates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextScheduledUpdateID, err := k.nextScheduledUpdateID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	revisions, err := k.GetAllMarketRevisions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextRevisionID, err := k.nextRevisionID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		MarketMap: types.MarketMap{
 			Markets: markets,
 		},
-		LastUpdated: lastUpdated,
-		Params:      params,
+		LastUpdated:                 lastUpdated,
+		Params:                      params,
+		ScheduledMarketUpdates:      scheduledUpdates,
+		NextScheduledMarketUpdateId: nextScheduledUpdateID,
+		MarketRevisions:             revisions,
+		NextMarketRevisionId:        nextRevisionID,
 	}
 }
 
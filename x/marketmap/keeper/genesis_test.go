@@ -98,6 +98,19 @@ func (s *KeeperTestSuite) TestInitExportGenesisValid() {
 		gs.MarketMap = types.MarketMap{
 			Markets: marketsMap,
 		}
+		gs.ScheduledMarketUpdates = []types.ScheduledMarketUpdate{
+			{Id: 3, Authority: gs.Params.Admin, Height: 20, Markets: []types.Market{usdtusd}},
+			{Id: 1, Authority: gs.Params.Admin, Height: 30, Markets: []types.Market{btcusdt}},
+		}
+		gs.NextScheduledMarketUpdateId = 5
+		previous := btcusdt
+		previous.Ticker.MinProviderCount = 2
+		gs.MarketRevisions = []types.MarketRevision{
+			{Id: 0, Ticker: btcusdt.Ticker.String(), Height: 3, Signer: gs.Params.Admin, Action: types.MarketRevisionActionCreate, Market: &previous},
+			{Id: 2, Ticker: btcusdt.Ticker.String(), Height: 4, Signer: gs.Params.Admin, Action: types.MarketRevisionActionUpdate, Previous: &previous, Market: &btcusdt},
+			{Id: 1, Ticker: ethusdt.Ticker.String(), Height: 3, Signer: gs.Params.Admin, Action: types.MarketRevisionActionCreate, Market: &ethusdt},
+		}
+		gs.NextMarketRevisionId = 3
 
 		s.Require().NotPanics(func() {
 			s.keeper.InitGenesis(s.ctx, *gs)
@@ -107,6 +120,10 @@ func (s *KeeperTestSuite) TestInitExportGenesisValid() {
 		s.Require().NoError(err)
 		s.Require().Equal(gs.MarketMap.Markets, gotMarkets)
 
+		revisions, err := s.keeper.GetMarketRevisionsByHeight(s.ctx, 3, 3)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketRevision{gs.MarketRevisions[0], gs.MarketRevisions[2]}, revisions)
+
 		var gotState *types.GenesisState
 		s.Require().NotPanics(func() {
 			gotState = s.keeper.ExportGenesis(s.ctx)
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// marketRevisionIndices are the indexes of the market revisions.
+type marketRevisionIndices struct {
+	// height is a multi-index on the block heights of the revisions, i.e. height -> (ticker, id) -> MarketRevision
+	height *indexes.Multi[uint64, collections.Pair[string, uint64], types.MarketRevision]
+}
+
+func newMarketRevisionIndices(sb *collections.SchemaBuilder) *marketRevisionIndices {
+	return &marketRevisionIndices{
+		height: indexes.NewMulti(
+			sb, types.MarketRevisionsByHeightPrefix, "market_revisions_by_height", collections.Uint64Key, types.MarketRevisionsCodec,
+			func(_ collections.Pair[string, uint64], revision types.MarketRevision) (uint64, error) {
+				return revision.Height, nil
+			},
+		),
+	}
+}
+
+func (i *marketRevisionIndices) IndexesList() []collections.Index[collections.Pair[string, uint64], types.MarketRevision] {
+	return []collections.Index[collections.Pair[string, uint64], types.MarketRevision]{
+		i.height,
+	}
+}
+
+// GetMarketRevisions returns the retained revisions of the market with the given ticker, ordered from oldest to
+// newest. If no revisions exist for the market, an empty list is returned.
+func (k *Keeper) GetMarketRevisions(ctx sdk.Context, tickerStr string) ([]types.MarketRevision, error) {
+	iter, err := k.revisions.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](tickerStr))
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	if revisions == nil {
+		revisions = make([]types.MarketRevision, 0)
+	}
+
+	return revisions, nil
+}
+
+// GetMarketRevisionsByHeight returns the retained revisions of every market that were made between the given block
+// heights (inclusive), ordered from oldest to newest.
+func (k *Keeper) GetMarketRevisionsByHeight(ctx sdk.Context, startHeight, endHeight uint64) ([]types.MarketRevision, error) {
+	if startHeight > endHeight {
+		return nil, fmt.Errorf("start height %d must not be greater than end height %d", startHeight, endHeight)
+	}
+
+	// iterate over the index from the start height, until a revision made after the end height is reached
+	rng := new(collections.Range[collections.Pair[uint64, collections.Pair[string, uint64]]]).
+		StartInclusive(collections.PairPrefix[uint64, collections.Pair[string, uint64]](startHeight))
+
+	var keys []collections.Pair[string, uint64]
+	err := k.revisions.Indexes.height.Walk(ctx, rng, func(height uint64, key collections.Pair[string, uint64]) (bool, error) {
+		if height > endHeight {
+			return true, nil
+		}
+
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]types.MarketRevision, 0, len(keys))
+	for _, key := range keys {
+		revision, err := k.revisions.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	// revisions made at the same height are ordered by ticker in the index
+	slices.SortFunc(revisions, func(a, b types.MarketRevision) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return revisions, nil
+}
+
+// GetAllMarketRevisions returns the retained revisions of every market, ordered by ticker and then from oldest to
+// newest.
+func (k *Keeper) GetAllMarketRevisions(ctx sdk.Context) ([]types.MarketRevision, error) {
+	iter, err := k.revisions.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// recordMarketRevision records that the given signer changed the market from previous to market at the current
+// block height, and prunes the oldest revisions of the market beyond the MaxMarketRevisions parameter. previous is
+// nil if the market was created, and market is nil if it was removed. This is a no-op if the history is disabled.
+func (k *Keeper) recordMarketRevision(ctx sdk.Context, signer, action string, previous, market *types.Market) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxMarketRevisions == 0 {
+		return nil
+	}
+
+	id, err := k.nextRevisionID.Next(ctx)
+	if err != nil {
+		return err
+	}
+
+	revision := types.MarketRevision{
+		Id:       id,
+		Height:   uint64(ctx.BlockHeight()), //nolint:gosec
+		Signer:   signer,
+		Action:   action,
+		Previous: previous,
+		Market:   market,
+	}
+	if market != nil {
+		revision.Ticker = market.Ticker.String()
+	} else {
+		revision.Ticker = previous.Ticker.String()
+	}
+
+	if err := k.setMarketRevision(ctx, revision); err != nil {
+		return err
+	}
+
+	return k.pruneMarketRevisions(ctx, revision.Ticker, params.MaxMarketRevisions)
+}
+
+// setMarketRevision sets a market revision, and increments the number of revisions retained for its market.
+func (k *Keeper) setMarketRevision(ctx sdk.Context, revision types.MarketRevision) error {
+	if err := k.revisions.Set(ctx, collections.Join(revision.Ticker, revision.Id), revision); err != nil {
+		return err
+	}
+
+	count, err := k.getMarketRevisionCount(ctx, revision.Ticker)
+	if err != nil {
+		return err
+	}
+
+	return k.revisionCounts.Set(ctx, revision.Ticker, count+1)
+}
+
+// PruneMarketRevisions removes the oldest revisions of every market whose revisions exceed the MaxMarketRevisions
+// parameter, e.g. after the parameter is lowered. If the history is disabled, all retained revisions are removed.
+func (k *Keeper) PruneMarketRevisions(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// collect the tickers to prune first, so that the counts are not modified while iterating over them
+	var tickers []string
+	err = k.revisionCounts.Walk(ctx, nil, func(tickerStr string, count uint64) (bool, error) {
+		if count > params.MaxMarketRevisions {
+			tickers = append(tickers, tickerStr)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, tickerStr := range tickers {
+		if err := k.pruneMarketRevisions(ctx, tickerStr, params.MaxMarketRevisions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneMarketRevisions removes the oldest revisions of the market with the given ticker until at most maxRevisions
+// are retained.
+func (k *Keeper) pruneMarketRevisions(ctx sdk.Context, tickerStr string, maxRevisions uint64) error {
+	count, err := k.getMarketRevisionCount(ctx, tickerStr)
+	if err != nil {
+		return err
+	}
+
+	if count <= maxRevisions {
+		return nil
+	}
+
+	// collect the keys of the oldest revisions, so that the map is not modified while iterating over it
+	toRemove := count - maxRevisions
+	keys := make([]collections.Pair[string, uint64], 0, toRemove)
+	rng := collections.NewPrefixedPairRange[string, uint64](tickerStr)
+	err = k.revisions.Walk(ctx, rng, func(key collections.Pair[string, uint64], _ types.MarketRevision) (bool, error) {
+		keys = append(keys, key)
+		return uint64(len(keys)) >= toRemove, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.revisions.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	if maxRevisions == 0 {
+		return k.revisionCounts.Remove(ctx, tickerStr)
+	}
+
+	return k.revisionCounts.Set(ctx, tickerStr, maxRevisions)
+}
+
+// getMarketRevisionCount returns the number of revisions retained for the market with the given ticker.
+func (k *Keeper) getMarketRevisionCount(ctx sdk.Context, tickerStr string) (uint64, error) {
+	count, err := k.revisionCounts.Get(ctx, tickerStr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"github.com/1119-Labs/slinky/x/marketmap/keeper"
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) setMaxMarketRevisions(maxRevisions uint64) {
+	params, err := s.keeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxMarketRevisions = maxRevisions
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestMarketRevisions() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	qs := keeper.NewQueryServer(s.keeper)
+	authority := s.marketAuthorities[0]
+
+	s.Run("no revisions are recorded if the history is disabled", func() {
+		s.setMaxMarketRevisions(0)
+
+		_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     authority,
+			CreateMarkets: []types.Market{usdtusd},
+		})
+		s.Require().NoError(err)
+
+		revisions, err := s.keeper.GetMarketRevisions(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Empty(revisions)
+	})
+
+	s.setMaxMarketRevisions(2)
+
+	created := btcusdt
+	s.Run("create is recorded", func() {
+		_, err := msgServer.CreateMarkets(s.ctx.WithBlockHeight(11), &types.MsgCreateMarkets{
+			Authority:     authority,
+			CreateMarkets: []types.Market{created},
+		})
+		s.Require().NoError(err)
+
+		res, err := qs.MarketRevisions(s.ctx, &types.MarketRevisionsRequest{CurrencyPair: created.Ticker.CurrencyPair})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketRevision{
+			{
+				Id:     0,
+				Ticker: created.Ticker.String(),
+				Height: 11,
+				Signer: authority,
+				Action: types.MarketRevisionActionCreate,
+				Market: &created,
+			},
+		}, res.Revisions)
+	})
+
+	updated := created
+	updated.Ticker.Enabled = true
+	s.Run("update is recorded", func() {
+		_, err := msgServer.UpdateMarkets(s.ctx.WithBlockHeight(12), &types.MsgUpdateMarkets{
+			Authority:     authority,
+			UpdateMarkets: []types.Market{updated},
+		})
+		s.Require().NoError(err)
+
+		revisions, err := s.keeper.GetMarketRevisions(s.ctx, created.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(revisions, 2)
+		s.Require().Equal(types.MarketRevision{
+			Id:       1,
+			Ticker:   created.Ticker.String(),
+			Height:   12,
+			Signer:   authority,
+			Action:   types.MarketRevisionActionUpdate,
+			Previous: &created,
+			Market:   &updated,
+		}, revisions[1])
+	})
+
+	s.Run("oldest revisions are pruned", func() {
+		upserted := updated
+		upserted.Ticker.MinProviderCount = 1
+		upserted.Ticker.Metadata_JSON = `{"source":"upsert"}`
+		_, err := msgServer.UpsertMarkets(s.ctx.WithBlockHeight(13), &types.MsgUpsertMarkets{
+			Authority: authority,
+			Markets:   []types.Market{upserted},
+		})
+		s.Require().NoError(err)
+
+		revisions, err := s.keeper.GetMarketRevisions(s.ctx, created.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(revisions, 2)
+		s.Require().Equal(uint64(1), revisions[0].Id)
+		s.Require().Equal(uint64(2), revisions[1].Id)
+		s.Require().Equal(&updated, revisions[1].Previous)
+		s.Require().Equal(&upserted, revisions[1].Market)
+	})
+
+	s.Run("remove is recorded", func() {
+		disabled, err := s.keeper.GetMarket(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+
+		_, err = msgServer.RemoveMarkets(s.ctx.WithBlockHeight(13), &types.MsgRemoveMarkets{
+			Authority: authority,
+			Markets:   []string{usdtusd.Ticker.String()},
+		})
+		s.Require().NoError(err)
+
+		revisions, err := s.keeper.GetMarketRevisions(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MarketRevision{
+			{
+				Id:       3,
+				Ticker:   usdtusd.Ticker.String(),
+				Height:   13,
+				Signer:   authority,
+				Action:   types.MarketRevisionActionRemove,
+				Previous: &disabled,
+			},
+		}, revisions)
+	})
+
+	s.Run("revisions by height", func() {
+		res, err := qs.MarketRevisionsByHeight(s.ctx, &types.MarketRevisionsByHeightRequest{StartHeight: 12, EndHeight: 13})
+		s.Require().NoError(err)
+		s.Require().Len(res.Revisions, 3)
+		for i, id := range []uint64{1, 2, 3} {
+			s.Require().Equal(id, res.Revisions[i].Id)
+		}
+
+		res, err = qs.MarketRevisionsByHeight(s.ctx, &types.MarketRevisionsByHeightRequest{StartHeight: 14, EndHeight: 20})
+		s.Require().NoError(err)
+		s.Require().Empty(res.Revisions)
+
+		_, err = qs.MarketRevisionsByHeight(s.ctx, &types.MarketRevisionsByHeightRequest{StartHeight: 13, EndHeight: 12})
+		s.Require().Error(err)
+	})
+
+	s.Run("lowering the maximum prunes the revisions of every market", func() {
+		s.setMaxMarketRevisions(1)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		revisions, err := s.keeper.GetMarketRevisions(s.ctx, created.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(revisions, 1)
+		s.Require().Equal(uint64(2), revisions[0].Id)
+
+		revisions, err = s.keeper.GetMarketRevisions(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(revisions, 1)
+		s.Require().Equal(uint64(3), revisions[0].Id)
+	})
+
+	s.Run("disabling the history removes the retained revisions", func() {
+		s.setMaxMarketRevisions(0)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+		revisions, err := s.keeper.GetAllMarketRevisions(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(revisions)
+	})
+}
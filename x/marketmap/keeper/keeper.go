@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
@@ -32,6 +33,34 @@ type Keeper struct {
 	// params is the module's parameters.
 	params collections.Item[types.Params]
 
+	// scheduledUpdates is keyed by (height, id) and contains the queue of market updates that are
+	// applied at the beginning of the block at height, indexed by id.
+	scheduledUpdates *collections.IndexedMap[collections.Pair[uint64, uint64], types.ScheduledMarketUpdate, *scheduledUpdateIndices]
+
+	// nextScheduledUpdateID is the ID of the next scheduled market update.
+	nextScheduledUpdateID collections.Sequence
+
+	// revisions is keyed by (ticker, id) and contains the retained history of changes made to each market,
+	// indexed by block height.
+	revisions *collections.IndexedMap[collections.Pair[string, uint64], types.MarketRevision, *marketRevisionIndices]
+
+	// revisionCounts is the number of revisions retained per market.
+	revisionCounts collections.Map[string, uint64]
+
+	// nextRevisionID is the ID of the next market revision.
+	nextRevisionID collections.Sequence
+
+	// changeHeights is keyed by ticker and contains the block height at which each market was last created,
+	// updated or removed, indexed by block height. Entries of removed markets are retained as tombstones until
+	// they fall out of the changesRetention window.
+	changeHeights *collections.IndexedMap[types.TickerString, uint64, *marketChangeIndices]
+
+	// changesTrackedSince is the block height from which every change to the markets is recorded in changeHeights.
+	changesTrackedSince collections.Item[uint64]
+
+	// changesRetention is the number of blocks for which market change heights are retained.
+	changesRetention uint64
+
 	// deleteValidationHooks are called by the keeper before any deletion call is performed.
 	deleteMarketValidationHooks types.MarketValidationHooks
 }
@@ -49,13 +78,24 @@ func NewKeeper(ss store.KVStoreService, cdc codec.BinaryCodec, authority sdk.Acc
 	)
 
 	k := &Keeper{
-		cdc:                         cdc,
-		authority:                   authority,
-		markets:                     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
-		lastUpdated:                 collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
-		params:                      params,
+		cdc:         cdc,
+		authority:   authority,
+		markets:     collections.NewMap(sb, types.MarketsPrefix, "markets", types.TickersCodec, codec.CollValue[types.Market](cdc)),
+		lastUpdated: collections.NewItem[uint64](sb, types.LastUpdatedPrefix, "last_updated", types.LastUpdatedCodec),
+		params:      params,
+		scheduledUpdates: collections.NewIndexedMap(sb, types.ScheduledMarketUpdatesPrefix, "scheduled_updates", types.ScheduledMarketUpdatesCodec,
+			codec.CollValue[types.ScheduledMarketUpdate](cdc), newScheduledUpdateIndices(sb)),
+		nextScheduledUpdateID: collections.NewSequence(sb, types.NextScheduledMarketUpdateIDPrefix, "next_scheduled_update_id"),
+		revisions: collections.NewIndexedMap(sb, types.MarketRevisionsPrefix, "market_revisions", types.MarketRevisionsCodec,
+			codec.CollValue[types.MarketRevision](cdc), newMarketRevisionIndices(sb)),
+		revisionCounts:              collections.NewMap(sb, types.MarketRevisionCountsPrefix, "market_revision_counts", collections.StringKey, collections.Uint64Value),
+		nextRevisionID:              collections.NewSequence(sb, types.NextMarketRevisionIDPrefix, "next_market_revision_id"),
+		changesTrackedSince:         collections.NewItem[uint64](sb, types.MarketChangesTrackedSincePrefix, "market_changes_tracked_since", collections.Uint64Value),
+		changesRetention:            DefaultMarketChangesRetention,
 		hooks:                       &types.NoopMarketMapHooks{},
 		deleteMarketValidationHooks: types.DefaultDeleteMarketValidationHooks(),
+		changeHeights: collections.NewIndexedMap(sb, types.MarketChangeHeightsPrefix, "market_change_heights", types.TickersCodec,
+			collections.Uint64Value, newMarketChangeIndices(sb)),
 	}
 
 	// apply options to default initialized keeper
@@ -86,35 +126,78 @@ func (k *Keeper) GetMarket(ctx sdk.Context, tickerStr string) (types.Market, err
 	return k.markets.Get(ctx, types.TickerString(tickerStr))
 }
 
-// setMarket sets a market.
+// setMarket sets a market, and records that it was changed at the current block height.
 func (k *Keeper) setMarket(ctx sdk.Context, market types.Market) error {
-	return k.markets.Set(ctx, types.TickerString(market.Ticker.String()), market)
+	if err := k.markets.Set(ctx, types.TickerString(market.Ticker.String()), market); err != nil {
+		return err
+	}
+
+	return k.recordMarketChange(ctx, market.Ticker.String())
 }
 
-// EnableMarket sets the Enabled field of a Market Ticker to true.
+// EnableMarket sets the Enabled field of a Market Ticker to true. Markets whose lifecycle state is set
+// must be enabled with SetMarketState instead.
 func (k *Keeper) EnableMarket(ctx sdk.Context, tickerStr string) error {
 	market, err := k.GetMarket(ctx, tickerStr)
 	if err != nil {
 		return err
 	}
 
+	if market.Ticker.State != "" {
+		return fmt.Errorf("market %s has lifecycle state %s - its state must be set instead", tickerStr, market.Ticker.State)
+	}
+
 	market.Ticker.Enabled = true
 
 	return k.setMarket(ctx, market)
 }
 
-// DisableMarket sets the Enabled field of a Market Ticker to false.
+// DisableMarket sets the Enabled field of a Market Ticker to false. Markets whose lifecycle state is set
+// must be disabled with SetMarketState instead.
 func (k *Keeper) DisableMarket(ctx sdk.Context, tickerStr string) error {
 	market, err := k.GetMarket(ctx, tickerStr)
 	if err != nil {
 		return err
 	}
 
+	if market.Ticker.State != "" {
+		return fmt.Errorf("market %s has lifecycle state %s - its state must be set instead", tickerStr, market.Ticker.State)
+	}
+
 	market.Ticker.Enabled = false
 
 	return k.setMarket(ctx, market)
 }
 
+// SetMarketState transitions a Market to the given lifecycle state, enabling or disabling it accordingly,
+// and runs the AfterMarketUpdated hooks. The change is recorded as a revision signed by the module authority.
+func (k *Keeper) SetMarketState(ctx sdk.Context, tickerStr, state string) error {
+	market, err := k.GetMarket(ctx, tickerStr)
+	if err != nil {
+		return err
+	}
+
+	updated := market
+	updated.Ticker.SetLifecycleState(state)
+	if err := updated.Ticker.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := types.ValidateMarketStateTransition(market.Ticker, updated.Ticker); err != nil {
+		return err
+	}
+
+	if err := k.setMarket(ctx, updated); err != nil {
+		return err
+	}
+
+	if err := k.hooks.AfterMarketUpdated(ctx, updated); err != nil {
+		return err
+	}
+
+	return k.recordMarketRevision(ctx, k.authority.String(), types.MarketRevisionActionUpdate, &market, &updated)
+}
+
 // GetAllMarkets returns the set of Market objects currently stored in state
 // as a map[TickerString] -> Markets.
 func (k *Keeper) GetAllMarkets(ctx sdk.Context) (map[string]types.Market, error) {
@@ -170,20 +253,96 @@ func (k *Keeper) CreateMarket(ctx sdk.Context, market types.Market) error {
 }
 
 // UpdateMarket updates a Market.
-// The Ticker.String corresponds to a market, and exist unique.
+// The Ticker.String corresponds to a market, and exist unique. The lifecycle state of the market
+// must be a valid transition from its existing state.
 func (k *Keeper) UpdateMarket(ctx sdk.Context, market types.Market) error {
 	// Check if Ticker already exists for the provider
-	alreadyExists, err := k.markets.Has(ctx, types.TickerString(market.Ticker.String()))
+	existing, err := k.markets.Get(ctx, types.TickerString(market.Ticker.String()))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+	}
 	if err != nil {
 		return err
 	}
-	if !alreadyExists {
-		return types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String()))
+	// Check that the lifecycle state of the market can be transitioned
+	if err := types.ValidateMarketStateTransition(existing.Ticker, market.Ticker); err != nil {
+		return err
 	}
 	// Create the config
 	return k.setMarket(ctx, market)
 }
 
+// upsertMarkets creates each of the given markets that does not exist and updates each that does,
+// after verifying that the authority is permitted to do so. Hooks are run and events are emitted for
+// each market, and the resulting state of the market map is validated.
+func (k *Keeper) upsertMarkets(ctx sdk.Context, params types.Params, authority string, markets []types.Market) error {
+	if err := k.verifyMarketUpserts(ctx, params, authority, markets); err != nil {
+		return err
+	}
+
+	// iterate over all markets and either create them (if no market exists), or update them
+	for _, market := range markets {
+		// check if market exists
+		existing, err := k.GetMarket(ctx, market.Ticker.String())
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		var eventType string
+		// if market does not exist, create it
+		if errors.Is(err, collections.ErrNotFound) {
+			err = k.CreateMarket(ctx, market)
+			if err != nil {
+				return err
+			}
+
+			// run hooks
+			if err = k.hooks.AfterMarketCreated(ctx, market); err != nil {
+				return err
+			}
+
+			if err = k.recordMarketRevision(ctx, authority, types.MarketRevisionActionCreate, nil, &market); err != nil {
+				return err
+			}
+
+			eventType = types.EventTypeCreateMarket
+		} else {
+			err = k.UpdateMarket(ctx, market)
+			if err != nil {
+				return err
+			}
+
+			// run hooks
+			if err = k.hooks.AfterMarketUpdated(ctx, market); err != nil {
+				return err
+			}
+
+			if err = k.recordMarketRevision(ctx, authority, types.MarketRevisionActionUpdate, &existing, &market); err != nil {
+				return err
+			}
+
+			eventType = types.EventTypeUpdateMarket
+		}
+
+		event := sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+			sdk.NewAttribute(types.AttributeKeyState, market.Ticker.LifecycleState()),
+		)
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	// validate that the new state of the marketmap is valid
+	if err := k.ValidateState(ctx, markets); err != nil {
+		return err
+	}
+
+	return k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
 // DeleteMarket removes a Market.  If the market does not exist, this is a no-op and nil is returned.
 // If the market exists, all DeleteMarketValidationHooks are called on the market before deletion.
 // Additionally, returns true if the market was deleted.
@@ -205,6 +364,10 @@ func (k *Keeper) DeleteMarket(ctx sdk.Context, tickerStr string) (bool, error) {
 		return false, err
 	}
 
+	if err := k.recordMarketChange(ctx, market.Ticker.String()); err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -224,7 +387,8 @@ func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
 }
 
 // ValidateState is called after keeper modifications have been made to the market map to verify that
-// the aggregate of all updates has led to a valid state.
+// the aggregate of all updates has led to a valid state, including that no market is (transitively)
+// normalized by itself through all of its provider configs.
 func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 	for _, market := range updates {
 		if err := k.IsMarketValid(ctx, market); err != nil {
@@ -232,7 +396,51 @@ func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 		}
 	}
 
-	return nil
+	// the updates may have introduced a cycle of normalization markets that cannot be priced. Such a
+	// cycle passes through an updated market, so only the markets reachable from the updates are checked.
+	markets, err := k.getNormalizationMarkets(ctx, updates)
+	if err != nil {
+		return err
+	}
+
+	mm := types.MarketMap{Markets: markets}
+	return mm.ValidateNormalizationGraph()
+}
+
+// getNormalizationMarkets returns the given markets as they are in state, along with every market they are
+// (transitively) normalized by, keyed by ticker. Normalization markets that are not in state are skipped.
+func (k *Keeper) getNormalizationMarkets(ctx sdk.Context, updates []types.Market) (map[string]types.Market, error) {
+	queue := make([]string, 0, len(updates))
+	for _, market := range updates {
+		queue = append(queue, market.Ticker.String())
+	}
+
+	markets := make(map[string]types.Market, len(updates))
+	for len(queue) > 0 {
+		ticker := queue[0]
+		queue = queue[1:]
+		if _, found := markets[ticker]; found {
+			continue
+		}
+
+		market, err := k.markets.Get(ctx, types.TickerString(ticker))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+		markets[ticker] = market
+
+		for _, providerConfig := range market.ProviderConfigs {
+			for _, step := range providerConfig.ConversionPairs() {
+				queue = append(queue, step.Pair.String())
+			}
+		}
+	}
+
+	return markets, nil
 }
 
 // IsMarketValid checks if a market is valid by statefully checking if each of the currency pairs
@@ -240,17 +448,17 @@ func (k *Keeper) ValidateState(ctx sdk.Context, updates []types.Market) error {
 func (k *Keeper) IsMarketValid(ctx sdk.Context, market types.Market) error {
 	// check that all markets already exist in the keeper store:
 	for _, providerConfig := range market.ProviderConfigs {
-		if providerConfig.NormalizeByPair != nil {
-			norm, err := k.markets.Get(ctx, types.TickerString(providerConfig.NormalizeByPair.String()))
+		for _, step := range providerConfig.ConversionPairs() {
+			norm, err := k.markets.Get(ctx, types.TickerString(step.Pair.String()))
 			if err != nil {
 				return fmt.Errorf("unable to get normalize market %s for market %s: %w",
-					providerConfig.NormalizeByPair.String(), market.Ticker.String(), err)
+					step.Pair.String(), market.Ticker.String(), err)
 			}
 
 			// if the new market is enabled, its normalize by market must also be enabled
 			if market.Ticker.Enabled && !norm.Ticker.Enabled {
 				return fmt.Errorf("needed normalize market %s for market %s is not enabled",
-					providerConfig.NormalizeByPair.String(), market.Ticker.String())
+					step.Pair.String(), market.Ticker.String())
 			}
 		}
 	}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/skip-mev/chaintestutil/sample"
@@ -301,6 +302,46 @@ func (s *KeeperTestSuite) TestValidUpdate() {
 	s.Require().NoError(s.keeper.ValidateState(s.ctx, []types.Market{validMarket}))
 }
 
+func (s *KeeperTestSuite) TestValidateStateOnlyReadsReachableMarkets() {
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, usdtusd))
+
+	normalized := btcusdt
+	normalized.ProviderConfigs = []types.ProviderConfig{
+		{
+			Name:            "kucoin",
+			OffChainTicker:  "btc-usdt",
+			NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+		},
+	}
+	s.Require().NoError(s.keeper.CreateMarket(s.ctx, normalized))
+
+	validateGas := func() storetypes.Gas {
+		ctx := s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		s.Require().NoError(s.keeper.ValidateState(ctx, []types.Market{normalized}))
+		return ctx.GasMeter().GasConsumed()
+	}
+	gas := validateGas()
+
+	// markets that the update is not (transitively) normalized by are not read
+	for i := 0; i < 500; i++ {
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx, types.Market{
+			Ticker: types.Ticker{
+				CurrencyPair:     slinkytypes.NewCurrencyPair(fmt.Sprintf("TOKEN%d", i), "USDT"),
+				Decimals:         8,
+				MinProviderCount: 1,
+			},
+			ProviderConfigs: []types.ProviderConfig{
+				{
+					Name:            "kucoin",
+					OffChainTicker:  fmt.Sprintf("token%d-usdt", i),
+					NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+				},
+			},
+		}))
+	}
+	s.Require().Equal(gas, validateGas())
+}
+
 func (s *KeeperTestSuite) TestInvalidUpdateDisabledNormalizeBy() {
 	marketBTCUSDT := btcusdt
 	marketETHUSDT := ethusdt
@@ -395,3 +436,76 @@ func (s *KeeperTestSuite) TestEnableDisableMarket() {
 	s.Require().NoError(err)
 	s.Require().False(market.Ticker.Enabled)
 }
+
+func (s *KeeperTestSuite) TestMarketLifecycle() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	proposed := btcusdt
+	proposed.Ticker.SetLifecycleState(types.MarketStateProposed)
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{proposed},
+	})
+	s.Require().NoError(err)
+
+	ticker := proposed.Ticker.String()
+
+	s.Run("markets with a state cannot be enabled or disabled", func() {
+		s.Require().Error(s.keeper.EnableMarket(s.ctx, ticker))
+		s.Require().Error(s.keeper.DisableMarket(s.ctx, ticker))
+	})
+
+	s.Run("invalid state fails", func() {
+		s.Require().Error(s.keeper.SetMarketState(s.ctx, ticker, "listed"))
+	})
+
+	s.Run("shadow markets are flagged in x/oracle", func() {
+		s.Require().NoError(s.keeper.SetMarketState(s.ctx, ticker, types.MarketStateShadow))
+
+		market, err := s.keeper.GetMarket(s.ctx, ticker)
+		s.Require().NoError(err)
+		s.Require().Equal(types.MarketStateShadow, market.Ticker.State)
+		s.Require().True(market.Ticker.Enabled)
+
+		shadow, err := s.oracleKeeper.IsCurrencyPairShadow(s.ctx, proposed.Ticker.CurrencyPair)
+		s.Require().NoError(err)
+		s.Require().True(shadow)
+	})
+
+	s.Run("active markets cannot be removed", func() {
+		s.Require().NoError(s.keeper.SetMarketState(s.ctx, ticker, types.MarketStateActive))
+
+		shadow, err := s.oracleKeeper.IsCurrencyPairShadow(s.ctx, proposed.Ticker.CurrencyPair)
+		s.Require().NoError(err)
+		s.Require().False(shadow)
+
+		_, err = s.keeper.DeleteMarket(s.ctx, ticker)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid transition fails", func() {
+		s.Require().Error(s.keeper.SetMarketState(s.ctx, ticker, types.MarketStateDelisted))
+
+		delisted := proposed
+		delisted.Ticker.SetLifecycleState(types.MarketStateDelisted)
+		_, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{delisted},
+		})
+		s.Require().Error(err)
+
+		unset := proposed
+		unset.Ticker.State = ""
+		unset.Ticker.Enabled = true
+		s.Require().Error(s.keeper.UpdateMarket(s.ctx, unset))
+	})
+
+	s.Run("delisted markets can be removed", func() {
+		s.Require().NoError(s.keeper.SetMarketState(s.ctx, ticker, types.MarketStateReduceOnly))
+		s.Require().NoError(s.keeper.SetMarketState(s.ctx, ticker, types.MarketStateDelisted))
+
+		deleted, err := s.keeper.DeleteMarket(s.ctx, ticker)
+		s.Require().NoError(err)
+		s.Require().True(deleted)
+	})
+}
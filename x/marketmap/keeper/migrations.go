@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// Migrator is a struct for handling in-place state migrations of the x/marketmap module.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator for the given keeper.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/marketmap module from consensus version 1 to 2. Version 1 params have no
+// MaxMarketRevisions, which would disable the market history, so it is set to the default. Market changes
+// are tracked from the upgrade height. The scheduled market updates and market revisions did not exist in
+// version 1, so their stores start out empty.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxMarketRevisions == 0 {
+		params.MaxMarketRevisions = types.DefaultMaxMarketRevisions
+		if err := m.k.SetParams(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	return m.k.trackMarketChanges(ctx)
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"github.com/1119-Labs/slinky/x/marketmap/keeper"
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.Run("sets the default max market revisions and tracks market changes", func() {
+		s.SetupTest()
+		s.setMaxMarketRevisions(0)
+
+		tracked, err := s.keeper.MarketChangesTracked(s.ctx, uint64(s.ctx.BlockHeight())) //nolint:gosec
+		s.Require().NoError(err)
+		s.Require().False(tracked)
+
+		s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(types.DefaultMaxMarketRevisions), params.MaxMarketRevisions)
+
+		tracked, err = s.keeper.MarketChangesTracked(s.ctx, uint64(s.ctx.BlockHeight())) //nolint:gosec
+		s.Require().NoError(err)
+		s.Require().True(tracked)
+
+		// revisions are recorded after the upgrade
+		_, err = keeper.NewMsgServer(s.keeper).CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     s.marketAuthorities[0],
+			CreateMarkets: []types.Market{usdtusd},
+		})
+		s.Require().NoError(err)
+
+		revisions, err := s.keeper.GetMarketRevisions(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Len(revisions, 1)
+	})
+
+	s.Run("does not overwrite a set max market revisions", func() {
+		s.SetupTest()
+		s.setMaxMarketRevisions(3)
+
+		s.Require().NoError(keeper.NewMigrator(s.keeper).Migrate1to2(s.ctx))
+
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), params.MaxMarketRevisions)
+	})
+}
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"slices"
 	"strconv"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
 	"github.com/1119-Labs/slinky/x/marketmap/types"
 )
 
@@ -30,62 +33,16 @@ func (ms msgServer) UpsertMarkets(goCtx context.Context, msg *types.MsgUpsertMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	params, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
-	// iterate over all markets and either create them (if no market exists), or update them
-	for _, market := range msg.Markets {
-		// check if market exists
-		exists, err := ms.k.HasMarket(ctx, market.Ticker.String())
-		if err != nil {
-			return nil, err
-		}
-
-		var eventType string
-		// if market does not exist, create it
-		if !exists {
-			err = ms.k.CreateMarket(ctx, market)
-			if err != nil {
-				return nil, err
-			}
-
-			// run hooks
-			if err = ms.k.hooks.AfterMarketCreated(ctx, market); err != nil {
-				return nil, err
-			}
-
-			eventType = types.EventTypeCreateMarket
-		} else {
-			err = ms.k.UpdateMarket(ctx, market)
-			if err != nil {
-				return nil, err
-			}
-
-			// run hooks
-			if err = ms.k.hooks.AfterMarketUpdated(ctx, market); err != nil {
-				return nil, err
-			}
-
-			eventType = types.EventTypeUpdateMarket
-		}
-
-		event := sdk.NewEvent(
-			eventType,
-			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
-			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
-			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
-			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
-		)
-		ctx.EventManager().EmitEvent(event)
-	}
-
-	// validate that the new state of the marketmap is valid
-	if err := ms.k.ValidateState(ctx, msg.Markets); err != nil {
+	if err := ms.k.upsertMarkets(ctx, params, msg.Authority, msg.Markets); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUpsertMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+	return &types.MsgUpsertMarketsResponse{}, nil
 }
 
 // CreateMarkets updates the marketmap by creating markets from the given message.  All updates are made to the market
@@ -94,12 +51,17 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	params, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	// create markets
 	for _, market := range msg.CreateMarkets {
+		if err := verifyMarketActions(params, msg.Authority, market.Ticker.CurrencyPair, types.MarketActionCreate); err != nil {
+			return nil, err
+		}
+
 		err := ms.k.CreateMarket(ctx, market)
 		if err != nil {
 			return nil, err
@@ -110,18 +72,23 @@ func (ms msgServer) CreateMarkets(goCtx context.Context, msg *types.MsgCreateMar
 			return nil, fmt.Errorf("unable to run create market hook: %w", err)
 		}
 
+		if err := ms.k.recordMarketRevision(ctx, msg.Authority, types.MarketRevisionActionCreate, nil, &market); err != nil {
+			return nil, fmt.Errorf("unable to record market revision: %w", err)
+		}
+
 		event := sdk.NewEvent(
 			types.EventTypeCreateMarket,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
 			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
 			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
 			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+			sdk.NewAttribute(types.AttributeKeyState, market.Ticker.LifecycleState()),
 		)
 		ctx.EventManager().EmitEvent(event)
 	}
 
 	// validate that the new state of the marketmap is valid
-	err := ms.k.ValidateState(ctx, msg.CreateMarkets)
+	err = ms.k.ValidateState(ctx, msg.CreateMarkets)
 	if err != nil {
 		return nil, fmt.Errorf("invalid state resulting from update: %w", err)
 	}
@@ -135,12 +102,23 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	params, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	for _, market := range msg.UpdateMarkets {
-		err := ms.k.UpdateMarket(ctx, market)
+		existing, err := ms.k.GetMarket(ctx, market.Ticker.String())
+		if err != nil {
+			return nil, fmt.Errorf("unable to update market: %w", types.NewMarketDoesNotExistsError(types.TickerString(market.Ticker.String())))
+		}
+
+		actions := types.RequiredMarketActions(existing, market)
+		if err := verifyMarketActions(params, msg.Authority, market.Ticker.CurrencyPair, actions...); err != nil {
+			return nil, err
+		}
+
+		err = ms.k.UpdateMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to update market: %w", err)
 		}
@@ -150,12 +128,17 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 			return nil, fmt.Errorf("unable to run update market hook: %w", err)
 		}
 
+		if err := ms.k.recordMarketRevision(ctx, msg.Authority, types.MarketRevisionActionUpdate, &existing, &market); err != nil {
+			return nil, fmt.Errorf("unable to record market revision: %w", err)
+		}
+
 		event := sdk.NewEvent(
 			types.EventTypeUpdateMarket,
 			sdk.NewAttribute(types.AttributeKeyCurrencyPair, market.Ticker.String()),
 			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(market.Ticker.Decimals, 10)),
 			sdk.NewAttribute(types.AttributeKeyMinProviderCount, strconv.FormatUint(market.Ticker.MinProviderCount, 10)),
 			sdk.NewAttribute(types.AttributeKeyMetadata, market.Ticker.Metadata_JSON),
+			sdk.NewAttribute(types.AttributeKeyState, market.Ticker.LifecycleState()),
 		)
 		ctx.EventManager().EmitEvent(event)
 
@@ -169,25 +152,37 @@ func (ms msgServer) UpdateMarkets(goCtx context.Context, msg *types.MsgUpdateMar
 	return &types.MsgUpdateMarketsResponse{}, ms.k.SetLastUpdated(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
 }
 
-// verifyMarketAuthorities verifies that the msg-submitter is a market-authority
-// and returns the context for the msg, this method returns an error if the submitter is not a market
-// authority.
+// verifyMarketAuthorities verifies that the msg-submitter is a market-authority or has been granted
+// permissions on some markets and returns the params of the module, this method returns an error if the
+// submitter is not a market authority. The permissions of the submitter on each market must then be
+// checked with verifyMarketActions.
 func (ms msgServer) verifyMarketAuthorities(ctx sdk.Context, msg interface {
 	GetAuthority() string
 },
-) error {
+) (types.Params, error) {
 	if msg == nil {
-		return fmt.Errorf("unable to process nil msg")
+		return types.Params{}, fmt.Errorf("unable to process nil msg")
 	}
 
 	params, err := ms.k.GetParams(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to get marketmap params: %w", err)
+		return types.Params{}, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if !params.IsMarketAuthority(msg.GetAuthority()) {
+		return types.Params{}, fmt.Errorf("request signer %s does not match module market authorities", msg.GetAuthority())
 	}
 
-	found := checkMarketAuthority(msg.GetAuthority(), params)
-	if !found {
-		return fmt.Errorf("request signer %s does not match module market authorities", msg.GetAuthority())
+	return params, nil
+}
+
+// verifyMarketActions verifies that the authority is permitted to perform each of the given actions on
+// the market with the given currency pair.
+func verifyMarketActions(params types.Params, authority string, cp slinkytypes.CurrencyPair, actions ...string) error {
+	for _, action := range actions {
+		if !params.IsAuthorized(authority, action, cp) {
+			return fmt.Errorf("request signer %s is not permitted to perform %s on market %s", authority, action, cp.String())
+		}
 	}
 
 	return nil
@@ -228,7 +223,7 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 		return nil, fmt.Errorf("request admin %s does not match module admin %s", msg.Admin, params.Admin)
 	}
 
-	if len(msg.RemoveAddresses) > len(params.MarketAuthorities) {
+	if len(msg.RemoveAddresses) > len(params.MarketAuthorities)+len(params.MarketAuthorityGrants) {
 		return nil, fmt.Errorf("remove addresses must be a subset of the current market authorities")
 	}
 
@@ -237,11 +232,15 @@ func (ms msgServer) RemoveMarketAuthorities(goCtx context.Context, msg *types.Ms
 		removeAddresses[remove] = struct{}{}
 	}
 
-	for i, address := range params.MarketAuthorities {
-		if _, found := removeAddresses[address]; found {
-			params.MarketAuthorities = slices.Delete(params.MarketAuthorities, i, i+1)
-		}
-	}
+	// remove the addresses from the market authorities and revoke all of their grants
+	params.MarketAuthorities = slices.DeleteFunc(params.MarketAuthorities, func(address string) bool {
+		_, found := removeAddresses[address]
+		return found
+	})
+	params.MarketAuthorityGrants = slices.DeleteFunc(params.MarketAuthorityGrants, func(grant types.MarketAuthorityGrant) bool {
+		_, found := removeAddresses[grant.Authority]
+		return found
+	})
 
 	if err := ms.k.SetParams(ctx, params); err != nil {
 		return nil, err
@@ -264,12 +263,27 @@ func (ms msgServer) RemoveMarkets(
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// perform basic msg validity checks
-	if err := ms.verifyMarketAuthorities(ctx, msg); err != nil {
+	params, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
 		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
 	deletedMarkets := make([]string, 0, len(msg.Markets))
 	for _, market := range msg.Markets {
+		cp, err := slinkytypes.CurrencyPairFromString(market)
+		if err != nil {
+			return nil, fmt.Errorf("invalid market %s: %w", market, err)
+		}
+
+		if err := verifyMarketActions(params, msg.Authority, cp, types.MarketActionRemove); err != nil {
+			return nil, err
+		}
+
+		previous, err := ms.k.GetMarket(ctx, market)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
 		deleted, err := ms.k.DeleteMarket(ctx, market)
 		if err != nil {
 			return nil, fmt.Errorf("unable to delete market: %w", err)
@@ -278,6 +292,10 @@ func (ms msgServer) RemoveMarkets(
 		if deleted {
 			ctx.Logger().Info(fmt.Sprintf("deleted market %s", market))
 			deletedMarkets = append(deletedMarkets, market)
+
+			if err := ms.k.recordMarketRevision(ctx, msg.Authority, types.MarketRevisionActionRemove, &previous, nil); err != nil {
+				return nil, fmt.Errorf("unable to record market revision: %w", err)
+			}
 		}
 
 		if err := ms.k.hooks.AfterMarketRemoved(ctx, market); err != nil {
@@ -301,17 +319,85 @@ func (ms msgServer) RemoveMarkets(
 	}, nil
 }
 
-// checkMarketAuthority checks if the given authority is the x/marketmap's list of MarketAuthorities.
-func checkMarketAuthority(authority string, params types.Params) bool {
-	if len(params.MarketAuthorities) == 0 {
-		return false
+// ScheduleMarketUpdates queues the markets in the given message to be upserted at the beginning of the block at
+// the given height. The permissions of the authority are verified against the current state of the market map,
+// and are verified again when the update is applied.
+func (ms msgServer) ScheduleMarketUpdates(
+	goCtx context.Context,
+	msg *types.MsgScheduleMarketUpdates,
+) (*types.MsgScheduleMarketUpdatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// perform basic msg validity checks
+	params, err := ms.verifyMarketAuthorities(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to verify market authorities: %w", err)
 	}
 
-	for _, auth := range params.MarketAuthorities {
-		if authority == auth {
-			return true
-		}
+	if msg.Height <= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return nil, fmt.Errorf("scheduled height %d must be greater than the current height %d", msg.Height, ctx.BlockHeight())
+	}
+
+	if err := ms.k.verifyMarketUpserts(ctx, params, msg.Authority, msg.Markets); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.k.ScheduleMarketUpdate(ctx, types.ScheduledMarketUpdate{
+		Authority: msg.Authority,
+		Height:    msg.Height,
+		Markets:   msg.Markets,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to schedule market updates: %w", err)
 	}
 
-	return false
+	event := sdk.NewEvent(
+		types.EventTypeScheduleMarketUpdates,
+		sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(msg.Height, 10)),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+	)
+	ctx.EventManager().EmitEvent(event)
+
+	return &types.MsgScheduleMarketUpdatesResponse{Id: id}, nil
+}
+
+// CancelScheduledMarketUpdates removes a pending scheduled market update from the queue. Only the authority that
+// scheduled the update or one of the MarketAuthorities may cancel it.
+func (ms msgServer) CancelScheduledMarketUpdates(
+	goCtx context.Context,
+	msg *types.MsgCancelScheduledMarketUpdates,
+) (*types.MsgCancelScheduledMarketUpdatesResponse, error) {
+	if msg == nil {
+		return nil, fmt.Errorf("unable to process nil msg")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := ms.k.GetParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	update, err := ms.k.GetScheduledMarketUpdate(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Authority != update.Authority && !slices.Contains(params.MarketAuthorities, msg.Authority) {
+		return nil, fmt.Errorf("request signer %s is neither the scheduling authority nor a module market authority", msg.Authority)
+	}
+
+	if err := ms.k.RemoveScheduledMarketUpdate(ctx, update); err != nil {
+		return nil, err
+	}
+
+	event := sdk.NewEvent(
+		types.EventTypeCancelScheduledMarketUpdates,
+		sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(update.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+	)
+	ctx.EventManager().EmitEvent(event)
+
+	return &types.MsgCancelScheduledMarketUpdatesResponse{}, nil
 }
@@ -211,6 +211,36 @@ func (s *KeeperTestSuite) TestMsgServerUpdateMarkets() {
 		s.Require().Error(err)
 		s.Require().Nil(resp)
 	})
+	s.Run("unable to update markets so that they are normalized by each other", func() {
+		btcusdtUpdate := btcusdt
+		btcusdtUpdate.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "btc-usd",
+				NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+			},
+		}
+
+		usdtusdUpdate := usdtusd
+		usdtusdUpdate.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:            "kucoin",
+				OffChainTicker:  "usdt-btc",
+				NormalizeByPair: &btcusdt.Ticker.CurrencyPair,
+			},
+		}
+
+		msg := &types.MsgUpdateMarkets{
+			Authority: s.marketAuthorities[0],
+			UpdateMarkets: []types.Market{
+				btcusdtUpdate,
+				usdtusdUpdate,
+			},
+		}
+		resp, err := msgServer.UpdateMarkets(s.ctx, msg)
+		s.Require().ErrorContains(err, "is normalized by itself through all of its provider configs")
+		s.Require().Nil(resp)
+	})
 }
 
 func (s *KeeperTestSuite) TestMsgServerParams() {
@@ -335,6 +365,147 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarketAuthorities() {
 	})
 }
 
+func (s *KeeperTestSuite) TestMsgServerScopedMarketAuthority() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	scoped := sample.Address(r)
+	s.Require().NoError(s.keeper.SetParams(s.ctx, types.Params{
+		MarketAuthorities: s.marketAuthorities,
+		Admin:             s.admin,
+		MarketAuthorityGrants: []types.MarketAuthorityGrant{
+			{
+				Authority: scoped,
+				Actions: []string{
+					types.MarketActionCreate,
+					types.MarketActionUpdateProviderConfigs,
+					types.MarketActionSetEnabled,
+					types.MarketActionRemove,
+				},
+				QuoteAssets:     []string{"USDT"},
+				ExcludedTickers: []string{btcusdt.Ticker.String()},
+			},
+		},
+	}))
+
+	// create initial markets with a full market authority
+	_, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+		Authority:     s.marketAuthorities[0],
+		CreateMarkets: []types.Market{btcusdt, usdtusd},
+	})
+	s.Require().NoError(err)
+
+	s.Run("unable to process for an address without grants", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     sample.Address(r),
+			CreateMarkets: []types.Market{ethusdt},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("able to create a market in scope", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     scoped,
+			CreateMarkets: []types.Market{ethusdt},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+	})
+
+	s.Run("unable to create a market out of scope", func() {
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     scoped,
+			CreateMarkets: []types.Market{usdcusd},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("able to update the provider configs of a market in scope", func() {
+		market := ethusdt
+		market.ProviderConfigs = []types.ProviderConfig{
+			{
+				Name:           "okx",
+				OffChainTicker: "ETH-USDT",
+			},
+		}
+
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     scoped,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		got, err := s.keeper.GetMarket(s.ctx, market.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().Equal(market, got)
+	})
+
+	s.Run("unable to update the ticker of a market without the action", func() {
+		market, err := s.keeper.GetMarket(s.ctx, ethusdt.Ticker.String())
+		s.Require().NoError(err)
+		market.Ticker.Decimals = 18
+
+		resp, err := msgServer.UpdateMarkets(s.ctx, &types.MsgUpdateMarkets{
+			Authority:     scoped,
+			UpdateMarkets: []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to enable an excluded market", func() {
+		market := btcusdt
+		market.Ticker.Enabled = true
+
+		resp, err := msgServer.UpsertMarkets(s.ctx, &types.MsgUpsertMarkets{
+			Authority: scoped,
+			Markets:   []types.Market{market},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to remove an excluded market", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: scoped,
+			Markets:   []string{btcusdt.Ticker.String()},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("able to remove a market in scope", func() {
+		resp, err := msgServer.RemoveMarkets(s.ctx, &types.MsgRemoveMarkets{
+			Authority: scoped,
+			Markets:   []string{ethusdt.Ticker.String()},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{ethusdt.Ticker.String()}, resp.DeletedMarkets)
+	})
+
+	s.Run("removing the authority revokes its grants", func() {
+		_, err := msgServer.RemoveMarketAuthorities(s.ctx, &types.MsgRemoveMarketAuthorities{
+			Admin:           s.admin,
+			RemoveAddresses: []string{scoped},
+		})
+		s.Require().NoError(err)
+
+		params, err := s.keeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(s.marketAuthorities, params.MarketAuthorities)
+		s.Require().Empty(params.MarketAuthorityGrants)
+
+		resp, err := msgServer.CreateMarkets(s.ctx, &types.MsgCreateMarkets{
+			Authority:     scoped,
+			CreateMarkets: []types.Market{ethusdt},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+}
+
 func (s *KeeperTestSuite) TestMsgServerUpsertMarkets() {
 	hooks := mmmocks.NewMarketMapHooks(s.T())
 
@@ -730,3 +901,95 @@ func (s *KeeperTestSuite) TestMsgServerRemoveMarkets() {
 		s.Require().Error(err)
 	})
 }
+
+func (s *KeeperTestSuite) TestMsgServerScheduleMarketUpdates() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+
+	s.Run("unable to process nil request", func() {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to process for invalid authority (valid bech32)", func() {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority: sdk.AccAddress("invalid").String(),
+			Height:    uint64(s.ctx.BlockHeight()) + 1,
+			Markets:   []types.Market{usdtusd},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to schedule for the current height", func() {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority: s.marketAuthorities[0],
+			Height:    uint64(s.ctx.BlockHeight()),
+			Markets:   []types.Market{usdtusd},
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("able to schedule market updates", func() {
+		resp, err := msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority: s.marketAuthorities[0],
+			Height:    uint64(s.ctx.BlockHeight()) + 5,
+			Markets:   []types.Market{usdtusd},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), resp.Id)
+
+		resp, err = msgServer.ScheduleMarketUpdates(s.ctx, &types.MsgScheduleMarketUpdates{
+			Authority: s.marketAuthorities[1],
+			Height:    uint64(s.ctx.BlockHeight()) + 1,
+			Markets:   []types.Market{usdcusd},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), resp.Id)
+
+		// the markets are not created until the scheduled height
+		exists, err := s.keeper.HasMarket(s.ctx, usdtusd.Ticker.String())
+		s.Require().NoError(err)
+		s.Require().False(exists)
+
+		updates, err := s.keeper.GetAllScheduledMarketUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ScheduledMarketUpdate{
+			{Id: 1, Authority: s.marketAuthorities[1], Height: uint64(s.ctx.BlockHeight()) + 1, Markets: []types.Market{usdcusd}},
+			{Id: 0, Authority: s.marketAuthorities[0], Height: uint64(s.ctx.BlockHeight()) + 5, Markets: []types.Market{usdtusd}},
+		}, updates)
+	})
+
+	s.Run("unable to cancel a scheduled update that does not exist", func() {
+		resp, err := msgServer.CancelScheduledMarketUpdates(s.ctx, &types.MsgCancelScheduledMarketUpdates{
+			Authority: s.marketAuthorities[0],
+			Id:        100,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("unable to cancel a scheduled update as another account", func() {
+		resp, err := msgServer.CancelScheduledMarketUpdates(s.ctx, &types.MsgCancelScheduledMarketUpdates{
+			Authority: sample.Address(r),
+			Id:        0,
+		})
+		s.Require().Error(err)
+		s.Require().Nil(resp)
+	})
+
+	s.Run("able to cancel a scheduled update as a market authority", func() {
+		resp, err := msgServer.CancelScheduledMarketUpdates(s.ctx, &types.MsgCancelScheduledMarketUpdates{
+			Authority: s.marketAuthorities[2],
+			Id:        0,
+		})
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		updates, err := s.keeper.GetAllScheduledMarketUpdates(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(updates, 1)
+		s.Require().Equal(uint64(1), updates[0].Id)
+	})
+}
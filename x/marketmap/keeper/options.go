@@ -19,3 +19,12 @@ func WithDeleteValidationHooks(hooks []types.MarketValidationHook) Option {
 		k.deleteMarketValidationHooks = hooks
 	}
 }
+
+// WithMarketChangesRetention sets the number of blocks for which the keeper retains the heights at which markets
+// were changed. Clients that have not synced within this many blocks must fetch the full market map. A retention of
+// 0 retains the change heights indefinitely.
+func WithMarketChangesRetention(blocks uint64) Option {
+	return func(k *Keeper) {
+		k.changesRetention = blocks
+	}
+}
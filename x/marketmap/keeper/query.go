@@ -117,3 +117,134 @@ func (q queryServerImpl) Params(goCtx context.Context, req *types.ParamsRequest)
 
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+// MarketAuthorityGrants returns the actions and markets that each market authority is permitted to
+// act on. If an authority is given, only the grants of that authority are returned.
+func (q queryServerImpl) MarketAuthorityGrants(goCtx context.Context, req *types.MarketAuthorityGrantsRequest) (*types.MarketAuthorityGrantsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := q.k.params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Authority) > 0 {
+		return &types.MarketAuthorityGrantsResponse{Grants: params.GrantsFor(req.Authority)}, nil
+	}
+
+	grants := make([]types.MarketAuthorityGrant, 0, len(params.MarketAuthorities)+len(params.MarketAuthorityGrants))
+	for _, authority := range params.MarketAuthorities {
+		grants = append(grants, types.NewFullMarketAuthorityGrant(authority))
+	}
+	grants = append(grants, params.MarketAuthorityGrants...)
+
+	return &types.MarketAuthorityGrantsResponse{Grants: grants}, nil
+}
+
+// ScheduledMarketUpdates returns the market updates that are queued to be applied at a future block height.
+func (q queryServerImpl) ScheduledMarketUpdates(goCtx context.Context, req *types.ScheduledMarketUpdatesRequest) (*types.ScheduledMarketUpdatesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	updates, err := q.k.GetAllScheduledMarketUpdates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ScheduledMarketUpdatesResponse{ScheduledUpdates: updates}, nil
+}
+
+// MarketRevisions returns the retained history of changes made to a market in the x/marketmap module.
+func (q queryServerImpl) MarketRevisions(goCtx context.Context, req *types.MarketRevisionsRequest) (*types.MarketRevisionsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	if err := req.CurrencyPair.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revisions, err := q.k.GetMarketRevisions(ctx, req.CurrencyPair.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketRevisionsResponse{Revisions: revisions}, nil
+}
+
+// MarketRevisionsByHeight returns the retained changes made to any market within a range of block heights in the
+// x/marketmap module.
+func (q queryServerImpl) MarketRevisionsByHeight(
+	goCtx context.Context,
+	req *types.MarketRevisionsByHeightRequest,
+) (*types.MarketRevisionsByHeightResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revisions, err := q.k.GetMarketRevisionsByHeight(ctx, req.StartHeight, req.EndHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketRevisionsByHeightResponse{Revisions: revisions}, nil
+}
+
+// MarketMapUpdates returns the markets that were created, updated or removed after the requested height in the
+// x/marketmap module. If those changes are not tracked, the response requires the client to fetch the full market map.
+func (q queryServerImpl) MarketMapUpdates(
+	goCtx context.Context,
+	req *types.MarketMapUpdatesRequest,
+) (*types.MarketMapUpdatesResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	// unwrap the context
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lastUpdated, err := q.k.GetLastUpdated(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.MarketMapUpdatesResponse{
+		Markets:        make([]types.Market, 0),
+		RemovedTickers: make([]string, 0),
+		Height:         uint64(ctx.BlockHeight()), //nolint:gosec
+		LastUpdated:    lastUpdated,
+		ChainId:        ctx.ChainID(),
+	}
+
+	tracked, err := q.k.MarketChangesTracked(ctx, req.SinceHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tracked {
+		resp.FullSyncRequired = true
+		return resp, nil
+	}
+
+	resp.Markets, resp.RemovedTickers, err = q.k.GetMarketChangesSince(ctx, req.SinceHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
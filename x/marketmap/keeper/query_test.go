@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"github.com/skip-mev/chaintestutil/sample"
+
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
 	"github.com/1119-Labs/slinky/x/marketmap/keeper"
 	"github.com/1119-Labs/slinky/x/marketmap/types"
@@ -161,6 +163,82 @@ func (s *KeeperTestSuite) TestParams() {
 	})
 }
 
+func (s *KeeperTestSuite) TestMarketAuthorityGrants() {
+	grant := types.MarketAuthorityGrant{
+		Authority:   sample.Address(r),
+		Actions:     []string{types.MarketActionCreate},
+		QuoteAssets: []string{"USDT"},
+	}
+
+	params := types.DefaultParams()
+	params.MarketAuthorityGrants = []types.MarketAuthorityGrant{grant}
+	s.Require().NoError(s.keeper.SetParams(s.ctx, params))
+
+	qs := keeper.NewQueryServer(s.keeper)
+
+	s.Run("run valid request for all authorities", func() {
+		resp, err := qs.MarketAuthorityGrants(s.ctx, &types.MarketAuthorityGrantsRequest{})
+		s.Require().NoError(err)
+
+		expected := []types.MarketAuthorityGrant{
+			types.NewFullMarketAuthorityGrant(params.MarketAuthorities[0]),
+			grant,
+		}
+		s.Require().Equal(expected, resp.Grants)
+	})
+
+	s.Run("run valid request for a market authority", func() {
+		resp, err := qs.MarketAuthorityGrants(s.ctx, &types.MarketAuthorityGrantsRequest{Authority: params.MarketAuthorities[0]})
+		s.Require().NoError(err)
+
+		s.Require().Equal([]types.MarketAuthorityGrant{types.NewFullMarketAuthorityGrant(params.MarketAuthorities[0])}, resp.Grants)
+	})
+
+	s.Run("run valid request for a scoped authority", func() {
+		resp, err := qs.MarketAuthorityGrants(s.ctx, &types.MarketAuthorityGrantsRequest{Authority: grant.Authority})
+		s.Require().NoError(err)
+
+		s.Require().Equal([]types.MarketAuthorityGrant{grant}, resp.Grants)
+	})
+
+	s.Run("run valid request for an address without grants", func() {
+		resp, err := qs.MarketAuthorityGrants(s.ctx, &types.MarketAuthorityGrantsRequest{Authority: sample.Address(r)})
+		s.Require().NoError(err)
+
+		s.Require().Empty(resp.Grants)
+	})
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.MarketAuthorityGrants(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestScheduledMarketUpdates() {
+	qs := keeper.NewQueryServer(s.keeper)
+
+	update := types.ScheduledMarketUpdate{
+		Authority: s.marketAuthorities[0],
+		Height:    100,
+		Markets:   []types.Market{usdtusd},
+	}
+	id, err := s.keeper.ScheduleMarketUpdate(s.ctx, update)
+	s.Require().NoError(err)
+	update.Id = id
+
+	s.Run("run valid request", func() {
+		resp, err := qs.ScheduledMarketUpdates(s.ctx, &types.ScheduledMarketUpdatesRequest{})
+		s.Require().NoError(err)
+
+		s.Require().Equal([]types.ScheduledMarketUpdate{update}, resp.ScheduledUpdates)
+	})
+
+	s.Run("run invalid nil request", func() {
+		_, err := qs.ScheduledMarketUpdates(s.ctx, nil)
+		s.Require().Error(err)
+	})
+}
+
 func (s *KeeperTestSuite) TestLastUpdated() {
 	qs := keeper.NewQueryServer(s.keeper)
 	// set initial states
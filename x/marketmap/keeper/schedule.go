@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// scheduledUpdateIndices are the indexes of the scheduled market updates.
+type scheduledUpdateIndices struct {
+	// id is a unique index on the IDs of the scheduled market updates, i.e. id -> (height, id)
+	id *indexes.Unique[uint64, collections.Pair[uint64, uint64], types.ScheduledMarketUpdate]
+}
+
+func newScheduledUpdateIndices(sb *collections.SchemaBuilder) *scheduledUpdateIndices {
+	return &scheduledUpdateIndices{
+		id: indexes.NewUnique(
+			sb, types.ScheduledMarketUpdatesByIDPrefix, "scheduled_updates_by_id", collections.Uint64Key, types.ScheduledMarketUpdatesCodec,
+			func(key collections.Pair[uint64, uint64], _ types.ScheduledMarketUpdate) (uint64, error) {
+				return key.K2(), nil
+			},
+		),
+	}
+}
+
+func (i *scheduledUpdateIndices) IndexesList() []collections.Index[collections.Pair[uint64, uint64], types.ScheduledMarketUpdate] {
+	return []collections.Index[collections.Pair[uint64, uint64], types.ScheduledMarketUpdate]{
+		i.id,
+	}
+}
+
+// ScheduleMarketUpdate adds the given update to the queue of scheduled market updates, and returns
+// the ID assigned to it. Any ID already set on the update is overwritten.
+func (k *Keeper) ScheduleMarketUpdate(ctx sdk.Context, update types.ScheduledMarketUpdate) (uint64, error) {
+	id, err := k.nextScheduledUpdateID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	update.Id = id
+	return id, k.setScheduledMarketUpdate(ctx, update)
+}
+
+// setScheduledMarketUpdate sets a scheduled market update.
+func (k *Keeper) setScheduledMarketUpdate(ctx sdk.Context, update types.ScheduledMarketUpdate) error {
+	return k.scheduledUpdates.Set(ctx, collections.Join(update.Height, update.Id), update)
+}
+
+// GetScheduledMarketUpdate returns the scheduled market update with the given ID. If there is no such
+// update, an error wrapping collections.ErrNotFound is returned.
+func (k *Keeper) GetScheduledMarketUpdate(ctx sdk.Context, id uint64) (types.ScheduledMarketUpdate, error) {
+	key, err := k.scheduledUpdates.Indexes.id.MatchExact(ctx, id)
+	if err != nil {
+		return types.ScheduledMarketUpdate{}, fmt.Errorf("scheduled market update %d: %w", id, err)
+	}
+
+	return k.scheduledUpdates.Get(ctx, key)
+}
+
+// RemoveScheduledMarketUpdate removes the given update from the queue of scheduled market updates.
+func (k *Keeper) RemoveScheduledMarketUpdate(ctx sdk.Context, update types.ScheduledMarketUpdate) error {
+	return k.scheduledUpdates.Remove(ctx, collections.Join(update.Height, update.Id))
+}
+
+// GetAllScheduledMarketUpdates returns all scheduled market updates, ordered by activation height.
+func (k *Keeper) GetAllScheduledMarketUpdates(ctx sdk.Context) ([]types.ScheduledMarketUpdate, error) {
+	iter, err := k.scheduledUpdates.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// getDueScheduledMarketUpdates returns all scheduled market updates with an activation height at or below
+// the given height, ordered by activation height.
+func (k *Keeper) getDueScheduledMarketUpdates(ctx sdk.Context, height uint64) ([]types.ScheduledMarketUpdate, error) {
+	rng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndExclusive(collections.Join(height+1, uint64(0)))
+
+	iter, err := k.scheduledUpdates.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// ApplyScheduledMarketUpdates applies, and removes from the queue, every scheduled market update that is
+// due at the current block height. Each update is applied atomically with the permissions that its
+// authority has at the time of activation; an update that cannot be applied is dropped and an event
+// is emitted with the reason.
+func (k *Keeper) ApplyScheduledMarketUpdates(ctx sdk.Context) error {
+	updates, err := k.getDueScheduledMarketUpdates(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	for _, update := range updates {
+		if err := k.RemoveScheduledMarketUpdate(ctx, update); err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.applyScheduledMarketUpdate(cacheCtx, update); err != nil {
+			ctx.Logger().Error(
+				"failed to apply scheduled market update",
+				"id", update.Id,
+				"authority", update.Authority,
+				"error", err,
+			)
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeScheduledMarketUpdatesFailed,
+				sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(update.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+
+		write()
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeScheduledMarketUpdatesApplied,
+			sdk.NewAttribute(types.AttributeKeyScheduledUpdateID, strconv.FormatUint(update.Id, 10)),
+		))
+	}
+
+	return nil
+}
+
+// applyScheduledMarketUpdate upserts the markets of the given update with the permissions of its authority.
+func (k *Keeper) applyScheduledMarketUpdate(ctx sdk.Context, update types.ScheduledMarketUpdate) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get marketmap params: %w", err)
+	}
+
+	if !params.IsMarketAuthority(update.Authority) {
+		return fmt.Errorf("scheduling authority %s is no longer a market authority", update.Authority)
+	}
+
+	return k.upsertMarkets(ctx, params, update.Authority, update.Markets)
+}
+
+// verifyMarketUpserts verifies that the authority is permitted to create each of the given markets that
+// does not exist, and to make the required changes to each of the given markets that does.
+func (k *Keeper) verifyMarketUpserts(ctx sdk.Context, params types.Params, authority string, markets []types.Market) error {
+	for _, market := range markets {
+		existing, err := k.GetMarket(ctx, market.Ticker.String())
+		switch {
+		case err == nil:
+			actions := types.RequiredMarketActions(existing, market)
+			if err := verifyMarketActions(params, authority, market.Ticker.CurrencyPair, actions...); err != nil {
+				return err
+			}
+		case errors.Is(err, collections.ErrNotFound):
+			if err := verifyMarketActions(params, authority, market.Ticker.CurrencyPair, types.MarketActionCreate); err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
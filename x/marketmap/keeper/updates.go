@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+// DefaultMarketChangesRetention is the default number of blocks for which market change heights are retained.
+const DefaultMarketChangesRetention uint64 = 100_000
+
+// marketChangeIndices are the indexes of the market change heights.
+type marketChangeIndices struct {
+	// height is a multi-index on the block heights at which the markets were last changed, i.e. height -> ticker
+	height *indexes.Multi[uint64, types.TickerString, uint64]
+}
+
+func newMarketChangeIndices(sb *collections.SchemaBuilder) *marketChangeIndices {
+	return &marketChangeIndices{
+		height: indexes.NewMulti(
+			sb, types.MarketChangeHeightsByHeightPrefix, "market_change_heights_by_height", collections.Uint64Key, types.TickersCodec,
+			func(_ types.TickerString, height uint64) (uint64, error) {
+				return height, nil
+			},
+		),
+	}
+}
+
+func (i *marketChangeIndices) IndexesList() []collections.Index[types.TickerString, uint64] {
+	return []collections.Index[types.TickerString, uint64]{
+		i.height,
+	}
+}
+
+// recordMarketChange records that the market with the given ticker was created, updated or removed at the current
+// block height.
+func (k *Keeper) recordMarketChange(ctx sdk.Context, tickerStr string) error {
+	return k.changeHeights.Set(ctx, types.TickerString(tickerStr), uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// trackMarketChanges sets the block height from which market changes are tracked to the current block height, if
+// it is not already set. Markets that have not been changed since then have no recorded change height.
+func (k *Keeper) trackMarketChanges(ctx sdk.Context) error {
+	tracked, err := k.changesTrackedSince.Has(ctx)
+	if err != nil || tracked {
+		return err
+	}
+
+	return k.changesTrackedSince.Set(ctx, uint64(ctx.BlockHeight())) //nolint:gosec
+}
+
+// pruneMarketChanges advances the block height from which market changes are tracked to the start of the retention
+// window, and removes the change heights at or below it, including the tombstones of removed markets. Changes at or
+// below the height from which changes are tracked are never returned, so the removed entries are not needed.
+func (k *Keeper) pruneMarketChanges(ctx sdk.Context) error {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	if k.changesRetention == 0 || height <= k.changesRetention {
+		return nil
+	}
+
+	trackedSince, err := k.changesTrackedSince.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	cutoff := height - k.changesRetention
+	if cutoff <= trackedSince {
+		return nil
+	}
+
+	if err := k.changesTrackedSince.Set(ctx, cutoff); err != nil {
+		return err
+	}
+
+	var tickers []types.TickerString
+	rng := collections.NewPrefixUntilPairRange[uint64, types.TickerString](cutoff)
+	err = k.changeHeights.Indexes.height.Walk(ctx, rng, func(_ uint64, ticker types.TickerString) (bool, error) {
+		tickers = append(tickers, ticker)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ticker := range tickers {
+		if err := k.changeHeights.Remove(ctx, ticker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarketChangesTracked returns true if every change made to the markets after the given block height is tracked,
+// i.e. GetMarketChangesSince returns a complete set of changes for it.
+func (k *Keeper) MarketChangesTracked(ctx sdk.Context, sinceHeight uint64) (bool, error) {
+	trackedSince, err := k.changesTrackedSince.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	// a height beyond the current block height is not one the client can have synced to on this chain
+	return sinceHeight >= trackedSince && sinceHeight <= uint64(ctx.BlockHeight()), nil //nolint:gosec
+}
+
+// GetMarketChangesSince returns the markets that were created or updated after the given block height in their
+// current state, and the tickers of the markets that were removed after it, ordered by the height of their last
+// change.
+func (k *Keeper) GetMarketChangesSince(ctx sdk.Context, sinceHeight uint64) ([]types.Market, []string, error) {
+	rng := new(collections.Range[collections.Pair[uint64, types.TickerString]]).
+		StartInclusive(collections.PairPrefix[uint64, types.TickerString](sinceHeight + 1))
+
+	var tickers []types.TickerString
+	err := k.changeHeights.Indexes.height.Walk(ctx, rng, func(_ uint64, ticker types.TickerString) (bool, error) {
+		tickers = append(tickers, ticker)
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	markets := make([]types.Market, 0, len(tickers))
+	removed := make([]string, 0)
+	for _, ticker := range tickers {
+		market, err := k.markets.Get(ctx, ticker)
+		switch {
+		case err == nil:
+			markets = append(markets, market)
+		case errors.Is(err, collections.ErrNotFound):
+			removed = append(removed, string(ticker))
+		default:
+			return nil, nil, err
+		}
+	}
+
+	return markets, removed, nil
+}
@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"github.com/1119-Labs/slinky/x/marketmap/keeper"
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func (s *KeeperTestSuite) TestMarketMapUpdates() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	qs := keeper.NewQueryServer(s.keeper)
+	authority := s.marketAuthorities[0]
+
+	s.Run("full sync is required before changes are tracked", func() {
+		res, err := qs.MarketMapUpdates(s.ctx, &types.MarketMapUpdatesRequest{SinceHeight: 0})
+		s.Require().NoError(err)
+		s.Require().True(res.FullSyncRequired)
+		s.Require().Empty(res.Markets)
+		s.Require().Empty(res.RemovedTickers)
+		s.Require().Equal(uint64(10), res.Height)
+	})
+
+	// start tracking changes at height 10
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	s.Run("created markets are returned", func() {
+		_, err := msgServer.CreateMarkets(s.ctx.WithBlockHeight(11), &types.MsgCreateMarkets{
+			Authority:     authority,
+			CreateMarkets: []types.Market{usdtusd, usdcusd},
+		})
+		s.Require().NoError(err)
+
+		res, err := qs.MarketMapUpdates(s.ctx.WithBlockHeight(11), &types.MarketMapUpdatesRequest{SinceHeight: 10})
+		s.Require().NoError(err)
+		s.Require().False(res.FullSyncRequired)
+		s.Require().ElementsMatch([]types.Market{usdtusd, usdcusd}, res.Markets)
+		s.Require().Empty(res.RemovedTickers)
+		s.Require().Equal(uint64(11), res.Height)
+		s.Require().Equal(uint64(11), res.LastUpdated)
+	})
+
+	updated := usdtusd
+	updated.Ticker.Enabled = true
+	s.Run("updated and removed markets are returned", func() {
+		_, err := msgServer.UpdateMarkets(s.ctx.WithBlockHeight(12), &types.MsgUpdateMarkets{
+			Authority:     authority,
+			UpdateMarkets: []types.Market{updated},
+		})
+		s.Require().NoError(err)
+
+		_, err = msgServer.RemoveMarkets(s.ctx.WithBlockHeight(13), &types.MsgRemoveMarkets{
+			Authority: authority,
+			Markets:   []string{usdcusd.Ticker.String()},
+		})
+		s.Require().NoError(err)
+
+		res, err := qs.MarketMapUpdates(s.ctx.WithBlockHeight(13), &types.MarketMapUpdatesRequest{SinceHeight: 11})
+		s.Require().NoError(err)
+		s.Require().False(res.FullSyncRequired)
+		s.Require().Equal([]types.Market{updated}, res.Markets)
+		s.Require().Equal([]string{usdcusd.Ticker.String()}, res.RemovedTickers)
+		s.Require().Equal(uint64(13), res.Height)
+
+		// only the removal was made after height 12
+		res, err = qs.MarketMapUpdates(s.ctx.WithBlockHeight(13), &types.MarketMapUpdatesRequest{SinceHeight: 12})
+		s.Require().NoError(err)
+		s.Require().Empty(res.Markets)
+		s.Require().Equal([]string{usdcusd.Ticker.String()}, res.RemovedTickers)
+
+		res, err = qs.MarketMapUpdates(s.ctx.WithBlockHeight(13), &types.MarketMapUpdatesRequest{SinceHeight: 13})
+		s.Require().NoError(err)
+		s.Require().False(res.FullSyncRequired)
+		s.Require().Empty(res.Markets)
+		s.Require().Empty(res.RemovedTickers)
+	})
+
+	s.Run("re-created markets are no longer removed", func() {
+		// changes made directly through the keeper are tracked as well
+		s.Require().NoError(s.keeper.CreateMarket(s.ctx.WithBlockHeight(14), usdcusd))
+
+		res, err := qs.MarketMapUpdates(s.ctx.WithBlockHeight(14), &types.MarketMapUpdatesRequest{SinceHeight: 11})
+		s.Require().NoError(err)
+		s.Require().ElementsMatch([]types.Market{updated, usdcusd}, res.Markets)
+		s.Require().Empty(res.RemovedTickers)
+	})
+
+	s.Run("full sync is required for untracked heights", func() {
+		// tracking is not restarted by later blocks
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(15)))
+
+		res, err := qs.MarketMapUpdates(s.ctx.WithBlockHeight(15), &types.MarketMapUpdatesRequest{SinceHeight: 10})
+		s.Require().NoError(err)
+		s.Require().False(res.FullSyncRequired)
+
+		res, err = qs.MarketMapUpdates(s.ctx.WithBlockHeight(15), &types.MarketMapUpdatesRequest{SinceHeight: 9})
+		s.Require().NoError(err)
+		s.Require().True(res.FullSyncRequired)
+		s.Require().Empty(res.Markets)
+
+		res, err = qs.MarketMapUpdates(s.ctx.WithBlockHeight(15), &types.MarketMapUpdatesRequest{SinceHeight: 16})
+		s.Require().NoError(err)
+		s.Require().True(res.FullSyncRequired)
+	})
+
+	s.Run("changes are tracked from genesis", func() {
+		k := s.initKeeper()
+		k.InitGenesis(s.ctx.WithBlockHeight(20), *types.DefaultGenesisState())
+
+		tracked, err := k.MarketChangesTracked(s.ctx.WithBlockHeight(21), 19)
+		s.Require().NoError(err)
+		s.Require().False(tracked)
+
+		tracked, err = k.MarketChangesTracked(s.ctx.WithBlockHeight(21), 20)
+		s.Require().NoError(err)
+		s.Require().True(tracked)
+	})
+}
+
+func (s *KeeperTestSuite) TestPruneMarketChanges() {
+	msgServer := keeper.NewMsgServer(s.keeper)
+	qs := keeper.NewQueryServer(s.keeper)
+	authority := s.marketAuthorities[0]
+	keeper.WithMarketChangesRetention(5)(s.keeper)
+
+	// start tracking changes at height 10
+	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
+
+	_, err := msgServer.CreateMarkets(s.ctx.WithBlockHeight(11), &types.MsgCreateMarkets{
+		Authority:     authority,
+		CreateMarkets: []types.Market{usdtusd, usdcusd},
+	})
+	s.Require().NoError(err)
+
+	_, err = msgServer.RemoveMarkets(s.ctx.WithBlockHeight(12), &types.MsgRemoveMarkets{
+		Authority: authority,
+		Markets:   []string{usdcusd.Ticker.String()},
+	})
+	s.Require().NoError(err)
+
+	updated := usdtusd
+	updated.Ticker.Enabled = true
+	_, err = msgServer.UpdateMarkets(s.ctx.WithBlockHeight(14), &types.MsgUpdateMarkets{
+		Authority:     authority,
+		UpdateMarkets: []types.Market{updated},
+	})
+	s.Require().NoError(err)
+
+	s.Run("changes within the retention window are kept", func() {
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(15)))
+
+		res, err := qs.MarketMapUpdates(s.ctx.WithBlockHeight(15), &types.MarketMapUpdatesRequest{SinceHeight: 10})
+		s.Require().NoError(err)
+		s.Require().False(res.FullSyncRequired)
+		s.Require().Equal([]types.Market{updated}, res.Markets)
+		s.Require().Equal([]string{usdcusd.Ticker.String()}, res.RemovedTickers)
+	})
+
+	s.Run("changes outside the retention window are pruned", func() {
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(17)))
+
+		res, err := qs.MarketMapUpdates(s.ctx.WithBlockHeight(17), &types.MarketMapUpdatesRequest{SinceHeight: 11})
+		s.Require().NoError(err)
+		s.Require().True(res.FullSyncRequired)
+
+		// the tombstone of the removed market at height 12 is pruned
+		res, err = qs.MarketMapUpdates(s.ctx.WithBlockHeight(17), &types.MarketMapUpdatesRequest{SinceHeight: 12})
+		s.Require().NoError(err)
+		s.Require().False(res.FullSyncRequired)
+		s.Require().Equal([]types.Market{updated}, res.Markets)
+		s.Require().Empty(res.RemovedTickers)
+
+		markets, removed, err := s.keeper.GetMarketChangesSince(s.ctx.WithBlockHeight(17), 0)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.Market{updated}, markets)
+		s.Require().Empty(removed)
+	})
+
+	s.Run("changes are not pruned without a retention", func() {
+		keeper.WithMarketChangesRetention(0)(s.keeper)
+		s.Require().NoError(s.keeper.BeginBlocker(s.ctx.WithBlockHeight(100)))
+
+		tracked, err := s.keeper.MarketChangesTracked(s.ctx.WithBlockHeight(100), 12)
+		s.Require().NoError(err)
+		s.Require().True(tracked)
+	})
+}
@@ -28,7 +28,7 @@ import (
 
 // ConsensusVersion is the x/marketmap module's current version, as modules integrate and updates are made, this value determines what
 // version of the module is being run by the chain.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasName        = AppModule{}
@@ -108,9 +108,9 @@ type AppModule struct {
 	k *keeper.Keeper
 }
 
-// BeginBlock is a no-op for x/marketmap.
-func (am AppModule) BeginBlock(_ context.Context) error {
-	return nil
+// BeginBlock calls the x/marketmap keeper's BeginBlocker function.
+func (am AppModule) BeginBlock(goCtx context.Context) error {
+	return am.k.BeginBlocker(goCtx)
 }
 
 // EndBlock is a no-op for x/marketmap.
@@ -144,6 +144,12 @@ func (am AppModule) RegisterServices(cfc module.Configurator) {
 
 	// register Query Service
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	// register in-place state migrations
+	m := keeper.NewMigrator(am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register x/marketmap migration from version 1 to 2: %s", err))
+	}
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+const (
+	// MarketActionCreate is the action of creating a market.
+	MarketActionCreate = "create"
+
+	// MarketActionUpdateTicker is the action of updating the decimals, min provider count or
+	// metadata of the ticker of a market.
+	MarketActionUpdateTicker = "update_ticker"
+
+	// MarketActionUpdateProviderConfigs is the action of updating the provider configs of a
+	// market.
+	MarketActionUpdateProviderConfigs = "update_provider_configs"
+
+	// MarketActionSetEnabled is the action of enabling or disabling a market, or changing its
+	// lifecycle state.
+	MarketActionSetEnabled = "set_enabled"
+
+	// MarketActionRemove is the action of removing a market.
+	MarketActionRemove = "remove"
+)
+
+// MarketActions is the list of all actions that can be granted to a market authority.
+var MarketActions = []string{
+	MarketActionCreate,
+	MarketActionUpdateTicker,
+	MarketActionUpdateProviderConfigs,
+	MarketActionSetEnabled,
+	MarketActionRemove,
+}
+
+// IsValidMarketAction returns true if the given action is one of the MarketActions.
+func IsValidMarketAction(action string) bool {
+	return slices.Contains(MarketActions, action)
+}
+
+// NewFullMarketAuthorityGrant returns the grant that is equivalent to being one of the
+// MarketAuthorities, i.e. every action on every market.
+func NewFullMarketAuthorityGrant(authority string) MarketAuthorityGrant {
+	return MarketAuthorityGrant{
+		Authority: authority,
+		Actions:   slices.Clone(MarketActions),
+	}
+}
+
+// ValidateBasic performs stateless validation of the MarketAuthorityGrant.
+func (g *MarketAuthorityGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(g.Authority); err != nil {
+		return fmt.Errorf("invalid grant authority string: %w", err)
+	}
+
+	if len(g.Actions) == 0 {
+		return fmt.Errorf("grant for %s must have at least one action", g.Authority)
+	}
+
+	seenActions := make(map[string]struct{}, len(g.Actions))
+	for _, action := range g.Actions {
+		if !IsValidMarketAction(action) {
+			return fmt.Errorf("invalid action %q in grant for %s", action, g.Authority)
+		}
+
+		if _, seen := seenActions[action]; seen {
+			return fmt.Errorf("duplicate action %q in grant for %s", action, g.Authority)
+		}
+		seenActions[action] = struct{}{}
+	}
+
+	for _, tickers := range [][]string{g.Tickers, g.ExcludedTickers} {
+		for _, ticker := range tickers {
+			cp, err := slinkytypes.CurrencyPairFromString(ticker)
+			if err != nil {
+				return fmt.Errorf("invalid ticker %q in grant for %s: %w", ticker, g.Authority, err)
+			}
+
+			if cp.String() != ticker {
+				return fmt.Errorf("ticker %q in grant for %s must be formatted as %s", ticker, g.Authority, cp.String())
+			}
+		}
+	}
+
+	for _, quote := range g.QuoteAssets {
+		cp := slinkytypes.NewCurrencyPair(quote, quote)
+		if err := cp.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid quote asset %q in grant for %s: %w", quote, g.Authority, err)
+		}
+	}
+
+	return nil
+}
+
+// InScope returns true if the market with the given currency pair is in the scope of the grant.
+func (g *MarketAuthorityGrant) InScope(cp slinkytypes.CurrencyPair) bool {
+	ticker := cp.String()
+	if slices.Contains(g.ExcludedTickers, ticker) {
+		return false
+	}
+
+	if len(g.Tickers) == 0 && len(g.QuoteAssets) == 0 {
+		return true
+	}
+
+	return slices.Contains(g.Tickers, ticker) || slices.Contains(g.QuoteAssets, cp.Quote)
+}
+
+// Allows returns true if the grant allows the given action on the market with the given currency
+// pair.
+func (g *MarketAuthorityGrant) Allows(action string, cp slinkytypes.CurrencyPair) bool {
+	return slices.Contains(g.Actions, action) && g.InScope(cp)
+}
+
+// GrantsFor returns the grants of the given authority. If the authority is one of the
+// MarketAuthorities, a single grant of every action on every market is returned.
+func (p *Params) GrantsFor(authority string) []MarketAuthorityGrant {
+	if slices.Contains(p.MarketAuthorities, authority) {
+		return []MarketAuthorityGrant{NewFullMarketAuthorityGrant(authority)}
+	}
+
+	var grants []MarketAuthorityGrant
+	for _, grant := range p.MarketAuthorityGrants {
+		if grant.Authority == authority {
+			grants = append(grants, grant)
+		}
+	}
+
+	return grants
+}
+
+// IsMarketAuthority returns true if the given authority is one of the MarketAuthorities or has
+// at least one grant.
+func (p *Params) IsMarketAuthority(authority string) bool {
+	return len(p.GrantsFor(authority)) > 0
+}
+
+// IsAuthorized returns true if the given authority may perform the given action on the market with
+// the given currency pair.
+func (p *Params) IsAuthorized(authority, action string, cp slinkytypes.CurrencyPair) bool {
+	for _, grant := range p.GrantsFor(authority) {
+		if grant.Allows(action, cp) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequiredMarketActions returns the actions that are required to update the existing market to the
+// updated market. An update that does not change the market requires MarketActionUpdateTicker.
+func RequiredMarketActions(existing, updated Market) []string {
+	var actions []string
+
+	ticker := existing.Ticker
+	ticker.Enabled = updated.Ticker.Enabled
+	ticker.State = updated.Ticker.State
+	if !ticker.Equal(updated.Ticker) {
+		actions = append(actions, MarketActionUpdateTicker)
+	}
+
+	if existing.Ticker.Enabled != updated.Ticker.Enabled || existing.Ticker.State != updated.Ticker.State {
+		actions = append(actions, MarketActionSetEnabled)
+	}
+
+	providerConfigs := existing
+	providerConfigs.Ticker = updated.Ticker
+	if !providerConfigs.Equal(updated) {
+		actions = append(actions, MarketActionUpdateProviderConfigs)
+	}
+
+	if len(actions) == 0 {
+		actions = append(actions, MarketActionUpdateTicker)
+	}
+
+	return actions
+}
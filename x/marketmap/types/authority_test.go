@@ -0,0 +1,316 @@
+package types_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+var (
+	fullAuthority   = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	scopedAuthority = authtypes.NewModuleAddress(authtypes.ModuleName).String()
+
+	btcusdPair   = slinkytypes.NewCurrencyPair("BTC", "USD")
+	btcusdtPair  = slinkytypes.NewCurrencyPair("BTC", "USDT")
+	pepeusdPair  = slinkytypes.NewCurrencyPair("PEPE", "USD")
+	pepeusdtPair = slinkytypes.NewCurrencyPair("PEPE", "USDT")
+)
+
+func TestMarketAuthorityGrantValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		grant  types.MarketAuthorityGrant
+		expErr bool
+	}{
+		{
+			name:  "valid full grant",
+			grant: types.NewFullMarketAuthorityGrant(scopedAuthority),
+		},
+		{
+			name: "valid scoped grant",
+			grant: types.MarketAuthorityGrant{
+				Authority:       scopedAuthority,
+				Actions:         []string{types.MarketActionCreate, types.MarketActionSetEnabled},
+				Tickers:         []string{"PEPE/USD"},
+				QuoteAssets:     []string{"USDT"},
+				ExcludedTickers: []string{"BTC/USDT"},
+			},
+		},
+		{
+			name: "invalid authority",
+			grant: types.MarketAuthorityGrant{
+				Authority: "invalid",
+				Actions:   []string{types.MarketActionCreate},
+			},
+			expErr: true,
+		},
+		{
+			name: "no actions",
+			grant: types.MarketAuthorityGrant{
+				Authority: scopedAuthority,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid action",
+			grant: types.MarketAuthorityGrant{
+				Authority: scopedAuthority,
+				Actions:   []string{"delete"},
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate action",
+			grant: types.MarketAuthorityGrant{
+				Authority: scopedAuthority,
+				Actions:   []string{types.MarketActionCreate, types.MarketActionCreate},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid ticker",
+			grant: types.MarketAuthorityGrant{
+				Authority: scopedAuthority,
+				Actions:   []string{types.MarketActionCreate},
+				Tickers:   []string{"PEPE"},
+			},
+			expErr: true,
+		},
+		{
+			name: "lower case ticker",
+			grant: types.MarketAuthorityGrant{
+				Authority: scopedAuthority,
+				Actions:   []string{types.MarketActionCreate},
+				Tickers:   []string{"pepe/usd"},
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid excluded ticker",
+			grant: types.MarketAuthorityGrant{
+				Authority:       scopedAuthority,
+				Actions:         []string{types.MarketActionCreate},
+				ExcludedTickers: []string{"BTC-USD"},
+			},
+			expErr: true,
+		},
+		{
+			name: "empty quote asset",
+			grant: types.MarketAuthorityGrant{
+				Authority:   scopedAuthority,
+				Actions:     []string{types.MarketActionCreate},
+				QuoteAssets: []string{""},
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.grant.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsIsAuthorized(t *testing.T) {
+	testCases := []struct {
+		name       string
+		grants     []types.MarketAuthorityGrant
+		authority  string
+		action     string
+		cp         slinkytypes.CurrencyPair
+		authorized bool
+	}{
+		{
+			name:       "market authority can perform any action",
+			authority:  fullAuthority,
+			action:     types.MarketActionRemove,
+			cp:         btcusdPair,
+			authorized: true,
+		},
+		{
+			name:      "address without grants cannot perform any action",
+			authority: scopedAuthority,
+			action:    types.MarketActionCreate,
+			cp:        pepeusdPair,
+		},
+		{
+			name: "unscoped grant applies to every market",
+			grants: []types.MarketAuthorityGrant{
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionSetEnabled}},
+			},
+			authority:  scopedAuthority,
+			action:     types.MarketActionSetEnabled,
+			cp:         btcusdPair,
+			authorized: true,
+		},
+		{
+			name: "grant does not permit other actions",
+			grants: []types.MarketAuthorityGrant{
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionSetEnabled}},
+			},
+			authority: scopedAuthority,
+			action:    types.MarketActionRemove,
+			cp:        btcusdPair,
+		},
+		{
+			name: "ticker in scope",
+			grants: []types.MarketAuthorityGrant{
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionCreate}, Tickers: []string{"PEPE/USD"}},
+			},
+			authority:  scopedAuthority,
+			action:     types.MarketActionCreate,
+			cp:         pepeusdPair,
+			authorized: true,
+		},
+		{
+			name: "ticker out of scope",
+			grants: []types.MarketAuthorityGrant{
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionCreate}, Tickers: []string{"PEPE/USD"}},
+			},
+			authority: scopedAuthority,
+			action:    types.MarketActionCreate,
+			cp:        btcusdPair,
+		},
+		{
+			name: "quote asset in scope",
+			grants: []types.MarketAuthorityGrant{
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionCreate}, QuoteAssets: []string{"USDT"}},
+			},
+			authority:  scopedAuthority,
+			action:     types.MarketActionCreate,
+			cp:         pepeusdtPair,
+			authorized: true,
+		},
+		{
+			name: "excluded ticker is out of scope",
+			grants: []types.MarketAuthorityGrant{
+				{
+					Authority:       scopedAuthority,
+					Actions:         []string{types.MarketActionCreate},
+					QuoteAssets:     []string{"USDT"},
+					ExcludedTickers: []string{"BTC/USDT"},
+				},
+			},
+			authority: scopedAuthority,
+			action:    types.MarketActionCreate,
+			cp:        btcusdtPair,
+		},
+		{
+			name: "grants of the same authority are combined",
+			grants: []types.MarketAuthorityGrant{
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionCreate}, Tickers: []string{"PEPE/USD"}},
+				{Authority: scopedAuthority, Actions: []string{types.MarketActionRemove}, QuoteAssets: []string{"USDT"}},
+			},
+			authority:  scopedAuthority,
+			action:     types.MarketActionRemove,
+			cp:         pepeusdtPair,
+			authorized: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.Params{
+				MarketAuthorities:     []string{fullAuthority},
+				Admin:                 fullAuthority,
+				MarketAuthorityGrants: tc.grants,
+			}
+			require.NoError(t, params.ValidateBasic())
+
+			require.Equal(t, tc.authorized, params.IsAuthorized(tc.authority, tc.action, tc.cp))
+			require.Equal(t, tc.authority == fullAuthority || len(tc.grants) > 0, params.IsMarketAuthority(tc.authority))
+		})
+	}
+}
+
+func TestRequiredMarketActions(t *testing.T) {
+	existing := types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair:     pepeusdPair,
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "pepe-usd",
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(m *types.Market)
+		expected []string
+	}{
+		{
+			name:     "no changes",
+			modify:   func(*types.Market) {},
+			expected: []string{types.MarketActionUpdateTicker},
+		},
+		{
+			name: "ticker changes",
+			modify: func(m *types.Market) {
+				m.Ticker.MinProviderCount = 2
+			},
+			expected: []string{types.MarketActionUpdateTicker},
+		},
+		{
+			name: "enabled changes",
+			modify: func(m *types.Market) {
+				m.Ticker.Enabled = true
+			},
+			expected: []string{types.MarketActionSetEnabled},
+		},
+		{
+			name: "state changes",
+			modify: func(m *types.Market) {
+				m.Ticker.State = types.MarketStateProposed
+			},
+			expected: []string{types.MarketActionSetEnabled},
+		},
+		{
+			name: "provider config changes",
+			modify: func(m *types.Market) {
+				m.ProviderConfigs = []types.ProviderConfig{
+					{
+						Name:           "okx",
+						OffChainTicker: "PEPE-USD",
+					},
+				}
+			},
+			expected: []string{types.MarketActionUpdateProviderConfigs},
+		},
+		{
+			name: "all changes",
+			modify: func(m *types.Market) {
+				m.Ticker.Decimals = 18
+				m.Ticker.Enabled = true
+				m.ProviderConfigs = nil
+			},
+			expected: []string{types.MarketActionUpdateTicker, types.MarketActionSetEnabled, types.MarketActionUpdateProviderConfigs},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			updated := existing
+			updated.ProviderConfigs = append([]types.ProviderConfig(nil), existing.ProviderConfigs...)
+			tc.modify(&updated)
+
+			require.Equal(t, tc.expected, types.RequiredMarketActions(existing, updated))
+		})
+	}
+}
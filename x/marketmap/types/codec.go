@@ -17,6 +17,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "slinky/x/marketmap/MsgParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpsertMarkets{}, "slinky/x/marketmap/MsgUpsertMarkets")
 	legacy.RegisterAminoMsg(cdc, &MsgRemoveMarkets{}, "slinky/x/marketmap/MsgRemoveMarkets")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleMarketUpdates{}, "slinky/x/marketmap/MsgScheduleMarketUpdates")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledMarketUpdates{}, "slinky/x/marketmap/MsgCancelScheduledMarketUpdates")
 }
 
 // RegisterInterfaces registers the x/marketmap messages + message service w/ the InterfaceRegistry (registry).
@@ -29,6 +31,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUpsertMarkets{},
 		&MsgRemoveMarkets{},
 		&MsgRemoveMarketAuthorities{},
+		&MsgScheduleMarketUpdates{},
+		&MsgCancelScheduledMarketUpdates{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
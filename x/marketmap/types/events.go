@@ -6,8 +6,18 @@ const (
 	EventTypeCreateMarket = "create_market"
 	EventTypeUpdateMarket = "update_market"
 
-	AttributeKeyCurrencyPair     = "currency_pair"
-	AttributeKeyDecimals         = "decimals"
-	AttributeKeyMinProviderCount = "min_provider_count"
-	AttributeKeyMetadata         = "metadata"
+	EventTypeScheduleMarketUpdates         = "schedule_market_updates"
+	EventTypeCancelScheduledMarketUpdates  = "cancel_scheduled_market_updates"
+	EventTypeScheduledMarketUpdatesApplied = "scheduled_market_updates_applied"
+	EventTypeScheduledMarketUpdatesFailed  = "scheduled_market_updates_failed"
+
+	AttributeKeyCurrencyPair      = "currency_pair"
+	AttributeKeyDecimals          = "decimals"
+	AttributeKeyMinProviderCount  = "min_provider_count"
+	AttributeKeyMetadata          = "metadata"
+	AttributeKeyState             = "state"
+	AttributeKeyScheduledUpdateID = "scheduled_update_id"
+	AttributeKeyHeight            = "height"
+	AttributeKeyAuthority         = "authority"
+	AttributeKeyError             = "error"
 )
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState returns an instance of GenesisState.
 func NewGenesisState(
 	marketMap MarketMap,
@@ -19,6 +21,40 @@ func (gs *GenesisState) ValidateBasic() error {
 		return err
 	}
 
+	seenIDs := make(map[uint64]struct{}, len(gs.ScheduledMarketUpdates))
+	for _, update := range gs.ScheduledMarketUpdates {
+		if _, seen := seenIDs[update.Id]; seen {
+			return fmt.Errorf("duplicate scheduled market update id %d", update.Id)
+		}
+
+		if update.Id >= gs.NextScheduledMarketUpdateId {
+			return fmt.Errorf("scheduled market update id %d must be less than the next id %d", update.Id, gs.NextScheduledMarketUpdateId)
+		}
+
+		if err := update.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scheduled market update %d: %w", update.Id, err)
+		}
+
+		seenIDs[update.Id] = struct{}{}
+	}
+
+	seenRevisionIDs := make(map[uint64]struct{}, len(gs.MarketRevisions))
+	for _, revision := range gs.MarketRevisions {
+		if _, seen := seenRevisionIDs[revision.Id]; seen {
+			return fmt.Errorf("duplicate market revision id %d", revision.Id)
+		}
+
+		if revision.Id >= gs.NextMarketRevisionId {
+			return fmt.Errorf("market revision id %d must be less than the next id %d", revision.Id, gs.NextMarketRevisionId)
+		}
+
+		if err := revision.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenRevisionIDs[revision.Id] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
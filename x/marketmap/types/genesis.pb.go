@@ -34,6 +34,16 @@ type GenesisState struct {
 	LastUpdated uint64 `protobuf:"varint,2,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	// Params are the parameters for the x/marketmap module.
 	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// ScheduledMarketUpdates is the queue of market updates that are pending
+	// activation at a future block height.
+	ScheduledMarketUpdates []ScheduledMarketUpdate `protobuf:"bytes,4,rep,name=scheduled_market_updates,json=scheduledMarketUpdates,proto3" json:"scheduled_market_updates"`
+	// NextScheduledMarketUpdateId is the identifier of the next scheduled market
+	// update.
+	NextScheduledMarketUpdateId uint64 `protobuf:"varint,5,opt,name=next_scheduled_market_update_id,json=nextScheduledMarketUpdateId,proto3" json:"next_scheduled_market_update_id,omitempty"`
+	// MarketRevisions is the history of changes made to the markets.
+	MarketRevisions []MarketRevision `protobuf:"bytes,6,rep,name=market_revisions,json=marketRevisions,proto3" json:"market_revisions"`
+	// NextMarketRevisionId is the identifier of the next market revision.
+	NextMarketRevisionId uint64 `protobuf:"varint,7,opt,name=next_market_revision_id,json=nextMarketRevisionId,proto3" json:"next_market_revision_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +100,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetScheduledMarketUpdates() []ScheduledMarketUpdate {
+	if m != nil {
+		return m.ScheduledMarketUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledMarketUpdateId() uint64 {
+	if m != nil {
+		return m.NextScheduledMarketUpdateId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMarketRevisions() []MarketRevision {
+	if m != nil {
+		return m.MarketRevisions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextMarketRevisionId() uint64 {
+	if m != nil {
+		return m.NextMarketRevisionId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "slinky.marketmap.v1.GenesisState")
 }
@@ -97,24 +135,31 @@ func init() {
 func init() { proto.RegisterFile("slinky/marketmap/v1/genesis.proto", fileDescriptor_a621f29fb8bf99f4) }
 
 var fileDescriptor_a621f29fb8bf99f4 = []byte{
-	// 266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x0a, 0xd8, 0x4c, 0x83, 0x70, 0xf0, 0xa9, 0x28, 0x48, 0x2c, 0x4a,
-	0xcc, 0x85, 0x5a, 0xa7, 0xb4, 0x91, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0x80, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0x21, 0x67, 0x2e, 0x2e, 0x88, 0xea, 0xf8, 0xdc, 0xc4, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x6e, 0x23, 0x39, 0x3d, 0x2c, 0x8e, 0xd2, 0xf3, 0x05, 0x73, 0x7c, 0x13, 0x0b, 0x9c, 0x58, 0x4e,
-	0xdc, 0x93, 0x67, 0x08, 0xe2, 0xcc, 0x85, 0x09, 0x08, 0x29, 0x72, 0xf1, 0xe4, 0x24, 0x16, 0x97,
-	0xc4, 0x97, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0xa6, 0x48, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x04, 0x71,
-	0x83, 0xc4, 0x42, 0x21, 0x42, 0x42, 0x96, 0x5c, 0x6c, 0x10, 0x87, 0x48, 0x30, 0x83, 0xed, 0x90,
-	0xc6, 0x6a, 0x47, 0x00, 0x58, 0x09, 0xd4, 0x02, 0xa8, 0x06, 0x27, 0xf7, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce,
-	0xcf, 0xd5, 0x37, 0x34, 0x34, 0xb4, 0xd4, 0xf5, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0x06, 0x42, 0x05,
-	0x52, 0x30, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xc0, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xda, 0x60, 0x84, 0x97, 0x01, 0x00, 0x00,
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0x5b, 0x41, 0x8c, 0x0b, 0x89, 0x66, 0x25, 0xda, 0x40, 0x52, 0x8a, 0x5e, 0x88, 0x09,
+	0x6d, 0x8a, 0xf1, 0xc0, 0x15, 0x4d, 0x08, 0x89, 0x24, 0x06, 0xf4, 0xe2, 0xa5, 0x59, 0xe8, 0xa6,
+	0x54, 0x68, 0xbb, 0xe9, 0x2e, 0x04, 0xde, 0xc2, 0xc7, 0xe2, 0xc8, 0xd1, 0x93, 0x31, 0xf0, 0x02,
+	0x3e, 0x82, 0xe9, 0xee, 0x62, 0xc4, 0x54, 0x6e, 0x9d, 0x99, 0x7f, 0xfe, 0xff, 0xeb, 0x66, 0x40,
+	0x95, 0x4e, 0xfc, 0x70, 0xbc, 0xb0, 0x02, 0x14, 0x8f, 0x31, 0x0b, 0x10, 0xb1, 0x66, 0xb6, 0xe5,
+	0xe1, 0x10, 0x53, 0x9f, 0x9a, 0x24, 0x8e, 0x58, 0x04, 0xcf, 0x84, 0xc4, 0xfc, 0x91, 0x98, 0x33,
+	0xbb, 0x54, 0xf4, 0x22, 0x2f, 0xe2, 0x73, 0x2b, 0xf9, 0x12, 0xd2, 0x92, 0x91, 0xe6, 0x26, 0x8a,
+	0x7d, 0x0a, 0x82, 0x62, 0x14, 0xc8, 0xb8, 0xcb, 0xaf, 0x0c, 0x28, 0xb4, 0x05, 0x40, 0x9f, 0x21,
+	0x86, 0xe1, 0x1d, 0x00, 0x42, 0xed, 0x04, 0x88, 0x68, 0xaa, 0xa1, 0xd6, 0xf2, 0x0d, 0xdd, 0x4c,
+	0x81, 0x32, 0xbb, 0xbc, 0xe8, 0x22, 0xd2, 0xca, 0x2e, 0x3f, 0x2a, 0x4a, 0xef, 0x38, 0xd8, 0x36,
+	0x60, 0x15, 0x14, 0x26, 0x88, 0x32, 0x67, 0x4a, 0x5c, 0xc4, 0xb0, 0xab, 0x1d, 0x18, 0x6a, 0x2d,
+	0xdb, 0xcb, 0x27, 0xbd, 0x67, 0xd1, 0x82, 0x4d, 0x90, 0x13, 0x20, 0x5a, 0x86, 0x67, 0x94, 0x53,
+	0x33, 0x1e, 0xb9, 0x44, 0x06, 0xc8, 0x05, 0xf8, 0x0a, 0x34, 0x3a, 0x1c, 0x61, 0x77, 0x3a, 0xc1,
+	0xae, 0x23, 0x61, 0x45, 0x12, 0xd5, 0xb2, 0x46, 0xa6, 0x96, 0x6f, 0x5c, 0xa7, 0x9a, 0xf5, 0xb7,
+	0x4b, 0x82, 0x5c, 0x90, 0x48, 0xef, 0x73, 0x9a, 0x36, 0xa4, 0xf0, 0x1e, 0x54, 0x42, 0x3c, 0x67,
+	0xce, 0x3f, 0x81, 0x8e, 0xef, 0x6a, 0x87, 0xfc, 0xe7, 0xca, 0x89, 0x2c, 0x35, 0xa1, 0xe3, 0xc2,
+	0x27, 0x70, 0x2a, 0xd7, 0x62, 0x3c, 0xf3, 0xa9, 0x1f, 0x85, 0x54, 0xcb, 0x71, 0xd2, 0xab, 0x3d,
+	0x4f, 0xdb, 0x93, 0x5a, 0x89, 0x78, 0x12, 0xec, 0x74, 0x29, 0xbc, 0x05, 0x17, 0x9c, 0xed, 0x8f,
+	0x75, 0xc2, 0x74, 0xc4, 0x99, 0x8a, 0xc9, 0x78, 0xd7, 0xab, 0xe3, 0xb6, 0xda, 0xcb, 0xb5, 0xae,
+	0xae, 0xd6, 0xba, 0xfa, 0xb9, 0xd6, 0xd5, 0xb7, 0x8d, 0xae, 0xac, 0x36, 0xba, 0xf2, 0xbe, 0xd1,
+	0x95, 0x97, 0xba, 0xe7, 0xb3, 0xd1, 0x74, 0x60, 0x0e, 0xa3, 0xc0, 0xb2, 0x6d, 0xbb, 0x59, 0x7f,
+	0x40, 0x03, 0x6a, 0xc9, 0x1b, 0x9a, 0xff, 0xba, 0x22, 0xb6, 0x20, 0x98, 0x0e, 0x72, 0xfc, 0x84,
+	0x6e, 0xbe, 0x07, 0x00, 0x7c, 0x1e, 0x5a, 0x51, 0xd6, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +182,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextMarketRevisionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextMarketRevisionId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MarketRevisions) > 0 {
+		for iNdEx := len(m.MarketRevisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketRevisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextScheduledMarketUpdateId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledMarketUpdateId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduledMarketUpdates) > 0 {
+		for iNdEx := len(m.ScheduledMarketUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledMarketUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -189,6 +272,24 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ScheduledMarketUpdates) > 0 {
+		for _, e := range m.ScheduledMarketUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledMarketUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledMarketUpdateId))
+	}
+	if len(m.MarketRevisions) > 0 {
+		for _, e := range m.MarketRevisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextMarketRevisionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextMarketRevisionId))
+	}
 	return n
 }
 
@@ -312,6 +413,112 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledMarketUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledMarketUpdates = append(m.ScheduledMarketUpdates, ScheduledMarketUpdate{})
+			if err := m.ScheduledMarketUpdates[len(m.ScheduledMarketUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledMarketUpdateId", wireType)
+			}
+			m.NextScheduledMarketUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledMarketUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketRevisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketRevisions = append(m.MarketRevisions, MarketRevision{})
+			if err := m.MarketRevisions[len(m.MarketRevisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextMarketRevisionId", wireType)
+			}
+			m.NextMarketRevisionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextMarketRevisionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,4 +30,70 @@ func TestGenesisState(t *testing.T) {
 		}
 		require.NoError(t, gs.ValidateBasic())
 	})
+	t.Run("good genesis state with scheduled market updates", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketUpdates = []types.ScheduledMarketUpdate{
+			{Id: 0, Authority: gs.Params.Admin, Height: 10, Markets: []types.Market{btcusdt}},
+			{Id: 2, Authority: gs.Params.Admin, Height: 5, Markets: []types.Market{usdtusd}},
+		}
+		gs.NextScheduledMarketUpdateId = 3
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate scheduled market update id - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketUpdates = []types.ScheduledMarketUpdate{
+			{Id: 1, Authority: gs.Params.Admin, Height: 10, Markets: []types.Market{btcusdt}},
+			{Id: 1, Authority: gs.Params.Admin, Height: 5, Markets: []types.Market{usdtusd}},
+		}
+		gs.NextScheduledMarketUpdateId = 3
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("good genesis state with market revisions", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketRevisions = []types.MarketRevision{
+			{Id: 0, Ticker: btcusdt.Ticker.String(), Height: 1, Signer: gs.Params.Admin, Action: types.MarketRevisionActionCreate, Market: &btcusdt},
+			{Id: 1, Ticker: btcusdt.Ticker.String(), Height: 2, Signer: gs.Params.Admin, Action: types.MarketRevisionActionRemove, Previous: &btcusdt},
+		}
+		gs.NextMarketRevisionId = 2
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("duplicate market revision id - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketRevisions = []types.MarketRevision{
+			{Id: 1, Ticker: btcusdt.Ticker.String(), Height: 1, Signer: gs.Params.Admin, Action: types.MarketRevisionActionCreate, Market: &btcusdt},
+			{Id: 1, Ticker: btcusdt.Ticker.String(), Height: 2, Signer: gs.Params.Admin, Action: types.MarketRevisionActionRemove, Previous: &btcusdt},
+		}
+		gs.NextMarketRevisionId = 2
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("market revision id not below the next id - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MarketRevisions = []types.MarketRevision{
+			{Id: 2, Ticker: btcusdt.Ticker.String(), Height: 1, Signer: gs.Params.Admin, Action: types.MarketRevisionActionCreate, Market: &btcusdt},
+		}
+		gs.NextMarketRevisionId = 2
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("scheduled market update id not below the next id - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketUpdates = []types.ScheduledMarketUpdate{
+			{Id: 3, Authority: gs.Params.Admin, Height: 10, Markets: []types.Market{btcusdt}},
+		}
+		gs.NextScheduledMarketUpdateId = 3
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("invalid scheduled market update - fail", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.ScheduledMarketUpdates = []types.ScheduledMarketUpdate{
+			{Id: 0, Authority: gs.Params.Admin, Height: 10},
+		}
+		gs.NextScheduledMarketUpdateId = 1
+		require.Error(t, gs.ValidateBasic())
+	})
 }
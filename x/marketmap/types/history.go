@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+const (
+	// MarketRevisionActionCreate is the action of a revision that created a market.
+	MarketRevisionActionCreate = "create"
+
+	// MarketRevisionActionUpdate is the action of a revision that updated a market.
+	MarketRevisionActionUpdate = "update"
+
+	// MarketRevisionActionRemove is the action of a revision that removed a market.
+	MarketRevisionActionRemove = "remove"
+)
+
+// MarketRevisionActions is the list of all actions that a market revision can record.
+var MarketRevisionActions = []string{
+	MarketRevisionActionCreate,
+	MarketRevisionActionUpdate,
+	MarketRevisionActionRemove,
+}
+
+// ValidateBasic performs stateless validation of the MarketRevision.
+func (r *MarketRevision) ValidateBasic() error {
+	cp, err := slinkytypes.CurrencyPairFromString(r.Ticker)
+	if err != nil {
+		return fmt.Errorf("invalid ticker %q in market revision %d: %w", r.Ticker, r.Id, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Signer); err != nil {
+		return fmt.Errorf("invalid signer string in market revision %d: %w", r.Id, err)
+	}
+
+	if !slices.Contains(MarketRevisionActions, r.Action) {
+		return fmt.Errorf("invalid action %q in market revision %d", r.Action, r.Id)
+	}
+
+	if (r.Previous == nil) != (r.Action == MarketRevisionActionCreate) {
+		return fmt.Errorf("market revision %d must have a previous market iff it does not create the market", r.Id)
+	}
+
+	if (r.Market == nil) != (r.Action == MarketRevisionActionRemove) {
+		return fmt.Errorf("market revision %d must have a market iff it does not remove the market", r.Id)
+	}
+
+	for _, market := range []*Market{r.Previous, r.Market} {
+		if market != nil && market.Ticker.String() != cp.String() {
+			return fmt.Errorf("market %s does not match the ticker %s of market revision %d", market.Ticker.String(), cp.String(), r.Id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func TestMarketRevisionValidateBasic(t *testing.T) {
+	updated := btcusdt
+	updated.Ticker.MinProviderCount = 2
+
+	testCases := []struct {
+		name     string
+		revision types.MarketRevision
+		expErr   bool
+	}{
+		{
+			name: "valid create",
+			revision: types.MarketRevision{
+				Ticker: btcusdt.Ticker.String(),
+				Signer: fullAuthority,
+				Action: types.MarketRevisionActionCreate,
+				Market: &btcusdt,
+			},
+		},
+		{
+			name: "valid update",
+			revision: types.MarketRevision{
+				Ticker:   btcusdt.Ticker.String(),
+				Signer:   fullAuthority,
+				Action:   types.MarketRevisionActionUpdate,
+				Previous: &btcusdt,
+				Market:   &updated,
+			},
+		},
+		{
+			name: "valid remove",
+			revision: types.MarketRevision{
+				Ticker:   btcusdt.Ticker.String(),
+				Signer:   fullAuthority,
+				Action:   types.MarketRevisionActionRemove,
+				Previous: &btcusdt,
+			},
+		},
+		{
+			name: "invalid ticker",
+			revision: types.MarketRevision{
+				Ticker: "BTC",
+				Signer: fullAuthority,
+				Action: types.MarketRevisionActionCreate,
+				Market: &btcusdt,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid signer",
+			revision: types.MarketRevision{
+				Ticker: btcusdt.Ticker.String(),
+				Signer: "invalid",
+				Action: types.MarketRevisionActionCreate,
+				Market: &btcusdt,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid action",
+			revision: types.MarketRevision{
+				Ticker: btcusdt.Ticker.String(),
+				Signer: fullAuthority,
+				Action: "enable",
+				Market: &btcusdt,
+			},
+			expErr: true,
+		},
+		{
+			name: "create with a previous market",
+			revision: types.MarketRevision{
+				Ticker:   btcusdt.Ticker.String(),
+				Signer:   fullAuthority,
+				Action:   types.MarketRevisionActionCreate,
+				Previous: &btcusdt,
+				Market:   &btcusdt,
+			},
+			expErr: true,
+		},
+		{
+			name: "remove with a market",
+			revision: types.MarketRevision{
+				Ticker:   btcusdt.Ticker.String(),
+				Signer:   fullAuthority,
+				Action:   types.MarketRevisionActionRemove,
+				Previous: &btcusdt,
+				Market:   &btcusdt,
+			},
+			expErr: true,
+		},
+		{
+			name: "market does not match the ticker",
+			revision: types.MarketRevision{
+				Ticker: "ETH/USDT",
+				Signer: fullAuthority,
+				Action: types.MarketRevisionActionCreate,
+				Market: &btcusdt,
+			},
+			expErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.revision.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
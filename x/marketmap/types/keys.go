@@ -22,11 +22,50 @@ var (
 	// ParamsPrefix is the key prefix of the module Params.
 	ParamsPrefix = collections.NewPrefix(3)
 
+	// ScheduledMarketUpdatesPrefix is the key prefix for the queue of ScheduledMarketUpdates.
+	ScheduledMarketUpdatesPrefix = collections.NewPrefix(4)
+
+	// NextScheduledMarketUpdateIDPrefix is the key prefix for the ID of the next ScheduledMarketUpdate.
+	NextScheduledMarketUpdateIDPrefix = collections.NewPrefix(5)
+
+	// MarketRevisionsPrefix is the key prefix for the history of MarketRevisions.
+	MarketRevisionsPrefix = collections.NewPrefix(6)
+
+	// MarketRevisionsByHeightPrefix is the key prefix for the index of MarketRevisions by block height.
+	MarketRevisionsByHeightPrefix = collections.NewPrefix(7)
+
+	// MarketRevisionCountsPrefix is the key prefix for the number of MarketRevisions retained per market.
+	MarketRevisionCountsPrefix = collections.NewPrefix(8)
+
+	// NextMarketRevisionIDPrefix is the key prefix for the ID of the next MarketRevision.
+	NextMarketRevisionIDPrefix = collections.NewPrefix(9)
+
+	// MarketChangeHeightsPrefix is the key prefix for the block height at which each market was last created,
+	// updated or removed.
+	MarketChangeHeightsPrefix = collections.NewPrefix(10)
+
+	// MarketChangeHeightsByHeightPrefix is the key prefix for the index of market change heights by block height.
+	MarketChangeHeightsByHeightPrefix = collections.NewPrefix(11)
+
+	// MarketChangesTrackedSincePrefix is the key prefix for the block height from which market changes are tracked.
+	MarketChangesTrackedSincePrefix = collections.NewPrefix(12)
+
+	// ScheduledMarketUpdatesByIDPrefix is the key prefix for the index of ScheduledMarketUpdates by ID.
+	ScheduledMarketUpdatesByIDPrefix = collections.NewPrefix(13)
+
 	// TickersCodec is the collections.KeyCodec value used for the markets map.
 	TickersCodec = codec.NewStringKeyCodec[TickerString]()
 
 	// LastUpdatedCodec is the collections.KeyCodec value used for the lastUpdated value.
 	LastUpdatedCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
+
+	// ScheduledMarketUpdatesCodec is the collections.KeyCodec value used for the scheduled market updates
+	// queue. Updates are keyed by (height, id) so that they are iterated in order of activation.
+	ScheduledMarketUpdatesCodec = collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)
+
+	// MarketRevisionsCodec is the collections.KeyCodec value used for the market revisions. Revisions are
+	// keyed by (ticker, id) so that the revisions of a market are iterated in the order they were made.
+	MarketRevisionsCodec = collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
 )
 
 // TickerString is the key used to identify unique pairs of Base/Quote with corresponding PathsConfig objects--or in other words AggregationConfigs.
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	// MarketStateProposed is the state of a market that has been listed, but whose price is not yet
+	// fetched by the oracle.
+	MarketStateProposed = "proposed"
+
+	// MarketStateShadow is the state of a market whose price is fetched by the oracle and stored, but
+	// is flagged so that it is not yet used by consumers.
+	MarketStateShadow = "shadow"
+
+	// MarketStateActive is the state of a market whose price is fetched by the oracle and exposed to
+	// consumers.
+	MarketStateActive = "active"
+
+	// MarketStateReduceOnly is the state of a deprecated market whose price is still fetched by the
+	// oracle and exposed to consumers, so that positions in it can be wound down.
+	MarketStateReduceOnly = "reduce_only"
+
+	// MarketStateDelisted is the state of a market whose price is no longer fetched by the oracle. Only
+	// proposed and delisted markets can be removed.
+	MarketStateDelisted = "delisted"
+)
+
+// MarketStates is the list of all lifecycle states of a market.
+var MarketStates = []string{
+	MarketStateProposed,
+	MarketStateShadow,
+	MarketStateActive,
+	MarketStateReduceOnly,
+	MarketStateDelisted,
+}
+
+// marketStateTransitions maps each lifecycle state to the states that a market can transition to
+// from it.
+var marketStateTransitions = map[string][]string{
+	MarketStateProposed:   {MarketStateShadow, MarketStateActive, MarketStateDelisted},
+	MarketStateShadow:     {MarketStateProposed, MarketStateActive, MarketStateDelisted},
+	MarketStateActive:     {MarketStateReduceOnly},
+	MarketStateReduceOnly: {MarketStateActive, MarketStateDelisted},
+	MarketStateDelisted:   {MarketStateProposed},
+}
+
+// IsValidMarketState returns true if the given state is one of the MarketStates.
+func IsValidMarketState(state string) bool {
+	return slices.Contains(MarketStates, state)
+}
+
+// IsEnabledMarketState returns true if the price of a market in the given state is fetched by the
+// oracle, i.e. the market must be enabled.
+func IsEnabledMarketState(state string) bool {
+	switch state {
+	case MarketStateShadow, MarketStateActive, MarketStateReduceOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+// LifecycleState returns the lifecycle state of the Ticker. If the state is not set, a market that
+// is enabled is active, and a market that is disabled is proposed.
+func (t *Ticker) LifecycleState() string {
+	switch {
+	case t.State != "":
+		return t.State
+	case t.Enabled:
+		return MarketStateActive
+	default:
+		return MarketStateProposed
+	}
+}
+
+// SetLifecycleState sets the lifecycle state of the Ticker, and enables or disables it accordingly.
+func (t *Ticker) SetLifecycleState(state string) {
+	t.State = state
+	t.Enabled = IsEnabledMarketState(state)
+}
+
+// validateLifecycleState checks that the state of the Ticker, if set, is valid and consistent with
+// Enabled.
+func (t *Ticker) validateLifecycleState() error {
+	if t.State == "" {
+		return nil
+	}
+
+	if !IsValidMarketState(t.State) {
+		return fmt.Errorf("invalid state %q for %s", t.State, t.CurrencyPair.String())
+	}
+
+	if IsEnabledMarketState(t.State) != t.Enabled {
+		return fmt.Errorf("market %s in state %s cannot have enabled set to %t", t.CurrencyPair.String(), t.State, t.Enabled)
+	}
+
+	return nil
+}
+
+// ValidateMarketStateTransition checks that the existing ticker can be updated to the updated ticker
+// as far as its lifecycle state is concerned. Updates that do not set a state are only valid for
+// tickers whose state has never been set, in which case the state is derived from Enabled.
+func ValidateMarketStateTransition(existing, updated Ticker) error {
+	if updated.State == "" {
+		if existing.State != "" {
+			return fmt.Errorf("cannot unset the state of market %s", existing.String())
+		}
+
+		return nil
+	}
+
+	from := existing.LifecycleState()
+	if from == updated.State || slices.Contains(marketStateTransitions[from], updated.State) {
+		return nil
+	}
+
+	return fmt.Errorf("market %s cannot transition from state %s to %s", existing.String(), from, updated.State)
+}
@@ -0,0 +1,185 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types"
+)
+
+func TestTickerLifecycleState(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    string
+		enabled  bool
+		expected string
+		expErr   bool
+	}{
+		{
+			name:     "unset state of an enabled ticker is active",
+			enabled:  true,
+			expected: types.MarketStateActive,
+		},
+		{
+			name:     "unset state of a disabled ticker is proposed",
+			expected: types.MarketStateProposed,
+		},
+		{
+			name:     "enabled shadow ticker",
+			state:    types.MarketStateShadow,
+			enabled:  true,
+			expected: types.MarketStateShadow,
+		},
+		{
+			name:     "disabled delisted ticker",
+			state:    types.MarketStateDelisted,
+			expected: types.MarketStateDelisted,
+		},
+		{
+			name:     "disabled shadow ticker",
+			state:    types.MarketStateShadow,
+			expected: types.MarketStateShadow,
+			expErr:   true,
+		},
+		{
+			name:     "enabled proposed ticker",
+			state:    types.MarketStateProposed,
+			enabled:  true,
+			expected: types.MarketStateProposed,
+			expErr:   true,
+		},
+		{
+			name:     "invalid state",
+			state:    "listed",
+			enabled:  true,
+			expected: "listed",
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ticker := types.NewTicker("PEPE", "USD", 8, 1, tc.enabled)
+			ticker.State = tc.state
+
+			require.Equal(t, tc.expected, ticker.LifecycleState())
+
+			err := ticker.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetLifecycleState(t *testing.T) {
+	ticker := types.NewTicker("PEPE", "USD", 8, 1, false)
+	for _, state := range types.MarketStates {
+		ticker.SetLifecycleState(state)
+		require.Equal(t, state, ticker.LifecycleState())
+		require.Equal(t, types.IsEnabledMarketState(state), ticker.Enabled)
+		require.NoError(t, ticker.ValidateBasic())
+	}
+}
+
+func TestValidateMarketStateTransition(t *testing.T) {
+	ticker := func(state string, enabled bool) types.Ticker {
+		ticker := types.NewTicker("PEPE", "USD", 8, 1, enabled)
+		ticker.State = state
+		return ticker
+	}
+
+	testCases := []struct {
+		name     string
+		existing types.Ticker
+		updated  types.Ticker
+		expErr   bool
+	}{
+		{
+			name:     "unset states",
+			existing: ticker("", false),
+			updated:  ticker("", true),
+		},
+		{
+			name:     "setting the state of an enabled ticker",
+			existing: ticker("", true),
+			updated:  ticker(types.MarketStateReduceOnly, true),
+		},
+		{
+			name:     "setting the state of an enabled ticker to shadow",
+			existing: ticker("", true),
+			updated:  ticker(types.MarketStateShadow, true),
+			expErr:   true,
+		},
+		{
+			name:     "unsetting the state",
+			existing: ticker(types.MarketStateActive, true),
+			updated:  ticker("", true),
+			expErr:   true,
+		},
+		{
+			name:     "unchanged state",
+			existing: ticker(types.MarketStateActive, true),
+			updated:  ticker(types.MarketStateActive, true),
+		},
+		{
+			name:     "proposed to shadow",
+			existing: ticker(types.MarketStateProposed, false),
+			updated:  ticker(types.MarketStateShadow, true),
+		},
+		{
+			name:     "shadow to active",
+			existing: ticker(types.MarketStateShadow, true),
+			updated:  ticker(types.MarketStateActive, true),
+		},
+		{
+			name:     "active to reduce only",
+			existing: ticker(types.MarketStateActive, true),
+			updated:  ticker(types.MarketStateReduceOnly, true),
+		},
+		{
+			name:     "reduce only to delisted",
+			existing: ticker(types.MarketStateReduceOnly, true),
+			updated:  ticker(types.MarketStateDelisted, false),
+		},
+		{
+			name:     "delisted to proposed",
+			existing: ticker(types.MarketStateDelisted, false),
+			updated:  ticker(types.MarketStateProposed, false),
+		},
+		{
+			name:     "active to delisted",
+			existing: ticker(types.MarketStateActive, true),
+			updated:  ticker(types.MarketStateDelisted, false),
+			expErr:   true,
+		},
+		{
+			name:     "active to shadow",
+			existing: ticker(types.MarketStateActive, true),
+			updated:  ticker(types.MarketStateShadow, true),
+			expErr:   true,
+		},
+		{
+			name:     "delisted to active",
+			existing: ticker(types.MarketStateDelisted, false),
+			updated:  ticker(types.MarketStateActive, true),
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMarketStateTransition(tc.existing, tc.updated)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
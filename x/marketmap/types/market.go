@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 )
 
 // ValidateBasic validates the market map configuration and its expected configuration.
@@ -11,7 +14,8 @@ import (
 //		1. Ensure that the market map is valid (ValidateBasic). This ensures that each of the provider's
 //		   markets are supported by the market map.
 //		2. Ensure that each provider config has a valid corresponding ticker.
-//	 	3. Ensure that all normalization markets are enabled.
+//	 	3. Ensure that all normalization markets, including each step of a conversion path, exist and are enabled.
+//		4. Ensure that no market is (transitively) normalized by itself through all of its provider configs.
 func (mm *MarketMap) ValidateBasic() error {
 	for ticker, market := range mm.Markets {
 		if err := market.ValidateBasic(); err != nil {
@@ -24,10 +28,10 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 
 		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
+			for _, step := range providerConfig.ConversionPairs() {
+				normalizeMarket, found := mm.Markets[step.Pair.String()]
 				if !found {
-					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, providerConfig.NormalizeByPair.String())
+					return fmt.Errorf("provider's (%s) pair for normalization (%s) was not found in the marketmap", providerConfig.Name, step.Pair.String())
 				}
 
 				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
@@ -37,46 +41,137 @@ func (mm *MarketMap) ValidateBasic() error {
 		}
 	}
 
+	return mm.ValidateNormalizationGraph()
+}
+
+// ValidateNormalizationGraph ensures that no market is normalized by itself through every one of its
+// provider configs, i.e. that there is no cycle of normalization markets which none of the markets in it
+// can be priced without. Cycles with such a provider config are allowed, as the oracle converts prices by
+// the index prices of the previous round, which the cycle is bootstrapped from. For example, USDT/USD
+// can be normalized by ETH/USD, and ETH/USD by USDT/USD, as long as either has a provider config which
+// is not normalized by the other.
+func (mm *MarketMap) ValidateNormalizationGraph() error {
+	// visit the markets in a deterministic order so that the same cycle is always reported
+	tickers := make([]string, 0, len(mm.Markets))
+	for ticker := range mm.Markets {
+		tickers = append(tickers, ticker)
+	}
+	sort.Strings(tickers)
+
+	// a market can be priced if one of its provider configs is only normalized by markets that can be
+	// priced. This is repeated until no more markets can be priced.
+	priced := make(map[string]bool, len(mm.Markets))
+	for updated := true; updated; {
+		updated = false
+		for _, ticker := range tickers {
+			if priced[ticker] {
+				continue
+			}
+
+			for _, providerConfig := range mm.Markets[ticker].ProviderConfigs {
+				if mm.isNormalizedBy(providerConfig, priced) {
+					priced[ticker] = true
+					updated = true
+					break
+				}
+			}
+		}
+	}
+
+	// every provider config of a market that cannot be priced is normalized by another market that cannot
+	// be priced, so following them either leads to a cycle, or to a market that has no provider configs
+	// or is missing (which ValidateBasic reports).
+	for _, ticker := range tickers {
+		if priced[ticker] {
+			continue
+		}
+
+		var path []string
+		for next, ok := ticker, true; ok; next, ok = mm.unpricedNormalizationMarket(next, priced) {
+			if i := slices.Index(path, next); i >= 0 {
+				return fmt.Errorf(
+					"market %s is normalized by itself through all of its provider configs: %s",
+					next, strings.Join(append(path[i:], next), " -> "),
+				)
+			}
+			path = append(path, next)
+		}
+	}
+
 	return nil
 }
 
+// isNormalizedBy returns true if every market that the given provider config is normalized by is in
+// the given set of markets.
+func (mm *MarketMap) isNormalizedBy(providerConfig ProviderConfig, markets map[string]bool) bool {
+	for _, step := range providerConfig.ConversionPairs() {
+		if !markets[step.Pair.String()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unpricedNormalizationMarket returns the first market that the first provider config of the given market
+// is normalized by, which is not in the given set of priced markets. False is returned if there is no
+// such market, e.g. because the given market is missing.
+func (mm *MarketMap) unpricedNormalizationMarket(ticker string, priced map[string]bool) (string, bool) {
+	market, found := mm.Markets[ticker]
+	if !found || len(market.ProviderConfigs) == 0 {
+		return "", false
+	}
+
+	for _, step := range market.ProviderConfigs[0].ConversionPairs() {
+		if !priced[step.Pair.String()] {
+			return step.Pair.String(), true
+		}
+	}
+
+	return "", false
+}
+
 // GetValidSubset outputs a MarketMap which contains the maximal valid subset of this MarketMap.
 //
-//	In particular, this will eliminate anything which would otherwise cause a failure in ValidateBasic.
-//	The resulting MarketMap should be able to pass ValidateBasic.
+//	In particular, this will eliminate anything which would otherwise cause a failure in ValidateBasic,
+//	including markets with an unknown lifecycle state or one that is inconsistent with Enabled. Markets
+//	in the shadow state are kept, so that sidecars fetch and vote on their prices. The resulting
+//	MarketMap should be able to pass ValidateBasic.
 func (mm *MarketMap) GetValidSubset() (MarketMap, error) {
-	validSubset := MarketMap{Markets: make(map[string]Market)}
-
-	// Operates in 2 passes:
-	// 1. Remove invalid ProviderConfigs
+	validSubset := MarketMap{Markets: make(map[string]Market, len(mm.Markets))}
 	for ticker, market := range mm.Markets {
-		var validProviderConfigs []ProviderConfig
-		for _, providerConfig := range market.ProviderConfigs {
-			if providerConfig.NormalizeByPair != nil {
-				normalizeMarket, found := mm.Markets[providerConfig.NormalizeByPair.String()]
-				if !found {
-					continue
-				}
+		validSubset.Markets[ticker] = market
+	}
 
-				if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
-					continue
+	// Operates in 2 passes, which are repeated until no market is removed, as removing a market
+	// can invalidate the ProviderConfigs of the markets that are normalized by it:
+	for removed := true; removed; {
+		removed = false
+
+		// 1. Remove invalid ProviderConfigs
+		for ticker, market := range validSubset.Markets {
+			var validProviderConfigs []ProviderConfig
+			for _, providerConfig := range market.ProviderConfigs {
+				if validSubset.hasValidConversionPairs(market, providerConfig) {
+					validProviderConfigs = append(validProviderConfigs, providerConfig)
 				}
 			}
-			validProviderConfigs = append(validProviderConfigs, providerConfig)
+			market.ProviderConfigs = validProviderConfigs
+			validSubset.Markets[ticker] = market
 		}
-		market.ProviderConfigs = validProviderConfigs
-		validSubset.Markets[ticker] = market
-	}
-	// 2. Remove ValidateBasic failures on all included markets
-	for ticker, market := range validSubset.Markets {
-		if err := market.ValidateBasic(); err != nil {
-			delete(validSubset.Markets, ticker)
-			continue
-		}
-		// expect that the ticker (index) is equal to the market.Ticker.String()
-		if ticker != market.Ticker.String() {
-			delete(validSubset.Markets, ticker)
-			continue
+		// 2. Remove ValidateBasic failures on all included markets
+		for ticker, market := range validSubset.Markets {
+			if err := market.ValidateBasic(); err != nil {
+				delete(validSubset.Markets, ticker)
+				removed = true
+				continue
+			}
+			// expect that the ticker (index) is equal to the market.Ticker.String()
+			if ticker != market.Ticker.String() {
+				delete(validSubset.Markets, ticker)
+				removed = true
+				continue
+			}
 		}
 	}
 	if valErr := validSubset.ValidateBasic(); valErr != nil {
@@ -86,6 +181,23 @@ func (mm *MarketMap) GetValidSubset() (MarketMap, error) {
 	return validSubset, nil
 }
 
+// hasValidConversionPairs returns true if every market that the given provider config is normalized
+// by is in the market map, and is enabled if the given market is enabled.
+func (mm *MarketMap) hasValidConversionPairs(market Market, providerConfig ProviderConfig) bool {
+	for _, step := range providerConfig.ConversionPairs() {
+		normalizeMarket, found := mm.Markets[step.Pair.String()]
+		if !found {
+			return false
+		}
+
+		if !normalizeMarket.Ticker.Enabled && market.Ticker.Enabled {
+			return false
+		}
+	}
+
+	return true
+}
+
 // String returns the string representation of the market map.
 func (mm *MarketMap) String() string {
 	return fmt.Sprintf(
@@ -115,6 +227,10 @@ func (m *Market) ValidateBasic() error {
 			return err
 		}
 
+		if err := providerConfig.validateConversionPath(m.Ticker); err != nil {
+			return err
+		}
+
 		// check for duplicate providers
 		key := providerConfig.Name + providerConfig.OffChainTicker
 		if _, seen := seenProviders[key]; seen {
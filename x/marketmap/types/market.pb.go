@@ -98,6 +98,11 @@ type Ticker struct {
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given ticker.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
+	// State is the lifecycle state of the market: proposed, shadow, active,
+	// reduce_only or delisted. Shadow, active and reduce_only markets must be
+	// enabled, while proposed and delisted markets must be disabled. If it is
+	// not set, the state of the market is derived from Enabled.
+	State string `protobuf:"bytes,16,opt,name=state,proto3" json:"state,omitempty"`
 }
 
 func (m *Ticker) Reset()      { *m = Ticker{} }
@@ -167,6 +172,13 @@ func (m *Ticker) GetMetadata_JSON() string {
 	return ""
 }
 
+func (m *Ticker) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
 type ProviderConfig struct {
 	// Name corresponds to the name of the provider for which the configuration is
 	// being set.
@@ -183,6 +195,14 @@ type ProviderConfig struct {
 	// Invert is a boolean indicating if the BASE and QUOTE of the market should
 	// be inverted. i.e. BASE -> QUOTE, QUOTE -> BASE
 	Invert bool `protobuf:"varint,4,opt,name=invert,proto3" json:"invert,omitempty"`
+	// NormalizeByPairs is an ordered list of conversion pairs for this ticker to
+	// be normalized by, for markets that require more than one conversion step.
+	// For example, if the desired Ticker is FOO/USD, this market could be reached
+	// using: OffChainTicker = FOO/ETH NormalizeByPairs = [ETH/USDT, USDT/USD].
+	// Each step must quote the currency that the previous step (or the provider
+	// price) is quoted in. This field is optional, and cannot be set together
+	// with NormalizeByPair.
+	NormalizeByPairs []ConversionPair `protobuf:"bytes,5,rep,name=normalize_by_pairs,json=normalizeByPairs,proto3" json:"normalize_by_pairs"`
 	// MetadataJSON is a string of JSON that encodes any extra configuration
 	// for the given provider config.
 	Metadata_JSON string `protobuf:"bytes,15,opt,name=metadata_JSON,json=metadataJSON,proto3" json:"metadata_JSON,omitempty"`
@@ -249,6 +269,13 @@ func (m *ProviderConfig) GetInvert() bool {
 	return false
 }
 
+func (m *ProviderConfig) GetNormalizeByPairs() []ConversionPair {
+	if m != nil {
+		return m.NormalizeByPairs
+	}
+	return nil
+}
+
 func (m *ProviderConfig) GetMetadata_JSON() string {
 	if m != nil {
 		return m.Metadata_JSON
@@ -256,6 +283,63 @@ func (m *ProviderConfig) GetMetadata_JSON() string {
 	return ""
 }
 
+// ConversionPair is a single step of a conversion path used to normalize a
+// provider price.
+type ConversionPair struct {
+	// Pair is the currency pair whose index price is used for this step.
+	Pair types.CurrencyPair `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	// Invert is a boolean indicating if the inverse of the pair's index price
+	// should be used for this step, i.e. QUOTE/BASE instead of BASE/QUOTE.
+	Invert bool `protobuf:"varint,2,opt,name=invert,proto3" json:"invert,omitempty"`
+}
+
+func (m *ConversionPair) Reset()         { *m = ConversionPair{} }
+func (m *ConversionPair) String() string { return proto.CompactTextString(m) }
+func (*ConversionPair) ProtoMessage()    {}
+func (*ConversionPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{3}
+}
+func (m *ConversionPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionPair.Merge(m, src)
+}
+func (m *ConversionPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionPair proto.InternalMessageInfo
+
+func (m *ConversionPair) GetPair() types.CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *ConversionPair) GetInvert() bool {
+	if m != nil {
+		return m.Invert
+	}
+	return false
+}
+
 // MarketMap maps ticker strings to their Markets.
 type MarketMap struct {
 	// Markets is the full list of tickers and their associated configurations
@@ -266,7 +350,7 @@ type MarketMap struct {
 func (m *MarketMap) Reset()      { *m = MarketMap{} }
 func (*MarketMap) ProtoMessage() {}
 func (*MarketMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fefe265720fc8a78, []int{3}
+	return fileDescriptor_fefe265720fc8a78, []int{4}
 }
 func (m *MarketMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,53 +386,245 @@ func (m *MarketMap) GetMarkets() map[string]Market {
 	return nil
 }
 
+// ScheduledMarketUpdate is a set of market upserts that is queued to be applied
+// at the beginning of a future block.
+type ScheduledMarketUpdate struct {
+	// Id is the unique identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Authority is the market authority that scheduled the update. The update is
+	// applied with the permissions of this authority at the activation height.
+	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height at the beginning of which the update is
+	// applied.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Markets is the list of markets to create, or update if they exist.
+	Markets []Market `protobuf:"bytes,4,rep,name=markets,proto3" json:"markets"`
+}
+
+func (m *ScheduledMarketUpdate) Reset()         { *m = ScheduledMarketUpdate{} }
+func (m *ScheduledMarketUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketUpdate) ProtoMessage()    {}
+func (*ScheduledMarketUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{5}
+}
+func (m *ScheduledMarketUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMarketUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketUpdate.Merge(m, src)
+}
+func (m *ScheduledMarketUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMarketUpdate proto.InternalMessageInfo
+
+func (m *ScheduledMarketUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledMarketUpdate) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *ScheduledMarketUpdate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledMarketUpdate) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// MarketRevision is a record of a change made to a market in the market map.
+type MarketRevision struct {
+	// Id is the unique identifier of the revision. Revisions are numbered in the
+	// order in which they were made.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Ticker is the ticker, i.e. BTC/USD, of the market that was changed.
+	Ticker string `protobuf:"bytes,2,opt,name=ticker,proto3" json:"ticker,omitempty"`
+	// Height is the block height at which the change was made.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Signer is the authority that made the change.
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Action is the change that was made: "create", "update" or "remove".
+	Action string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	// Previous is the market before the change (nil if it was created).
+	Previous *Market `protobuf:"bytes,6,opt,name=previous,proto3" json:"previous,omitempty"`
+	// Market is the market after the change (nil if it was removed).
+	Market *Market `protobuf:"bytes,7,opt,name=market,proto3" json:"market,omitempty"`
+}
+
+func (m *MarketRevision) Reset()         { *m = MarketRevision{} }
+func (m *MarketRevision) String() string { return proto.CompactTextString(m) }
+func (*MarketRevision) ProtoMessage()    {}
+func (*MarketRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fefe265720fc8a78, []int{6}
+}
+func (m *MarketRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRevision.Merge(m, src)
+}
+func (m *MarketRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketRevision proto.InternalMessageInfo
+
+func (m *MarketRevision) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MarketRevision) GetTicker() string {
+	if m != nil {
+		return m.Ticker
+	}
+	return ""
+}
+
+func (m *MarketRevision) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MarketRevision) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MarketRevision) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *MarketRevision) GetPrevious() *Market {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *MarketRevision) GetMarket() *Market {
+	if m != nil {
+		return m.Market
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Market)(nil), "slinky.marketmap.v1.Market")
 	proto.RegisterType((*Ticker)(nil), "slinky.marketmap.v1.Ticker")
 	proto.RegisterType((*ProviderConfig)(nil), "slinky.marketmap.v1.ProviderConfig")
+	proto.RegisterType((*ConversionPair)(nil), "slinky.marketmap.v1.ConversionPair")
 	proto.RegisterType((*MarketMap)(nil), "slinky.marketmap.v1.MarketMap")
 	proto.RegisterMapType((map[string]Market)(nil), "slinky.marketmap.v1.MarketMap.MarketsEntry")
+	proto.RegisterType((*ScheduledMarketUpdate)(nil), "slinky.marketmap.v1.ScheduledMarketUpdate")
+	proto.RegisterType((*MarketRevision)(nil), "slinky.marketmap.v1.MarketRevision")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/market.proto", fileDescriptor_fefe265720fc8a78) }
 
 var fileDescriptor_fefe265720fc8a78 = []byte{
-	// 550 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0x12, 0x41,
-	0x14, 0xdf, 0x01, 0xa4, 0x30, 0xa5, 0x80, 0xa3, 0x31, 0x1b, 0x8c, 0xdb, 0x0d, 0x5c, 0x36, 0xd1,
-	0x2e, 0xd9, 0x7a, 0xb1, 0x3d, 0x42, 0x8c, 0x7f, 0xab, 0xcd, 0xda, 0xc4, 0xc4, 0xcb, 0x66, 0x58,
-	0x06, 0x3a, 0x81, 0x9d, 0xd9, 0xcc, 0x0e, 0x44, 0x3c, 0xf9, 0x11, 0x3c, 0x7a, 0x34, 0xf1, 0x63,
-	0xf8, 0x05, 0x7a, 0xec, 0xc9, 0x78, 0x30, 0xc6, 0x40, 0xfc, 0x1e, 0x66, 0x67, 0x07, 0x0a, 0x09,
-	0x4d, 0x7a, 0x7b, 0xef, 0xcd, 0x6f, 0x7e, 0x6f, 0x7e, 0xbf, 0x79, 0x0f, 0xda, 0xc9, 0x98, 0xb2,
-	0xd1, 0xac, 0x1d, 0x61, 0x31, 0x22, 0x32, 0xc2, 0x71, 0x7b, 0xea, 0xe9, 0xc4, 0x8d, 0x05, 0x97,
-	0x1c, 0xdd, 0xc9, 0x10, 0xee, 0x0a, 0xe1, 0x4e, 0xbd, 0xc6, 0xdd, 0x21, 0x1f, 0x72, 0x75, 0xde,
-	0x4e, 0xa3, 0x0c, 0xda, 0x68, 0x69, 0x32, 0x39, 0x8b, 0x49, 0x92, 0x12, 0x85, 0x13, 0x21, 0x08,
-	0x0b, 0x67, 0x41, 0x8c, 0xa9, 0xc8, 0x40, 0xcd, 0xef, 0x00, 0x16, 0x4f, 0x14, 0x17, 0x3a, 0x82,
-	0x45, 0x49, 0xc3, 0x11, 0x11, 0x26, 0xb0, 0x81, 0xb3, 0x7b, 0x78, 0xdf, 0xdd, 0xd2, 0xcb, 0x3d,
-	0x53, 0x90, 0x4e, 0xe1, 0xe2, 0xcf, 0xbe, 0xe1, 0xeb, 0x0b, 0xe8, 0x0c, 0xd6, 0x63, 0xc1, 0xa7,
-	0xb4, 0x4f, 0x44, 0x10, 0x72, 0x36, 0xa0, 0xc3, 0xc4, 0xcc, 0xd9, 0x79, 0x67, 0xf7, 0xb0, 0xb5,
-	0x95, 0xe4, 0x54, 0x83, 0xbb, 0x0a, 0xab, 0xc9, 0x6a, 0xf1, 0x46, 0x35, 0x39, 0x2e, 0x7d, 0xfd,
-	0xb6, 0x6f, 0x7c, 0xfe, 0x6d, 0x1b, 0xcd, 0x7f, 0x00, 0x16, 0xb3, 0xc6, 0xe8, 0x39, 0xdc, 0xdb,
-	0xd0, 0xa1, 0x1f, 0xfb, 0x60, 0xd9, 0x47, 0xa9, 0x4d, 0x7b, 0x74, 0x35, 0xea, 0x14, 0xd3, 0xe5,
-	0x73, 0x2b, 0xe1, 0x5a, 0x0d, 0x35, 0x60, 0xa9, 0x4f, 0x42, 0x1a, 0xe1, 0x71, 0xfa, 0x58, 0xe0,
-	0x14, 0xfc, 0x55, 0x8e, 0x1e, 0x41, 0x14, 0x51, 0x16, 0xac, 0x89, 0x9a, 0x30, 0x69, 0xe6, 0x15,
-	0xaa, 0x1e, 0x51, 0x76, 0x25, 0x60, 0xc2, 0x24, 0x32, 0xe1, 0x0e, 0x61, 0xb8, 0x37, 0x26, 0x7d,
-	0xb3, 0x6a, 0x03, 0xa7, 0xe4, 0x2f, 0x53, 0xd4, 0x82, 0x7b, 0x11, 0x91, 0xb8, 0x8f, 0x25, 0x0e,
-	0x5e, 0xbe, 0x7b, 0xfb, 0xc6, 0xac, 0xd9, 0xc0, 0x29, 0xfb, 0x95, 0x65, 0x31, 0xad, 0xad, 0xe9,
-	0xfc, 0x09, 0x60, 0x75, 0xd3, 0x1b, 0x84, 0x60, 0x81, 0xe1, 0x88, 0x28, 0x99, 0x65, 0x5f, 0xc5,
-	0xc8, 0x81, 0x75, 0x3e, 0x18, 0x04, 0xe1, 0x39, 0xa6, 0x2c, 0xd0, 0x7f, 0x96, 0x53, 0xe7, 0x55,
-	0x3e, 0x18, 0x74, 0xd3, 0xb2, 0x76, 0xeb, 0x05, 0xbc, 0xcd, 0xb8, 0x88, 0xf0, 0x98, 0x7e, 0x22,
-	0x41, 0x4f, 0x3b, 0x96, 0xbf, 0x81, 0x63, 0x7e, 0x6d, 0x75, 0xaf, 0x93, 0xd9, 0x75, 0x0f, 0x16,
-	0x29, 0x9b, 0x12, 0x21, 0xcd, 0x82, 0xd2, 0xa8, 0xb3, 0x1b, 0x49, 0x6c, 0xfe, 0x00, 0xb0, 0x9c,
-	0x8d, 0xd9, 0x09, 0x8e, 0xd1, 0x2b, 0xb8, 0x93, 0x8d, 0x43, 0x62, 0x02, 0x35, 0x25, 0x0f, 0xb7,
-	0x4e, 0xc9, 0xea, 0x82, 0x8e, 0x92, 0xa7, 0x4c, 0x8a, 0x99, 0xfe, 0xcb, 0x25, 0x43, 0xe3, 0x3d,
-	0xac, 0xac, 0x1f, 0xa3, 0x3a, 0xcc, 0x8f, 0xc8, 0x4c, 0xfb, 0x95, 0x86, 0xc8, 0x83, 0xb7, 0xa6,
-	0x78, 0x3c, 0x21, 0xca, 0xa3, 0xeb, 0xe6, 0x3a, 0xe3, 0xf0, 0x33, 0xe4, 0x71, 0xee, 0x09, 0xb8,
-	0xfa, 0x96, 0xce, 0xb3, 0x8b, 0xb9, 0x05, 0x2e, 0xe7, 0x16, 0xf8, 0x3b, 0xb7, 0xc0, 0x97, 0x85,
-	0x65, 0x5c, 0x2e, 0x2c, 0xe3, 0xd7, 0xc2, 0x32, 0x3e, 0x1c, 0x0c, 0xa9, 0x3c, 0x9f, 0xf4, 0xdc,
-	0x90, 0x47, 0x6d, 0xcf, 0xf3, 0x8e, 0x0e, 0x5e, 0xe3, 0x5e, 0xd2, 0xd6, 0x8b, 0xf7, 0x71, 0x6d,
-	0x8f, 0x95, 0xc9, 0xbd, 0xa2, 0x5a, 0xba, 0xc7, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x59, 0xf0,
-	0x3b, 0x68, 0xe8, 0x03, 0x00, 0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x1d, 0xc7, 0x4d, 0xa6, 0x6d, 0x1a, 0x96, 0x52, 0x59, 0x01, 0xd2, 0x28, 0xbd, 0x44,
+	0x82, 0x26, 0x4a, 0x7b, 0x28, 0x2d, 0xb7, 0x46, 0x88, 0xdf, 0x42, 0xe5, 0x16, 0x55, 0xe2, 0x12,
+	0x6d, 0xec, 0x4d, 0xb2, 0x4a, 0xbc, 0xb6, 0xec, 0x75, 0x44, 0x38, 0xf1, 0x08, 0x1c, 0x11, 0x27,
+	0x24, 0x1e, 0x80, 0x07, 0xe0, 0x05, 0x7a, 0xec, 0x91, 0x03, 0x42, 0xa8, 0xbd, 0xf3, 0x02, 0x5c,
+	0x90, 0xd7, 0x9b, 0xd4, 0x69, 0x4b, 0x5b, 0x6e, 0xfb, 0xcd, 0x7e, 0x3b, 0x33, 0xdf, 0xb7, 0xe3,
+	0x35, 0x94, 0x83, 0x01, 0x65, 0xfd, 0x51, 0xdd, 0xc1, 0x7e, 0x9f, 0x70, 0x07, 0x7b, 0xf5, 0x61,
+	0x43, 0x82, 0x9a, 0xe7, 0xbb, 0xdc, 0x45, 0x37, 0x63, 0x46, 0x6d, 0xc2, 0xa8, 0x0d, 0x1b, 0xc5,
+	0xc5, 0xae, 0xdb, 0x75, 0xc5, 0x7e, 0x3d, 0x5a, 0xc5, 0xd4, 0xe2, 0x8a, 0x4c, 0xc6, 0x47, 0x1e,
+	0x09, 0xa2, 0x44, 0x56, 0xe8, 0xfb, 0x84, 0x59, 0xa3, 0x96, 0x87, 0xa9, 0x1f, 0x93, 0x2a, 0x5f,
+	0x14, 0xd0, 0x77, 0x44, 0x2e, 0xb4, 0x09, 0x3a, 0xa7, 0x56, 0x9f, 0xf8, 0x86, 0x52, 0x56, 0xaa,
+	0xb3, 0x6b, 0xb7, 0x6b, 0x17, 0xd4, 0xaa, 0xed, 0x0b, 0xca, 0xb6, 0x76, 0xf8, 0x73, 0x39, 0x65,
+	0xca, 0x03, 0x68, 0x1f, 0x0a, 0x9e, 0xef, 0x0e, 0xa9, 0x4d, 0xfc, 0x96, 0xe5, 0xb2, 0x0e, 0xed,
+	0x06, 0x86, 0x5a, 0x4e, 0x57, 0x67, 0xd7, 0x56, 0x2e, 0x4c, 0xb2, 0x2b, 0xc9, 0x4d, 0xc1, 0x95,
+	0xc9, 0x16, 0xbc, 0xa9, 0x68, 0xb0, 0x95, 0xfd, 0xf8, 0x79, 0x39, 0xf5, 0xfe, 0x47, 0x39, 0x55,
+	0xf9, 0xa3, 0x80, 0x1e, 0x17, 0x46, 0x4f, 0x60, 0x7e, 0x4a, 0x87, 0x6c, 0xf6, 0xee, 0xb8, 0x8e,
+	0x50, 0x1b, 0xd5, 0x68, 0x4a, 0xd6, 0x2e, 0xa6, 0xe3, 0x76, 0xe7, 0xac, 0x44, 0x0c, 0x15, 0x21,
+	0x6b, 0x13, 0x8b, 0x3a, 0x78, 0x10, 0x35, 0xab, 0x54, 0x35, 0x73, 0x82, 0xd1, 0x7d, 0x40, 0x0e,
+	0x65, 0xad, 0x84, 0xa8, 0x90, 0x71, 0x23, 0x2d, 0x58, 0x05, 0x87, 0xb2, 0x53, 0x01, 0x21, 0xe3,
+	0xc8, 0x80, 0x19, 0xc2, 0x70, 0x7b, 0x40, 0x6c, 0x23, 0x5f, 0x56, 0xaa, 0x59, 0x73, 0x0c, 0xd1,
+	0x0a, 0xcc, 0x3b, 0x84, 0x63, 0x1b, 0x73, 0xdc, 0x7a, 0xb6, 0xf7, 0xea, 0xa5, 0xb1, 0x50, 0x56,
+	0xaa, 0x39, 0x73, 0x6e, 0x1c, 0x8c, 0x62, 0x68, 0x11, 0x32, 0x01, 0xc7, 0x9c, 0x18, 0x05, 0xb1,
+	0x19, 0x83, 0x84, 0xfa, 0xaf, 0x2a, 0xe4, 0xa7, 0x1d, 0x43, 0x08, 0x34, 0x86, 0x1d, 0x22, 0xc4,
+	0xe7, 0x4c, 0xb1, 0x46, 0x55, 0x28, 0xb8, 0x9d, 0x4e, 0xcb, 0xea, 0x61, 0xca, 0x5a, 0xf2, 0x26,
+	0x55, 0xb1, 0x9f, 0x77, 0x3b, 0x9d, 0x66, 0x14, 0x96, 0x1e, 0x3e, 0x85, 0x1b, 0xcc, 0xf5, 0x1d,
+	0x3c, 0xa0, 0xef, 0x48, 0xab, 0x2d, 0x7d, 0x4c, 0x5f, 0xc3, 0x47, 0x73, 0x61, 0x72, 0x6e, 0x3b,
+	0x36, 0x71, 0x09, 0x74, 0xca, 0x86, 0xc4, 0xe7, 0x86, 0x26, 0x94, 0x4b, 0x84, 0x0e, 0x00, 0x9d,
+	0x2b, 0x11, 0x18, 0x99, 0x4b, 0x66, 0xa2, 0xe9, 0x46, 0x27, 0x03, 0xea, 0xb2, 0xc4, 0x8d, 0x15,
+	0xce, 0xd4, 0x0b, 0xae, 0xe5, 0x68, 0x05, 0x43, 0x7e, 0x3a, 0x1d, 0xda, 0x00, 0xed, 0x7f, 0xa7,
+	0x45, 0xf3, 0xa6, 0x05, 0xaa, 0x49, 0x81, 0x95, 0x6f, 0x0a, 0xe4, 0xe2, 0x0f, 0x67, 0x07, 0x7b,
+	0xe8, 0x39, 0xcc, 0xc4, 0x62, 0x02, 0x43, 0x11, 0x1a, 0xef, 0x5d, 0xa8, 0x71, 0x72, 0x40, 0xae,
+	0x82, 0x47, 0x8c, 0xfb, 0x23, 0x59, 0x6f, 0x9c, 0xa1, 0x78, 0x00, 0x73, 0xc9, 0x6d, 0x54, 0x80,
+	0x74, 0x9f, 0x8c, 0xe4, 0x5d, 0x47, 0x4b, 0xd4, 0x80, 0xcc, 0x10, 0x0f, 0x42, 0x62, 0xa8, 0x97,
+	0x7c, 0xa9, 0x71, 0x0e, 0x33, 0x66, 0x6e, 0xa9, 0x0f, 0x94, 0xc4, 0x48, 0x7d, 0x52, 0xe0, 0xd6,
+	0x9e, 0xd5, 0x23, 0x76, 0x38, 0x20, 0x76, 0x4c, 0x7c, 0xed, 0xd9, 0x98, 0x13, 0x94, 0x07, 0x95,
+	0xda, 0xa2, 0x96, 0x66, 0xaa, 0xd4, 0x46, 0x77, 0x20, 0x87, 0x43, 0xde, 0x73, 0x7d, 0xca, 0x47,
+	0x72, 0x9c, 0x4e, 0x03, 0x91, 0x3b, 0x3d, 0x42, 0xbb, 0xbd, 0xf1, 0xb7, 0x21, 0x11, 0x7a, 0x78,
+	0xea, 0x87, 0x56, 0x4e, 0x5f, 0xd1, 0xe2, 0x19, 0xfd, 0x95, 0xdf, 0x0a, 0xe4, 0x65, 0xf3, 0x64,
+	0x48, 0xa3, 0x2b, 0x3c, 0xd7, 0xd5, 0xd2, 0xe4, 0xad, 0x8a, 0x5b, 0x92, 0xe8, 0x9f, 0xfd, 0x2c,
+	0x81, 0x1e, 0xd0, 0x2e, 0x23, 0xbe, 0x18, 0xd3, 0x9c, 0x29, 0x51, 0x14, 0xc7, 0x16, 0xa7, 0x2e,
+	0x33, 0x32, 0x71, 0x3c, 0x46, 0x68, 0x03, 0xb2, 0x9e, 0x4f, 0x86, 0xd4, 0x0d, 0x03, 0x43, 0xbf,
+	0xda, 0xe3, 0x09, 0x19, 0xad, 0x83, 0x1e, 0x13, 0x8c, 0x99, 0xab, 0x8f, 0x49, 0xea, 0xf6, 0xe3,
+	0xc3, 0xe3, 0x92, 0x72, 0x74, 0x5c, 0x52, 0x7e, 0x1d, 0x97, 0x94, 0x0f, 0x27, 0xa5, 0xd4, 0xd1,
+	0x49, 0x29, 0xf5, 0xfd, 0xa4, 0x94, 0x7a, 0xb3, 0xda, 0xa5, 0xbc, 0x17, 0xb6, 0x6b, 0x96, 0xeb,
+	0xd4, 0x1b, 0x8d, 0xc6, 0xe6, 0xea, 0x0b, 0xdc, 0x0e, 0xea, 0xf2, 0x61, 0x7f, 0x9b, 0xf8, 0x4f,
+	0x88, 0x41, 0x6e, 0xeb, 0xe2, 0x51, 0x5f, 0xff, 0x3b, 0x00, 0xca, 0x3b, 0x90, 0x07, 0x48, 0x06,
+	0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -418,6 +694,15 @@ func (m *Ticker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.Metadata_JSON) > 0 {
 		i -= len(m.Metadata_JSON)
 		copy(dAtA[i:], m.Metadata_JSON)
@@ -485,6 +770,20 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x7a
 	}
+	if len(m.NormalizeByPairs) > 0 {
+		for iNdEx := len(m.NormalizeByPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizeByPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Invert {
 		i--
 		if m.Invert {
@@ -524,6 +823,49 @@ func (m *ProviderConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConversionPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Invert {
+		i--
+		if m.Invert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Pair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MarketMap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -571,55 +913,191 @@ func (m *MarketMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledMarketUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Ticker.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if len(m.ProviderConfigs) > 0 {
-		for _, e := range m.ProviderConfigs {
-			l = e.Size()
-			n += 1 + l + sovMarket(uint64(l))
-		}
-	}
-	return n
+
+func (m *ScheduledMarketUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Ticker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ScheduledMarketUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.Decimals != 0 {
-		n += 1 + sovMarket(uint64(m.Decimals))
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.MinProviderCount != 0 {
-		n += 1 + sovMarket(uint64(m.MinProviderCount))
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Enabled {
-		n += 2
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Market != nil {
+		{
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Ticker) > 0 {
+		i -= len(m.Ticker)
+		copy(dAtA[i:], m.Ticker)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Ticker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Ticker.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if len(m.ProviderConfigs) > 0 {
+		for _, e := range m.ProviderConfigs {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Ticker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovMarket(uint64(m.Decimals))
+	}
+	if m.MinProviderCount != 0 {
+		n += 1 + sovMarket(uint64(m.MinProviderCount))
+	}
+	if m.Enabled {
+		n += 2
 	}
 	l = len(m.Metadata_JSON)
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
 	}
+	l = len(m.State)
+	if l > 0 {
+		n += 2 + l + sovMarket(uint64(l))
+	}
 	return n
 }
 
@@ -644,6 +1122,12 @@ func (m *ProviderConfig) Size() (n int) {
 	if m.Invert {
 		n += 2
 	}
+	if len(m.NormalizeByPairs) > 0 {
+		for _, e := range m.NormalizeByPairs {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
 	l = len(m.Metadata_JSON)
 	if l > 0 {
 		n += 1 + l + sovMarket(uint64(l))
@@ -651,6 +1135,20 @@ func (m *ProviderConfig) Size() (n int) {
 	return n
 }
 
+func (m *ConversionPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Invert {
+		n += 2
+	}
+	return n
+}
+
 func (m *MarketMap) Size() (n int) {
 	if m == nil {
 		return 0
@@ -669,6 +1167,66 @@ func (m *MarketMap) Size() (n int) {
 	return n
 }
 
+func (m *ScheduledMarketUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
 func sovMarket(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -944,6 +1502,38 @@ func (m *Ticker) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata_JSON = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -1114,6 +1704,40 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Invert = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizeByPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizeByPairs = append(m.NormalizeByPairs, ConversionPair{})
+			if err := m.NormalizeByPairs[len(m.NormalizeByPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Metadata_JSON", wireType)
@@ -1167,7 +1791,7 @@ func (m *ProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketMap) Unmarshal(dAtA []byte) error {
+func (m *ConversionPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,15 +1814,15 @@ func (m *MarketMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConversionPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConversionPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1225,31 +1849,134 @@ func (m *MarketMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Markets == nil {
-				m.Markets = make(map[string]Market)
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &Market{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMarket
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Invert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Invert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Markets == nil {
+				m.Markets = make(map[string]Market)
+			}
+			var mapkey string
+			mapvalue := &Market{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMarket
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowMarket
@@ -1346,6 +2073,416 @@ func (m *MarketMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledMarketUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMarketUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMarketUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &Market{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Market == nil {
+				m.Market = &Market{}
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMarket(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
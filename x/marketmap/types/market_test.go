@@ -120,6 +120,44 @@ var (
 		},
 	}
 
+	usdtusdShadow = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: slinkytypes.CurrencyPair{
+				Base:  "USDT",
+				Quote: "USD",
+			},
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			State:            types.MarketStateShadow,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "usdt-usd",
+			},
+		},
+	}
+
+	usdtusdInvalidState = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: slinkytypes.CurrencyPair{
+				Base:  "USDT",
+				Quote: "USD",
+			},
+			Decimals:         8,
+			MinProviderCount: 1,
+			Enabled:          true,
+			State:            "listed",
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "usdt-usd",
+			},
+		},
+	}
+
 	usdcusdDisabled = types.Market{
 		Ticker: types.Ticker{
 			CurrencyPair: slinkytypes.CurrencyPair{
@@ -203,6 +241,28 @@ var (
 		},
 	}
 
+	pepeusd = types.Market{
+		Ticker: types.Ticker{
+			CurrencyPair: slinkytypes.CurrencyPair{
+				Base:  "PEPE",
+				Quote: "USD",
+			},
+			Decimals:         18,
+			MinProviderCount: 1,
+			Enabled:          true,
+		},
+		ProviderConfigs: []types.ProviderConfig{
+			{
+				Name:           "kucoin",
+				OffChainTicker: "pepe-eth",
+				NormalizeByPairs: []types.ConversionPair{
+					{Pair: ethusdt.Ticker.CurrencyPair},
+					{Pair: usdtusd.Ticker.CurrencyPair},
+				},
+			},
+		},
+	}
+
 	markets = map[string]types.Market{
 		btcusdt.Ticker.String(): btcusdt,
 		btcusd.Ticker.String():  btcusd,
@@ -445,6 +505,35 @@ func TestMarketMapGetValidSubset(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "conversion path with a missing intermediate market, remove entire market",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				pepeusd.Ticker.String(): pepeusd,
+				usdtusd.Ticker.String(): usdtusd,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				usdtusd.Ticker.String(): usdtusd,
+			}},
+		},
+		{
+			name: "shadow market is kept",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				usdtusd.Ticker.String(): usdtusdShadow,
+				btcusd.Ticker.String():  btcusd,
+			}},
+			validSubset: types.MarketMap{Markets: map[string]types.Market{
+				usdtusd.Ticker.String(): usdtusdShadow,
+				btcusd.Ticker.String():  btcusd,
+			}},
+		},
+		{
+			name: "market with an invalid state is removed, along with the provider configs normalized by it",
+			marketMap: types.MarketMap{Markets: map[string]types.Market{
+				usdtusd.Ticker.String(): usdtusdInvalidState,
+				btcusd.Ticker.String():  btcusd,
+			}},
+			validSubset: emptyMM,
+		},
 		{
 			name:        "invalid disabled normalize, remove entire market",
 			marketMap:   types.MarketMap{Markets: partiallyValidMarkets1},
@@ -600,6 +689,155 @@ func TestMarketMapValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid conversion path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					pepeusd.Ticker.String(): pepeusd,
+					ethusdt.Ticker.String(): ethusdt,
+					usdtusd.Ticker.String(): usdtusd,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "conversion path with a missing intermediate market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					pepeusd.Ticker.String(): pepeusd,
+					usdtusd.Ticker.String(): usdtusd,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "conversion path that does not end in the ticker's quote",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					pepeusd.Ticker.String(): {
+						Ticker: pepeusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "kucoin",
+								OffChainTicker: "pepe-eth",
+								NormalizeByPairs: []types.ConversionPair{
+									{Pair: ethusdt.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+					ethusdt.Ticker.String(): ethusdt,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "cyclic conversion path",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusd.Ticker.String(): {
+						Ticker: btcusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "kucoin",
+								OffChainTicker: "btc-eth",
+								NormalizeByPairs: []types.ConversionPair{
+									{Pair: ethusdt.Ticker.CurrencyPair},
+									{Pair: btcusdt.Ticker.CurrencyPair, Invert: true},
+									{Pair: btcusd.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+					btcusdt.Ticker.String(): btcusdt,
+					ethusdt.Ticker.String(): ethusdt,
+					usdtusd.Ticker.String(): usdtusd,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "markets normalized by each other",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdt.Ticker.String(): {
+						Ticker: btcusdt.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "btc-usd",
+								NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+							},
+						},
+					},
+					usdtusd.Ticker.String(): {
+						Ticker: usdtusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "usdt-btc",
+								NormalizeByPair: &btcusdt.Ticker.CurrencyPair,
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "markets normalized by each other with provider configs outside of the cycle",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					btcusdt.Ticker.String(): {
+						Ticker: btcusdt.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "btc-usd",
+								NormalizeByPair: &usdtusd.Ticker.CurrencyPair,
+							},
+							{
+								Name:           "binance",
+								OffChainTicker: "btc-usdt",
+							},
+						},
+					},
+					usdtusd.Ticker.String(): {
+						Ticker: usdtusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:            "kucoin",
+								OffChainTicker:  "usdt-btc",
+								NormalizeByPair: &btcusdt.Ticker.CurrencyPair,
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "cycle through a conversion path of another market",
+			marketMap: types.MarketMap{
+				Markets: map[string]types.Market{
+					pepeusd.Ticker.String(): pepeusd,
+					ethusdt.Ticker.String(): ethusdt,
+					usdtusd.Ticker.String(): {
+						Ticker: usdtusd.Ticker,
+						ProviderConfigs: []types.ProviderConfig{
+							{
+								Name:           "kucoin",
+								OffChainTicker: "usdt-pepe",
+								NormalizeByPairs: []types.ConversionPair{
+									{Pair: pepeusd.Ticker.CurrencyPair},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "valid single provider",
 			marketMap: types.MarketMap{
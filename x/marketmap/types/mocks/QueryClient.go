@@ -173,6 +173,80 @@ func (_c *QueryClient_Market_Call) RunAndReturn(run func(context.Context, *types
 	return _c
 }
 
+// MarketAuthorityGrants provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketAuthorityGrants(ctx context.Context, in *types.MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*types.MarketAuthorityGrantsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketAuthorityGrants")
+	}
+
+	var r0 *types.MarketAuthorityGrantsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) (*types.MarketAuthorityGrantsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) *types.MarketAuthorityGrantsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketAuthorityGrantsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketAuthorityGrants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketAuthorityGrants'
+type QueryClient_MarketAuthorityGrants_Call struct {
+	*mock.Call
+}
+
+// MarketAuthorityGrants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketAuthorityGrantsRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketAuthorityGrants(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketAuthorityGrants_Call {
+	return &QueryClient_MarketAuthorityGrants_Call{Call: _e.mock.On("MarketAuthorityGrants",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketAuthorityGrants_Call) Run(run func(ctx context.Context, in *types.MarketAuthorityGrantsRequest, opts ...grpc.CallOption)) *QueryClient_MarketAuthorityGrants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketAuthorityGrantsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketAuthorityGrants_Call) Return(_a0 *types.MarketAuthorityGrantsResponse, _a1 error) *QueryClient_MarketAuthorityGrants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketAuthorityGrants_Call) RunAndReturn(run func(context.Context, *types.MarketAuthorityGrantsRequest, ...grpc.CallOption) (*types.MarketAuthorityGrantsResponse, error)) *QueryClient_MarketAuthorityGrants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarketMap provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) MarketMap(ctx context.Context, in *types.MarketMapRequest, opts ...grpc.CallOption) (*types.MarketMapResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -247,6 +321,228 @@ func (_c *QueryClient_MarketMap_Call) RunAndReturn(run func(context.Context, *ty
 	return _c
 }
 
+// MarketMapUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketMapUpdates(ctx context.Context, in *types.MarketMapUpdatesRequest, opts ...grpc.CallOption) (*types.MarketMapUpdatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketMapUpdates")
+	}
+
+	var r0 *types.MarketMapUpdatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapUpdatesRequest, ...grpc.CallOption) (*types.MarketMapUpdatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketMapUpdatesRequest, ...grpc.CallOption) *types.MarketMapUpdatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketMapUpdatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketMapUpdatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketMapUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketMapUpdates'
+type QueryClient_MarketMapUpdates_Call struct {
+	*mock.Call
+}
+
+// MarketMapUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketMapUpdatesRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketMapUpdates(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketMapUpdates_Call {
+	return &QueryClient_MarketMapUpdates_Call{Call: _e.mock.On("MarketMapUpdates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketMapUpdates_Call) Run(run func(ctx context.Context, in *types.MarketMapUpdatesRequest, opts ...grpc.CallOption)) *QueryClient_MarketMapUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketMapUpdatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketMapUpdates_Call) Return(_a0 *types.MarketMapUpdatesResponse, _a1 error) *QueryClient_MarketMapUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketMapUpdates_Call) RunAndReturn(run func(context.Context, *types.MarketMapUpdatesRequest, ...grpc.CallOption) (*types.MarketMapUpdatesResponse, error)) *QueryClient_MarketMapUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarketRevisions provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketRevisions(ctx context.Context, in *types.MarketRevisionsRequest, opts ...grpc.CallOption) (*types.MarketRevisionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketRevisions")
+	}
+
+	var r0 *types.MarketRevisionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketRevisionsRequest, ...grpc.CallOption) (*types.MarketRevisionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketRevisionsRequest, ...grpc.CallOption) *types.MarketRevisionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketRevisionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketRevisionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketRevisions'
+type QueryClient_MarketRevisions_Call struct {
+	*mock.Call
+}
+
+// MarketRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketRevisionsRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketRevisions(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketRevisions_Call {
+	return &QueryClient_MarketRevisions_Call{Call: _e.mock.On("MarketRevisions",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketRevisions_Call) Run(run func(ctx context.Context, in *types.MarketRevisionsRequest, opts ...grpc.CallOption)) *QueryClient_MarketRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketRevisionsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketRevisions_Call) Return(_a0 *types.MarketRevisionsResponse, _a1 error) *QueryClient_MarketRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketRevisions_Call) RunAndReturn(run func(context.Context, *types.MarketRevisionsRequest, ...grpc.CallOption) (*types.MarketRevisionsResponse, error)) *QueryClient_MarketRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarketRevisionsByHeight provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) MarketRevisionsByHeight(ctx context.Context, in *types.MarketRevisionsByHeightRequest, opts ...grpc.CallOption) (*types.MarketRevisionsByHeightResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarketRevisionsByHeight")
+	}
+
+	var r0 *types.MarketRevisionsByHeightResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketRevisionsByHeightRequest, ...grpc.CallOption) (*types.MarketRevisionsByHeightResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.MarketRevisionsByHeightRequest, ...grpc.CallOption) *types.MarketRevisionsByHeightResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarketRevisionsByHeightResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.MarketRevisionsByHeightRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_MarketRevisionsByHeight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarketRevisionsByHeight'
+type QueryClient_MarketRevisionsByHeight_Call struct {
+	*mock.Call
+}
+
+// MarketRevisionsByHeight is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.MarketRevisionsByHeightRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) MarketRevisionsByHeight(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_MarketRevisionsByHeight_Call {
+	return &QueryClient_MarketRevisionsByHeight_Call{Call: _e.mock.On("MarketRevisionsByHeight",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_MarketRevisionsByHeight_Call) Run(run func(ctx context.Context, in *types.MarketRevisionsByHeightRequest, opts ...grpc.CallOption)) *QueryClient_MarketRevisionsByHeight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.MarketRevisionsByHeightRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_MarketRevisionsByHeight_Call) Return(_a0 *types.MarketRevisionsByHeightResponse, _a1 error) *QueryClient_MarketRevisionsByHeight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_MarketRevisionsByHeight_Call) RunAndReturn(run func(context.Context, *types.MarketRevisionsByHeightRequest, ...grpc.CallOption) (*types.MarketRevisionsByHeightResponse, error)) *QueryClient_MarketRevisionsByHeight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Markets provides a mock function with given fields: ctx, in, opts
 func (_m *QueryClient) Markets(ctx context.Context, in *types.MarketsRequest, opts ...grpc.CallOption) (*types.MarketsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -395,6 +691,80 @@ func (_c *QueryClient_Params_Call) RunAndReturn(run func(context.Context, *types
 	return _c
 }
 
+// ScheduledMarketUpdates provides a mock function with given fields: ctx, in, opts
+func (_m *QueryClient) ScheduledMarketUpdates(ctx context.Context, in *types.ScheduledMarketUpdatesRequest, opts ...grpc.CallOption) (*types.ScheduledMarketUpdatesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduledMarketUpdates")
+	}
+
+	var r0 *types.ScheduledMarketUpdatesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ScheduledMarketUpdatesRequest, ...grpc.CallOption) (*types.ScheduledMarketUpdatesResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.ScheduledMarketUpdatesRequest, ...grpc.CallOption) *types.ScheduledMarketUpdatesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ScheduledMarketUpdatesResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.ScheduledMarketUpdatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryClient_ScheduledMarketUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduledMarketUpdates'
+type QueryClient_ScheduledMarketUpdates_Call struct {
+	*mock.Call
+}
+
+// ScheduledMarketUpdates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *types.ScheduledMarketUpdatesRequest
+//   - opts ...grpc.CallOption
+func (_e *QueryClient_Expecter) ScheduledMarketUpdates(ctx interface{}, in interface{}, opts ...interface{}) *QueryClient_ScheduledMarketUpdates_Call {
+	return &QueryClient_ScheduledMarketUpdates_Call{Call: _e.mock.On("ScheduledMarketUpdates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *QueryClient_ScheduledMarketUpdates_Call) Run(run func(ctx context.Context, in *types.ScheduledMarketUpdatesRequest, opts ...grpc.CallOption)) *QueryClient_ScheduledMarketUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*types.ScheduledMarketUpdatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *QueryClient_ScheduledMarketUpdates_Call) Return(_a0 *types.ScheduledMarketUpdatesResponse, _a1 error) *QueryClient_ScheduledMarketUpdates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryClient_ScheduledMarketUpdates_Call) RunAndReturn(run func(context.Context, *types.ScheduledMarketUpdatesRequest, ...grpc.CallOption) (*types.ScheduledMarketUpdatesResponse, error)) *QueryClient_ScheduledMarketUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewQueryClient creates a new instance of QueryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQueryClient(t interface {
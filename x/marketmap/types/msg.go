@@ -15,6 +15,8 @@ var (
 	_ sdk.Msg = &MsgRemoveMarketAuthorities{}
 	_ sdk.Msg = &MsgUpsertMarkets{}
 	_ sdk.Msg = &MsgRemoveMarkets{}
+	_ sdk.Msg = &MsgScheduleMarketUpdates{}
+	_ sdk.Msg = &MsgCancelScheduledMarketUpdates{}
 )
 
 // ValidateBasic asserts that the authority address in the upsert-markets message is formatted correctly.
@@ -155,3 +157,26 @@ func (m *MsgRemoveMarkets) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address, the height is set and all markets w/in the message are valid.
+func (m *MsgScheduleMarketUpdates) ValidateBasic() error {
+	update := ScheduledMarketUpdate{
+		Authority: m.Authority,
+		Height:    m.Height,
+		Markets:   m.Markets,
+	}
+
+	return update.ValidateBasic()
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the signer is a valid acc-address.
+func (m *MsgCancelScheduledMarketUpdates) ValidateBasic() error {
+	// validate signer address
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	return nil
+}
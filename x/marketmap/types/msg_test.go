@@ -577,3 +577,77 @@ func TestValidateBasicMsgRemoveMarkets(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgScheduleMarketUpdates(t *testing.T) {
+	rng := sample.Rand()
+
+	tcs := []struct {
+		name       string
+		msg        types.MsgScheduleMarketUpdates
+		expectPass bool
+	}{
+		{
+			"if the Authority is not an acc-address - fail",
+			types.MsgScheduleMarketUpdates{
+				Authority: "invalid",
+				Height:    100,
+				Markets:   []types.Market{btcusdt},
+			},
+			false,
+		},
+		{
+			name: "invalid message (no height) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Markets:   []types.Market{btcusdt},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (no markets) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    100,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (duplicate markets) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    100,
+				Markets:   []types.Market{btcusdt, btcusdt},
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid message (invalid market) - fail",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    100,
+				Markets:   []types.Market{{Ticker: btcusdt.Ticker}},
+			},
+			expectPass: false,
+		},
+		{
+			name: "valid message",
+			msg: types.MsgScheduleMarketUpdates{
+				Authority: sample.Address(rng),
+				Height:    100,
+				Markets:   []types.Market{btcusdt, usdtusd},
+			},
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
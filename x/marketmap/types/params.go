@@ -8,23 +8,34 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// DefaultMaxMarketRevisions is the default maximum number of revisions retained per market. A
+// MaxMarketRevisions of zero disables the market history: no revisions are recorded, and the
+// retained revisions are removed.
+const DefaultMaxMarketRevisions = 10
+
+// MaxMaxMarketRevisions is the upper bound of the MaxMarketRevisions parameter. The retained revisions of a market
+// are loaded in full when they are queried, and each of them holds up to two copies of the market.
+const MaxMaxMarketRevisions uint64 = 1_000
+
 // DefaultParams returns default marketmap parameters.
 func DefaultParams() Params {
 	return Params{
-		MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
-		Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		MarketAuthorities:  []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+		Admin:              authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		MaxMarketRevisions: DefaultMaxMarketRevisions,
 	}
 }
 
-// NewParams returns a new Params instance.
+// NewParams returns a new Params instance that retains the default number of revisions per market.
 func NewParams(authorities []string, admin string) (Params, error) {
 	if authorities == nil {
 		return Params{}, fmt.Errorf("cannot create Params with nil authority")
 	}
 
 	return Params{
-		MarketAuthorities: authorities,
-		Admin:             admin,
+		MarketAuthorities:  authorities,
+		Admin:              admin,
+		MaxMarketRevisions: DefaultMaxMarketRevisions,
 	}, nil
 }
 
@@ -51,5 +62,19 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("invalid marketmap admin string: %w", err)
 	}
 
+	if p.MaxMarketRevisions > MaxMaxMarketRevisions {
+		return fmt.Errorf("max market revisions cannot exceed %d: %d", MaxMaxMarketRevisions, p.MaxMarketRevisions)
+	}
+
+	for _, grant := range p.MarketAuthorityGrants {
+		if err := grant.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, found := seenAuthorities[grant.Authority]; found {
+			return fmt.Errorf("market authority %s cannot also have a market authority grant", grant.Authority)
+		}
+	}
+
 	return nil
 }
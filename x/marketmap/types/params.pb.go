@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -30,6 +31,17 @@ type Params struct {
 	// Admin is an address that can remove addresses from the MarketAuthorities
 	// list. Only governance can add to the MarketAuthorities or change the Admin.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	// MarketAuthorityGrants is the list of scoped permissions of accounts that
+	// are not MarketAuthorities. Each grant allows an account to perform a set of
+	// actions on the markets in the scope of the grant. Only governance can change
+	// the grants, and the Admin can remove them.
+	MarketAuthorityGrants []MarketAuthorityGrant `protobuf:"bytes,3,rep,name=market_authority_grants,json=marketAuthorityGrants,proto3" json:"market_authority_grants"`
+	// MaxMarketRevisions is the maximum number of revisions retained per market.
+	// The oldest revisions of a market are pruned once it has more, including at
+	// the beginning of every block after this is lowered. If zero, no revisions
+	// are recorded and the retained revisions are removed. This cannot exceed
+	// 1000.
+	MaxMarketRevisions uint64 `protobuf:"varint,4,opt,name=max_market_revisions,json=maxMarketRevisions,proto3" json:"max_market_revisions,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,26 +91,142 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMarketAuthorityGrants() []MarketAuthorityGrant {
+	if m != nil {
+		return m.MarketAuthorityGrants
+	}
+	return nil
+}
+
+func (m *Params) GetMaxMarketRevisions() uint64 {
+	if m != nil {
+		return m.MaxMarketRevisions
+	}
+	return 0
+}
+
+// MarketAuthorityGrant allows an account to perform a set of actions on a
+// subset of the markets in the marketmap. A market is in the scope of the grant
+// if its ticker is in Tickers or its quote asset is in QuoteAssets (or if both
+// are empty), and its ticker is not in ExcludedTickers.
+type MarketAuthorityGrant struct {
+	// Authority is the account that is granted the permissions.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Actions is the list of actions that the authority may perform on the
+	// markets in scope. The supported actions are "create", "update_ticker",
+	// "update_provider_configs", "set_enabled" and "remove".
+	Actions []string `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	// Tickers is the list of tickers, i.e. BTC/USD, in the scope of the grant.
+	Tickers []string `protobuf:"bytes,3,rep,name=tickers,proto3" json:"tickers,omitempty"`
+	// QuoteAssets is the list of quote assets, i.e. USD, whose markets are in
+	// the scope of the grant.
+	QuoteAssets []string `protobuf:"bytes,4,rep,name=quote_assets,json=quoteAssets,proto3" json:"quote_assets,omitempty"`
+	// ExcludedTickers is the list of tickers that are never in the scope of the
+	// grant, even if their quote asset is in QuoteAssets.
+	ExcludedTickers []string `protobuf:"bytes,5,rep,name=excluded_tickers,json=excludedTickers,proto3" json:"excluded_tickers,omitempty"`
+}
+
+func (m *MarketAuthorityGrant) Reset()         { *m = MarketAuthorityGrant{} }
+func (m *MarketAuthorityGrant) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityGrant) ProtoMessage()    {}
+func (*MarketAuthorityGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee4934564ff92a6f, []int{1}
+}
+func (m *MarketAuthorityGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketAuthorityGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityGrant.Merge(m, src)
+}
+func (m *MarketAuthorityGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketAuthorityGrant proto.InternalMessageInfo
+
+func (m *MarketAuthorityGrant) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MarketAuthorityGrant) GetActions() []string {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *MarketAuthorityGrant) GetTickers() []string {
+	if m != nil {
+		return m.Tickers
+	}
+	return nil
+}
+
+func (m *MarketAuthorityGrant) GetQuoteAssets() []string {
+	if m != nil {
+		return m.QuoteAssets
+	}
+	return nil
+}
+
+func (m *MarketAuthorityGrant) GetExcludedTickers() []string {
+	if m != nil {
+		return m.ExcludedTickers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "slinky.marketmap.v1.Params")
+	proto.RegisterType((*MarketAuthorityGrant)(nil), "slinky.marketmap.v1.MarketAuthorityGrant")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/params.proto", fileDescriptor_ee4934564ff92a6f) }
 
 var fileDescriptor_ee4934564ff92a6f = []byte{
-	// 190 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x28, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xc9, 0x4d, 0x2c, 0xd0, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8,
-	0xd0, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x54, 0xf2, 0xe5, 0x62, 0x0b, 0x00, 0x2b, 0x12, 0xd2, 0xe5,
-	0x12, 0x82, 0xc8, 0xc4, 0x27, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x96, 0x64, 0xa6, 0x16, 0x4b,
-	0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0x09, 0x42, 0x64, 0x1c, 0x11, 0x12, 0x42, 0x22, 0x5c, 0xac,
-	0x89, 0x29, 0xb9, 0x99, 0x79, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x93, 0xfb,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
-	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94,
-	0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x1b, 0x1a, 0x1a, 0x5a, 0xea, 0xfa, 0x24, 0x26, 0x15, 0xeb,
-	0x43, 0x1d, 0x5d, 0x81, 0xe4, 0xec, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x9b, 0x8d,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x5d, 0x6f, 0x87, 0xd7, 0x00, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0xe3, 0xfe, 0x43, 0x71, 0x91, 0x00, 0x13, 0x44, 0x84, 0x50, 0x08, 0x9d, 0xd2, 0xa1,
+	0x09, 0x81, 0x89, 0xb1, 0x5d, 0xba, 0x80, 0x84, 0x22, 0x26, 0x96, 0xc8, 0x4d, 0xad, 0xd4, 0x6a,
+	0x1d, 0x87, 0xd8, 0xa9, 0x92, 0x6f, 0xc1, 0xa7, 0xe1, 0x33, 0x74, 0xec, 0x78, 0xd3, 0xe9, 0xd4,
+	0x2e, 0xf7, 0x31, 0x4e, 0xb1, 0x9b, 0xf6, 0xee, 0xd4, 0x2d, 0xef, 0xf3, 0x7b, 0xde, 0xc7, 0xaf,
+	0xe3, 0x17, 0xba, 0x62, 0x43, 0xb3, 0x75, 0x1d, 0x30, 0x5c, 0xac, 0x89, 0x64, 0x38, 0x0f, 0xb6,
+	0x61, 0x90, 0xe3, 0x02, 0x33, 0xe1, 0xe7, 0x05, 0x97, 0x1c, 0xbd, 0xd5, 0x0e, 0xff, 0xec, 0xf0,
+	0xb7, 0xe1, 0x07, 0x2b, 0xe5, 0x29, 0x57, 0x3c, 0x68, 0xbe, 0xb4, 0x75, 0x74, 0x0f, 0xe0, 0xe0,
+	0x97, 0xea, 0x45, 0x13, 0x88, 0x74, 0x43, 0x8c, 0x4b, 0xb9, 0xe2, 0x05, 0x95, 0x94, 0x08, 0x1b,
+	0xb8, 0x5d, 0xcf, 0x8c, 0xde, 0x68, 0x32, 0xbd, 0x00, 0x64, 0xc1, 0x3e, 0x5e, 0x32, 0x9a, 0xd9,
+	0x1d, 0x17, 0x78, 0x66, 0xa4, 0x0b, 0x94, 0xc2, 0xf7, 0xcf, 0x42, 0xea, 0x38, 0x2d, 0x70, 0x26,
+	0x85, 0xdd, 0x75, 0xbb, 0xde, 0xf0, 0xeb, 0xd8, 0xbf, 0x32, 0x9c, 0xff, 0xf3, 0x49, 0x7c, 0x3d,
+	0x6f, 0x3a, 0x66, 0xbd, 0xdd, 0xed, 0x27, 0x23, 0x7a, 0xc7, 0xae, 0x30, 0x81, 0xbe, 0x40, 0x8b,
+	0xe1, 0x2a, 0x3e, 0x1d, 0x56, 0x90, 0x2d, 0x15, 0x94, 0x67, 0xc2, 0xee, 0xb9, 0xc0, 0xeb, 0x45,
+	0x88, 0xe1, 0x4a, 0x67, 0x46, 0x2d, 0x19, 0xfd, 0x07, 0xd0, 0xba, 0x76, 0x0e, 0xfa, 0x08, 0xcd,
+	0xf3, 0xb0, 0x36, 0x50, 0xb7, 0xb9, 0x08, 0xc8, 0x86, 0x2f, 0x70, 0x22, 0x55, 0x76, 0x47, 0xfd,
+	0x8b, 0xb6, 0x6c, 0x88, 0xa4, 0xc9, 0x9a, 0x14, 0xfa, 0x6e, 0x66, 0xd4, 0x96, 0xe8, 0x33, 0x7c,
+	0xf9, 0xb7, 0xe4, 0x92, 0xc4, 0x58, 0x08, 0x22, 0x9b, 0xa1, 0x1a, 0x3c, 0x54, 0xda, 0x54, 0x49,
+	0x68, 0x0c, 0x5f, 0x93, 0x2a, 0xd9, 0x94, 0x4b, 0xb2, 0x8c, 0xdb, 0x94, 0xbe, 0xb2, 0xbd, 0x6a,
+	0xf5, 0xdf, 0x5a, 0x9e, 0xcd, 0x77, 0x07, 0x07, 0xec, 0x0f, 0x0e, 0xb8, 0x3b, 0x38, 0xe0, 0xdf,
+	0xd1, 0x31, 0xf6, 0x47, 0xc7, 0xb8, 0x39, 0x3a, 0xc6, 0x9f, 0x49, 0x4a, 0xe5, 0xaa, 0x5c, 0xf8,
+	0x09, 0x67, 0x41, 0x18, 0x86, 0xdf, 0x27, 0x3f, 0xf0, 0x42, 0x04, 0xa7, 0xfd, 0xa8, 0x1e, 0x6d,
+	0x88, 0xac, 0x73, 0x22, 0x16, 0x03, 0xf5, 0xe6, 0xdf, 0x1e, 0x06, 0x00, 0xb7, 0x3b, 0x8d, 0x38,
+	0x42, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +249,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMarketRevisions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMarketRevisions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MarketAuthorityGrants) > 0 {
+		for iNdEx := len(m.MarketAuthorityGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketAuthorityGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -140,6 +287,72 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarketAuthorityGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExcludedTickers) > 0 {
+		for iNdEx := len(m.ExcludedTickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedTickers[iNdEx])
+			copy(dAtA[i:], m.ExcludedTickers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ExcludedTickers[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.QuoteAssets) > 0 {
+		for iNdEx := len(m.QuoteAssets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.QuoteAssets[iNdEx])
+			copy(dAtA[i:], m.QuoteAssets[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.QuoteAssets[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Tickers) > 0 {
+		for iNdEx := len(m.Tickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tickers[iNdEx])
+			copy(dAtA[i:], m.Tickers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Tickers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actions[iNdEx])
+			copy(dAtA[i:], m.Actions[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Actions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -167,6 +380,52 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.MarketAuthorityGrants) > 0 {
+		for _, e := range m.MarketAuthorityGrants {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxMarketRevisions != 0 {
+		n += 1 + sovParams(uint64(m.MaxMarketRevisions))
+	}
+	return n
+}
+
+func (m *MarketAuthorityGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, s := range m.Actions {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Tickers) > 0 {
+		for _, s := range m.Tickers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.QuoteAssets) > 0 {
+		for _, s := range m.QuoteAssets {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.ExcludedTickers) > 0 {
+		for _, s := range m.ExcludedTickers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +528,269 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketAuthorityGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketAuthorityGrants = append(m.MarketAuthorityGrants, MarketAuthorityGrant{})
+			if err := m.MarketAuthorityGrants[len(m.MarketAuthorityGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMarketRevisions", wireType)
+			}
+			m.MaxMarketRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMarketRevisions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tickers = append(m.Tickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAssets = append(m.QuoteAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedTickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedTickers = append(m.ExcludedTickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -63,6 +63,66 @@ func TestValidateBasic(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid market authority grant",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityGrants: []types.MarketAuthorityGrant{
+					{
+						Authority:   authtypes.NewModuleAddress(authtypes.ModuleName).String(),
+						Actions:     []string{types.MarketActionCreate},
+						QuoteAssets: []string{"USDT"},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid market authority grant",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityGrants: []types.MarketAuthorityGrant{
+					{
+						Authority: authtypes.NewModuleAddress(authtypes.ModuleName).String(),
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid market authority grant for a market authority",
+			params: types.Params{
+				MarketAuthorities: []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MarketAuthorityGrants: []types.MarketAuthorityGrant{
+					{
+						Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+						Actions:   []string{types.MarketActionCreate},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid max market revisions at the upper bound",
+			params: types.Params{
+				MarketAuthorities:  []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:              authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MaxMarketRevisions: types.MaxMaxMarketRevisions,
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid max market revisions above the upper bound",
+			params: types.Params{
+				MarketAuthorities:  []string{authtypes.NewModuleAddress(govtypes.ModuleName).String()},
+				Admin:              authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				MaxMarketRevisions: types.MaxMaxMarketRevisions + 1,
+			},
+			expectErr: true,
+		},
 		{
 			name:      "invalid empty params",
 			params:    types.Params{},
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/1119-Labs/slinky/pkg/json"
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
 )
 
 // ValidateBasic performs basic validation on a ProviderConfig.
@@ -23,6 +24,35 @@ func (pc *ProviderConfig) ValidateBasic() error {
 		}
 	}
 
+	if len(pc.NormalizeByPairs) > 0 {
+		if pc.NormalizeByPair != nil {
+			return fmt.Errorf("provider config cannot set both normalize by pair and normalize by pairs")
+		}
+
+		seenPairs := make(map[string]struct{}, len(pc.NormalizeByPairs))
+		for i, step := range pc.NormalizeByPairs {
+			if err := step.Pair.ValidateBasic(); err != nil {
+				return err
+			}
+
+			if _, seen := seenPairs[step.Pair.String()]; seen {
+				return fmt.Errorf("duplicate normalize by pair %s", step.Pair.String())
+			}
+			seenPairs[step.Pair.String()] = struct{}{}
+
+			// each step must convert from the currency the previous step converted to
+			if i > 0 {
+				prev := pc.NormalizeByPairs[i-1].Converted()
+				if next := step.Converted(); prev.Quote != next.Base {
+					return fmt.Errorf(
+						"normalize by pair %s cannot follow %s: expected a pair with base %s",
+						next.String(), prev.String(), prev.Quote,
+					)
+				}
+			}
+		}
+	}
+
 	if len(pc.Metadata_JSON) > MaxMetadataJSONFieldLength {
 		return fmt.Errorf("metadata json field is longer than maximum length of %d", MaxMetadataJSONFieldLength)
 	}
@@ -62,5 +92,80 @@ func (pc *ProviderConfig) Equal(other ProviderConfig) bool {
 		}
 	}
 
+	if len(pc.NormalizeByPairs) != len(other.NormalizeByPairs) {
+		return false
+	}
+
+	for i, step := range pc.NormalizeByPairs {
+		if !step.Equal(other.NormalizeByPairs[i]) {
+			return false
+		}
+	}
+
 	return pc.Metadata_JSON == other.Metadata_JSON
 }
+
+// ConversionPairs returns the ordered conversion steps used to normalize the provider price, i.e. the
+// NormalizeByPairs if set, or a single non-inverted step for the NormalizeByPair if set.
+func (pc *ProviderConfig) ConversionPairs() []ConversionPair {
+	if len(pc.NormalizeByPairs) > 0 {
+		return pc.NormalizeByPairs
+	}
+
+	if pc.NormalizeByPair != nil {
+		return []ConversionPair{{Pair: *pc.NormalizeByPair}}
+	}
+
+	return nil
+}
+
+// validateConversionPath checks that the NormalizeByPairs of the provider config convert a provider
+// price into the given ticker. The path must end in the ticker's quote and must not visit the same
+// currency twice, which would make the conversion cyclic (e.g. normalizing by the ticker itself).
+func (pc *ProviderConfig) validateConversionPath(ticker Ticker) error {
+	if len(pc.NormalizeByPairs) == 0 {
+		return nil
+	}
+
+	last := pc.NormalizeByPairs[len(pc.NormalizeByPairs)-1].Converted()
+	if last.Quote != ticker.CurrencyPair.Quote {
+		return fmt.Errorf(
+			"provider's (%s) conversion path for %s ends in %s",
+			pc.Name, ticker.String(), last.Quote,
+		)
+	}
+
+	// the provider price is quoted in the base of the first step, and each step converts it to its quote
+	visited := map[string]struct{}{ticker.CurrencyPair.Base: {}}
+	currencies := []string{pc.NormalizeByPairs[0].Converted().Base}
+	for _, step := range pc.NormalizeByPairs {
+		currencies = append(currencies, step.Converted().Quote)
+	}
+
+	for _, currency := range currencies {
+		if _, seen := visited[currency]; seen {
+			return fmt.Errorf(
+				"provider's (%s) conversion path for %s is cyclic: %s is visited more than once",
+				pc.Name, ticker.String(), currency,
+			)
+		}
+		visited[currency] = struct{}{}
+	}
+
+	return nil
+}
+
+// Converted returns the currency pair that this step converts by, i.e. the inverse of the pair if the
+// step is inverted.
+func (cp *ConversionPair) Converted() slinkytypes.CurrencyPair {
+	if cp.Invert {
+		return cp.Pair.Invert()
+	}
+
+	return cp.Pair
+}
+
+// Equal returns true iff the ConversionPair is equal to the given ConversionPair.
+func (cp *ConversionPair) Equal(other ConversionPair) bool {
+	return cp.Invert == other.Invert && cp.Pair.Equal(other.Pair)
+}
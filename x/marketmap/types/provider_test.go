@@ -52,6 +52,60 @@ func TestProviderConfigValidateBasic(t *testing.T) {
 		}
 		require.Error(t, pc.ValidateBasic())
 	})
+	t.Run("valid config with a conversion path - pass", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.ConversionPair{
+				{Pair: slinkytypes.NewCurrencyPair("ETH", "USDT")},
+				{Pair: slinkytypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+			},
+		}
+		require.NoError(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with both normalize by fields - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:            "mexc",
+			OffChainTicker:  "ticker",
+			NormalizeByPair: &slinkytypes.CurrencyPair{Base: "USDT", Quote: "USD"},
+			NormalizeByPairs: []types.ConversionPair{
+				{Pair: slinkytypes.NewCurrencyPair("USDT", "USD")},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with an invalid conversion pair - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.ConversionPair{
+				{Pair: slinkytypes.CurrencyPair{Base: "BASE", Quote: ""}},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with a broken conversion path - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.ConversionPair{
+				{Pair: slinkytypes.NewCurrencyPair("ETH", "USDT")},
+				{Pair: slinkytypes.NewCurrencyPair("USDC", "USD")},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
+	t.Run("invalid config with a repeated conversion pair - fail", func(t *testing.T) {
+		pc := types.ProviderConfig{
+			Name:           "mexc",
+			OffChainTicker: "ticker",
+			NormalizeByPairs: []types.ConversionPair{
+				{Pair: slinkytypes.NewCurrencyPair("ETH", "USDT")},
+				{Pair: slinkytypes.NewCurrencyPair("ETH", "USDT"), Invert: true},
+			},
+		}
+		require.Error(t, pc.ValidateBasic())
+	})
 	t.Run("invalid name - fail", func(t *testing.T) {
 		pc := types.ProviderConfig{
 			Name:           "",
@@ -209,6 +263,26 @@ func TestProviderConfigEqual(t *testing.T) {
 			},
 			exp: false,
 		},
+		{
+			name: "different conversion path",
+			pc: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.ConversionPair{
+					{Pair: slinkytypes.NewCurrencyPair("ETH", "USDT")},
+					{Pair: slinkytypes.NewCurrencyPair("USDT", "USD")},
+				},
+			},
+			other: types.ProviderConfig{
+				Name:           "mexc",
+				OffChainTicker: "ticker",
+				NormalizeByPairs: []types.ConversionPair{
+					{Pair: slinkytypes.NewCurrencyPair("ETH", "USDT")},
+					{Pair: slinkytypes.NewCurrencyPair("USD", "USDT"), Invert: true},
+				},
+			},
+			exp: false,
+		},
 		{
 			name: "different metadata",
 			pc: types.ProviderConfig{
@@ -478,739 +478,2693 @@ func (m *LastUpdatedResponse) GetLastUpdated() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MarketMapRequest)(nil), "slinky.marketmap.v1.MarketMapRequest")
-	proto.RegisterType((*MarketMapResponse)(nil), "slinky.marketmap.v1.MarketMapResponse")
-	proto.RegisterType((*MarketsRequest)(nil), "slinky.marketmap.v1.MarketsRequest")
-	proto.RegisterType((*MarketsResponse)(nil), "slinky.marketmap.v1.MarketsResponse")
-	proto.RegisterType((*MarketRequest)(nil), "slinky.marketmap.v1.MarketRequest")
-	proto.RegisterType((*MarketResponse)(nil), "slinky.marketmap.v1.MarketResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "slinky.marketmap.v1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "slinky.marketmap.v1.ParamsResponse")
-	proto.RegisterType((*LastUpdatedRequest)(nil), "slinky.marketmap.v1.LastUpdatedRequest")
-	proto.RegisterType((*LastUpdatedResponse)(nil), "slinky.marketmap.v1.LastUpdatedResponse")
+// MarketAuthorityGrantsRequest is the query request for the
+// MarketAuthorityGrants query.
+type MarketAuthorityGrantsRequest struct {
+	// Authority is an optional account to return the grants of. If empty, the
+	// grants of all market authorities are returned.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 }
 
-func init() { proto.RegisterFile("slinky/marketmap/v1/query.proto", fileDescriptor_b5d6ff68f3c474a0) }
-
-var fileDescriptor_b5d6ff68f3c474a0 = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0x12, 0x41,
-	0x14, 0x66, 0xfa, 0x0b, 0x79, 0x94, 0xb6, 0x4e, 0x7b, 0x40, 0xda, 0x6e, 0xb7, 0x4b, 0xad, 0x78,
-	0xe8, 0x6e, 0xc0, 0x8b, 0x8d, 0xb7, 0xf6, 0xa0, 0xa6, 0xad, 0xa9, 0x24, 0x1e, 0xf4, 0x42, 0x06,
-	0xd8, 0xd0, 0x4d, 0xd9, 0xdd, 0xe9, 0xce, 0x42, 0xc4, 0x63, 0x0f, 0x5e, 0xbc, 0x98, 0x98, 0xf8,
-	0x37, 0xf5, 0xd8, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x3f, 0xc4, 0xec, 0xfc, 0xa0, 0x60, 0x61, 0xf0,
-	0xc6, 0xbe, 0xf9, 0xde, 0xf7, 0x7d, 0xf3, 0xde, 0x37, 0xc0, 0x0e, 0x6b, 0x7b, 0xc1, 0x65, 0xcf,
-	0xf1, 0x49, 0x74, 0xe9, 0xc6, 0x3e, 0xa1, 0x4e, 0xb7, 0xec, 0x5c, 0x75, 0xdc, 0xa8, 0x67, 0xd3,
-	0x28, 0x8c, 0x43, 0xbc, 0x2e, 0x00, 0xf6, 0x10, 0x60, 0x77, 0xcb, 0x85, 0x8d, 0x56, 0xd8, 0x0a,
-	0xf9, 0xb9, 0x93, 0xfc, 0x12, 0xd0, 0xc2, 0x56, 0x2b, 0x0c, 0x5b, 0x6d, 0xd7, 0x21, 0xd4, 0x73,
-	0x48, 0x10, 0x84, 0x31, 0x89, 0xbd, 0x30, 0x60, 0xf2, 0xb4, 0x28, 0x95, 0xe2, 0x1e, 0x75, 0x59,
-	0xa2, 0xd2, 0xe8, 0x44, 0x91, 0x1b, 0x34, 0x7a, 0x35, 0x4a, 0xbc, 0x48, 0x82, 0xcc, 0x49, 0x76,
-	0xc4, 0x87, 0x0e, 0x41, 0x49, 0x44, 0x7c, 0x29, 0x64, 0x61, 0x58, 0x3b, 0xe3, 0x87, 0x67, 0x84,
-	0x56, 0xdd, 0xab, 0x8e, 0xcb, 0x62, 0xeb, 0x3b, 0x82, 0x87, 0x23, 0x45, 0x46, 0xc3, 0x80, 0xb9,
-	0xf8, 0x18, 0x40, 0xd0, 0xd4, 0x7c, 0x42, 0xf3, 0xc8, 0x44, 0xa5, 0x6c, 0xc5, 0xb0, 0x27, 0x5c,
-	0xd8, 0x1e, 0xf6, 0x1e, 0x2d, 0xdc, 0xfc, 0xda, 0x49, 0x55, 0x33, 0xbe, 0x2a, 0xe0, 0x5d, 0x58,
-	0x6e, 0x13, 0x16, 0xd7, 0x3a, 0xb4, 0x49, 0x62, 0xb7, 0x99, 0x9f, 0x33, 0x51, 0x69, 0xa1, 0x9a,
-	0x4d, 0x6a, 0xef, 0x44, 0x09, 0x3f, 0x82, 0x07, 0x8d, 0x0b, 0xe2, 0x05, 0x35, 0xaf, 0x99, 0x9f,
-	0x37, 0x51, 0x29, 0x53, 0x4d, 0xf3, 0xef, 0xd7, 0x4d, 0x6b, 0x0d, 0x56, 0x04, 0x37, 0x53, 0x56,
-	0xdf, 0xc0, 0xea, 0xb0, 0x22, 0x7d, 0xbe, 0x80, 0xb4, 0xd0, 0x63, 0x79, 0x64, 0xce, 0x97, 0xb2,
-	0x95, 0x4d, 0x8d, 0x49, 0xe9, 0x50, 0x75, 0x58, 0xef, 0x21, 0x27, 0x0e, 0xa4, 0x00, 0x7e, 0x05,
-	0xb9, 0xb1, 0xd1, 0xcb, 0x8b, 0x6f, 0x2b, 0x4e, 0xbe, 0xa0, 0x84, 0xef, 0x58, 0xa2, 0xce, 0x89,
-	0x17, 0x49, 0xd6, 0xe5, 0xc6, 0x48, 0xcd, 0x3a, 0x51, 0xe6, 0x87, 0x4e, 0x0f, 0x61, 0x49, 0xe8,
-	0x4a, 0xd2, 0xff, 0x30, 0x2a, 0x1b, 0xac, 0x55, 0xc8, 0x9d, 0xf3, 0x35, 0xaa, 0x41, 0x9c, 0xc0,
-	0x8a, 0x2a, 0xdc, 0xb1, 0x8b, 0x4d, 0x6b, 0xd9, 0x45, 0x93, 0x62, 0x17, 0x0d, 0xd6, 0x06, 0xe0,
-	0xd3, 0xbb, 0x8d, 0x28, 0x89, 0xe7, 0xb0, 0x3e, 0x56, 0x95, 0x3a, 0xff, 0xae, 0x14, 0xdd, 0x5b,
-	0x69, 0xe5, 0xf3, 0x22, 0x2c, 0xbe, 0x4d, 0x9e, 0x09, 0xbe, 0x46, 0x90, 0x19, 0xc6, 0x03, 0x3f,
-	0xd6, 0xc7, 0x47, 0x0a, 0x17, 0xf6, 0x67, 0xc1, 0x84, 0x13, 0x6b, 0xff, 0xfa, 0xc7, 0x9f, 0x6f,
-	0x73, 0x26, 0x36, 0x9c, 0xe9, 0x0f, 0xc3, 0x27, 0x14, 0x7f, 0x82, 0xb4, 0x0c, 0x0d, 0x2e, 0x6a,
-	0xa8, 0xd5, 0x6c, 0x0b, 0x7b, 0x7a, 0x90, 0x54, 0xdf, 0xe3, 0xea, 0x06, 0xde, 0xd2, 0xa8, 0x33,
-	0xdc, 0x85, 0x25, 0xd1, 0x88, 0x2d, 0x0d, 0xab, 0x52, 0x2e, 0x6a, 0x31, 0x52, 0xb8, 0xc8, 0x85,
-	0xb7, 0xf1, 0xa6, 0x46, 0x18, 0x7f, 0x41, 0x90, 0x1d, 0xd9, 0x1e, 0x7e, 0x32, 0x91, 0xf9, 0xfe,
-	0xd6, 0x0b, 0xa5, 0xd9, 0x40, 0xe9, 0xe3, 0x29, 0xf7, 0x51, 0xc4, 0xbb, 0x13, 0x7d, 0x8c, 0x66,
-	0x24, 0x99, 0x82, 0x08, 0xde, 0x94, 0x29, 0x8c, 0x65, 0x7b, 0xca, 0x14, 0xc6, 0xe3, 0x3e, 0x63,
-	0x0a, 0x22, 0xd8, 0x47, 0x2f, 0x6f, 0xfa, 0x06, 0xba, 0xed, 0x1b, 0xe8, 0x77, 0xdf, 0x40, 0x5f,
-	0x07, 0x46, 0xea, 0x76, 0x60, 0xa4, 0x7e, 0x0e, 0x8c, 0xd4, 0x87, 0x83, 0x96, 0x17, 0x5f, 0x74,
-	0xea, 0x76, 0x23, 0xf4, 0x9d, 0x72, 0xb9, 0x7c, 0x78, 0x70, 0x4a, 0xea, 0x4c, 0x51, 0x7d, 0x1c,
-	0x21, 0xe3, 0x0f, 0xbe, 0xbe, 0xc4, 0xff, 0x3d, 0x9f, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xfe,
-	0x02, 0xba, 0x0b, 0x12, 0x06, 0x00, 0x00,
+func (m *MarketAuthorityGrantsRequest) Reset()         { *m = MarketAuthorityGrantsRequest{} }
+func (m *MarketAuthorityGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityGrantsRequest) ProtoMessage()    {}
+func (*MarketAuthorityGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{10}
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityGrantsRequest.Merge(m, src)
+}
+func (m *MarketAuthorityGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityGrantsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MarketAuthorityGrantsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.  NOTE: the value returned by this query is not safe for on-chain
-	// code.
-	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
-	// Market returns all stored in the x/marketmap
-	// module as a sorted list.
-	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+func (m *MarketAuthorityGrantsRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// MarketAuthorityGrantsResponse is the query response for the
+// MarketAuthorityGrants query.
+type MarketAuthorityGrantsResponse struct {
+	// Grants is the list of grants of the market authorities.
+	Grants []MarketAuthorityGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *MarketAuthorityGrantsResponse) Reset()         { *m = MarketAuthorityGrantsResponse{} }
+func (m *MarketAuthorityGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketAuthorityGrantsResponse) ProtoMessage()    {}
+func (*MarketAuthorityGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{11}
 }
-
-func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
-	out := new(MarketMapResponse)
-	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/MarketMap", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MarketAuthorityGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
-	out := new(MarketsResponse)
-	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Markets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MarketAuthorityGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketAuthorityGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MarketAuthorityGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketAuthorityGrantsResponse.Merge(m, src)
+}
+func (m *MarketAuthorityGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketAuthorityGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketAuthorityGrantsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
-	out := new(MarketResponse)
-	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Market", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketAuthorityGrantsResponse proto.InternalMessageInfo
+
+func (m *MarketAuthorityGrantsResponse) GetGrants() []MarketAuthorityGrant {
+	if m != nil {
+		return m.Grants
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
-	out := new(LastUpdatedResponse)
-	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/LastUpdated", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ScheduledMarketUpdatesRequest is the query request for the
+// ScheduledMarketUpdates query. It takes no arguments.
+type ScheduledMarketUpdatesRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScheduledMarketUpdatesRequest) Reset()         { *m = ScheduledMarketUpdatesRequest{} }
+func (m *ScheduledMarketUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketUpdatesRequest) ProtoMessage()    {}
+func (*ScheduledMarketUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{12}
+}
+func (m *ScheduledMarketUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMarketUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// MarketMap returns the full market map stored in the x/marketmap
-	// module.  NOTE: the value returned by this query is not safe for on-chain
-	// code.
-	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
-	// Market returns all stored in the x/marketmap
-	// module as a sorted list.
-	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
-	// Market returns a market stored in the x/marketmap
-	// module.
-	Market(context.Context, *MarketRequest) (*MarketResponse, error)
-	// LastUpdated returns the last height the market map was updated at.
-	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
-	// Params returns the current x/marketmap module parameters.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+func (m *ScheduledMarketUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketUpdatesRequest.Merge(m, src)
+}
+func (m *ScheduledMarketUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMarketUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketUpdatesRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_ScheduledMarketUpdatesRequest proto.InternalMessageInfo
+
+// ScheduledMarketUpdatesResponse is the query response for the
+// ScheduledMarketUpdates query.
+type ScheduledMarketUpdatesResponse struct {
+	// ScheduledUpdates is the list of pending market updates, ordered by
+	// activation height.
+	ScheduledUpdates []ScheduledMarketUpdate `protobuf:"bytes,1,rep,name=scheduled_updates,json=scheduledUpdates,proto3" json:"scheduled_updates"`
 }
 
-func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+func (m *ScheduledMarketUpdatesResponse) Reset()         { *m = ScheduledMarketUpdatesResponse{} }
+func (m *ScheduledMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMarketUpdatesResponse) ProtoMessage()    {}
+func (*ScheduledMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{13}
 }
-func (*UnimplementedQueryServer) Markets(ctx context.Context, req *MarketsRequest) (*MarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+func (m *ScheduledMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+func (m *ScheduledMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+func (m *ScheduledMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMarketUpdatesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ScheduledMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ScheduledMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMarketUpdatesResponse.DiscardUnknown(m)
 }
 
-func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketMapRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MarketMap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.marketmap.v1.Query/MarketMap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+var xxx_messageInfo_ScheduledMarketUpdatesResponse proto.InternalMessageInfo
+
+func (m *ScheduledMarketUpdatesResponse) GetScheduledUpdates() []ScheduledMarketUpdate {
+	if m != nil {
+		return m.ScheduledUpdates
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Markets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.marketmap.v1.Query/Markets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Markets(ctx, req.(*MarketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MarketRevisionsRequest is the query request for the MarketRevisions query.
+type MarketRevisionsRequest struct {
+	// CurrencyPair is the currency pair associated with the market whose
+	// revisions are requested.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
 }
 
-func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MarketRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Market(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.marketmap.v1.Query/Market",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+func (m *MarketRevisionsRequest) Reset()         { *m = MarketRevisionsRequest{} }
+func (m *MarketRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketRevisionsRequest) ProtoMessage()    {}
+func (*MarketRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{14}
+}
+func (m *MarketRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRevisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MarketRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRevisionsRequest.Merge(m, src)
+}
+func (m *MarketRevisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRevisionsRequest.DiscardUnknown(m)
 }
 
-func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LastUpdatedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastUpdated(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.marketmap.v1.Query/LastUpdated",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+var xxx_messageInfo_MarketRevisionsRequest proto.InternalMessageInfo
+
+func (m *MarketRevisionsRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.CurrencyPair{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.marketmap.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MarketRevisionsResponse is the query response for the MarketRevisions
+// query.
+type MarketRevisionsResponse struct {
+	// Revisions is the list of revisions of the market.
+	Revisions []MarketRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "slinky.marketmap.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "MarketMap",
-			Handler:    _Query_MarketMap_Handler,
-		},
-		{
-			MethodName: "Markets",
-			Handler:    _Query_Markets_Handler,
-		},
-		{
-			MethodName: "Market",
-			Handler:    _Query_Market_Handler,
-		},
-		{
-			MethodName: "LastUpdated",
-			Handler:    _Query_LastUpdated_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "slinky/marketmap/v1/query.proto",
+func (m *MarketRevisionsResponse) Reset()         { *m = MarketRevisionsResponse{} }
+func (m *MarketRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketRevisionsResponse) ProtoMessage()    {}
+func (*MarketRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{15}
 }
-
-func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRevisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRevisionsResponse.Merge(m, src)
 }
-
-func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketRevisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRevisionsResponse.DiscardUnknown(m)
 }
 
-func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketRevisionsResponse proto.InternalMessageInfo
+
+func (m *MarketRevisionsResponse) GetRevisions() []MarketRevision {
+	if m != nil {
+		return m.Revisions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketRevisionsByHeightRequest is the query request for the
+// MarketRevisionsByHeight query.
+type MarketRevisionsByHeightRequest struct {
+	// StartHeight is the first block height (inclusive) of the range.
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// EndHeight is the last block height (inclusive) of the range.
+	EndHeight uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketRevisionsByHeightRequest) Reset()         { *m = MarketRevisionsByHeightRequest{} }
+func (m *MarketRevisionsByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketRevisionsByHeightRequest) ProtoMessage()    {}
+func (*MarketRevisionsByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{16}
+}
+func (m *MarketRevisionsByHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRevisionsByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRevisionsByHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MarketRevisionsByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRevisionsByHeightRequest.Merge(m, src)
 }
-
-func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketRevisionsByHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRevisionsByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRevisionsByHeightRequest.DiscardUnknown(m)
 }
 
-func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MarketRevisionsByHeightRequest proto.InternalMessageInfo
+
+func (m *MarketRevisionsByHeightRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketRevisionsByHeightRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketRevisionsByHeightResponse is the query response for the
+// MarketRevisionsByHeight query.
+type MarketRevisionsByHeightResponse struct {
+	// Revisions is the list of revisions made within the range.
+	Revisions []MarketRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
 }
 
-func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *MarketRevisionsByHeightResponse) Reset()         { *m = MarketRevisionsByHeightResponse{} }
+func (m *MarketRevisionsByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketRevisionsByHeightResponse) ProtoMessage()    {}
+func (*MarketRevisionsByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{17}
+}
+func (m *MarketRevisionsByHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketRevisionsByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketRevisionsByHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MarketRevisionsByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketRevisionsByHeightResponse.Merge(m, src)
+}
+func (m *MarketRevisionsByHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketRevisionsByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketRevisionsByHeightResponse.DiscardUnknown(m)
 }
 
-func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketRevisionsByHeightResponse proto.InternalMessageInfo
+
+func (m *MarketRevisionsByHeightResponse) GetRevisions() []MarketRevision {
+	if m != nil {
+		return m.Revisions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketMapUpdatesRequest is the query request for the MarketMapUpdates query.
+type MarketMapUpdatesRequest struct {
+	// SinceHeight is the block height of the client's copy of the market map.
+	// Only changes made after this height are returned.
+	SinceHeight uint64 `protobuf:"varint,1,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
 }
 
-func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarketMapUpdatesRequest) Reset()         { *m = MarketMapUpdatesRequest{} }
+func (m *MarketMapUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketMapUpdatesRequest) ProtoMessage()    {}
+func (*MarketMapUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{18}
+}
+func (m *MarketMapUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarketMapUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapUpdatesRequest.Merge(m, src)
+}
+func (m *MarketMapUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapUpdatesRequest.DiscardUnknown(m)
 }
 
-func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketMapUpdatesRequest proto.InternalMessageInfo
+
+func (m *MarketMapUpdatesRequest) GetSinceHeight() uint64 {
+	if m != nil {
+		return m.SinceHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MarketMapUpdatesResponse is the query response for the MarketMapUpdates
+// query.
+type MarketMapUpdatesResponse struct {
+	// Markets is the list of markets that were created or updated after the
+	// requested height, in their current state.
+	Markets []Market `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets"`
+	// RemovedTickers is the list of tickers (BASE/QUOTE) of the markets that were
+	// removed after the requested height.
+	RemovedTickers []string `protobuf:"bytes,2,rep,name=removed_tickers,json=removedTickers,proto3" json:"removed_tickers,omitempty"`
+	// Height is the block height the response is current as of. Clients should
+	// pass it as the since height of their next request.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// LastUpdated is the last block height that the market map was updated.
+	LastUpdated uint64 `protobuf:"varint,4,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// ChainId is the chain identifier for the market map.
+	ChainId string `protobuf:"bytes,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// FullSyncRequired is true if the changes made after the requested height
+	// are not tracked, in which case no markets are returned and the client must
+	// fetch the full market map instead.
+	FullSyncRequired bool `protobuf:"varint,6,opt,name=full_sync_required,json=fullSyncRequired,proto3" json:"full_sync_required,omitempty"`
 }
 
-func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *MarketMapUpdatesResponse) Reset()         { *m = MarketMapUpdatesResponse{} }
+func (m *MarketMapUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketMapUpdatesResponse) ProtoMessage()    {}
+func (*MarketMapUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d6ff68f3c474a0, []int{19}
+}
+func (m *MarketMapUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketMapUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketMapUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarketMapUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketMapUpdatesResponse.Merge(m, src)
+}
+func (m *MarketMapUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketMapUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketMapUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MarketMapUpdatesResponse proto.InternalMessageInfo
+
+func (m *MarketMapUpdatesResponse) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketMapUpdatesResponse) GetRemovedTickers() []string {
+	if m != nil {
+		return m.RemovedTickers
+	}
+	return nil
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MarketMapUpdatesResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MarketMapUpdatesResponse) GetLastUpdated() uint64 {
+	if m != nil {
+		return m.LastUpdated
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MarketMapUpdatesResponse) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *MarketMapUpdatesResponse) GetFullSyncRequired() bool {
+	if m != nil {
+		return m.FullSyncRequired
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*MarketMapRequest)(nil), "slinky.marketmap.v1.MarketMapRequest")
+	proto.RegisterType((*MarketMapResponse)(nil), "slinky.marketmap.v1.MarketMapResponse")
+	proto.RegisterType((*MarketsRequest)(nil), "slinky.marketmap.v1.MarketsRequest")
+	proto.RegisterType((*MarketsResponse)(nil), "slinky.marketmap.v1.MarketsResponse")
+	proto.RegisterType((*MarketRequest)(nil), "slinky.marketmap.v1.MarketRequest")
+	proto.RegisterType((*MarketResponse)(nil), "slinky.marketmap.v1.MarketResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "slinky.marketmap.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "slinky.marketmap.v1.ParamsResponse")
+	proto.RegisterType((*LastUpdatedRequest)(nil), "slinky.marketmap.v1.LastUpdatedRequest")
+	proto.RegisterType((*LastUpdatedResponse)(nil), "slinky.marketmap.v1.LastUpdatedResponse")
+	proto.RegisterType((*MarketAuthorityGrantsRequest)(nil), "slinky.marketmap.v1.MarketAuthorityGrantsRequest")
+	proto.RegisterType((*MarketAuthorityGrantsResponse)(nil), "slinky.marketmap.v1.MarketAuthorityGrantsResponse")
+	proto.RegisterType((*ScheduledMarketUpdatesRequest)(nil), "slinky.marketmap.v1.ScheduledMarketUpdatesRequest")
+	proto.RegisterType((*ScheduledMarketUpdatesResponse)(nil), "slinky.marketmap.v1.ScheduledMarketUpdatesResponse")
+	proto.RegisterType((*MarketRevisionsRequest)(nil), "slinky.marketmap.v1.MarketRevisionsRequest")
+	proto.RegisterType((*MarketRevisionsResponse)(nil), "slinky.marketmap.v1.MarketRevisionsResponse")
+	proto.RegisterType((*MarketRevisionsByHeightRequest)(nil), "slinky.marketmap.v1.MarketRevisionsByHeightRequest")
+	proto.RegisterType((*MarketRevisionsByHeightResponse)(nil), "slinky.marketmap.v1.MarketRevisionsByHeightResponse")
+	proto.RegisterType((*MarketMapUpdatesRequest)(nil), "slinky.marketmap.v1.MarketMapUpdatesRequest")
+	proto.RegisterType((*MarketMapUpdatesResponse)(nil), "slinky.marketmap.v1.MarketMapUpdatesResponse")
 }
 
-func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("slinky/marketmap/v1/query.proto", fileDescriptor_b5d6ff68f3c474a0) }
+
+var fileDescriptor_b5d6ff68f3c474a0 = []byte{
+	// 1057 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0x55,
+	0x10, 0xcf, 0x26, 0xa9, 0x53, 0x8f, 0xf3, 0xd5, 0xd7, 0x92, 0x1a, 0x37, 0xde, 0x38, 0xeb, 0x36,
+	0x71, 0x21, 0xf6, 0xca, 0x4e, 0x11, 0x54, 0xf4, 0x42, 0x7a, 0x48, 0x51, 0x1b, 0x54, 0x5c, 0x38,
+	0x80, 0x84, 0x56, 0xcf, 0xbb, 0x0f, 0x7b, 0x89, 0xbd, 0xbb, 0xd9, 0x5d, 0x5b, 0x98, 0x0b, 0x52,
+	0xc5, 0x8d, 0x0b, 0x12, 0x12, 0x17, 0x0e, 0xfc, 0x13, 0x1c, 0xb8, 0x70, 0xef, 0xb1, 0x12, 0x17,
+	0x4e, 0x08, 0x25, 0xfc, 0x11, 0x1c, 0x2b, 0xbf, 0x37, 0x6f, 0xe3, 0xcf, 0xcd, 0x46, 0xca, 0x2d,
+	0x9e, 0x37, 0x33, 0xbf, 0xdf, 0x7c, 0x78, 0x7e, 0x0e, 0x6c, 0x05, 0x6d, 0xdb, 0x39, 0xee, 0xeb,
+	0x1d, 0xea, 0x1f, 0xb3, 0xb0, 0x43, 0x3d, 0xbd, 0x57, 0xd5, 0x4f, 0xba, 0xcc, 0xef, 0x57, 0x3c,
+	0xdf, 0x0d, 0x5d, 0x72, 0x53, 0x38, 0x54, 0x22, 0x87, 0x4a, 0xaf, 0x9a, 0xbb, 0xd5, 0x74, 0x9b,
+	0x2e, 0x7f, 0xd7, 0x07, 0x7f, 0x09, 0xd7, 0xdc, 0x66, 0xd3, 0x75, 0x9b, 0x6d, 0xa6, 0x53, 0xcf,
+	0xd6, 0xa9, 0xe3, 0xb8, 0x21, 0x0d, 0x6d, 0xd7, 0x09, 0xf0, 0xb5, 0x88, 0x48, 0x61, 0xdf, 0x63,
+	0xc1, 0x00, 0xc5, 0xec, 0xfa, 0x3e, 0x73, 0xcc, 0xbe, 0xe1, 0x51, 0xdb, 0x47, 0xa7, 0xc2, 0x34,
+	0x3a, 0xe2, 0x43, 0x9c, 0x87, 0x47, 0x7d, 0xda, 0x41, 0x20, 0x8d, 0xc0, 0xfa, 0x11, 0x7f, 0x3c,
+	0xa2, 0x5e, 0x9d, 0x9d, 0x74, 0x59, 0x10, 0x6a, 0xbf, 0x28, 0x70, 0x63, 0xc8, 0x18, 0x78, 0xae,
+	0x13, 0x30, 0xf2, 0x18, 0x40, 0xa4, 0x31, 0x3a, 0xd4, 0xcb, 0x2a, 0x05, 0xa5, 0x94, 0xa9, 0xa9,
+	0x95, 0x29, 0x05, 0x57, 0xa2, 0xd8, 0x83, 0xc5, 0x57, 0xff, 0x6c, 0xcd, 0xd5, 0xd3, 0x1d, 0x69,
+	0x20, 0xdb, 0xb0, 0xdc, 0xa6, 0x41, 0x68, 0x74, 0x3d, 0x8b, 0x86, 0xcc, 0xca, 0xce, 0x17, 0x94,
+	0xd2, 0x62, 0x3d, 0x33, 0xb0, 0x7d, 0x2e, 0x4c, 0xe4, 0x6d, 0xb8, 0x6e, 0xb6, 0xa8, 0xed, 0x18,
+	0xb6, 0x95, 0x5d, 0x28, 0x28, 0xa5, 0x74, 0x7d, 0x89, 0x7f, 0xfe, 0xd8, 0xd2, 0xd6, 0x61, 0x55,
+	0xe4, 0x0e, 0x24, 0xd5, 0x4f, 0x60, 0x2d, 0xb2, 0x20, 0xcf, 0x0f, 0x61, 0x49, 0xe0, 0x05, 0x59,
+	0xa5, 0xb0, 0x50, 0xca, 0xd4, 0xee, 0xc4, 0x90, 0x44, 0x86, 0x32, 0x42, 0xfb, 0x02, 0x56, 0xc4,
+	0x03, 0x02, 0x90, 0x27, 0xb0, 0x32, 0xd2, 0x7a, 0x2c, 0x3c, 0x2f, 0x73, 0xf2, 0x01, 0x0d, 0xf2,
+	0x3d, 0x46, 0xaf, 0xe7, 0xd4, 0xf6, 0x31, 0xeb, 0xb2, 0x39, 0x64, 0xd3, 0x9e, 0x4a, 0xf2, 0x11,
+	0xd3, 0x87, 0x90, 0x12, 0xb8, 0x98, 0x34, 0x01, 0x51, 0x0c, 0xd0, 0xd6, 0x60, 0xe5, 0x39, 0x1f,
+	0xa3, 0x6c, 0xc4, 0x53, 0x58, 0x95, 0x86, 0xf3, 0xec, 0x62, 0xd2, 0xb1, 0xd9, 0x45, 0x90, 0xcc,
+	0x2e, 0x02, 0xb4, 0x5b, 0x40, 0x9e, 0x9d, 0x4f, 0x44, 0x42, 0x7c, 0x00, 0x37, 0x47, 0xac, 0x88,
+	0x33, 0x3e, 0x52, 0x65, 0x62, 0xa4, 0xda, 0x23, 0xd8, 0x14, 0x55, 0x7c, 0xd4, 0x0d, 0x5b, 0xae,
+	0x6f, 0x87, 0xfd, 0x43, 0x9f, 0x3a, 0xd1, 0x14, 0xc9, 0x26, 0xa4, 0xa9, 0x7c, 0xe1, 0xf1, 0xe9,
+	0xfa, 0xb9, 0x41, 0x6b, 0x41, 0x7e, 0x46, 0x34, 0x32, 0x38, 0x84, 0x54, 0x93, 0x5b, 0x70, 0xe0,
+	0xf7, 0x63, 0xfa, 0x38, 0x9a, 0x43, 0xd6, 0x2d, 0xc2, 0xb5, 0x2d, 0xc8, 0xbf, 0x30, 0x5b, 0xcc,
+	0xea, 0xb6, 0x99, 0x25, 0xdc, 0x45, 0x05, 0x51, 0x97, 0xbf, 0x07, 0x75, 0x96, 0x03, 0x72, 0xf9,
+	0x0a, 0x6e, 0x04, 0xd2, 0x03, 0x5b, 0x22, 0x69, 0xbd, 0x33, 0x95, 0xd6, 0xd4, 0x7c, 0xc8, 0x6b,
+	0x3d, 0x4a, 0x85, 0x30, 0x5a, 0x03, 0x36, 0xe4, 0x12, 0xf5, 0xec, 0x60, 0x70, 0x30, 0xae, 0x7e,
+	0x51, 0x1b, 0x70, 0x7b, 0x02, 0x23, 0xea, 0x74, 0xda, 0x97, 0x46, 0xac, 0xaa, 0x18, 0xd3, 0x6c,
+	0x99, 0x40, 0xde, 0x81, 0x28, 0x56, 0x6b, 0x80, 0x3a, 0x86, 0x71, 0xd0, 0x7f, 0xc2, 0xec, 0x66,
+	0x2b, 0xfa, 0xe2, 0x6d, 0xc3, 0x72, 0x10, 0x52, 0x3f, 0x34, 0x5a, 0xdc, 0x2c, 0xd7, 0x8a, 0xdb,
+	0x84, 0x27, 0xc9, 0x03, 0x30, 0xc7, 0x92, 0x0e, 0xe2, 0x94, 0xa4, 0x99, 0x63, 0x89, 0x67, 0xed,
+	0x1b, 0xd8, 0x9a, 0x89, 0x71, 0xd5, 0xf5, 0x3c, 0x92, 0x3d, 0x3b, 0xa2, 0xde, 0xe8, 0xce, 0xf0,
+	0x42, 0x6c, 0xc7, 0x64, 0xe3, 0x85, 0x0c, 0x6c, 0xc8, 0xf4, 0x87, 0x79, 0xc8, 0x4e, 0x86, 0x5f,
+	0xc1, 0x3d, 0x23, 0xbb, 0xb0, 0xe6, 0xb3, 0x8e, 0xdb, 0x63, 0x96, 0x11, 0xda, 0xe6, 0x31, 0xf3,
+	0x83, 0xec, 0x7c, 0x61, 0xa1, 0x94, 0xae, 0xaf, 0xa2, 0xf9, 0x33, 0x61, 0x25, 0x1b, 0x90, 0x42,
+	0x7e, 0x0b, 0x9c, 0x1f, 0x7e, 0x9a, 0xf8, 0x76, 0x2f, 0xc6, 0x1f, 0xec, 0x6b, 0x23, 0x07, 0x9b,
+	0xec, 0x01, 0xf9, 0xba, 0xdb, 0x6e, 0x1b, 0x41, 0xdf, 0x31, 0x0d, 0x9f, 0x9d, 0x74, 0x6d, 0x9f,
+	0x59, 0xd9, 0x54, 0x41, 0x29, 0x5d, 0xaf, 0xaf, 0x0f, 0x5e, 0x5e, 0xf4, 0x1d, 0xb3, 0x8e, 0xf6,
+	0xda, 0xff, 0x19, 0xb8, 0xf6, 0xe9, 0x40, 0x4d, 0xc9, 0x4b, 0x05, 0xd2, 0x51, 0x43, 0xc8, 0xbd,
+	0x78, 0x95, 0xc1, 0x46, 0xe7, 0x76, 0x2e, 0x72, 0x13, 0x0d, 0xd5, 0x76, 0x5e, 0xfe, 0xf5, 0xdf,
+	0xcf, 0xf3, 0x05, 0xa2, 0xea, 0xb3, 0xf5, 0xb3, 0x43, 0x3d, 0xf2, 0x1d, 0x2c, 0x1d, 0x61, 0x1b,
+	0xe3, 0x96, 0x42, 0x0e, 0x3a, 0x77, 0x37, 0xde, 0x09, 0xd1, 0xef, 0x72, 0x74, 0x95, 0x6c, 0xc6,
+	0xa0, 0x07, 0xa4, 0x07, 0x29, 0x11, 0x48, 0xb4, 0xd8, 0x7d, 0x14, 0xc8, 0xf1, 0x3b, 0x8b, 0xc0,
+	0x45, 0x0e, 0x9c, 0x27, 0x77, 0x62, 0x80, 0xc9, 0x8f, 0x0a, 0x64, 0x86, 0x8e, 0x3c, 0xd9, 0x9d,
+	0x9a, 0x79, 0x52, 0x1c, 0x72, 0xa5, 0x8b, 0x1d, 0x91, 0xc7, 0x7d, 0xce, 0xa3, 0x48, 0xb6, 0xa7,
+	0xf2, 0x18, 0x5e, 0xb6, 0x41, 0x17, 0x84, 0x3e, 0xcd, 0xe8, 0xc2, 0x88, 0x04, 0xe6, 0x8a, 0xb1,
+	0x3e, 0x89, 0xba, 0x20, 0xf4, 0x8f, 0xfc, 0xae, 0xc0, 0x5b, 0x53, 0x25, 0x87, 0x54, 0x13, 0x4b,
+	0x4b, 0x44, 0xab, 0x76, 0x99, 0x10, 0x64, 0xf9, 0x80, 0xb3, 0xac, 0x90, 0xbd, 0x98, 0x59, 0x19,
+	0x91, 0x42, 0x1a, 0x42, 0xbe, 0xc8, 0x1f, 0x0a, 0x6c, 0x4c, 0x97, 0x27, 0x52, 0x4b, 0xae, 0x3d,
+	0x11, 0xf1, 0xfd, 0x4b, 0xc5, 0x20, 0xf3, 0xf7, 0x38, 0x73, 0x9d, 0x94, 0xa7, 0x32, 0x3f, 0x97,
+	0x46, 0xac, 0x01, 0x15, 0x92, 0xfc, 0xaa, 0xc0, 0xda, 0xe8, 0x8d, 0x0d, 0xc8, 0xbb, 0x09, 0x2e,
+	0x71, 0x44, 0x76, 0x2f, 0x99, 0x33, 0xb2, 0x2c, 0x73, 0x96, 0xbb, 0xe4, 0x5e, 0x5c, 0x7f, 0xa3,
+	0xeb, 0x4e, 0xfe, 0x54, 0xe0, 0xf6, 0x0c, 0x29, 0x21, 0xfb, 0x49, 0x80, 0xc7, 0xc4, 0x2d, 0xf7,
+	0xe0, 0x72, 0x41, 0xc8, 0xfa, 0x7d, 0xce, 0xba, 0x4a, 0xf4, 0x44, 0xac, 0x8d, 0x46, 0x1f, 0x25,
+	0x87, 0xfc, 0xa6, 0x0c, 0xfd, 0xca, 0x97, 0x2b, 0xb1, 0x17, 0x7f, 0x2e, 0xc7, 0x96, 0xa1, 0x9c,
+	0xd0, 0x1b, 0xa9, 0x56, 0x38, 0xd5, 0x12, 0xd9, 0x89, 0xbf, 0xb1, 0x72, 0xfe, 0x07, 0x87, 0xaf,
+	0x4e, 0x55, 0xe5, 0xf5, 0xa9, 0xaa, 0xfc, 0x7b, 0xaa, 0x2a, 0x3f, 0x9d, 0xa9, 0x73, 0xaf, 0xcf,
+	0xd4, 0xb9, 0xbf, 0xcf, 0xd4, 0xb9, 0x2f, 0xcb, 0x4d, 0x3b, 0x6c, 0x75, 0x1b, 0x15, 0xd3, 0xed,
+	0xe8, 0xd5, 0x6a, 0xf5, 0x61, 0xf9, 0x19, 0x6d, 0x04, 0x32, 0xeb, 0xb7, 0x43, 0x79, 0xf9, 0x0f,
+	0x9c, 0x46, 0x8a, 0xff, 0x5b, 0xb3, 0xff, 0x66, 0x00, 0x87, 0x5c, 0xf1, 0x47, 0xab, 0x0d, 0x00,
+	0x00,
 }
 
-func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MarketMap returns the full market map stored in the x/marketmap
+	// module.  NOTE: the value returned by this query is not safe for on-chain
+	// code.
+	MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error)
+	// Market returns all stored in the x/marketmap
+	// module as a sorted list.
+	Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// MarketAuthorityGrants returns the permissions of each market authority.
+	// MarketAuthorities are returned with a grant of every action on every
+	// market.
+	MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error)
+	// ScheduledMarketUpdates returns the market updates that are queued to be
+	// applied at a future block height, ordered by activation height.
+	ScheduledMarketUpdates(ctx context.Context, in *ScheduledMarketUpdatesRequest, opts ...grpc.CallOption) (*ScheduledMarketUpdatesResponse, error)
+	// MarketRevisions returns the retained history of changes made to a market,
+	// ordered from oldest to newest.
+	MarketRevisions(ctx context.Context, in *MarketRevisionsRequest, opts ...grpc.CallOption) (*MarketRevisionsResponse, error)
+	// MarketRevisionsByHeight returns the retained changes made to any market
+	// within a range of block heights, ordered from oldest to newest.
+	MarketRevisionsByHeight(ctx context.Context, in *MarketRevisionsByHeightRequest, opts ...grpc.CallOption) (*MarketRevisionsByHeightResponse, error)
+	// MarketMapUpdates returns the markets that were created, updated or removed
+	// after a given block height, so that clients can keep a copy of the market
+	// map in sync without downloading all of it.
+	MarketMapUpdates(ctx context.Context, in *MarketMapUpdatesRequest, opts ...grpc.CallOption) (*MarketMapUpdatesResponse, error)
 }
 
-func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MarketMap(ctx context.Context, in *MarketMapRequest, opts ...grpc.CallOption) (*MarketMapResponse, error) {
+	out := new(MarketMapResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/MarketMap", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Markets(ctx context.Context, in *MarketsRequest, opts ...grpc.CallOption) (*MarketsResponse, error) {
+	out := new(MarketsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Market(ctx context.Context, in *MarketRequest, opts ...grpc.CallOption) (*MarketResponse, error) {
+	out := new(MarketResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Market", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) LastUpdated(ctx context.Context, in *LastUpdatedRequest, opts ...grpc.CallOption) (*LastUpdatedResponse, error) {
+	out := new(LastUpdatedResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/LastUpdated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MarketMapRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MarketMapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketAuthorityGrants(ctx context.Context, in *MarketAuthorityGrantsRequest, opts ...grpc.CallOption) (*MarketAuthorityGrantsResponse, error) {
+	out := new(MarketAuthorityGrantsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/MarketAuthorityGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.MarketMap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	return out, nil
+}
+
+func (c *queryClient) ScheduledMarketUpdates(ctx context.Context, in *ScheduledMarketUpdatesRequest, opts ...grpc.CallOption) (*ScheduledMarketUpdatesResponse, error) {
+	out := new(ScheduledMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/ScheduledMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) MarketRevisions(ctx context.Context, in *MarketRevisionsRequest, opts ...grpc.CallOption) (*MarketRevisionsResponse, error) {
+	out := new(MarketRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/MarketRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketRevisionsByHeight(ctx context.Context, in *MarketRevisionsByHeightRequest, opts ...grpc.CallOption) (*MarketRevisionsByHeightResponse, error) {
+	out := new(MarketRevisionsByHeightResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/MarketRevisionsByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MarketMapUpdates(ctx context.Context, in *MarketMapUpdatesRequest, opts ...grpc.CallOption) (*MarketMapUpdatesResponse, error) {
+	out := new(MarketMapUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Query/MarketMapUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MarketMap returns the full market map stored in the x/marketmap
+	// module.  NOTE: the value returned by this query is not safe for on-chain
+	// code.
+	MarketMap(context.Context, *MarketMapRequest) (*MarketMapResponse, error)
+	// Market returns all stored in the x/marketmap
+	// module as a sorted list.
+	Markets(context.Context, *MarketsRequest) (*MarketsResponse, error)
+	// Market returns a market stored in the x/marketmap
+	// module.
+	Market(context.Context, *MarketRequest) (*MarketResponse, error)
+	// LastUpdated returns the last height the market map was updated at.
+	LastUpdated(context.Context, *LastUpdatedRequest) (*LastUpdatedResponse, error)
+	// Params returns the current x/marketmap module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// MarketAuthorityGrants returns the permissions of each market authority.
+	// MarketAuthorities are returned with a grant of every action on every
+	// market.
+	MarketAuthorityGrants(context.Context, *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error)
+	// ScheduledMarketUpdates returns the market updates that are queued to be
+	// applied at a future block height, ordered by activation height.
+	ScheduledMarketUpdates(context.Context, *ScheduledMarketUpdatesRequest) (*ScheduledMarketUpdatesResponse, error)
+	// MarketRevisions returns the retained history of changes made to a market,
+	// ordered from oldest to newest.
+	MarketRevisions(context.Context, *MarketRevisionsRequest) (*MarketRevisionsResponse, error)
+	// MarketRevisionsByHeight returns the retained changes made to any market
+	// within a range of block heights, ordered from oldest to newest.
+	MarketRevisionsByHeight(context.Context, *MarketRevisionsByHeightRequest) (*MarketRevisionsByHeightResponse, error)
+	// MarketMapUpdates returns the markets that were created, updated or removed
+	// after a given block height, so that clients can keep a copy of the market
+	// map in sync without downloading all of it.
+	MarketMapUpdates(context.Context, *MarketMapUpdatesRequest) (*MarketMapUpdatesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MarketMap(ctx context.Context, req *MarketMapRequest) (*MarketMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMap not implemented")
+}
+func (*UnimplementedQueryServer) Markets(ctx context.Context, req *MarketsRequest) (*MarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (*UnimplementedQueryServer) Market(ctx context.Context, req *MarketRequest) (*MarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Market not implemented")
+}
+func (*UnimplementedQueryServer) LastUpdated(ctx context.Context, req *LastUpdatedRequest) (*LastUpdatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastUpdated not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) MarketAuthorityGrants(ctx context.Context, req *MarketAuthorityGrantsRequest) (*MarketAuthorityGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketAuthorityGrants not implemented")
+}
+func (*UnimplementedQueryServer) ScheduledMarketUpdates(ctx context.Context, req *ScheduledMarketUpdatesRequest) (*ScheduledMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledMarketUpdates not implemented")
+}
+func (*UnimplementedQueryServer) MarketRevisions(ctx context.Context, req *MarketRevisionsRequest) (*MarketRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketRevisions not implemented")
+}
+func (*UnimplementedQueryServer) MarketRevisionsByHeight(ctx context.Context, req *MarketRevisionsByHeightRequest) (*MarketRevisionsByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketRevisionsByHeight not implemented")
+}
+func (*UnimplementedQueryServer) MarketMapUpdates(ctx context.Context, req *MarketMapUpdatesRequest) (*MarketMapUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketMapUpdates not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MarketMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/MarketMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMap(ctx, req.(*MarketMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*MarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Market_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Market(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/Market",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Market(ctx, req.(*MarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastUpdated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LastUpdatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastUpdated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/LastUpdated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastUpdated(ctx, req.(*LastUpdatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketAuthorityGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketAuthorityGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/MarketAuthorityGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketAuthorityGrants(ctx, req.(*MarketAuthorityGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledMarketUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/ScheduledMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledMarketUpdates(ctx, req.(*ScheduledMarketUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/MarketRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketRevisions(ctx, req.(*MarketRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketRevisionsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketRevisionsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketRevisionsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/MarketRevisionsByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketRevisionsByHeight(ctx, req.(*MarketRevisionsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketMapUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketMapUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketMapUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Query/MarketMapUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketMapUpdates(ctx, req.(*MarketMapUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.marketmap.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MarketMap",
+			Handler:    _Query_MarketMap_Handler,
+		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "Market",
+			Handler:    _Query_Market_Handler,
+		},
+		{
+			MethodName: "LastUpdated",
+			Handler:    _Query_LastUpdated_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "MarketAuthorityGrants",
+			Handler:    _Query_MarketAuthorityGrants_Handler,
+		},
+		{
+			MethodName: "ScheduledMarketUpdates",
+			Handler:    _Query_ScheduledMarketUpdates_Handler,
+		},
+		{
+			MethodName: "MarketRevisions",
+			Handler:    _Query_MarketRevisions_Handler,
+		},
+		{
+			MethodName: "MarketRevisionsByHeight",
+			Handler:    _Query_MarketRevisionsByHeight_Handler,
+		},
+		{
+			MethodName: "MarketMapUpdates",
+			Handler:    _Query_MarketMapUpdates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slinky/marketmap/v1/query.proto",
+}
+
+func (m *MarketMapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.MarketMap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *LastUpdatedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastUpdatedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastUpdatedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketAuthorityGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketAuthorityGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketAuthorityGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMarketUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledUpdates) > 0 {
+		for iNdEx := len(m.ScheduledUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRevisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRevisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRevisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRevisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRevisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRevisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRevisionsByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRevisionsByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRevisionsByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketRevisionsByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketRevisionsByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketRevisionsByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketMapUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketMapUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketMapUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullSyncRequired {
+		i--
+		if m.FullSyncRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastUpdated != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdated))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RemovedTickers) > 0 {
+		for iNdEx := len(m.RemovedTickers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedTickers[iNdEx])
+			copy(dAtA[i:], m.RemovedTickers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RemovedTickers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MarketMapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketMapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MarketMap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Market.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LastUpdatedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *LastUpdatedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	return n
+}
+
+func (m *MarketAuthorityGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MarketAuthorityGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledMarketUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ScheduledMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ScheduledUpdates) > 0 {
+		for _, e := range m.ScheduledUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketRevisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketRevisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketRevisionsByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *MarketRevisionsByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketMapUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func (m *MarketMapUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemovedTickers) > 0 {
+		for _, s := range m.RemovedTickers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.LastUpdated != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdated))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FullSyncRequired {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *MarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Market.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *LastUpdatedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *LastUpdatedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastUpdated != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdated))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
+func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,12 +3187,31 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			}
+			m.LastUpdated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1260,7 +3233,7 @@ func (m *MarketMapRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketAuthorityGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,17 +3256,17 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketMapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketAuthorityGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketMapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketAuthorityGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1303,49 +3276,79 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarketMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketAuthorityGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.LastUpdated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastUpdated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketAuthorityGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketAuthorityGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1355,23 +3358,25 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.Grants = append(m.Grants, MarketAuthorityGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1394,7 +3399,7 @@ func (m *MarketMapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
+func (m *ScheduledMarketUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1417,10 +3422,10 @@ func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledMarketUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledMarketUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1444,7 +3449,7 @@ func (m *MarketsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduledMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1467,15 +3472,15 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledMarketUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1502,8 +3507,8 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduledUpdates = append(m.ScheduledUpdates, ScheduledMarketUpdate{})
+			if err := m.ScheduledUpdates[len(m.ScheduledUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1528,7 +3533,7 @@ func (m *MarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketRevisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1551,10 +3556,10 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketRevisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketRevisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1611,7 +3616,7 @@ func (m *MarketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MarketResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketRevisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1634,15 +3639,15 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MarketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketRevisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketRevisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Market", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1669,7 +3674,8 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Market.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revisions = append(m.Revisions, MarketRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1694,7 +3700,7 @@ func (m *MarketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketRevisionsByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1717,12 +3723,50 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketRevisionsByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketRevisionsByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1744,7 +3788,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketRevisionsByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1767,15 +3811,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketRevisionsByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketRevisionsByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1802,7 +3846,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Revisions = append(m.Revisions, MarketRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1827,7 +3872,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketMapUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1850,12 +3895,31 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMapUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMapUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1877,7 +3941,7 @@ func (m *LastUpdatedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketMapUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1900,13 +3964,98 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastUpdatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketMapUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastUpdatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketMapUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedTickers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedTickers = append(m.RemovedTickers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdated", wireType)
 			}
@@ -1925,6 +4074,58 @@ func (m *LastUpdatedResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullSyncRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullSyncRequired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
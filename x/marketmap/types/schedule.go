@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of the ScheduledMarketUpdate.
+func (u *ScheduledMarketUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Authority); err != nil {
+		return fmt.Errorf("invalid scheduled update authority string: %w", err)
+	}
+
+	if u.Height == 0 {
+		return fmt.Errorf("scheduled update height must be positive")
+	}
+
+	if len(u.Markets) == 0 {
+		return fmt.Errorf("no markets to upsert")
+	}
+
+	seenTickers := make(map[string]struct{}, len(u.Markets))
+	for _, market := range u.Markets {
+		ticker := market.Ticker.CurrencyPair.String()
+
+		if _, seen := seenTickers[ticker]; seen {
+			return fmt.Errorf("duplicate ticker: %s", ticker)
+		}
+
+		if err := market.ValidateBasic(); err != nil {
+			return err
+		}
+
+		seenTickers[ticker] = struct{}{}
+	}
+
+	return nil
+}
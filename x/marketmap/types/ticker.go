@@ -60,7 +60,7 @@ func (t *Ticker) ValidateBasic() error {
 		return fmt.Errorf("invalid ticker metadata json: %w", err)
 	}
 
-	return nil
+	return t.validateLifecycleState()
 }
 
 // Equal returns true iff the Ticker is equal to the given Ticker.
@@ -69,5 +69,6 @@ func (t *Ticker) Equal(other Ticker) bool {
 		t.Decimals == other.Decimals &&
 		t.MinProviderCount == other.MinProviderCount &&
 		t.Metadata_JSON == other.Metadata_JSON &&
-		t.Enabled == other.Enabled
+		t.Enabled == other.Enabled &&
+		t.State == other.State
 }
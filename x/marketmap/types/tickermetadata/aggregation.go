@@ -0,0 +1,42 @@
+package tickermetadata
+
+import "encoding/json"
+
+// Aggregation is the optional aggregation configuration that may be included in a Ticker.Metadata_JSON alongside
+// any other metadata. It overrides the x/oracle module's aggregation strategy parameter for the Ticker, and selects
+// how the oracle sidecar aggregates provider prices for the Ticker.
+type Aggregation struct {
+	// Strategy is the name of the strategy used to aggregate validator prices for the Ticker, e.g. "median",
+	// "trimmed_mean" or "mad_mean". If empty, the x/oracle module's parameter is used.
+	Strategy string `json:"aggregation_strategy,omitempty"`
+	// ProviderAggregation is the name of the method used by the oracle sidecar to aggregate the prices reported
+	// by each provider into the Ticker's index price, e.g. "median", "volume_weighted_median" or "vwap". If empty,
+	// the median is used.
+	ProviderAggregation string `json:"provider_aggregation,omitempty"`
+}
+
+// NewAggregation returns a new Aggregation instance.
+func NewAggregation(strategy string) Aggregation {
+	return Aggregation{
+		Strategy: strategy,
+	}
+}
+
+// MarshalAggregation returns the JSON byte encoding of the Aggregation.
+func MarshalAggregation(m Aggregation) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// AggregationFromJSONString returns an Aggregation instance from a JSON string.
+func AggregationFromJSONString(jsonString string) (Aggregation, error) {
+	var elem Aggregation
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// AggregationFromJSONBytes returns an Aggregation instance from JSON bytes.
+func AggregationFromJSONBytes(jsonBytes []byte) (Aggregation, error) {
+	var elem Aggregation
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
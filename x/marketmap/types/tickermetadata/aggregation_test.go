@@ -0,0 +1,45 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalAggregation(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewAggregation("trimmed_mean")
+
+		bz, err := tickermetadata.MarshalAggregation(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.AggregationFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal the aggregation from other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"reference_price":1,"liquidity":2,"aggregate_ids":[],"max_price_change":"0.1","aggregation_strategy":"mad_mean"}`
+		elem, err := tickermetadata.AggregationFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewAggregation("mad_mean"), elem)
+	})
+
+	t.Run("can unmarshal the provider aggregation", func(t *testing.T) {
+		elemJSON := `{"aggregation_strategy":"median","provider_aggregation":"vwap"}`
+		elem, err := tickermetadata.AggregationFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.Aggregation{Strategy: "median", ProviderAggregation: "vwap"}, elem)
+	})
+
+	t.Run("metadata without an aggregation unmarshals to an empty struct", func(t *testing.T) {
+		elem, err := tickermetadata.AggregationFromJSONString(`{"aggregate_ids":[]}`)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.Aggregation{}, elem)
+	})
+}
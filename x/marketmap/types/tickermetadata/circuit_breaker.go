@@ -0,0 +1,37 @@
+package tickermetadata
+
+import "encoding/json"
+
+// CircuitBreaker is the optional circuit breaker configuration that may be included in a Ticker.Metadata_JSON
+// alongside any other metadata. It overrides the x/oracle module's circuit breaker parameters for the Ticker.
+type CircuitBreaker struct {
+	// MaxPriceChange is the maximum fractional change (as a decimal string, e.g. "0.25") allowed between a newly
+	// aggregated price and the Ticker's reference price. If empty, the x/oracle module's parameter is used.
+	MaxPriceChange string `json:"max_price_change,omitempty"`
+}
+
+// NewCircuitBreaker returns a new CircuitBreaker instance.
+func NewCircuitBreaker(maxPriceChange string) CircuitBreaker {
+	return CircuitBreaker{
+		MaxPriceChange: maxPriceChange,
+	}
+}
+
+// MarshalCircuitBreaker returns the JSON byte encoding of the CircuitBreaker.
+func MarshalCircuitBreaker(m CircuitBreaker) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// CircuitBreakerFromJSONString returns a CircuitBreaker instance from a JSON string.
+func CircuitBreakerFromJSONString(jsonString string) (CircuitBreaker, error) {
+	var elem CircuitBreaker
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// CircuitBreakerFromJSONBytes returns a CircuitBreaker instance from JSON bytes.
+func CircuitBreakerFromJSONBytes(jsonBytes []byte) (CircuitBreaker, error) {
+	var elem CircuitBreaker
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
@@ -0,0 +1,37 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalCircuitBreaker(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewCircuitBreaker("0.25")
+
+		bz, err := tickermetadata.MarshalCircuitBreaker(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.CircuitBreakerFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal the circuit breaker from other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"reference_price":1,"liquidity":2,"aggregate_ids":[],"max_price_change":"0.1"}`
+		elem, err := tickermetadata.CircuitBreakerFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewCircuitBreaker("0.1"), elem)
+	})
+
+	t.Run("metadata without a circuit breaker unmarshals to an empty struct", func(t *testing.T) {
+		elem, err := tickermetadata.CircuitBreakerFromJSONString(`{"aggregate_ids":[]}`)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.CircuitBreaker{}, elem)
+	})
+}
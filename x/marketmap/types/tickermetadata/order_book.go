@@ -0,0 +1,42 @@
+package tickermetadata
+
+import "encoding/json"
+
+// OrderBook is the optional order book configuration that may be included in a ProviderConfig.Metadata_JSON alongside
+// any other metadata. It selects how websocket providers that support order book pricing price the ProviderConfig's
+// ticker. Order book pricing is harder to manipulate than the last trade price for illiquid markets.
+type OrderBook struct {
+	// Pricing is the method used to price the ticker, i.e. "last_trade", "mid" or "depth_weighted". If empty, the last
+	// trade (or ticker) price reported by the provider is used.
+	Pricing string `json:"order_book_pricing,omitempty"`
+	// DepthNotional is the notional, in units of the quote currency, that the depth weighted price is calculated at, as
+	// a decimal string (e.g. "10000"). It is required for, and only used by, depth weighted pricing.
+	DepthNotional string `json:"order_book_depth_notional,omitempty"`
+}
+
+// NewOrderBook returns a new OrderBook instance.
+func NewOrderBook(pricing, depthNotional string) OrderBook {
+	return OrderBook{
+		Pricing:       pricing,
+		DepthNotional: depthNotional,
+	}
+}
+
+// MarshalOrderBook returns the JSON byte encoding of the OrderBook.
+func MarshalOrderBook(m OrderBook) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// OrderBookFromJSONString returns an OrderBook instance from a JSON string.
+func OrderBookFromJSONString(jsonString string) (OrderBook, error) {
+	var elem OrderBook
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// OrderBookFromJSONBytes returns an OrderBook instance from JSON bytes.
+func OrderBookFromJSONBytes(jsonBytes []byte) (OrderBook, error) {
+	var elem OrderBook
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
@@ -0,0 +1,37 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalOrderBook(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewOrderBook("depth_weighted", "10000")
+
+		bz, err := tickermetadata.MarshalOrderBook(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.OrderBookFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal the order book from other provider config metadata", func(t *testing.T) {
+		elemJSON := `{"address":"0x1","base_decimals":18,"order_book_pricing":"mid"}`
+		elem, err := tickermetadata.OrderBookFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewOrderBook("mid", ""), elem)
+	})
+
+	t.Run("metadata without an order book unmarshals to an empty struct", func(t *testing.T) {
+		elem, err := tickermetadata.OrderBookFromJSONString(`{"address":"0x1"}`)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.OrderBook{}, elem)
+	})
+}
@@ -0,0 +1,46 @@
+package tickermetadata
+
+import "encoding/json"
+
+// OutlierFilter is the optional outlier filter configuration that may be included in a Ticker.Metadata_JSON alongside
+// any other metadata. It configures how the oracle sidecar rejects outlying provider prices for the Ticker before they
+// are aggregated. Each bound is a decimal string (e.g. "0.05"), and an empty bound disables the corresponding filter.
+type OutlierFilter struct {
+	// MaxIndexPriceDeviation is the maximum fractional deviation of a provider price from the Ticker's previous index
+	// price.
+	MaxIndexPriceDeviation string `json:"max_index_price_deviation,omitempty"`
+	// MADMultiplier is the maximum deviation of a provider price from the median of the provider prices, as a multiple
+	// of their median absolute deviation (MAD).
+	MADMultiplier string `json:"outlier_mad_multiplier,omitempty"`
+	// MaxMedianDeviation is the maximum fractional deviation of a provider price from the median of the provider
+	// prices.
+	MaxMedianDeviation string `json:"max_median_deviation,omitempty"`
+}
+
+// NewOutlierFilter returns a new OutlierFilter instance.
+func NewOutlierFilter(maxIndexPriceDeviation, madMultiplier, maxMedianDeviation string) OutlierFilter {
+	return OutlierFilter{
+		MaxIndexPriceDeviation: maxIndexPriceDeviation,
+		MADMultiplier:          madMultiplier,
+		MaxMedianDeviation:     maxMedianDeviation,
+	}
+}
+
+// MarshalOutlierFilter returns the JSON byte encoding of the OutlierFilter.
+func MarshalOutlierFilter(m OutlierFilter) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+// OutlierFilterFromJSONString returns an OutlierFilter instance from a JSON string.
+func OutlierFilterFromJSONString(jsonString string) (OutlierFilter, error) {
+	var elem OutlierFilter
+	err := json.Unmarshal([]byte(jsonString), &elem)
+	return elem, err
+}
+
+// OutlierFilterFromJSONBytes returns an OutlierFilter instance from JSON bytes.
+func OutlierFilterFromJSONBytes(jsonBytes []byte) (OutlierFilter, error) {
+	var elem OutlierFilter
+	err := json.Unmarshal(jsonBytes, &elem)
+	return elem, err
+}
@@ -0,0 +1,37 @@
+package tickermetadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+)
+
+func Test_UnmarshalOutlierFilter(t *testing.T) {
+	t.Run("can marshal and unmarshal the same struct and values", func(t *testing.T) {
+		elem := tickermetadata.NewOutlierFilter("0.1", "3", "0.05")
+
+		bz, err := tickermetadata.MarshalOutlierFilter(elem)
+		require.NoError(t, err)
+
+		elem2, err := tickermetadata.OutlierFilterFromJSONBytes(bz)
+		require.NoError(t, err)
+		require.Equal(t, elem, elem2)
+	})
+
+	t.Run("can unmarshal the outlier filter from other ticker metadata", func(t *testing.T) {
+		elemJSON := `{"reference_price":1,"liquidity":2,"aggregate_ids":[],"max_price_change":"0.1","outlier_mad_multiplier":"2.5"}`
+		elem, err := tickermetadata.OutlierFilterFromJSONString(elemJSON)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.NewOutlierFilter("", "2.5", ""), elem)
+	})
+
+	t.Run("metadata without an outlier filter unmarshals to an empty struct", func(t *testing.T) {
+		elem, err := tickermetadata.OutlierFilterFromJSONString(`{"aggregate_ids":[]}`)
+		require.NoError(t, err)
+
+		require.Equal(t, tickermetadata.OutlierFilter{}, elem)
+	})
+}
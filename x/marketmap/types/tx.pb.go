@@ -620,6 +620,219 @@ func (m *MsgRemoveMarketsResponse) GetDeletedMarkets() []string {
 	return nil
 }
 
+// MsgScheduleMarketUpdates defines a message carrying a payload for queueing
+// market upserts to be applied at a future block height in the x/marketmap
+// module.
+type MsgScheduleMarketUpdates struct {
+	// Authority is the signer of this transaction.  This authority must be
+	// authorized by the module to execute the message.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Height is the block height at the beginning of which the markets are
+	// upserted. It must be greater than the current block height.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Markets is the list of markets to create, or update if they exist.
+	Markets []Market `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets"`
+}
+
+func (m *MsgScheduleMarketUpdates) Reset()         { *m = MsgScheduleMarketUpdates{} }
+func (m *MsgScheduleMarketUpdates) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdates) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{12}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdates.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMarketUpdates proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketUpdates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleMarketUpdates) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgScheduleMarketUpdates) GetMarkets() []Market {
+	if m != nil {
+		return m.Markets
+	}
+	return nil
+}
+
+// MsgScheduleMarketUpdatesResponse is the response message for
+// MsgScheduleMarketUpdates.
+type MsgScheduleMarketUpdatesResponse struct {
+	// Id is the identifier of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Reset()         { *m = MsgScheduleMarketUpdatesResponse{} }
+func (m *MsgScheduleMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleMarketUpdatesResponse) ProtoMessage()    {}
+func (*MsgScheduleMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{13}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.Merge(m, src)
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleMarketUpdatesResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleMarketUpdatesResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledMarketUpdates defines the
+// Msg/CancelScheduledMarketUpdates request type. It removes a pending
+// scheduled market update from the queue.
+type MsgCancelScheduledMarketUpdates struct {
+	// Authority is the signer of this transaction. This must be the authority
+	// that scheduled the update or one of the MarketAuthorities.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Id is the identifier of the scheduled update to cancel.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelScheduledMarketUpdates) Reset()         { *m = MsgCancelScheduledMarketUpdates{} }
+func (m *MsgCancelScheduledMarketUpdates) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMarketUpdates) ProtoMessage()    {}
+func (*MsgCancelScheduledMarketUpdates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{14}
+}
+func (m *MsgCancelScheduledMarketUpdates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMarketUpdates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMarketUpdates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledMarketUpdates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMarketUpdates.Merge(m, src)
+}
+func (m *MsgCancelScheduledMarketUpdates) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMarketUpdates) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMarketUpdates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMarketUpdates proto.InternalMessageInfo
+
+func (m *MsgCancelScheduledMarketUpdates) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelScheduledMarketUpdates) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelScheduledMarketUpdatesResponse defines the
+// Msg/CancelScheduledMarketUpdates response type.
+type MsgCancelScheduledMarketUpdatesResponse struct {
+}
+
+func (m *MsgCancelScheduledMarketUpdatesResponse) Reset() {
+	*m = MsgCancelScheduledMarketUpdatesResponse{}
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduledMarketUpdatesResponse) ProtoMessage()    {}
+func (*MsgCancelScheduledMarketUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9adadfc18297083, []int{15}
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduledMarketUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduledMarketUpdatesResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduledMarketUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelScheduledMarketUpdatesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpsertMarkets)(nil), "slinky.marketmap.v1.MsgUpsertMarkets")
 	proto.RegisterType((*MsgUpsertMarketsResponse)(nil), "slinky.marketmap.v1.MsgUpsertMarketsResponse")
@@ -634,59 +847,71 @@ func init() {
 	proto.RegisterType((*MsgRemoveMarketAuthoritiesResponse)(nil), "slinky.marketmap.v1.MsgRemoveMarketAuthoritiesResponse")
 	proto.RegisterType((*MsgRemoveMarkets)(nil), "slinky.marketmap.v1.MsgRemoveMarkets")
 	proto.RegisterType((*MsgRemoveMarketsResponse)(nil), "slinky.marketmap.v1.MsgRemoveMarketsResponse")
+	proto.RegisterType((*MsgScheduleMarketUpdates)(nil), "slinky.marketmap.v1.MsgScheduleMarketUpdates")
+	proto.RegisterType((*MsgScheduleMarketUpdatesResponse)(nil), "slinky.marketmap.v1.MsgScheduleMarketUpdatesResponse")
+	proto.RegisterType((*MsgCancelScheduledMarketUpdates)(nil), "slinky.marketmap.v1.MsgCancelScheduledMarketUpdates")
+	proto.RegisterType((*MsgCancelScheduledMarketUpdatesResponse)(nil), "slinky.marketmap.v1.MsgCancelScheduledMarketUpdatesResponse")
 }
 
 func init() { proto.RegisterFile("slinky/marketmap/v1/tx.proto", fileDescriptor_e9adadfc18297083) }
 
 var fileDescriptor_e9adadfc18297083 = []byte{
-	// 739 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0xa5, 0x4d, 0x21, 0x0f, 0x92, 0xb6, 0x6e, 0xa5, 0x1a, 0x83, 0xdc, 0xc8, 0x10, 0x28,
-	0x95, 0x62, 0x2b, 0x45, 0x02, 0x1a, 0x96, 0x36, 0x15, 0x82, 0x81, 0x48, 0xc8, 0xa8, 0x0c, 0x2c,
-	0x91, 0x1b, 0x9f, 0x5c, 0x2b, 0x75, 0x6c, 0xf9, 0x2e, 0x51, 0xb3, 0x21, 0x2a, 0x31, 0x30, 0x20,
-	0x46, 0x46, 0xf8, 0x06, 0x1d, 0xf8, 0x06, 0x2c, 0xdd, 0xa8, 0x98, 0x98, 0x10, 0x6a, 0x87, 0xf2,
-	0x31, 0x50, 0x7c, 0x17, 0x37, 0x6e, 0xed, 0x90, 0x14, 0x96, 0xe8, 0xfc, 0xde, 0xef, 0xbd, 0xf7,
-	0x7b, 0x7f, 0xee, 0x5d, 0xe0, 0x06, 0xd9, 0xb1, 0x5b, 0xcd, 0xae, 0xe6, 0x18, 0x7e, 0x13, 0x53,
-	0xc7, 0xf0, 0xb4, 0x4e, 0x59, 0xa3, 0xbb, 0xaa, 0xe7, 0xbb, 0xd4, 0x15, 0xe6, 0x98, 0x56, 0x0d,
-	0xb5, 0x6a, 0xa7, 0x2c, 0x2d, 0x34, 0x5c, 0xe2, 0xb8, 0x44, 0x73, 0x88, 0xd5, 0x03, 0x3b, 0xc4,
-	0x62, 0x68, 0x69, 0xde, 0x72, 0x2d, 0x37, 0x38, 0x6a, 0xbd, 0x13, 0x97, 0x5e, 0x63, 0xf0, 0x3a,
-	0x53, 0xb0, 0x0f, 0xae, 0x9a, 0x35, 0x1c, 0xbb, 0xe5, 0x6a, 0xc1, 0x2f, 0x17, 0x15, 0xe2, 0xf8,
-	0xb0, 0x8f, 0x61, 0x08, 0xcf, 0xf0, 0x0d, 0x87, 0xbb, 0x55, 0xbe, 0x22, 0x98, 0xa9, 0x11, 0x6b,
-	0xd3, 0x23, 0xd8, 0xa7, 0xb5, 0x00, 0x46, 0x84, 0xfb, 0x90, 0x35, 0xda, 0x74, 0xdb, 0xf5, 0x6d,
-	0xda, 0x15, 0x51, 0x01, 0x2d, 0x65, 0xab, 0xe2, 0xf7, 0x2f, 0xa5, 0x79, 0x4e, 0x68, 0xdd, 0x34,
-	0x7d, 0x4c, 0xc8, 0x0b, 0xea, 0xdb, 0x2d, 0x4b, 0x3f, 0x85, 0x0a, 0x8f, 0xe0, 0x12, 0x8b, 0x44,
-	0xc4, 0x74, 0x61, 0x62, 0xe9, 0xca, 0xca, 0x75, 0x35, 0xa6, 0x28, 0x2a, 0x0b, 0x53, 0x9d, 0x3c,
-	0xf8, 0xb9, 0x98, 0xd2, 0xfb, 0x16, 0x95, 0xca, 0xef, 0x4f, 0x8b, 0xa9, 0x37, 0x27, 0xfb, 0xcb,
-	0xa7, 0x0e, 0xdf, 0x9d, 0xec, 0x2f, 0xdf, 0xe4, 0x29, 0xec, 0x0e, 0x24, 0x71, 0x96, 0xb0, 0xf2,
-	0x0d, 0x81, 0x78, 0x56, 0xa8, 0x63, 0xe2, 0xb9, 0x2d, 0x82, 0x85, 0x26, 0xe4, 0x99, 0x69, 0xbd,
-	0xed, 0x99, 0x06, 0xc5, 0x44, 0x44, 0x01, 0xb9, 0xb5, 0x78, 0x72, 0x09, 0x6e, 0x38, 0xeb, 0x4d,
-	0xe6, 0xe2, 0x71, 0x8b, 0xfa, 0xdd, 0x6a, 0x5a, 0x44, 0x7a, 0xce, 0x19, 0x94, 0x4b, 0x6b, 0x20,
-	0x9c, 0x07, 0x0a, 0x33, 0x30, 0xd1, 0xc4, 0xbc, 0x94, 0x7a, 0xef, 0x28, 0xcc, 0x43, 0xa6, 0x63,
-	0xec, 0xb4, 0xb1, 0x98, 0x2e, 0xa0, 0xa5, 0xcb, 0x3a, 0xfb, 0xa8, 0xa4, 0x1f, 0xa2, 0xca, 0xe4,
-	0xc7, 0xcf, 0x8b, 0x48, 0x39, 0x64, 0x7d, 0xd9, 0xf0, 0xb1, 0x41, 0xf1, 0xbf, 0xf6, 0xe5, 0x29,
-	0xe4, 0x1b, 0x81, 0xa3, 0xfa, 0xd8, 0xed, 0xc9, 0x35, 0x06, 0x19, 0x8c, 0xd9, 0xa4, 0x08, 0x7b,
-	0x45, 0x0a, 0x7a, 0x14, 0x91, 0xf5, 0x8b, 0xdb, 0x4f, 0x97, 0x15, 0xed, 0x3f, 0xa4, 0xcb, 0x3a,
-	0x7d, 0x81, 0x74, 0xdb, 0x83, 0x0c, 0xc6, 0x9e, 0x49, 0xf3, 0x5c, 0xba, 0x11, 0x59, 0x98, 0xee,
-	0x7b, 0x04, 0xd9, 0x1a, 0xb1, 0x9e, 0x07, 0x37, 0x51, 0x58, 0x85, 0x29, 0x76, 0x27, 0x83, 0x24,
-	0x93, 0x78, 0x32, 0x30, 0xe7, 0xc9, 0x0d, 0xa2, 0x25, 0x4a, 0x8f, 0x5c, 0xa2, 0x4a, 0x3e, 0x9a,
-	0x94, 0x32, 0x07, 0xb3, 0x21, 0x9f, 0x90, 0xe5, 0x1e, 0x02, 0xa9, 0x46, 0x2c, 0x1d, 0x3b, 0x6e,
-	0x87, 0xa7, 0xb0, 0xce, 0x2d, 0x6c, 0x4c, 0x84, 0xbb, 0x30, 0xe3, 0x07, 0xaa, 0xba, 0xc1, 0xc2,
-	0xf0, 0x9b, 0x95, 0xd5, 0xa7, 0x99, 0x7c, 0xbd, 0x2f, 0x16, 0x54, 0xc8, 0x18, 0xa6, 0x63, 0xb7,
-	0xfe, 0x4a, 0x91, 0xc1, 0x2a, 0xd0, 0xa3, 0xc7, 0xce, 0xca, 0x2d, 0x50, 0x92, 0x49, 0x84, 0x5c,
-	0x69, 0x30, 0x3f, 0x83, 0xa8, 0x8b, 0xcf, 0x8f, 0x18, 0x5d, 0x63, 0xd9, 0xd3, 0x1d, 0x75, 0xb6,
-	0x6c, 0x1b, 0x41, 0x8f, 0x23, 0x51, 0xc3, 0xb5, 0x73, 0x07, 0xa6, 0x4d, 0xbc, 0x83, 0x29, 0x36,
-	0xc3, 0x31, 0x64, 0xd5, 0xc9, 0x73, 0x31, 0x37, 0x58, 0x79, 0x9b, 0x81, 0x89, 0x1a, 0xb1, 0x04,
-	0x0c, 0xb9, 0xe8, 0x75, 0x2f, 0x26, 0x2d, 0xa8, 0x08, 0x4c, 0x2a, 0x8d, 0x04, 0x0b, 0x79, 0x61,
-	0xc8, 0x45, 0xaf, 0x59, 0x31, 0x79, 0x0f, 0x9a, 0xa3, 0x84, 0x89, 0x1d, 0x71, 0xe1, 0x25, 0x5c,
-	0x65, 0x0a, 0x3e, 0xe4, 0x72, 0x92, 0x39, 0xd3, 0x4b, 0xb7, 0x87, 0xeb, 0x43, 0xbf, 0x7b, 0x08,
-	0x16, 0x92, 0x26, 0x52, 0x4b, 0xf2, 0x91, 0x60, 0x20, 0x3d, 0x18, 0xd3, 0x20, 0x5a, 0xc4, 0xc1,
-	0x27, 0xb3, 0x38, 0xd2, 0x63, 0x32, 0xac, 0x88, 0x71, 0x4f, 0x17, 0x86, 0x5c, 0x74, 0xa4, 0x8b,
-	0xa3, 0x10, 0x1e, 0x12, 0x26, 0x76, 0x54, 0xa5, 0xcc, 0xeb, 0x93, 0xfd, 0x65, 0x54, 0x7d, 0x72,
-	0x70, 0x24, 0xa3, 0xc3, 0x23, 0x19, 0xfd, 0x3a, 0x92, 0xd1, 0x87, 0x63, 0x39, 0x75, 0x78, 0x2c,
-	0xa7, 0x7e, 0x1c, 0xcb, 0xa9, 0x57, 0x25, 0xcb, 0xa6, 0xdb, 0xed, 0x2d, 0xb5, 0xe1, 0x3a, 0x5a,
-	0xb9, 0x5c, 0x5e, 0x2d, 0x3d, 0x33, 0xb6, 0x88, 0x16, 0xb3, 0x05, 0x69, 0xd7, 0xc3, 0x64, 0x6b,
-	0x2a, 0xf8, 0x6f, 0x71, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0x8d, 0x14, 0xfd, 0x31,
-	0x09, 0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6f, 0xeb, 0x44,
+	0x14, 0xcd, 0x38, 0x69, 0x1f, 0xb9, 0x90, 0xbc, 0x3c, 0xbf, 0x42, 0x8d, 0xa9, 0x92, 0xc8, 0x10,
+	0xfa, 0x81, 0x12, 0x2b, 0xe1, 0xb3, 0x81, 0x45, 0x9b, 0xaa, 0x82, 0x05, 0x91, 0x90, 0xab, 0xb2,
+	0x60, 0x13, 0xb9, 0xf1, 0xc8, 0xb1, 0x12, 0xc7, 0x96, 0xc7, 0x89, 0x9a, 0x05, 0x12, 0xa2, 0x3b,
+	0x16, 0x08, 0xb1, 0x42, 0xac, 0xe0, 0x1f, 0x64, 0xc1, 0x3f, 0x60, 0xd3, 0x1d, 0x15, 0x2b, 0x56,
+	0x08, 0xb5, 0x8b, 0x22, 0xf1, 0x27, 0x50, 0x3c, 0x63, 0x37, 0x4e, 0xed, 0x34, 0xc9, 0xeb, 0x26,
+	0xb2, 0xef, 0x3d, 0x77, 0xee, 0x39, 0x77, 0x66, 0x4e, 0x0c, 0x5b, 0xa4, 0x67, 0xf4, 0xbb, 0x23,
+	0xd9, 0x54, 0x9d, 0x2e, 0x76, 0x4d, 0xd5, 0x96, 0x87, 0x55, 0xd9, 0x3d, 0xaf, 0xd8, 0x8e, 0xe5,
+	0x5a, 0xfc, 0x73, 0x9a, 0xad, 0x04, 0xd9, 0xca, 0xb0, 0x2a, 0x6e, 0xb6, 0x2d, 0x62, 0x5a, 0x44,
+	0x36, 0x89, 0x3e, 0x01, 0x9b, 0x44, 0xa7, 0x68, 0x71, 0x43, 0xb7, 0x74, 0xcb, 0x7b, 0x94, 0x27,
+	0x4f, 0x2c, 0xfa, 0x3a, 0x85, 0xb7, 0x68, 0x82, 0xbe, 0xb0, 0xd4, 0x33, 0xd5, 0x34, 0xfa, 0x96,
+	0xec, 0xfd, 0xb2, 0x50, 0x31, 0x8a, 0x0f, 0x7d, 0x99, 0x87, 0xb0, 0x55, 0x47, 0x35, 0xd9, 0xb2,
+	0xd2, 0xef, 0x08, 0x72, 0x4d, 0xa2, 0x9f, 0xda, 0x04, 0x3b, 0x6e, 0xd3, 0x83, 0x11, 0xfe, 0x03,
+	0x48, 0xab, 0x03, 0xb7, 0x63, 0x39, 0x86, 0x3b, 0x12, 0x50, 0x11, 0xed, 0xa4, 0x1b, 0xc2, 0x9f,
+	0xbf, 0x95, 0x37, 0x18, 0xa1, 0x43, 0x4d, 0x73, 0x30, 0x21, 0x27, 0xae, 0x63, 0xf4, 0x75, 0xe5,
+	0x0e, 0xca, 0x7f, 0x0c, 0x4f, 0x68, 0x27, 0x22, 0x70, 0xc5, 0xe4, 0xce, 0xcb, 0xb5, 0x37, 0x2a,
+	0x11, 0x43, 0xa9, 0xd0, 0x36, 0x8d, 0xd4, 0xe5, 0xdf, 0x85, 0x84, 0xe2, 0x57, 0xd4, 0xeb, 0xff,
+	0xfe, 0x52, 0x48, 0x7c, 0x7b, 0x3b, 0xde, 0xbb, 0x5b, 0xf0, 0xbb, 0xdb, 0xf1, 0xde, 0x9b, 0x4c,
+	0xc2, 0xf9, 0x94, 0x88, 0x59, 0xc2, 0xd2, 0x1f, 0x08, 0x84, 0xd9, 0xa0, 0x82, 0x89, 0x6d, 0xf5,
+	0x09, 0xe6, 0xbb, 0x90, 0xa5, 0xa5, 0xad, 0x81, 0xad, 0xa9, 0x2e, 0x26, 0x02, 0xf2, 0xc8, 0x1d,
+	0x44, 0x93, 0x8b, 0x59, 0x86, 0xb1, 0x3e, 0xa5, 0x4b, 0x1c, 0xf7, 0x5d, 0x67, 0xd4, 0xe0, 0x04,
+	0xa4, 0x64, 0xcc, 0xe9, 0xb8, 0x78, 0x00, 0xfc, 0x7d, 0x20, 0x9f, 0x83, 0x64, 0x17, 0xb3, 0x51,
+	0x2a, 0x93, 0x47, 0x7e, 0x03, 0xd6, 0x86, 0x6a, 0x6f, 0x80, 0x05, 0xae, 0x88, 0x76, 0x5e, 0x52,
+	0xe8, 0x4b, 0x9d, 0xfb, 0x08, 0xd5, 0x53, 0x3f, 0xfd, 0x5a, 0x40, 0xd2, 0x15, 0xdd, 0x97, 0x23,
+	0x07, 0xab, 0x2e, 0x7e, 0xd1, 0x7d, 0xf9, 0x0c, 0xb2, 0x6d, 0x6f, 0xa1, 0xd6, 0xd2, 0xdb, 0x93,
+	0x69, 0x4f, 0x33, 0x58, 0x72, 0x93, 0x42, 0xec, 0x25, 0x11, 0x84, 0xd9, 0x98, 0x3f, 0x5c, 0x5f,
+	0x2e, 0x1d, 0xda, 0x23, 0xc8, 0xa5, 0x3b, 0xbd, 0x82, 0xdc, 0x81, 0xad, 0xad, 0x2c, 0x37, 0xc4,
+	0x9e, 0xc9, 0x0d, 0xc5, 0x02, 0xb9, 0xdf, 0x23, 0x48, 0x37, 0x89, 0xfe, 0x85, 0x77, 0x13, 0xf9,
+	0x7d, 0x58, 0xa7, 0x77, 0xd2, 0x13, 0x19, 0xc7, 0x93, 0x82, 0x19, 0x4f, 0x56, 0x10, 0x1e, 0x11,
+	0xb7, 0xf0, 0x88, 0xea, 0xd9, 0xb0, 0x28, 0xe9, 0x39, 0x3c, 0x0b, 0xf8, 0x04, 0x2c, 0x2f, 0x10,
+	0x88, 0x4d, 0xa2, 0x2b, 0xd8, 0xb4, 0x86, 0x4c, 0xc2, 0x21, 0xab, 0x30, 0x30, 0xe1, 0x77, 0x21,
+	0xe7, 0x78, 0xa9, 0x96, 0x4a, 0xdb, 0xb0, 0x9b, 0x95, 0x56, 0x9e, 0xd2, 0xf8, 0xa1, 0x1f, 0xe6,
+	0x2b, 0xb0, 0xa6, 0x6a, 0xa6, 0xd1, 0x7f, 0x90, 0x22, 0x85, 0xd5, 0x61, 0x42, 0x8f, 0x3e, 0x4b,
+	0x6f, 0x81, 0x14, 0x4f, 0x22, 0xe0, 0xea, 0x42, 0x6e, 0x06, 0xb5, 0xfa, 0xf9, 0x11, 0xc2, 0x36,
+	0x96, 0xbe, 0xf3, 0xa8, 0xd9, 0xb1, 0x1d, 0x81, 0x30, 0xdb, 0x35, 0xb0, 0x9d, 0x6d, 0x78, 0xaa,
+	0xe1, 0x1e, 0x76, 0xb1, 0x16, 0x1c, 0x43, 0x3a, 0x9d, 0x2c, 0x0b, 0xfb, 0x07, 0xe5, 0x3f, 0x6a,
+	0x5e, 0x27, 0xed, 0x0e, 0xd6, 0x06, 0x3d, 0x1c, 0xb2, 0x8f, 0x95, 0x35, 0xbc, 0x06, 0xeb, 0x1d,
+	0x6c, 0xe8, 0x1d, 0xd7, 0x1b, 0x79, 0x4a, 0x61, 0x6f, 0xd3, 0x16, 0x9d, 0x5c, 0xda, 0xa2, 0x1b,
+	0xd1, 0xd7, 0xe1, 0x9d, 0xe8, 0xeb, 0x10, 0x29, 0x48, 0xaa, 0x41, 0x31, 0x2e, 0x17, 0x8c, 0x2e,
+	0x0b, 0x9c, 0xa1, 0x79, 0x6a, 0x53, 0x0a, 0x67, 0x68, 0xd2, 0x18, 0x41, 0x61, 0x62, 0x1d, 0x6a,
+	0xbf, 0x8d, 0x7b, 0x7e, 0xa9, 0xf6, 0x38, 0x83, 0xa2, 0xbd, 0x38, 0xbf, 0x57, 0xfd, 0xf8, 0xbe,
+	0xbe, 0x5a, 0x8c, 0xbb, 0xcd, 0xa1, 0x23, 0xed, 0xc2, 0xf6, 0x03, 0x10, 0x5f, 0x6d, 0xed, 0xe7,
+	0x27, 0x90, 0x6c, 0x12, 0x9d, 0xc7, 0x90, 0x09, 0xdb, 0x7d, 0x29, 0xee, 0x0f, 0x2a, 0x04, 0x13,
+	0xcb, 0x0b, 0xc1, 0x82, 0xe1, 0x62, 0xc8, 0x84, 0x6d, 0xb6, 0x14, 0xff, 0x3f, 0xa8, 0x2d, 0xd2,
+	0x26, 0xd2, 0xe2, 0xf8, 0x2f, 0xe1, 0x15, 0x9a, 0x60, 0x26, 0x97, 0x8f, 0x2b, 0xa7, 0x79, 0xf1,
+	0xed, 0xf9, 0xf9, 0x60, 0xdd, 0x0b, 0x04, 0x9b, 0x71, 0x8e, 0x24, 0xc7, 0xad, 0x11, 0x53, 0x20,
+	0x7e, 0xb8, 0x64, 0x41, 0x78, 0x88, 0xd3, 0x9f, 0x4c, 0xa5, 0x85, 0x3e, 0x26, 0xc4, 0xf2, 0x42,
+	0xb0, 0xe9, 0x36, 0x61, 0x4b, 0x2b, 0x2d, 0x42, 0x78, 0x4e, 0x9b, 0x68, 0xab, 0xfa, 0x1a, 0x5e,
+	0x8d, 0x76, 0x9f, 0xd8, 0x75, 0x22, 0xe1, 0xe2, 0xfb, 0x4b, 0xc1, 0x83, 0xf6, 0x3f, 0x22, 0xd8,
+	0x9a, 0x7b, 0xb7, 0xdf, 0x8b, 0x3d, 0xe1, 0x73, 0xaa, 0xc4, 0x4f, 0x56, 0xa9, 0xf2, 0x49, 0x89,
+	0x6b, 0xdf, 0xdc, 0x8e, 0xf7, 0x50, 0xe3, 0xd3, 0xcb, 0xeb, 0x3c, 0xba, 0xba, 0xce, 0xa3, 0x7f,
+	0xae, 0xf3, 0xe8, 0x87, 0x9b, 0x7c, 0xe2, 0xea, 0x26, 0x9f, 0xf8, 0xeb, 0x26, 0x9f, 0xf8, 0xaa,
+	0xac, 0x1b, 0x6e, 0x67, 0x70, 0x56, 0x69, 0x5b, 0xa6, 0x5c, 0xad, 0x56, 0xf7, 0xcb, 0x9f, 0xab,
+	0x67, 0x44, 0x8e, 0xb0, 0x0a, 0x77, 0x64, 0x63, 0x72, 0xb6, 0xee, 0x7d, 0x6f, 0xbf, 0xfb, 0xff,
+	0x00, 0xfb, 0xc0, 0xed, 0x02, 0x45, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -719,6 +944,12 @@ type MsgClient interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opts ...grpc.CallOption) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates queues market upserts to be applied at the beginning
+	// of a future block.
+	ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledMarketUpdates removes a pending scheduled market update
+	// from the queue.
+	CancelScheduledMarketUpdates(ctx context.Context, in *MsgCancelScheduledMarketUpdates, opts ...grpc.CallOption) (*MsgCancelScheduledMarketUpdatesResponse, error)
 }
 
 type msgClient struct {
@@ -783,6 +1014,24 @@ func (c *msgClient) RemoveMarkets(ctx context.Context, in *MsgRemoveMarkets, opt
 	return out, nil
 }
 
+func (c *msgClient) ScheduleMarketUpdates(ctx context.Context, in *MsgScheduleMarketUpdates, opts ...grpc.CallOption) (*MsgScheduleMarketUpdatesResponse, error) {
+	out := new(MsgScheduleMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMarketUpdates(ctx context.Context, in *MsgCancelScheduledMarketUpdates, opts ...grpc.CallOption) (*MsgCancelScheduledMarketUpdatesResponse, error) {
+	out := new(MsgCancelScheduledMarketUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.marketmap.v1.Msg/CancelScheduledMarketUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateMarkets creates markets from the given message.
@@ -803,6 +1052,12 @@ type MsgServer interface {
 	// - they exist in the map
 	// - they are disabled
 	RemoveMarkets(context.Context, *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error)
+	// ScheduleMarketUpdates queues market upserts to be applied at the beginning
+	// of a future block.
+	ScheduleMarketUpdates(context.Context, *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error)
+	// CancelScheduledMarketUpdates removes a pending scheduled market update
+	// from the queue.
+	CancelScheduledMarketUpdates(context.Context, *MsgCancelScheduledMarketUpdates) (*MsgCancelScheduledMarketUpdatesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -827,6 +1082,12 @@ func (*UnimplementedMsgServer) UpsertMarkets(ctx context.Context, req *MsgUpsert
 func (*UnimplementedMsgServer) RemoveMarkets(ctx context.Context, req *MsgRemoveMarkets) (*MsgRemoveMarketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveMarkets not implemented")
 }
+func (*UnimplementedMsgServer) ScheduleMarketUpdates(ctx context.Context, req *MsgScheduleMarketUpdates) (*MsgScheduleMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMarketUpdates not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMarketUpdates(ctx context.Context, req *MsgCancelScheduledMarketUpdates) (*MsgCancelScheduledMarketUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMarketUpdates not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -940,6 +1201,42 @@ func _Msg_RemoveMarkets_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ScheduleMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/ScheduleMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleMarketUpdates(ctx, req.(*MsgScheduleMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMarketUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledMarketUpdates)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMarketUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.marketmap.v1.Msg/CancelScheduledMarketUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMarketUpdates(ctx, req.(*MsgCancelScheduledMarketUpdates))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.marketmap.v1.Msg",
@@ -969,6 +1266,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveMarkets",
 			Handler:    _Msg_RemoveMarkets_Handler,
 		},
+		{
+			MethodName: "ScheduleMarketUpdates",
+			Handler:    _Msg_ScheduleMarketUpdates_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMarketUpdates",
+			Handler:    _Msg_CancelScheduledMarketUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/marketmap/v1/tx.proto",
@@ -1398,61 +1703,196 @@ func (m *MsgRemoveMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgScheduleMarketUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpsertMarkets) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgScheduleMarketUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
 	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpsertMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScheduleMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.MarketUpdates) > 0 {
-		for k, v := range m.MarketUpdates {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
-			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
-		}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateMarkets) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelScheduledMarketUpdates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMarketUpdates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMarketUpdates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduledMarketUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduledMarketUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduledMarketUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpsertMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpsertMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MarketUpdates) > 0 {
+		for k, v := range m.MarketUpdates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTx(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovTx(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateMarkets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	if len(m.CreateMarkets) > 0 {
@@ -1587,6 +2027,65 @@ func (m *MsgRemoveMarketsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgScheduleMarketUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMarketUpdates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduledMarketUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2731,6 +3230,361 @@ func (m *MsgRemoveMarketsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgScheduleMarketUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMarketUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMarketUpdates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMarketUpdates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMarketUpdates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelScheduledMarketUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelScheduledMarketUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelScheduledMarketUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,13 +35,19 @@ func DefaultDeleteMarketValidationHooks() MarketValidationHooks {
 // DefaultDeleteMarketValidationHook returns the default DeleteMarketValidationHook for x/marketmap.
 // This hook checks:
 // - if the given market is enabled - error
-// - if the given market is disabled - return nil.
+// - if the given market is not proposed or delisted - error
+// - otherwise - return nil.
 func DefaultDeleteMarketValidationHook() MarketValidationHook {
 	return func(_ context.Context, market Market) error {
 		if market.Ticker.Enabled {
 			return fmt.Errorf("market is enabled - cannot be deleted")
 		}
 
-		return nil
+		switch state := market.Ticker.LifecycleState(); state {
+		case MarketStateProposed, MarketStateDelisted:
+			return nil
+		default:
+			return fmt.Errorf("market is %s - cannot be deleted", state)
+		}
 	}
 }
@@ -48,6 +48,54 @@ func TestDefaultDeleteMarketValidationHooks_ValidateMarket(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid - delisted market",
+			market: types.Market{
+				Ticker: types.Ticker{
+					CurrencyPair: slinkytypes.CurrencyPair{
+						Base:  "BTC",
+						Quote: "USD",
+					},
+					Decimals:         3,
+					MinProviderCount: 3,
+					Enabled:          false,
+					State:            types.MarketStateDelisted,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid - reduce only market",
+			market: types.Market{
+				Ticker: types.Ticker{
+					CurrencyPair: slinkytypes.CurrencyPair{
+						Base:  "BTC",
+						Quote: "USD",
+					},
+					Decimals:         3,
+					MinProviderCount: 3,
+					Enabled:          true,
+					State:            types.MarketStateReduceOnly,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - disabled market in an enabled state",
+			market: types.Market{
+				Ticker: types.Ticker{
+					CurrencyPair: slinkytypes.CurrencyPair{
+						Base:  "BTC",
+						Quote: "USD",
+					},
+					Decimals:         3,
+					MinProviderCount: 3,
+					Enabled:          false,
+					State:            types.MarketStateShadow,
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
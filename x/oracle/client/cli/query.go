@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -26,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetPriceCmd(),
 		GetAllCurrencyPairsCmd(),
+		GetPriceHistoryCmd(),
+		GetTWAPCmd(),
+		GetParamsCmd(),
+		GetValidatorPerformanceCmd(),
+		GetValidatorPerformancesCmd(),
 	)
 
 	return cmd
@@ -95,3 +101,186 @@ func GetAllCurrencyPairsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceHistoryCmd returns the cli-command that queries the historical prices stored for a given CurrencyPair.
+func GetPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [base] [quote]",
+		Short: "Query for the historical prices of a specified currency-pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the price history
+			res, err := qc.GetPriceHistory(cmd.Context(), &types.GetPriceHistoryRequest{
+				CurrencyPair: cp,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+const (
+	flagBlocks   = "blocks"
+	flagDuration = "duration"
+)
+
+// GetTWAPCmd returns the cli-command that queries the time-weighted average price of a given CurrencyPair over either
+// a number of blocks or a duration.
+func GetTWAPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [base] [quote]",
+		Short: "Query for the time-weighted average price of a specified currency-pair",
+		Long: fmt.Sprintf("Query for the time-weighted average price of a specified currency-pair over either --%s or --%s.",
+			flagBlocks, flagDuration),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := cmd.Flags().GetUint64(flagBlocks)
+			if err != nil {
+				return err
+			}
+
+			duration, err := cmd.Flags().GetDuration(flagDuration)
+			if err != nil {
+				return err
+			}
+
+			// retrieve CurrencyPair from arguments
+			cp := slinkytypes.NewCurrencyPair(args[0], args[1])
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the twap
+			res, err := qc.GetTWAP(cmd.Context(), &types.GetTWAPRequest{
+				CurrencyPair: cp,
+				BlockWindow:  blocks,
+				TimeWindow:   duration,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Uint64(flagBlocks, 0, "number of blocks to average over")
+	cmd.Flags().Duration(flagDuration, time.Duration(0), "duration to average over")
+	cmd.MarkFlagsMutuallyExclusive(flagBlocks, flagDuration)
+	cmd.MarkFlagsOneRequired(flagBlocks, flagDuration)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetParamsCmd returns the cli-command that queries the current x/oracle module parameters.
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query for the current parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the params
+			res, err := qc.Params(cmd.Context(), &types.ParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorPerformanceCmd returns the cli-command that queries the performance of a given validator over the
+// module's performance window.
+func GetValidatorPerformanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performance [cons-address]",
+		Short: "Query for the oracle performance of a specified validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// get context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the validator's performance
+			res, err := qc.ValidatorPerformance(cmd.Context(), &types.ValidatorPerformanceRequest{
+				Validator: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetValidatorPerformancesCmd returns the cli-command that queries the performance of every validator over the
+// module's performance window.
+func GetValidatorPerformancesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-performances",
+		Short: "Query for the oracle performance of all validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			// get the context
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// create a new query client
+			qc := types.NewQueryClient(clientCtx)
+
+			// query for the performance of all validators
+			res, err := qc.ValidatorPerformances(cmd.Context(), &types.ValidatorPerformancesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -7,9 +7,19 @@ import (
 )
 
 // BeginBlocker is called at the beginning of every block.  It resets the count of
-// removed currency pairs.
+// removed currency pairs, prunes the price history of every currency pair to
+// the configured maximum length, and updates the staleness of every currency pair's
+// price (prices for the current block have already been written in PreBlock).
 func (k *Keeper) BeginBlocker(goCtx context.Context) error {
 	// unwrap the context
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	return k.numRemoves.Set(ctx, 0)
+	if err := k.numRemoves.Set(ctx, 0); err != nil {
+		return err
+	}
+
+	if err := k.PrunePriceHistory(ctx); err != nil {
+		return err
+	}
+
+	return k.UpdatePriceStaleness(ctx)
 }
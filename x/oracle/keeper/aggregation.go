@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// GetAggregationStrategy returns the name of the strategy used to aggregate validator prices for the given
+// CurrencyPair. If the CurrencyPair's market in x/marketmap specifies an aggregation_strategy in its ticker metadata,
+// that value is used, otherwise the AggregationStrategy parameter is used.
+func (k *Keeper) GetAggregationStrategy(ctx sdk.Context, cp slinkytypes.CurrencyPair) (string, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if k.mmKeeper == nil {
+		return params.AggregationStrategy, nil
+	}
+
+	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return params.AggregationStrategy, nil
+		}
+
+		return "", err
+	}
+
+	// the ticker metadata is free-form, so metadata without an aggregation configuration is not an error
+	if market.Ticker.Metadata_JSON == "" {
+		return params.AggregationStrategy, nil
+	}
+	aggregation, err := tickermetadata.AggregationFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil || aggregation.Strategy == "" {
+		return params.AggregationStrategy, nil
+	}
+
+	if err := types.ValidateAggregationStrategy(aggregation.Strategy); err != nil {
+		ctx.Logger().Error(
+			"invalid aggregation strategy in ticker metadata; falling back to module params",
+			"currency_pair", cp.String(),
+			"aggregation_strategy", aggregation.Strategy,
+			"err", err,
+		)
+
+		return params.AggregationStrategy, nil
+	}
+
+	return aggregation.Strategy, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestGetAggregationStrategy() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	params, err := s.oracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.AggregationStrategy = types.AggregationStrategyTrimmedMean
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+	cases := []struct {
+		name      string
+		metadata  string
+		getErr    error
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "market does not exist - use params",
+			getErr:   collections.ErrNotFound,
+			expected: types.AggregationStrategyTrimmedMean,
+		},
+		{
+			name:      "error getting market - fail",
+			getErr:    fmt.Errorf("fail"),
+			expectErr: true,
+		},
+		{
+			name:     "empty metadata - use params",
+			expected: types.AggregationStrategyTrimmedMean,
+		},
+		{
+			name:     "metadata without an aggregation strategy - use params",
+			metadata: `{"aggregate_ids":[]}`,
+			expected: types.AggregationStrategyTrimmedMean,
+		},
+		{
+			name:     "unsupported aggregation strategy in metadata - use params",
+			metadata: `{"aggregation_strategy":"mode"}`,
+			expected: types.AggregationStrategyTrimmedMean,
+		},
+		{
+			name:     "aggregation strategy in metadata - override params",
+			metadata: `{"aggregate_ids":[],"aggregation_strategy":"mad_mean"}`,
+			expected: types.AggregationStrategyMADMean,
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+				Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8, Metadata_JSON: tc.metadata},
+			}, tc.getErr).Once()
+
+			strategy, err := s.oracleKeeper.GetAggregationStrategy(s.ctx, cp)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expected, strategy)
+		})
+	}
+
+	s.Run("without x/marketmap - use params", func() {
+		s.SetupWithNoMMKeeper()
+		params.AggregationStrategy = types.AggregationStrategyMedian
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		strategy, err := s.oracleKeeper.GetAggregationStrategy(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Equal(types.AggregationStrategyMedian, strategy)
+	})
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/marketmap/types/tickermetadata"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// GetMaxPriceChange returns the maximum fractional change allowed between a newly aggregated price for the given
+// CurrencyPair and its reference price before the circuit breaker trips. If the CurrencyPair's market in x/marketmap
+// specifies a max_price_change in its ticker metadata, that value is used, otherwise the MaxPriceChange parameter is
+// used. A value of zero means the circuit breaker is disabled for the CurrencyPair.
+func (k *Keeper) GetMaxPriceChange(ctx sdk.Context, cp slinkytypes.CurrencyPair) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if k.mmKeeper == nil {
+		return params.MaxPriceChange, nil
+	}
+
+	market, err := k.mmKeeper.GetMarket(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return params.MaxPriceChange, nil
+		}
+
+		return math.LegacyDec{}, err
+	}
+
+	// the ticker metadata is free-form, so metadata without a circuit breaker configuration is not an error
+	if market.Ticker.Metadata_JSON == "" {
+		return params.MaxPriceChange, nil
+	}
+	cb, err := tickermetadata.CircuitBreakerFromJSONString(market.Ticker.Metadata_JSON)
+	if err != nil || cb.MaxPriceChange == "" {
+		return params.MaxPriceChange, nil
+	}
+
+	maxPriceChange, err := math.LegacyNewDecFromStr(cb.MaxPriceChange)
+	if err == nil {
+		err = types.ValidateMaxPriceChange(maxPriceChange)
+	}
+	if err != nil {
+		ctx.Logger().Error(
+			"invalid max price change in ticker metadata; falling back to module params",
+			"currency_pair", cp.String(),
+			"max_price_change", cb.MaxPriceChange,
+			"err", err,
+		)
+
+		return params.MaxPriceChange, nil
+	}
+
+	return maxPriceChange, nil
+}
+
+// RecordCircuitBreakerTrip records that the circuit breaker rejected a price for the given CurrencyPair that was above
+// (upward) or below its reference price, and returns the number of consecutive rejections in that direction. A
+// rejection in the other direction restarts the count.
+func (k *Keeper) RecordCircuitBreakerTrip(ctx sdk.Context, cp slinkytypes.CurrencyPair, upward bool) (uint64, error) {
+	trips, err := k.circuitBreakerTrips.Get(ctx, cp.String())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	if err != nil || trips.Upward != upward {
+		trips = types.CircuitBreakerTrips{Upward: upward}
+	}
+	trips.Count++
+
+	if err := k.circuitBreakerTrips.Set(ctx, cp.String(), trips); err != nil {
+		return 0, err
+	}
+
+	return trips.Count, nil
+}
+
+// ResetCircuitBreakerTrips clears the consecutive circuit breaker rejections recorded for the given CurrencyPair.
+func (k *Keeper) ResetCircuitBreakerTrips(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	return k.circuitBreakerTrips.Remove(ctx, cp.String())
+}
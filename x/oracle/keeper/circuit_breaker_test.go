@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestGetMaxPriceChange() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	params, err := s.oracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxPriceChange = sdkmath.LegacyNewDecWithPrec(1, 1)
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+	cases := []struct {
+		name      string
+		metadata  string
+		getErr    error
+		expected  sdkmath.LegacyDec
+		expectErr bool
+	}{
+		{
+			name:     "market does not exist - use params",
+			getErr:   collections.ErrNotFound,
+			expected: sdkmath.LegacyNewDecWithPrec(1, 1),
+		},
+		{
+			name:      "error getting market - fail",
+			getErr:    fmt.Errorf("fail"),
+			expectErr: true,
+		},
+		{
+			name:     "empty metadata - use params",
+			expected: sdkmath.LegacyNewDecWithPrec(1, 1),
+		},
+		{
+			name:     "metadata without a circuit breaker - use params",
+			metadata: `{"aggregate_ids":[]}`,
+			expected: sdkmath.LegacyNewDecWithPrec(1, 1),
+		},
+		{
+			name:     "metadata that is not an object - use params",
+			metadata: `[]`,
+			expected: sdkmath.LegacyNewDecWithPrec(1, 1),
+		},
+		{
+			name:     "invalid max price change in metadata - use params",
+			metadata: `{"max_price_change":"-0.5"}`,
+			expected: sdkmath.LegacyNewDecWithPrec(1, 1),
+		},
+		{
+			name:     "max price change in metadata - override params",
+			metadata: `{"aggregate_ids":[],"max_price_change":"0.25"}`,
+			expected: sdkmath.LegacyNewDecWithPrec(25, 2),
+		},
+		{
+			name:     "circuit breaker disabled in metadata",
+			metadata: `{"max_price_change":"0"}`,
+			expected: sdkmath.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name, func() {
+			s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+				Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8, Metadata_JSON: tc.metadata},
+			}, tc.getErr).Once()
+
+			maxPriceChange, err := s.oracleKeeper.GetMaxPriceChange(s.ctx, cp)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(tc.expected.Equal(maxPriceChange), "expected %s, got %s", tc.expected, maxPriceChange)
+		})
+	}
+
+	s.Run("without x/marketmap - use params", func() {
+		s.SetupWithNoMMKeeper()
+		params.MaxPriceChange = sdkmath.LegacyNewDecWithPrec(3, 1)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		maxPriceChange, err := s.oracleKeeper.GetMaxPriceChange(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(sdkmath.LegacyNewDecWithPrec(3, 1).Equal(maxPriceChange))
+	})
+}
+
+func (s *KeeperTestSuite) TestCircuitBreakerTrips() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	for i := uint64(1); i <= 3; i++ {
+		trips, err := s.oracleKeeper.RecordCircuitBreakerTrip(s.ctx, cp, true)
+		s.Require().NoError(err)
+		s.Require().Equal(i, trips)
+	}
+
+	s.Run("a trip in the other direction restarts the count", func() {
+		trips, err := s.oracleKeeper.RecordCircuitBreakerTrip(s.ctx, cp, false)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), trips)
+	})
+
+	s.Run("trips are exported and imported in genesis", func() {
+		gs := s.oracleKeeper.ExportGenesis(s.ctx)
+		s.Require().NoError(gs.Validate())
+		s.Require().Equal([]types.CurrencyPairCircuitBreakerTrips{
+			types.NewCurrencyPairCircuitBreakerTrips(cp, types.CircuitBreakerTrips{Upward: false, Count: 1}),
+		}, gs.CircuitBreakerTrips)
+
+		s.SetupTest()
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+		trips, err := s.oracleKeeper.RecordCircuitBreakerTrip(s.ctx, cp, false)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), trips)
+	})
+
+	s.Run("reset clears the count", func() {
+		s.Require().NoError(s.oracleKeeper.ResetCircuitBreakerTrips(s.ctx, cp))
+		trips, err := s.oracleKeeper.RecordCircuitBreakerTrip(s.ctx, cp, false)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), trips)
+	})
+
+	s.Run("trips are removed with the currency pair", func() {
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+		s.Require().Empty(s.oracleKeeper.ExportGenesis(s.ctx).CircuitBreakerTrips)
+	})
+}
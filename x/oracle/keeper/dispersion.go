@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// SetPriceDispersion sets the dispersion of the validator prices that the given CurrencyPair's latest price was
+// aggregated from. The CurrencyPair must exist.
+func (k *Keeper) SetPriceDispersion(ctx sdk.Context, cp slinkytypes.CurrencyPair, dispersion types.PriceDispersion) error {
+	if !k.HasCurrencyPair(ctx, cp) {
+		return types.NewCurrencyPairNotExistError(cp)
+	}
+
+	return k.priceDispersions.Set(ctx, cp.String(), dispersion)
+}
+
+// GetPriceDispersion returns the dispersion of the validator prices that the given CurrencyPair's latest price was
+// aggregated from. A PriceDispersionNotExistError is returned if no dispersion has been stored for the CurrencyPair,
+// or if the stored dispersion does not belong to the CurrencyPair's latest price.
+func (k *Keeper) GetPriceDispersion(ctx sdk.Context, cp slinkytypes.CurrencyPair) (types.PriceDispersion, error) {
+	dispersion, err := k.priceDispersions.Get(ctx, cp.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PriceDispersion{}, types.NewPriceDispersionNotExistError(cp)
+		}
+
+		return types.PriceDispersion{}, err
+	}
+
+	// the latest price may have been written without a dispersion, in which case the stored dispersion is outdated
+	qp, err := k.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return types.PriceDispersion{}, err
+	}
+
+	if qp.BlockHeight != dispersion.BlockHeight {
+		return types.PriceDispersion{}, types.NewPriceDispersionNotExistError(cp)
+	}
+
+	return dispersion, nil
+}
+
+// getPriceDispersionForQuery returns the dispersion of the given CurrencyPair's latest price for use in query
+// responses, i.e. nil if the latest price has no dispersion.
+func (k *Keeper) getPriceDispersionForQuery(ctx sdk.Context, cp slinkytypes.CurrencyPair) (*types.PriceDispersion, error) {
+	dispersion, err := k.GetPriceDispersion(ctx, cp)
+	if err != nil {
+		var priceDispersionNotExistError types.PriceDispersionNotExistError
+		var quotePriceNotExistError types.QuotePriceNotExistError
+		if errors.As(err, &priceDispersionNotExistError) || errors.As(err, &quotePriceNotExistError) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &dispersion, nil
+}
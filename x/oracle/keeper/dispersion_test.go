@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/keeper"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func priceDispersion(height uint64) types.PriceDispersion {
+	return types.PriceDispersion{
+		Min:           sdkmath.NewInt(90),
+		Max:           sdkmath.NewInt(120),
+		LowerQuartile: sdkmath.NewInt(95),
+		UpperQuartile: sdkmath.NewInt(110),
+		NumValidators: 3,
+		Power:         sdkmath.NewInt(60),
+		TotalPower:    sdkmath.NewInt(100),
+		BlockHeight:   height,
+	}
+}
+
+func (s *KeeperTestSuite) TestPriceDispersion() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	s.Run("currency pair does not exist - fail", func() {
+		s.Require().Error(s.oracleKeeper.SetPriceDispersion(s.ctx, cp, priceDispersion(1)))
+	})
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	s.Run("no dispersion stored - fail", func() {
+		_, err := s.oracleKeeper.GetPriceDispersion(s.ctx, cp)
+		s.Require().ErrorAs(err, &types.PriceDispersionNotExistError{})
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(100), BlockHeight: 1}))
+	s.Require().NoError(s.oracleKeeper.SetPriceDispersion(s.ctx, cp, priceDispersion(1)))
+
+	s.Run("dispersion of the latest price - pass", func() {
+		dispersion, err := s.oracleKeeper.GetPriceDispersion(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().Equal(priceDispersion(1), dispersion)
+	})
+
+	s.Run("dispersion of an outdated price - fail", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(100), BlockHeight: 2}))
+
+		_, err := s.oracleKeeper.GetPriceDispersion(s.ctx, cp)
+		s.Require().ErrorAs(err, &types.PriceDispersionNotExistError{})
+	})
+
+	s.Run("dispersion is removed with the currency pair", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceDispersion(s.ctx, cp, priceDispersion(2)))
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+		s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+		_, err := s.oracleKeeper.GetPriceDispersion(s.ctx, cp)
+		s.Require().ErrorAs(err, &types.PriceDispersionNotExistError{})
+	})
+}
+
+func (s *KeeperTestSuite) TestGetPriceWithDispersion() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8},
+	}, nil)
+
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(100), BlockHeight: 1}))
+
+	s.Run("price without dispersion", func() {
+		res, err := qs.GetPrice(s.ctx, &types.GetPriceRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Nil(res.Dispersion)
+	})
+
+	s.Require().NoError(s.oracleKeeper.SetPriceDispersion(s.ctx, cp, priceDispersion(1)))
+
+	s.Run("price with dispersion", func() {
+		res, err := qs.GetPrice(s.ctx, &types.GetPriceRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Equal(priceDispersion(1), *res.Dispersion)
+
+		prices, err := qs.GetPrices(s.ctx, &types.GetPricesRequest{CurrencyPairIds: []string{cp.String()}})
+		s.Require().NoError(err)
+		s.Require().Len(prices.Prices, 1)
+		s.Require().Equal(priceDispersion(1), *prices.Prices[0].Dispersion)
+	})
+}
+
+func (s *KeeperTestSuite) TestPriceDispersionGenesisRoundTrip() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{Price: sdkmath.NewInt(100), BlockHeight: 1}))
+	s.Require().NoError(s.oracleKeeper.SetPriceDispersion(s.ctx, cp, priceDispersion(1)))
+
+	gs := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal([]types.CurrencyPairPriceDispersion{types.NewCurrencyPairPriceDispersion(cp, priceDispersion(1))}, gs.PriceDispersions)
+
+	// initialize a fresh keeper from the exported genesis
+	s.SetupTest()
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	dispersion, err := s.oracleKeeper.GetPriceDispersion(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Equal(priceDispersion(1), dispersion)
+}
@@ -17,6 +17,11 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		panic(err)
 	}
 
+	// set the params
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// initialize all CurrencyPairs + genesis prices
 	for _, cpg := range gs.CurrencyPairGenesis {
 		state := types.NewCurrencyPairState(cpg.Id, cpg.Nonce, cpg.CurrencyPairPrice)
@@ -26,6 +31,41 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 		}
 	}
 
+	// initialize the price history of each CurrencyPair
+	for _, history := range gs.PriceHistory {
+		if err := k.SetPriceHistory(ctx, history.CurrencyPair, history.Prices); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the validator reports within the performance window, and the performance derived from them
+	for _, report := range gs.ValidatorReports {
+		if err := k.addValidatorReport(ctx, report); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the set of CurrencyPairs whose prices are stale
+	for _, cp := range gs.StaleCurrencyPairs {
+		if err := k.stalePrices.Set(ctx, cp.String()); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the price dispersion of each CurrencyPair
+	for _, dispersion := range gs.PriceDispersions {
+		if err := k.SetPriceDispersion(ctx, dispersion.CurrencyPair, dispersion.Dispersion); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
+	// initialize the consecutive circuit breaker rejections of each CurrencyPair
+	for _, trip := range gs.CircuitBreakerTrips {
+		if err := k.circuitBreakerTrips.Set(ctx, trip.CurrencyPair.String(), trip.Trips); err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+	}
+
 	// set the next ID to state
 	if err := k.nextCurrencyPairID.Set(ctx, gs.NextId); err != nil {
 		panic(fmt.Errorf("error in genesis: %w", err))
@@ -49,10 +89,21 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("error in genesis: %w", err))
 	}
 
+	// get the current params
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	// instantiate genesis-state w/ empty array
 	gs := &types.GenesisState{
 		CurrencyPairGenesis: make([]types.CurrencyPairGenesis, 0),
 		NextId:              id,
+		PriceHistory:        make([]types.CurrencyPairPriceHistory, 0),
+		Params:              params,
+		StaleCurrencyPairs:  make([]slinkytypes.CurrencyPair, 0),
+		PriceDispersions:    make([]types.CurrencyPairPriceDispersion, 0),
+		CircuitBreakerTrips: make([]types.CurrencyPairCircuitBreakerTrips, 0),
 	}
 
 	// next, iterate over NonceKey to retrieve any CurrencyPairs that have not yet been traversed (CurrencyPairs w/ no Price info)
@@ -69,5 +120,67 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	// export the price history of each CurrencyPair that has one
+	for _, cpg := range gs.CurrencyPairGenesis {
+		prices, err := k.GetPriceHistory(ctx, cpg.CurrencyPair)
+		if err != nil {
+			panic(fmt.Errorf("error in genesis: %w", err))
+		}
+
+		if len(prices) == 0 {
+			continue
+		}
+
+		gs.PriceHistory = append(gs.PriceHistory, types.NewCurrencyPairPriceHistory(cpg.CurrencyPair, prices))
+	}
+
+	// export the validator reports within the performance window
+	gs.ValidatorReports, err = k.GetAllValidatorReports(ctx)
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	// export the set of CurrencyPairs whose prices are stale
+	err = k.stalePrices.Walk(ctx, nil, func(cpStr string) (bool, error) {
+		cp, err := slinkytypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return true, err
+		}
+
+		gs.StaleCurrencyPairs = append(gs.StaleCurrencyPairs, cp)
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	// export the price dispersion of each CurrencyPair that has one
+	err = k.priceDispersions.Walk(ctx, nil, func(cpStr string, dispersion types.PriceDispersion) (bool, error) {
+		cp, err := slinkytypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return true, err
+		}
+
+		gs.PriceDispersions = append(gs.PriceDispersions, types.NewCurrencyPairPriceDispersion(cp, dispersion))
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
+	// export the consecutive circuit breaker rejections of each CurrencyPair that has any
+	err = k.circuitBreakerTrips.Walk(ctx, nil, func(cpStr string, trips types.CircuitBreakerTrips) (bool, error) {
+		cp, err := slinkytypes.CurrencyPairFromString(cpStr)
+		if err != nil {
+			return true, err
+		}
+
+		gs.CircuitBreakerTrips = append(gs.CircuitBreakerTrips, types.NewCurrencyPairCircuitBreakerTrips(cp, trips))
+		return false, nil
+	})
+	if err != nil {
+		panic(fmt.Errorf("error in genesis: %w", err))
+	}
+
 	return gs
 }
@@ -35,6 +35,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 						},
 					},
 				},
+				Params: types.DefaultParams(),
 			},
 			false,
 		},
@@ -62,6 +63,7 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 					},
 				},
 				NextId: 2,
+				Params: types.DefaultParams(),
 			},
 			true,
 		},
@@ -194,6 +196,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 				},
 			},
 			NextId: 2,
+			Params: types.DefaultParams(),
 		}
 		// init genesis
 		s.oracleKeeper.InitGenesis(s.ctx, gs)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
@@ -67,12 +68,31 @@ func (q queryServer) GetPrice(goCtx context.Context, req *types.GetPriceRequest)
 		return nil, err
 	}
 
+	blocksSinceUpdate, stale, err := q.k.GetPriceStaleness(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	dispersion, err := q.k.getPriceDispersionForQuery(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	shadow, err := q.k.IsCurrencyPairShadow(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
 	// return the QuotePrice + Nonce
 	return &types.GetPriceResponse{
-		Price:    &qpn.QuotePrice,
-		Nonce:    qpn.Nonce(),
-		Decimals: decimals,
-		Id:       id,
+		Price:             &qpn.QuotePrice,
+		Nonce:             qpn.Nonce(),
+		Decimals:          decimals,
+		Id:                id,
+		BlocksSinceUpdate: blocksSinceUpdate,
+		Stale:             stale,
+		Dispersion:        dispersion,
+		Shadow:            shadow,
 	}, nil
 }
 
@@ -111,11 +131,30 @@ func (q queryServer) GetPrices(goCtx context.Context, req *types.GetPricesReques
 			return nil, err
 		}
 
+		blocksSinceUpdate, stale, err := q.k.GetPriceStaleness(ctx, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		dispersion, err := q.k.getPriceDispersionForQuery(ctx, cp)
+		if err != nil {
+			return nil, err
+		}
+
+		shadow, err := q.k.IsCurrencyPairShadow(ctx, cp)
+		if err != nil {
+			return nil, err
+		}
+
 		prices = append(prices, types.GetPriceResponse{
-			Price:    &qpn.QuotePrice,
-			Nonce:    qpn.Nonce(),
-			Decimals: decimals,
-			Id:       id,
+			Price:             &qpn.QuotePrice,
+			Nonce:             qpn.Nonce(),
+			Decimals:          decimals,
+			Id:                id,
+			BlocksSinceUpdate: blocksSinceUpdate,
+			Stale:             stale,
+			Dispersion:        dispersion,
+			Shadow:            shadow,
 		})
 	}
 
@@ -145,3 +184,134 @@ func (q queryServer) GetCurrencyPairMappingList(ctx context.Context, _ *types.Ge
 
 	return &types.GetCurrencyPairMappingListResponse{Mappings: pairs}, nil
 }
+
+// GetPriceHistory returns the historical QuotePrices stored for a given CurrencyPair, ordered by ascending block height.
+func (q queryServer) GetPriceHistory(goCtx context.Context, req *types.GetPriceHistoryRequest) (*types.GetPriceHistoryResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, fmt.Errorf("the module is not tracking CurrencyPair: %s", cp.String())
+	}
+
+	prices, err := q.k.GetPriceHistory(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetPriceHistoryResponse{
+		Prices: prices,
+	}, nil
+}
+
+// GetTWAP returns the time-weighted average price of a given CurrencyPair over either a block window or a time window.
+// Exactly one of the windows must be set in the request.
+func (q queryServer) GetTWAP(goCtx context.Context, req *types.GetTWAPRequest) (*types.GetTWAPResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	cp := req.CurrencyPair
+	if err := cp.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid currency pair: %w", err)
+	}
+
+	if (req.BlockWindow == 0) == (req.TimeWindow == 0) {
+		return nil, fmt.Errorf("exactly one of block_window or time_window must be set")
+	}
+
+	// unwrap ctx
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !q.k.HasCurrencyPair(ctx, cp) {
+		return nil, fmt.Errorf("the module is not tracking CurrencyPair: %s", cp.String())
+	}
+
+	var (
+		twap    math.Int
+		samples uint64
+		err     error
+	)
+	if req.BlockWindow != 0 {
+		twap, samples, err = q.k.GetTWAPByBlocks(ctx, cp, req.BlockWindow)
+	} else {
+		twap, samples, err = q.k.GetTWAPByDuration(ctx, cp, req.TimeWindow)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	decimals, err := q.k.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetTWAPResponse{
+		Price:      twap,
+		Decimals:   decimals,
+		NumSamples: samples,
+	}, nil
+}
+
+// Params returns the current x/oracle module parameters.
+func (q queryServer) Params(goCtx context.Context, req *types.ParamsRequest) (*types.ParamsResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	params, err := q.k.GetParams(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ParamsResponse{Params: params}, nil
+}
+
+// ValidatorPerformance returns the performance of a given validator over the PerformanceWindow. It fails if the
+// validator's consensus address is invalid, or if the validator has no reports within the window.
+func (q queryServer) ValidatorPerformance(goCtx context.Context, req *types.ValidatorPerformanceRequest) (*types.ValidatorPerformanceResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	validator, err := sdk.ConsAddressFromBech32(req.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator consensus address: %w", err)
+	}
+
+	performance, err := q.k.GetValidatorPerformance(sdk.UnwrapSDKContext(goCtx), validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorPerformanceResponse{Performance: performance}, nil
+}
+
+// ValidatorPerformances returns the performance over the PerformanceWindow of every validator with reports within
+// the window.
+func (q queryServer) ValidatorPerformances(goCtx context.Context, req *types.ValidatorPerformancesRequest) (*types.ValidatorPerformancesResponse, error) {
+	// fail on nil requests
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	performances, err := q.k.GetAllValidatorPerformances(sdk.UnwrapSDKContext(goCtx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ValidatorPerformancesResponse{Performances: performances}, nil
+}
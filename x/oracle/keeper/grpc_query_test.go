@@ -273,3 +273,31 @@ func (s *KeeperTestSuite) TestGetCurrencyPairMappingListGRPC() {
 		}, res.Mappings)
 	})
 }
+
+func (s *KeeperTestSuite) TestParamsQuery() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	s.Run("nil request - fail", func() {
+		_, err := qs.Params(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("default params - pass", func() {
+		res, err := qs.Params(s.ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), res.Params)
+	})
+
+	s.Run("updated params - pass", func() {
+		params := types.NewParams(sdkmath.LegacyOneDec(), 0, 0, sdkmath.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0)
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		res, err := qs.Params(s.ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(params, res.Params)
+
+		threshold, err := s.oracleKeeper.GetVotePowerThreshold(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.LegacyOneDec(), threshold)
+	})
+}
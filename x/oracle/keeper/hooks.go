@@ -6,8 +6,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
 )
 
+// SetHooks sets the x/oracle hooks.  This method must take a pointer due to nature of the hooks interface
+// and SDK start up sequence.
+func (k *Keeper) SetHooks(oh types.OracleHooks) {
+	k.hooks = oh
+}
+
 // Hooks is a wrapper struct around Keeper.
 type Hooks struct {
 	k *Keeper
@@ -22,35 +29,53 @@ func (k *Keeper) Hooks() Hooks {
 
 // AfterMarketCreated is the marketmap hook for x/oracle that is run after a market is created in
 // the marketmap.  After the market is created, a currency pair and its state are initialized in the
-// oracle module.
+// oracle module, and the currency pair is flagged if the market is in the shadow state.
 func (h Hooks) AfterMarketCreated(ctx sdk.Context, market marketmaptypes.Market) error {
 	ctx.Logger().Info(fmt.Sprintf("creating x/oracle state for market %s", market.Ticker.String()))
-	return h.k.CreateCurrencyPair(ctx, market.Ticker.CurrencyPair)
+	if err := h.k.CreateCurrencyPair(ctx, market.Ticker.CurrencyPair); err != nil {
+		return err
+	}
+
+	return h.k.SetCurrencyPairShadow(ctx, market.Ticker.CurrencyPair, isShadowMarket(market))
 }
 
 // AfterMarketUpdated is the marketmap hook for x/oracle that is run after a market is updated in
-// the marketmap.
+// the marketmap.  The currency pair is flagged if the market is in the shadow state, and un-flagged
+// otherwise.
 func (h Hooks) AfterMarketUpdated(ctx sdk.Context, market marketmaptypes.Market) error {
 	ctx.Logger().Info(fmt.Sprintf("market %s updated", market.Ticker.String()))
-	return nil
+	if !h.k.HasCurrencyPair(ctx, market.Ticker.CurrencyPair) {
+		return nil
+	}
+
+	return h.k.SetCurrencyPairShadow(ctx, market.Ticker.CurrencyPair, isShadowMarket(market))
 }
 
 // AfterMarketGenesis verifies that all markets set in the x/marketmap genesis are registered in
-// the x/oracle module.
+// the x/oracle module, and flags the currency pairs of the markets in the shadow state.
 func (h Hooks) AfterMarketGenesis(ctx sdk.Context, markets map[string]marketmaptypes.Market) error {
 	for _, market := range markets {
 		if !h.k.HasCurrencyPair(ctx, market.Ticker.CurrencyPair) {
 			return fmt.Errorf("currency pair %s is registered in x/marketmap but not in x/oracle", market.Ticker.String())
 		}
+
+		if err := h.k.SetCurrencyPairShadow(ctx, market.Ticker.CurrencyPair, isShadowMarket(market)); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 // AfterMarketRemoved is the marketmap hook for x/oracle that is run after a market is removed in
-// the marketmap.
+// the marketmap.  The currency pair is no longer flagged as shadow, but its state is otherwise retained.
 func (h Hooks) AfterMarketRemoved(ctx sdk.Context, key string) error {
 	ctx.Logger().Info(fmt.Sprintf("market %s removed. retaining x/oracle state if it exists", key))
 
-	return nil
+	return h.k.shadowCurrencyPairs.Remove(ctx, key)
+}
+
+// isShadowMarket returns true if the given market is in the shadow state of its lifecycle.
+func isShadowMarket(market marketmaptypes.Market) bool {
+	return market.Ticker.LifecycleState() == marketmaptypes.MarketStateShadow
 }
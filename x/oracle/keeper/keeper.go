@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -67,6 +68,41 @@ type Keeper struct {
 	// numCPs is the number of CPs.
 	numCPs collections.Item[uint64]
 
+	// priceHistory is the set of historical QuotePrices, keyed by (CurrencyPair.String(), block height).
+	priceHistory collections.Map[collections.Pair[string, uint64], types.QuotePrice]
+
+	// priceHistoryCounts is the number of historical QuotePrices stored per CurrencyPair.
+	priceHistoryCounts collections.Map[string, uint64]
+
+	// params is the module's parameters.
+	params collections.Item[types.Params]
+
+	// stalePrices is the set of CurrencyPairs (by CurrencyPair.String()) whose prices are currently stale.
+	stalePrices collections.KeySet[string]
+
+	// validatorReports is the set of validator reports within the performance window, keyed by
+	// (block height, validator consensus address).
+	validatorReports collections.Map[collections.Pair[uint64, string], types.ValidatorReport]
+
+	// validatorPerformances is the performance of each validator accumulated over validatorReports, keyed by
+	// validator consensus address.
+	validatorPerformances collections.Map[string, types.ValidatorPerformance]
+
+	// priceDispersions is the dispersion of the validator prices that each CurrencyPair's latest price was
+	// aggregated from, keyed by CurrencyPair.String().
+	priceDispersions collections.Map[string, types.PriceDispersion]
+
+	// shadowCurrencyPairs is the set of CurrencyPairs (by CurrencyPair.String()) whose markets are in the shadow
+	// state of their lifecycle in x/marketmap.
+	shadowCurrencyPairs collections.KeySet[string]
+
+	// circuitBreakerTrips is the number of consecutive prices that the circuit breaker rejected for moving in the same
+	// direction, keyed by CurrencyPair.String().
+	circuitBreakerTrips collections.Map[string, types.CircuitBreakerTrips]
+
+	// registered hooks
+	hooks types.OracleHooks
+
 	// module authority
 	authority sdk.AccAddress
 }
@@ -98,6 +134,21 @@ func NewKeeper(
 		nextCurrencyPairID: collections.NewSequence(sb, types.CurrencyPairIDKeyPrefix, "currency_pair_id"),
 		currencyPairs:      collections.NewIndexedMap(sb, types.CurrencyPairKeyPrefix, "currency_pair", collections.StringKey, codec.CollValue[types.CurrencyPairState](cdc), indices),
 		idIndex:            idMulti,
+		priceHistory: collections.NewMap(sb, types.PriceHistoryKeyPrefix, "price_history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.QuotePrice](cdc)),
+		priceHistoryCounts: collections.NewMap(sb, types.PriceHistoryCountKeyPrefix, "price_history_counts", collections.StringKey, collections.Uint64Value),
+		params:             collections.NewItem(sb, types.ParamsKeyPrefix, "params", codec.CollValue[types.Params](cdc)),
+		stalePrices:        collections.NewKeySet(sb, types.StalePricesKeyPrefix, "stale_prices", collections.StringKey),
+		validatorReports: collections.NewMap(sb, types.ValidatorReportsKeyPrefix, "validator_reports",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), codec.CollValue[types.ValidatorReport](cdc)),
+		validatorPerformances: collections.NewMap(sb, types.ValidatorPerformanceKeyPrefix, "validator_performances",
+			collections.StringKey, codec.CollValue[types.ValidatorPerformance](cdc)),
+		priceDispersions: collections.NewMap(sb, types.PriceDispersionKeyPrefix, "price_dispersions",
+			collections.StringKey, codec.CollValue[types.PriceDispersion](cdc)),
+		shadowCurrencyPairs: collections.NewKeySet(sb, types.ShadowCurrencyPairsKeyPrefix, "shadow_currency_pairs", collections.StringKey),
+		circuitBreakerTrips: collections.NewMap(sb, types.CircuitBreakerTripsKeyPrefix, "circuit_breaker_trips",
+			collections.StringKey, codec.CollValue[types.CircuitBreakerTrips](cdc)),
+		hooks: &types.NoopOracleHooks{},
 	}
 
 	// create the schema
@@ -123,6 +174,21 @@ func (k *Keeper) RemoveCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair
 	if err := k.incrementRemovedCPCounter(ctx); err != nil {
 		return err
 	}
+	if err := k.removePriceHistory(ctx, cp); err != nil {
+		return err
+	}
+	if err := k.stalePrices.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.priceDispersions.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.shadowCurrencyPairs.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
+	if err := k.circuitBreakerTrips.Remove(ctx, cp.String()); err != nil {
+		return err
+	}
 
 	return k.decrementCPCounter(ctx)
 }
@@ -192,7 +258,7 @@ func (k *Keeper) GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 
 // SetPriceForCurrencyPair sets the given QuotePrice for a given CurrencyPair, and updates the CurrencyPair's nonce. Note, no validation is performed on
 // either the CurrencyPair or the QuotePrice (it is expected the caller performs this validation). If the CurrencyPair does not exist, create the currency-pair
-// and set its nonce to 0.
+// and set its nonce to 0. The QuotePrice is also appended to the CurrencyPair's price history.
 func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) error {
 	// get the current state for the currency-pair, fail if it does not exist
 	cps, err := k.currencyPairs.Get(ctx, cp.String())
@@ -211,7 +277,11 @@ func (k *Keeper) SetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.Currenc
 	}
 
 	// set the updated state
-	return k.currencyPairs.Set(ctx, cp.String(), cps)
+	if err := k.currencyPairs.Set(ctx, cp.String(), cps); err != nil {
+		return err
+	}
+
+	return k.addPriceToHistory(ctx, cp, qp)
 }
 
 // CreateCurrencyPair creates a CurrencyPair in state, and sets its ID to the next available ID. If the CurrencyPair already exists, return an error.
@@ -406,6 +476,27 @@ func (k *Keeper) decrementCPCounter(ctx sdk.Context) error {
 	return k.numCPs.Set(ctx, val)
 }
 
+// SetParams sets the x/oracle module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	return k.params.Set(ctx, params)
+}
+
+// GetParams returns the x/oracle module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
+	return k.params.Get(ctx)
+}
+
+// GetVotePowerThreshold returns the fraction of the total voting power that must have reported a price for a
+// currency-pair in order for an aggregated price to be written to state.
+func (k *Keeper) GetVotePowerThreshold(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return params.VotePowerThreshold, nil
+}
+
 // GetNumCurrencyPairs returns the number of currency pairs currently in state.
 func (k *Keeper) GetNumCurrencyPairs(ctx sdk.Context) (uint64, error) {
 	return k.numCPs.Get(ctx)
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place state migrations of the x/oracle module.
+type Migrator struct {
+	k *Keeper
+}
+
+// NewMigrator returns a new Migrator for the given keeper.
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{k: k}
+}
+
+// Migrate1to2 migrates the x/oracle module from consensus version 1 to 2. Version 1 has no module
+// parameters, so the default parameters are written to state.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	hasParams, err := m.k.params.Has(ctx)
+	if err != nil || hasParams {
+		return err
+	}
+
+	return m.k.SetParams(ctx, types.DefaultParams())
+}
@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"github.com/1119-Labs/slinky/x/oracle/keeper"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestMigrate1to2() {
+	s.Run("writes the default params if none are set", func() {
+		s.SetupWithNoMMKeeper()
+
+		_, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().Error(err)
+
+		s.Require().NoError(keeper.NewMigrator(&s.oracleKeeper).Migrate1to2(s.ctx))
+
+		params, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultParams(), params)
+
+		// the begin blocker no longer fails for lack of params
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+	})
+
+	s.Run("does not overwrite existing params", func() {
+		s.SetupTest()
+
+		params := types.DefaultParams()
+		params.MaxPriceHistory = 5
+		s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+
+		s.Require().NoError(keeper.NewMigrator(&s.oracleKeeper).Migrate1to2(s.ctx))
+
+		stored, err := s.oracleKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, stored)
+	})
+}
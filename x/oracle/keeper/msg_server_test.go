@@ -103,6 +103,7 @@ func (s *KeeperTestSuite) TestMsgAddCurrencyPairs() {
 			},
 		},
 		NextId: 101,
+		Params: types.DefaultParams(),
 	}
 	s.oracleKeeper.InitGenesis(s.ctx, gs)
 
@@ -171,6 +172,7 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 			},
 		},
 		NextId: 102,
+		Params: types.DefaultParams(),
 	}
 	// init genesis
 	s.oracleKeeper.InitGenesis(s.ctx, gs)
@@ -254,3 +256,64 @@ func (s *KeeperTestSuite) TestMsgRemoveCurrencyPairs() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	ms := keeper.NewMsgServer(s.oracleKeeper)
+	newParams := types.NewParams(sdkmath.LegacyNewDecWithPrec(5, 1), 10, 0, sdkmath.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0)
+
+	tcs := []struct {
+		name       string
+		req        *types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the request is empty - fail",
+			nil,
+			false,
+		},
+		{
+			"if the authority is not the module authority - fail",
+			&types.MsgUpdateParams{
+				Authority: sdk.AccAddress("not-authority").String(),
+				Params:    newParams,
+			},
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			&types.MsgUpdateParams{
+				Authority: moduleAuthAddr.String(),
+				Params:    types.NewParams(sdkmath.LegacyZeroDec(), 10, 0, sdkmath.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			},
+			false,
+		},
+		{
+			"if the authority + params are valid (with x/marketmap enabled) - pass",
+			&types.MsgUpdateParams{
+				Authority: moduleAuthAddr.String(),
+				Params:    newParams,
+			},
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		s.Run(tc.name, func() {
+			before, err := s.oracleKeeper.GetParams(s.ctx)
+			s.Require().NoError(err)
+
+			_, err = ms.UpdateParams(s.ctx, tc.req)
+
+			after, getErr := s.oracleKeeper.GetParams(s.ctx)
+			s.Require().NoError(getErr)
+
+			if tc.expectPass {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.req.Params, after)
+			} else {
+				s.Require().Error(err)
+				s.Require().Equal(before, after)
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// GetPriceHistory returns the historical QuotePrices stored for the given CurrencyPair, ordered by ascending
+// block height. If no history exists for the CurrencyPair, an empty list is returned.
+func (k *Keeper) GetPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair) ([]types.QuotePrice, error) {
+	prices := make([]types.QuotePrice, 0)
+	err := k.iteratePriceHistory(ctx, cp, func(_ uint64, qp types.QuotePrice) (bool, error) {
+		prices = append(prices, qp)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// GetTWAPByBlocks returns the time-weighted average price for the given CurrencyPair over the last blocks blocks
+// (including the current block), where each historical price is weighted by the number of blocks for which it was
+// the latest price. The number of historical prices contributing to the average is returned alongside it.
+func (k *Keeper) GetTWAPByBlocks(ctx sdk.Context, cp slinkytypes.CurrencyPair, blocks uint64) (math.Int, uint64, error) {
+	if blocks == 0 {
+		return math.Int{}, 0, fmt.Errorf("block window must be positive")
+	}
+
+	end := ctx.BlockHeight() + 1
+	start := max(end-int64(blocks), 0) //nolint:gosec
+
+	// only the prices inside the window, and the latest price before it, contribute to the average
+	prices, err := k.getPriceHistorySince(ctx, cp, uint64(start)) //nolint:gosec
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	if len(prices) == 0 {
+		return math.Int{}, 0, types.NewPriceHistoryNotExistError(cp)
+	}
+
+	twap, samples, err := types.ComputeTWAP(prices, start, end, func(qp types.QuotePrice) int64 {
+		return int64(qp.BlockHeight) //nolint:gosec
+	})
+	if err != nil {
+		return math.Int{}, 0, fmt.Errorf("failed to compute twap for %s: %w", cp, err)
+	}
+
+	return twap, samples, nil
+}
+
+// GetTWAPByDuration returns the time-weighted average price for the given CurrencyPair over the given duration ending
+// at the current block time, where each historical price is weighted by the time for which it was the latest price.
+// The number of historical prices contributing to the average is returned alongside it.
+func (k *Keeper) GetTWAPByDuration(ctx sdk.Context, cp slinkytypes.CurrencyPair, window time.Duration) (math.Int, uint64, error) {
+	if window <= 0 {
+		return math.Int{}, 0, fmt.Errorf("time window must be positive")
+	}
+
+	end := ctx.BlockTime().UnixNano()
+	start := ctx.BlockTime().Add(-window).UnixNano()
+
+	// only the prices inside the window, and the latest price before it, contribute to the average
+	prices, err := k.getPriceHistorySinceTime(ctx, cp, ctx.BlockTime().Add(-window))
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	if len(prices) == 0 {
+		return math.Int{}, 0, types.NewPriceHistoryNotExistError(cp)
+	}
+
+	twap, samples, err := types.ComputeTWAP(prices, start, end, func(qp types.QuotePrice) int64 {
+		return qp.BlockTimestamp.UnixNano()
+	})
+	if err != nil {
+		return math.Int{}, 0, fmt.Errorf("failed to compute twap for %s: %w", cp, err)
+	}
+
+	return twap, samples, nil
+}
+
+// PrunePriceHistory removes the oldest historical QuotePrices of every CurrencyPair whose history exceeds the
+// MaxPriceHistory parameter. If the price history is disabled, all retained history is removed.
+func (k *Keeper) PrunePriceHistory(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// collect the pairs to prune first, so that the counts are not modified while iterating over them
+	var pairs []string
+	err = k.priceHistoryCounts.Walk(ctx, nil, func(cp string, count uint64) (bool, error) {
+		if count > params.MaxPriceHistory {
+			pairs = append(pairs, cp)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, cpString := range pairs {
+		cp, err := slinkytypes.CurrencyPairFromString(cpString)
+		if err != nil {
+			return err
+		}
+
+		if err := k.prunePriceHistory(ctx, cp, params.MaxPriceHistory); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// prunePriceHistory removes the oldest historical QuotePrices of the given CurrencyPair until at most maxPriceHistory
+// are retained.
+func (k *Keeper) prunePriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, maxPriceHistory uint64) error {
+	count, err := k.getPriceHistoryCount(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+
+	if count <= maxPriceHistory {
+		return nil
+	}
+
+	// collect the heights of the oldest entries, so that the history is not modified while iterating over it
+	toRemove := count - maxPriceHistory
+	heights := make([]uint64, 0, toRemove)
+	err = k.iteratePriceHistory(ctx, cp, func(height uint64, _ types.QuotePrice) (bool, error) {
+		heights = append(heights, height)
+		return uint64(len(heights)) >= toRemove, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, height := range heights {
+		if err := k.priceHistory.Remove(ctx, collections.Join(cp.String(), height)); err != nil {
+			return err
+		}
+	}
+
+	return k.setPriceHistoryCount(ctx, cp.String(), maxPriceHistory)
+}
+
+// SetPriceHistory overwrites the historical QuotePrices stored for the given CurrencyPair. No validation is performed
+// on the prices, it is expected that the caller has validated them.
+func (k *Keeper) SetPriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, prices []types.QuotePrice) error {
+	if err := k.removePriceHistory(ctx, cp); err != nil {
+		return err
+	}
+
+	for _, qp := range prices {
+		if err := k.addPriceToHistory(ctx, cp, qp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addPriceToHistory appends the given QuotePrice to the CurrencyPair's price history. If a price has already been
+// recorded for the same block height, it is overwritten. This is a no-op if the price history is disabled. The
+// history is pruned to MaxPriceHistory entries in the BeginBlocker.
+func (k *Keeper) addPriceToHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair, qp types.QuotePrice) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxPriceHistory == 0 {
+		return nil
+	}
+
+	key := collections.Join(cp.String(), qp.BlockHeight)
+	exists, err := k.priceHistory.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if err := k.priceHistory.Set(ctx, key, qp); err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	count, err := k.getPriceHistoryCount(ctx, cp.String())
+	if err != nil {
+		return err
+	}
+
+	return k.setPriceHistoryCount(ctx, cp.String(), count+1)
+}
+
+// removePriceHistory removes all historical QuotePrices stored for the given CurrencyPair.
+func (k *Keeper) removePriceHistory(ctx sdk.Context, cp slinkytypes.CurrencyPair) error {
+	if err := k.priceHistory.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](cp.String())); err != nil {
+		return err
+	}
+
+	return k.priceHistoryCounts.Remove(ctx, cp.String())
+}
+
+// iteratePriceHistory iterates over the historical QuotePrices of the given CurrencyPair in ascending order of block
+// height. Iteration stops once the callback returns true or an error.
+func (k *Keeper) iteratePriceHistory(
+	ctx sdk.Context,
+	cp slinkytypes.CurrencyPair,
+	cb func(height uint64, qp types.QuotePrice) (bool, error),
+) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](cp.String())
+	return k.priceHistory.Walk(ctx, rng, func(key collections.Pair[string, uint64], qp types.QuotePrice) (bool, error) {
+		return cb(key.K2(), qp)
+	})
+}
+
+// getPriceHistorySince returns the historical QuotePrices of the given CurrencyPair recorded after the given block
+// height, preceded by the latest QuotePrice recorded at or before it (if any), ordered by ascending block height.
+func (k *Keeper) getPriceHistorySince(ctx sdk.Context, cp slinkytypes.CurrencyPair, height uint64) ([]types.QuotePrice, error) {
+	prices := make([]types.QuotePrice, 0)
+	before := collections.NewPrefixedPairRange[string, uint64](cp.String()).EndInclusive(height).Descending()
+	err := k.priceHistory.Walk(ctx, before, func(_ collections.Pair[string, uint64], qp types.QuotePrice) (bool, error) {
+		prices = append(prices, qp)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	after := collections.NewPrefixedPairRange[string, uint64](cp.String()).StartExclusive(height)
+	err = k.priceHistory.Walk(ctx, after, func(_ collections.Pair[string, uint64], qp types.QuotePrice) (bool, error) {
+		prices = append(prices, qp)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prices, nil
+}
+
+// getPriceHistorySinceTime returns the historical QuotePrices stored for the given CurrencyPair with a block timestamp
+// after t, preceded by the latest one at or before t if it exists, ordered by ascending block height. The history is
+// walked from the latest QuotePrice, so that the QuotePrices before t are not read.
+func (k *Keeper) getPriceHistorySinceTime(ctx sdk.Context, cp slinkytypes.CurrencyPair, t time.Time) ([]types.QuotePrice, error) {
+	prices := make([]types.QuotePrice, 0)
+	rng := collections.NewPrefixedPairRange[string, uint64](cp.String()).Descending()
+	err := k.priceHistory.Walk(ctx, rng, func(_ collections.Pair[string, uint64], qp types.QuotePrice) (bool, error) {
+		prices = append(prices, qp)
+		return !qp.BlockTimestamp.After(t), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(prices)
+	return prices, nil
+}
+
+// getPriceHistoryCount returns the number of historical QuotePrices stored for the given CurrencyPair.
+func (k *Keeper) getPriceHistoryCount(ctx sdk.Context, cp string) (uint64, error) {
+	count, err := k.priceHistoryCounts.Get(ctx, cp)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// setPriceHistoryCount sets the number of historical QuotePrices stored for the given CurrencyPair.
+func (k *Keeper) setPriceHistoryCount(ctx sdk.Context, cp string, count uint64) error {
+	if count == 0 {
+		return k.priceHistoryCounts.Remove(ctx, cp)
+	}
+
+	return k.priceHistoryCounts.Set(ctx, cp, count)
+}
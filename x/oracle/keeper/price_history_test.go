@@ -0,0 +1,315 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/keeper"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+var historyStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// simulateBlocks runs BeginBlocker and writes price(height) for cp at each of the given number of
+// consecutive blocks, starting at startHeight. Blocks are one second apart.
+func (s *KeeperTestSuite) simulateBlocks(cp slinkytypes.CurrencyPair, startHeight, blocks int64, price func(height int64) int64) {
+	for h := startHeight; h < startHeight+blocks; h++ {
+		s.ctx = s.ctx.WithBlockHeight(h).WithBlockTime(historyStart.Add(time.Duration(h) * time.Second))
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+			Price:          sdkmath.NewInt(price(h)),
+			BlockTimestamp: s.ctx.BlockTime(),
+			BlockHeight:    uint64(h), //nolint:gosec
+		}))
+	}
+}
+
+func (s *KeeperTestSuite) setMaxPriceHistory(maxPriceHistory uint64) {
+	params, err := s.oracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxPriceHistory = maxPriceHistory
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryIsBounded() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	s.setMaxPriceHistory(10)
+	s.simulateBlocks(cp, 1, 250, func(h int64) int64 { return h })
+
+	// the history is pruned at the beginning of every block
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+	history, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Len(history, 10)
+
+	// the retained history is the most recent prices in ascending order
+	for i, qp := range history {
+		s.Require().Equal(uint64(241+i), qp.BlockHeight) //nolint:gosec
+		s.Require().Equal(sdkmath.NewInt(int64(241+i)), qp.Price)
+	}
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryShrinksWhenMaxLowered() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.simulateBlocks(cp, 1, 50, func(h int64) int64 { return h })
+
+	history, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Len(history, 50)
+
+	// the history is shrunk in the next block, even if no new price is added
+	s.setMaxPriceHistory(5)
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+	history, err = s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Len(history, 5)
+	s.Require().Equal(uint64(46), history[0].BlockHeight)
+
+	// disabling the history clears it in the next block
+	s.setMaxPriceHistory(0)
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+	history, err = s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+}
+
+func (s *KeeperTestSuite) TestPriceHistorySameHeightOverwrites() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.setMaxPriceHistory(2)
+
+	for _, price := range []int64{100, 200, 300} {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+			Price:       sdkmath.NewInt(price),
+			BlockHeight: 10,
+		}))
+	}
+
+	history, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Require().Equal(sdkmath.NewInt(300), history[0].Price)
+}
+
+func (s *KeeperTestSuite) TestRemoveCurrencyPairRemovesPriceHistory() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.simulateBlocks(cp, 1, 5, func(h int64) int64 { return h })
+
+	s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(s.ctx, cp))
+	history, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Empty(history)
+
+	// re-adding the pair starts a fresh history
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.simulateBlocks(cp, 6, 1, func(h int64) int64 { return h })
+	history, err = s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+}
+
+func (s *KeeperTestSuite) TestGetTWAP() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	s.Run("no history - fail", func() {
+		_, _, err := s.oracleKeeper.GetTWAPByBlocks(s.ctx.WithBlockHeight(10), cp, 5)
+		s.Require().ErrorAs(err, &types.PriceHistoryNotExistError{})
+
+		_, _, err = s.oracleKeeper.GetTWAPByDuration(s.ctx.WithBlockTime(historyStart), cp, time.Minute)
+		s.Require().ErrorAs(err, &types.PriceHistoryNotExistError{})
+	})
+
+	// prices 100 for blocks [1, 10], then 200 for blocks [11, 20]
+	s.simulateBlocks(cp, 1, 20, func(h int64) int64 {
+		if h <= 10 {
+			return 100
+		}
+		return 200
+	})
+
+	s.Run("zero windows - fail", func() {
+		_, _, err := s.oracleKeeper.GetTWAPByBlocks(s.ctx, cp, 0)
+		s.Require().Error(err)
+
+		_, _, err = s.oracleKeeper.GetTWAPByDuration(s.ctx, cp, 0)
+		s.Require().Error(err)
+	})
+
+	s.Run("block window spanning both prices", func() {
+		twap, samples, err := s.oracleKeeper.GetTWAPByBlocks(s.ctx, cp, 20)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(150), twap)
+		s.Require().Equal(uint64(20), samples)
+	})
+
+	s.Run("no prices in the window - fail with the underlying error", func() {
+		// every price was written after the window
+		_, _, err := s.oracleKeeper.GetTWAPByDuration(s.ctx.WithBlockTime(historyStart), cp, time.Second)
+		s.Require().Error(err)
+		s.Require().NotErrorAs(err, &types.PriceHistoryNotExistError{})
+		s.Require().ErrorContains(err, "no prices in window")
+	})
+
+	s.Run("block window within the latest price", func() {
+		twap, samples, err := s.oracleKeeper.GetTWAPByBlocks(s.ctx, cp, 5)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), twap)
+		s.Require().Equal(uint64(5), samples)
+	})
+
+	s.Run("block window larger than the history", func() {
+		twap, _, err := s.oracleKeeper.GetTWAPByBlocks(s.ctx, cp, 1000)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(150), twap)
+	})
+
+	s.Run("block window with missing updates carries the previous price", func() {
+		// no updates for 10 blocks, the price of 200 is carried over
+		ctx := s.ctx.WithBlockHeight(30)
+		twap, samples, err := s.oracleKeeper.GetTWAPByBlocks(ctx, cp, 20)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), twap)
+		s.Require().Equal(uint64(10), samples)
+	})
+
+	s.Run("time window spanning both prices", func() {
+		// the window (0s, 20s] weights 100 for 10s ([1s, 11s)) and 200 for 9s ([11s, 20s))
+		twap, _, err := s.oracleKeeper.GetTWAPByDuration(s.ctx, cp, 19*time.Second)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt((100*10+200*9)/19), twap)
+	})
+
+	s.Run("time window ending at a new price weights the previous price", func() {
+		// a new price at a later time, with no time elapsed since
+		s.simulateBlocks(cp, 21, 1, func(int64) int64 { return 400 })
+		twap, _, err := s.oracleKeeper.GetTWAPByDuration(s.ctx, cp, time.Nanosecond)
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(200), twap)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetTWAPReadsOnlyTheWindow() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+
+	// the history is much longer than the window, with a different price at every block
+	s.simulateBlocks(cp, 1, 100, func(h int64) int64 { return h * h })
+
+	blockTWAP, blockSamples, err := s.oracleKeeper.GetTWAPByBlocks(s.ctx, cp, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), blockSamples)
+
+	// a block window of 10 blocks ending at the current block is the time window of 10s ending a second after it,
+	// since blocks are one second apart
+	ctx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Second))
+	durationTWAP, durationSamples, err := s.oracleKeeper.GetTWAPByDuration(ctx, cp, 10*time.Second)
+	s.Require().NoError(err)
+	s.Require().Equal(blockTWAP, durationTWAP)
+	s.Require().Equal(blockSamples, durationSamples)
+
+	// a window starting between blocks weights the price before it for the part of the window it was the latest
+	durationTWAP, durationSamples, err = s.oracleKeeper.GetTWAPByDuration(ctx, cp, 9500*time.Millisecond)
+	s.Require().NoError(err)
+	weightedSum := int64(91 * 91 * 500)
+	for h := int64(92); h <= 100; h++ {
+		weightedSum += h * h * 1000
+	}
+	s.Require().Equal(sdkmath.NewInt(weightedSum/9500), durationTWAP)
+	s.Require().Equal(uint64(10), durationSamples)
+}
+
+func (s *KeeperTestSuite) TestPriceHistoryGenesisRoundTrip() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.simulateBlocks(cp, 1, 30, func(h int64) int64 { return 1000 + h })
+
+	gs := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(gs.Validate())
+	s.Require().Len(gs.PriceHistory, 1)
+	s.Require().Len(gs.PriceHistory[0].Prices, 30)
+
+	// initialize a fresh keeper from the exported genesis
+	s.SetupTest()
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	history, err := s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Equal(gs.PriceHistory[0].Prices, history)
+
+	// the history continues to be bounded after import
+	s.setMaxPriceHistory(10)
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx))
+	history, err = s.oracleKeeper.GetPriceHistory(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().Len(history, 10)
+}
+
+func (s *KeeperTestSuite) TestGetPriceHistoryQuery() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	s.Run("nil request - fail", func() {
+		_, err := qs.GetPriceHistory(s.ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("untracked currency pair - fail", func() {
+		_, err := qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{CurrencyPair: cp})
+		s.Require().Error(err)
+	})
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.simulateBlocks(cp, 1, 3, func(h int64) int64 { return h })
+
+	s.Run("tracked currency pair - pass", func() {
+		res, err := qs.GetPriceHistory(s.ctx, &types.GetPriceHistoryRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Len(res.Prices, 3)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetTWAPQuery() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.simulateBlocks(cp, 1, 10, func(h int64) int64 { return 10 * h })
+
+	s.Run("no window - fail", func() {
+		_, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp})
+		s.Require().Error(err)
+	})
+
+	s.Run("both windows - fail", func() {
+		_, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp, BlockWindow: 1, TimeWindow: time.Second})
+		s.Require().Error(err)
+	})
+
+	s.Run("block window - pass", func() {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8},
+		}, nil).Once()
+
+		res, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp, BlockWindow: 2})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(95), res.Price)
+		s.Require().Equal(uint64(8), res.Decimals)
+		s.Require().Equal(uint64(2), res.NumSamples)
+	})
+
+	s.Run("time window - pass", func() {
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+			Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8},
+		}, nil).Once()
+
+		res, err := qs.GetTWAP(s.ctx, &types.GetTWAPRequest{CurrencyPair: cp, TimeWindow: 2 * time.Second})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(85), res.Price)
+	})
+}
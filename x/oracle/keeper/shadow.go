@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+// IsCurrencyPairShadow returns true if the given CurrencyPair's market is in the shadow state of its lifecycle in
+// x/marketmap. The prices of shadow CurrencyPairs are aggregated and stored as usual, but must not be used by
+// consumers until the market is activated.
+func (k *Keeper) IsCurrencyPairShadow(ctx sdk.Context, cp slinkytypes.CurrencyPair) (bool, error) {
+	return k.shadowCurrencyPairs.Has(ctx, cp.String())
+}
+
+// SetCurrencyPairShadow flags (or un-flags) the given CurrencyPair as belonging to a market in the shadow state.
+func (k *Keeper) SetCurrencyPairShadow(ctx sdk.Context, cp slinkytypes.CurrencyPair, shadow bool) error {
+	if shadow {
+		return k.shadowCurrencyPairs.Set(ctx, cp.String())
+	}
+
+	return k.shadowCurrencyPairs.Remove(ctx, cp.String())
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/keeper"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) TestShadowMarketHooks() {
+	cp := slinkytypes.NewCurrencyPair("PEPE", "USD")
+	market := marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{
+			CurrencyPair:     cp,
+			Decimals:         8,
+			MinProviderCount: 1,
+		},
+	}
+	market.Ticker.SetLifecycleState(marketmaptypes.MarketStateShadow)
+
+	hooks := s.oracleKeeper.Hooks()
+
+	s.Run("shadow market is flagged on creation", func() {
+		s.Require().NoError(hooks.AfterMarketCreated(s.ctx, market))
+
+		shadow, err := s.oracleKeeper.IsCurrencyPairShadow(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(shadow)
+	})
+
+	s.Run("shadow market prices are stored and flagged", func() {
+		s.Require().NoError(s.oracleKeeper.SetPriceForCurrencyPair(s.ctx, cp, types.QuotePrice{
+			Price:          sdkmath.NewInt(100),
+			BlockTimestamp: s.ctx.BlockTime(),
+			BlockHeight:    uint64(s.ctx.BlockHeight()), //nolint:gosec
+		}))
+		s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(market, nil)
+
+		res, err := keeper.NewQueryServer(s.oracleKeeper).GetPrice(s.ctx, &types.GetPriceRequest{CurrencyPair: cp})
+		s.Require().NoError(err)
+		s.Require().Equal(sdkmath.NewInt(100), res.Price.Price)
+		s.Require().True(res.Shadow)
+	})
+
+	s.Run("activated market is no longer flagged", func() {
+		market.Ticker.SetLifecycleState(marketmaptypes.MarketStateActive)
+		s.Require().NoError(hooks.AfterMarketUpdated(s.ctx, market))
+
+		shadow, err := s.oracleKeeper.IsCurrencyPairShadow(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(shadow)
+	})
+
+	s.Run("genesis flags shadow markets", func() {
+		market.Ticker.SetLifecycleState(marketmaptypes.MarketStateShadow)
+		s.Require().NoError(hooks.AfterMarketGenesis(s.ctx, map[string]marketmaptypes.Market{cp.String(): market}))
+
+		shadow, err := s.oracleKeeper.IsCurrencyPairShadow(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(shadow)
+	})
+
+	s.Run("removed market is no longer flagged", func() {
+		s.Require().NoError(hooks.AfterMarketRemoved(s.ctx, cp.String()))
+
+		shadow, err := s.oracleKeeper.IsCurrencyPairShadow(s.ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(shadow)
+	})
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// GetPriceStaleness returns the number of consecutive blocks that the given CurrencyPair's price has gone without
+// an update, and whether that number exceeds the MaxPriceStaleness parameter. If the CurrencyPair has no price,
+// zero and false are returned. An error is returned if the CurrencyPair does not exist.
+func (k *Keeper) GetPriceStaleness(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, bool, error) {
+	qp, err := k.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		var quotePriceNotExistError types.QuotePriceNotExistError
+		if errors.As(err, &quotePriceNotExistError) {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	blocksSinceUpdate := blocksSince(ctx, qp)
+	return blocksSinceUpdate, isStale(params, blocksSinceUpdate), nil
+}
+
+// IsPriceStale returns true if the given CurrencyPair is currently tracked as having a stale price, i.e. the
+// CurrencyPair's price has gone without an update for more than MaxPriceStaleness blocks as of the last
+// call to UpdatePriceStaleness.
+func (k *Keeper) IsPriceStale(ctx sdk.Context, cp slinkytypes.CurrencyPair) (bool, error) {
+	return k.stalePrices.Has(ctx, cp.String())
+}
+
+// UpdatePriceStaleness checks the staleness of every CurrencyPair's price against the MaxPriceStaleness parameter.
+// An EventTypePriceStale event is emitted for each CurrencyPair whose price has become stale, and an
+// EventTypePriceRecovered event is emitted for each previously stale CurrencyPair whose price has been updated
+// (or for all stale CurrencyPairs if staleness tracking has been disabled).
+func (k *Keeper) UpdatePriceStaleness(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// collect the transitions first, so that the stale set is not modified while iterating over the currency-pairs
+	type transition struct {
+		cp    string
+		qp    types.QuotePrice
+		stale bool
+	}
+	var transitions []transition
+	err = k.currencyPairs.Walk(ctx, nil, func(cp string, cps types.CurrencyPairState) (bool, error) {
+		// currency-pairs that have never received a price are not tracked
+		if cps.Price == nil {
+			return false, nil
+		}
+
+		wasStale, err := k.stalePrices.Has(ctx, cp)
+		if err != nil {
+			return true, err
+		}
+
+		if stale := isStale(params, blocksSince(ctx, *cps.Price)); stale != wasStale {
+			transitions = append(transitions, transition{cp: cp, qp: *cps.Price, stale: stale})
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, t := range transitions {
+		eventType := types.EventTypePriceStale
+		if t.stale {
+			err = k.stalePrices.Set(ctx, t.cp)
+		} else {
+			eventType = types.EventTypePriceRecovered
+			err = k.stalePrices.Remove(ctx, t.cp)
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyCurrencyPair, t.cp),
+			sdk.NewAttribute(types.AttributeKeyBlocksSinceUpdate, strconv.FormatUint(blocksSince(ctx, t.qp), 10)),
+			sdk.NewAttribute(types.AttributeKeyLastUpdateHeight, strconv.FormatUint(t.qp.BlockHeight, 10)),
+		))
+	}
+
+	return nil
+}
+
+// blocksSince returns the number of blocks between the given QuotePrice's update and the current block.
+func blocksSince(ctx sdk.Context, qp types.QuotePrice) uint64 {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	if height <= qp.BlockHeight {
+		return 0
+	}
+
+	return height - qp.BlockHeight
+}
+
+// isStale returns true if blocksSinceUpdate exceeds the MaxPriceStaleness parameter. Staleness tracking is
+// disabled if MaxPriceStaleness is zero.
+func isStale(params types.Params, blocksSinceUpdate uint64) bool {
+	return params.MaxPriceStaleness != 0 && blocksSinceUpdate > params.MaxPriceStaleness
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
+	"github.com/1119-Labs/slinky/x/oracle/keeper"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func (s *KeeperTestSuite) setMaxPriceStaleness(maxPriceStaleness uint64) {
+	params, err := s.oracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MaxPriceStaleness = maxPriceStaleness
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+}
+
+// stalenessEvents returns the staleness events of the given type emitted to the context's event manager.
+func stalenessEvents(ctx sdk.Context, eventType string) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func (s *KeeperTestSuite) TestGetPriceStaleness() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	s.Run("currency pair does not exist - fail", func() {
+		_, _, err := s.oracleKeeper.GetPriceStaleness(s.ctx, cp)
+		s.Require().Error(err)
+	})
+
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.setMaxPriceStaleness(5)
+
+	s.Run("currency pair without a price is not stale", func() {
+		blocks, stale, err := s.oracleKeeper.GetPriceStaleness(s.ctx.WithBlockHeight(100), cp)
+		s.Require().NoError(err)
+		s.Require().Zero(blocks)
+		s.Require().False(stale)
+	})
+
+	s.simulateBlocks(cp, 10, 1, func(int64) int64 { return 100 })
+
+	s.Run("fresh price", func() {
+		blocks, stale, err := s.oracleKeeper.GetPriceStaleness(s.ctx.WithBlockHeight(10), cp)
+		s.Require().NoError(err)
+		s.Require().Zero(blocks)
+		s.Require().False(stale)
+	})
+
+	s.Run("price at the staleness bound is not stale", func() {
+		blocks, stale, err := s.oracleKeeper.GetPriceStaleness(s.ctx.WithBlockHeight(15), cp)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(5), blocks)
+		s.Require().False(stale)
+	})
+
+	s.Run("price past the staleness bound is stale", func() {
+		blocks, stale, err := s.oracleKeeper.GetPriceStaleness(s.ctx.WithBlockHeight(16), cp)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(6), blocks)
+		s.Require().True(stale)
+	})
+
+	s.Run("staleness tracking disabled", func() {
+		s.setMaxPriceStaleness(0)
+		blocks, stale, err := s.oracleKeeper.GetPriceStaleness(s.ctx.WithBlockHeight(1000), cp)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(990), blocks)
+		s.Require().False(stale)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdatePriceStaleness() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.setMaxPriceStaleness(2)
+
+	// a price is written at height 1, then the pair goes without updates
+	s.simulateBlocks(cp, 1, 1, func(int64) int64 { return 100 })
+
+	for h := int64(2); h <= 3; h++ {
+		ctx := s.ctx.WithBlockHeight(h).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+		s.Require().Empty(stalenessEvents(ctx, types.EventTypePriceStale))
+
+		stale, err := s.oracleKeeper.IsPriceStale(ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(stale)
+	}
+
+	// the pair crosses the staleness bound
+	ctx := s.ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+	events := stalenessEvents(ctx, types.EventTypePriceStale)
+	s.Require().Len(events, 1)
+	s.Require().Equal(sdk.NewEvent(
+		types.EventTypePriceStale,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyBlocksSinceUpdate, "3"),
+		sdk.NewAttribute(types.AttributeKeyLastUpdateHeight, "1"),
+	), events[0])
+
+	stale, err := s.oracleKeeper.IsPriceStale(ctx, cp)
+	s.Require().NoError(err)
+	s.Require().True(stale)
+
+	// the event is only emitted once
+	ctx = s.ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+	s.Require().Empty(stalenessEvents(ctx, types.EventTypePriceStale))
+
+	// the price is updated, and the pair recovers
+	s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+	s.simulateBlocks(cp, 6, 1, func(int64) int64 { return 101 })
+	ctx = s.ctx.WithBlockHeight(7).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+	events = stalenessEvents(ctx, types.EventTypePriceRecovered)
+	s.Require().Len(events, 1)
+	s.Require().Equal(sdk.NewEvent(
+		types.EventTypePriceRecovered,
+		sdk.NewAttribute(types.AttributeKeyCurrencyPair, cp.String()),
+		sdk.NewAttribute(types.AttributeKeyBlocksSinceUpdate, "1"),
+		sdk.NewAttribute(types.AttributeKeyLastUpdateHeight, "6"),
+	), events[0])
+
+	stale, err = s.oracleKeeper.IsPriceStale(ctx, cp)
+	s.Require().NoError(err)
+	s.Require().False(stale)
+
+	s.Run("removing a stale currency pair clears its staleness", func() {
+		ctx := s.ctx.WithBlockHeight(100)
+		s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+		stale, err := s.oracleKeeper.IsPriceStale(ctx, cp)
+		s.Require().NoError(err)
+		s.Require().True(stale)
+
+		s.Require().NoError(s.oracleKeeper.RemoveCurrencyPair(ctx, cp))
+		stale, err = s.oracleKeeper.IsPriceStale(ctx, cp)
+		s.Require().NoError(err)
+		s.Require().False(stale)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetPriceQueryStaleness() {
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.setMaxPriceStaleness(3)
+	s.simulateBlocks(cp, 1, 1, func(int64) int64 { return 100 })
+	s.mockMarketMapKeeper.On("GetMarket", mock.Anything, cp.String()).Return(marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 8},
+	}, nil)
+
+	res, err := qs.GetPrice(s.ctx.WithBlockHeight(3), &types.GetPriceRequest{CurrencyPair: cp})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.BlocksSinceUpdate)
+	s.Require().False(res.Stale)
+
+	res, err = qs.GetPrice(s.ctx.WithBlockHeight(5), &types.GetPriceRequest{CurrencyPair: cp})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), res.BlocksSinceUpdate)
+	s.Require().True(res.Stale)
+
+	prices, err := qs.GetPrices(s.ctx.WithBlockHeight(5), &types.GetPricesRequest{CurrencyPairIds: []string{cp.String()}})
+	s.Require().NoError(err)
+	s.Require().Len(prices.Prices, 1)
+	s.Require().Equal(uint64(4), prices.Prices[0].BlocksSinceUpdate)
+	s.Require().True(prices.Prices[0].Stale)
+}
+
+func (s *KeeperTestSuite) TestPriceStalenessGenesisRoundTrip() {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(s.ctx, cp))
+	s.setMaxPriceStaleness(2)
+
+	s.simulateBlocks(cp, 1, 1, func(int64) int64 { return 100 })
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(s.ctx.WithBlockHeight(4)))
+
+	gs := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(gs.Validate())
+	s.Require().Equal([]slinkytypes.CurrencyPair{cp}, gs.StaleCurrencyPairs)
+
+	// initialize a fresh keeper from the exported genesis
+	s.SetupTest()
+	s.oracleKeeper.InitGenesis(s.ctx, *gs)
+
+	stale, err := s.oracleKeeper.IsPriceStale(s.ctx, cp)
+	s.Require().NoError(err)
+	s.Require().True(stale)
+
+	// the recovery of the imported stale pair is detected
+	s.simulateBlocks(cp, 5, 1, func(int64) int64 { return 101 })
+	ctx := s.ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(s.oracleKeeper.BeginBlocker(ctx))
+	s.Require().Len(stalenessEvents(ctx, types.EventTypePriceRecovered), 1)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+// RecordValidatorReports adds the given ValidatorReports for the current block to each validator's performance, and
+// removes the reports that have fallen out of the PerformanceWindow. The OracleHooks are then called with the updated
+// performance of every validator with a report in the current block. Each hook is run in a cache context, so an
+// error from a hook discards its state changes and is logged, rather than failing the block. If validator performance
+// tracking is disabled, any retained reports and performances are removed instead.
+func (k *Keeper) RecordValidatorReports(ctx sdk.Context, reports []types.ValidatorReport) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.PerformanceWindow == 0 {
+		return k.clearValidatorReports(ctx)
+	}
+
+	for _, report := range reports {
+		if err := k.addValidatorReport(ctx, report); err != nil {
+			return err
+		}
+	}
+
+	if err := k.pruneValidatorReports(ctx, params.PerformanceWindow); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		validator, err := sdk.ConsAddressFromBech32(report.Validator)
+		if err != nil {
+			return err
+		}
+
+		performance, err := k.validatorPerformances.Get(ctx, report.Validator)
+		if err != nil {
+			return err
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.hooks.AfterValidatorPerformanceUpdated(cacheCtx, validator, performance); err != nil {
+			ctx.Logger().Error(
+				"oracle hook failed after validator performance update; discarding its state changes",
+				"validator", report.Validator,
+				"err", err,
+			)
+
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// GetValidatorPerformance returns the given validator's performance over the PerformanceWindow. An error is returned
+// if the validator has no reports within the window.
+func (k *Keeper) GetValidatorPerformance(ctx sdk.Context, validator sdk.ConsAddress) (types.ValidatorPerformance, error) {
+	performance, err := k.validatorPerformances.Get(ctx, validator.String())
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ValidatorPerformance{}, fmt.Errorf("no performance recorded for validator %s", validator)
+		}
+
+		return types.ValidatorPerformance{}, err
+	}
+
+	return performance, nil
+}
+
+// GetAllValidatorPerformances returns the performance over the PerformanceWindow of every validator with reports
+// within the window.
+func (k *Keeper) GetAllValidatorPerformances(ctx sdk.Context) ([]types.ValidatorPerformance, error) {
+	performances := make([]types.ValidatorPerformance, 0)
+	err := k.validatorPerformances.Walk(ctx, nil, func(_ string, performance types.ValidatorPerformance) (bool, error) {
+		performances = append(performances, performance)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return performances, nil
+}
+
+// GetAllValidatorReports returns every ValidatorReport within the PerformanceWindow, ordered by ascending height.
+func (k *Keeper) GetAllValidatorReports(ctx sdk.Context) ([]types.ValidatorReport, error) {
+	reports := make([]types.ValidatorReport, 0)
+	err := k.validatorReports.Walk(ctx, nil, func(_ collections.Pair[uint64, string], report types.ValidatorReport) (bool, error) {
+		reports = append(reports, report)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+// addValidatorReport stores the given ValidatorReport and adds it to the validator's performance. If a report has
+// already been stored for the validator at the same height, it is replaced.
+func (k *Keeper) addValidatorReport(ctx sdk.Context, report types.ValidatorReport) error {
+	performance, err := k.validatorPerformances.Get(ctx, report.Validator)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+
+		performance = types.NewValidatorPerformance(report.Validator)
+	}
+
+	key := collections.Join(report.Height, report.Validator)
+	existing, err := k.validatorReports.Get(ctx, key)
+	switch {
+	case err == nil:
+		performance.Sub(existing)
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	performance.Add(report)
+	if err := k.validatorReports.Set(ctx, key, report); err != nil {
+		return err
+	}
+
+	return k.validatorPerformances.Set(ctx, report.Validator, performance)
+}
+
+// clearValidatorReports removes every stored ValidatorReport and ValidatorPerformance.
+func (k *Keeper) clearValidatorReports(ctx sdk.Context) error {
+	if err := k.validatorReports.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	return k.validatorPerformances.Clear(ctx, nil)
+}
+
+// pruneValidatorReports removes the reports that are older than window blocks as of the current block from each
+// validator's performance. Validators without any remaining reports are removed.
+func (k *Keeper) pruneValidatorReports(ctx sdk.Context, window uint64) error {
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	if height < window {
+		return nil
+	}
+
+	// collect the expired reports first, so that the reports are not modified while iterating over them
+	cutoff := height - window + 1
+	rng := new(collections.Range[collections.Pair[uint64, string]]).EndExclusive(collections.Join(cutoff, ""))
+
+	var expired []types.ValidatorReport
+	err := k.validatorReports.Walk(ctx, rng, func(_ collections.Pair[uint64, string], report types.ValidatorReport) (bool, error) {
+		expired = append(expired, report)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, report := range expired {
+		if err := k.validatorReports.Remove(ctx, collections.Join(report.Height, report.Validator)); err != nil {
+			return err
+		}
+
+		performance, err := k.validatorPerformances.Get(ctx, report.Validator)
+		if err != nil {
+			return err
+		}
+
+		performance.Sub(report)
+		if performance.NumBlocks == 0 {
+			err = k.validatorPerformances.Remove(ctx, report.Validator)
+		} else {
+			err = k.validatorPerformances.Set(ctx, report.Validator, performance)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/mock"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/oracle/keeper"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+	"github.com/1119-Labs/slinky/x/oracle/types/mocks"
+)
+
+var (
+	val1 = sdk.ConsAddress("val1")
+	val2 = sdk.ConsAddress("val2")
+	val3 = sdk.ConsAddress("val3")
+)
+
+func (s *KeeperTestSuite) setPerformanceWindow(window uint64) {
+	params, err := s.oracleKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.PerformanceWindow = window
+	s.Require().NoError(s.oracleKeeper.SetParams(s.ctx, params))
+}
+
+// report returns a ValidatorReport for the given validator at the given height.
+func report(validator sdk.ConsAddress, height uint64, absent bool, missing, reported uint64, deviation sdkmath.LegacyDec) types.ValidatorReport {
+	r := types.NewValidatorReport(validator, height)
+	r.Absent = absent
+	r.MissingPrices = missing
+	r.ReportedPrices = reported
+	r.Deviation = deviation
+	return r
+}
+
+func (s *KeeperTestSuite) TestRecordValidatorReports() {
+	s.Run("performance tracking disabled", func() {
+		s.setPerformanceWindow(0)
+
+		ctx := s.ctx.WithBlockHeight(1)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+			report(val1, 1, false, 0, 2, sdkmath.LegacyZeroDec()),
+		}))
+
+		performances, err := s.oracleKeeper.GetAllValidatorPerformances(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(performances)
+	})
+
+	s.Run("reports are accumulated and pruned over the window", func() {
+		s.setPerformanceWindow(3)
+
+		// val1 reports every block, val2 is absent at every odd height
+		for height := uint64(1); height <= 5; height++ {
+			val2Report := report(val2, height, false, 0, 2, sdkmath.LegacyZeroDec())
+			if height%2 == 1 {
+				val2Report = report(val2, height, true, 0, 0, sdkmath.LegacyZeroDec())
+			}
+
+			ctx := s.ctx.WithBlockHeight(int64(height))
+			s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+				report(val1, height, false, 1, 1, sdkmath.LegacyNewDecWithPrec(1, 2)),
+				val2Report,
+			}))
+		}
+
+		// only heights 3, 4, 5 are within the window
+		performance, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), performance.NumBlocks)
+		s.Require().Equal(uint64(0), performance.NumAbsent)
+		s.Require().Equal(uint64(3), performance.NumMissingPrices)
+		s.Require().Equal(uint64(3), performance.NumReportedPrices)
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(3, 2), performance.TotalDeviation)
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(5, 1), performance.MissRate())
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(1, 2), performance.AverageDeviation())
+
+		performance, err = s.oracleKeeper.GetValidatorPerformance(s.ctx, val2)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), performance.NumBlocks)
+		s.Require().Equal(uint64(2), performance.NumAbsent)
+		s.Require().Equal(uint64(2), performance.NumReportedPrices)
+		s.Require().Equal(sdkmath.LegacyNewDec(2).QuoInt64(3), performance.AbsentRate())
+
+		reports, err := s.oracleKeeper.GetAllValidatorReports(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(reports, 6)
+		s.Require().Equal(uint64(3), reports[0].Height)
+	})
+
+	s.Run("validators without reports in the window are removed", func() {
+		s.setPerformanceWindow(3)
+
+		for height := uint64(6); height <= 8; height++ {
+			ctx := s.ctx.WithBlockHeight(int64(height))
+			s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+				report(val1, height, false, 0, 2, sdkmath.LegacyZeroDec()),
+			}))
+		}
+
+		_, err := s.oracleKeeper.GetValidatorPerformance(s.ctx, val2)
+		s.Require().Error(err)
+
+		performances, err := s.oracleKeeper.GetAllValidatorPerformances(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.ValidatorPerformance{
+			{
+				Validator:         val1.String(),
+				NumBlocks:         3,
+				NumReportedPrices: 6,
+				TotalDeviation:    sdkmath.LegacyZeroDec(),
+			},
+		}, performances)
+	})
+
+	s.Run("a report for the same height replaces the previous report", func() {
+		s.setPerformanceWindow(3)
+
+		ctx := s.ctx.WithBlockHeight(8)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+			report(val1, 8, true, 0, 0, sdkmath.LegacyZeroDec()),
+		}))
+
+		performance, err := s.oracleKeeper.GetValidatorPerformance(ctx, val1)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(3), performance.NumBlocks)
+		s.Require().Equal(uint64(1), performance.NumAbsent)
+		s.Require().Equal(uint64(4), performance.NumReportedPrices)
+	})
+
+	s.Run("disabling performance tracking removes the retained reports", func() {
+		s.setPerformanceWindow(0)
+
+		ctx := s.ctx.WithBlockHeight(9)
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, nil))
+
+		performances, err := s.oracleKeeper.GetAllValidatorPerformances(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(performances)
+
+		reports, err := s.oracleKeeper.GetAllValidatorReports(ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(reports)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidatorPerformanceHooks() {
+	s.setPerformanceWindow(10)
+	ctx := s.ctx.WithBlockHeight(1)
+
+	hooks := mocks.NewOracleHooks(s.T())
+	s.oracleKeeper.SetHooks(hooks)
+	defer s.oracleKeeper.SetHooks(&types.NoopOracleHooks{})
+
+	s.Run("hooks are called with the updated performance of each validator", func() {
+		expected := types.NewValidatorPerformance(val1.String())
+		expected.NumBlocks = 1
+		expected.NumAbsent = 1
+
+		hooks.On("AfterValidatorPerformanceUpdated", mock.Anything, val1, expected).Return(nil).Once()
+
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+			report(val1, 1, true, 0, 0, sdkmath.LegacyZeroDec()),
+		}))
+	})
+
+	s.Run("hook errors discard the hook's state changes and do not fail", func() {
+		failed := slinkytypes.NewCurrencyPair("FAIL", "USD")
+		passed := slinkytypes.NewCurrencyPair("PASS", "USD")
+
+		hooks.On("AfterValidatorPerformanceUpdated", mock.Anything, val2, mock.Anything).Run(func(args mock.Arguments) {
+			s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(args.Get(0).(sdk.Context), failed))
+		}).Return(fmt.Errorf("fail")).Once()
+		hooks.On("AfterValidatorPerformanceUpdated", mock.Anything, val3, mock.Anything).Run(func(args mock.Arguments) {
+			s.Require().NoError(s.oracleKeeper.CreateCurrencyPair(args.Get(0).(sdk.Context), passed))
+		}).Return(nil).Once()
+
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+			report(val2, 1, false, 0, 1, sdkmath.LegacyZeroDec()),
+			report(val3, 1, false, 0, 1, sdkmath.LegacyZeroDec()),
+		}))
+
+		s.Require().False(s.oracleKeeper.HasCurrencyPair(ctx, failed))
+		s.Require().True(s.oracleKeeper.HasCurrencyPair(ctx, passed))
+
+		// the performance is recorded regardless of the hook error
+		_, err := s.oracleKeeper.GetValidatorPerformance(ctx, val2)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidatorPerformanceQueries() {
+	s.setPerformanceWindow(10)
+	qs := keeper.NewQueryServer(s.oracleKeeper)
+
+	ctx := s.ctx.WithBlockHeight(1)
+	s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+		report(val1, 1, false, 0, 1, sdkmath.LegacyNewDecWithPrec(1, 1)),
+	}))
+
+	s.Run("nil request - fail", func() {
+		_, err := qs.ValidatorPerformance(ctx, nil)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid validator address - fail", func() {
+		_, err := qs.ValidatorPerformance(ctx, &types.ValidatorPerformanceRequest{Validator: "invalid"})
+		s.Require().Error(err)
+	})
+
+	s.Run("validator without performance - fail", func() {
+		_, err := qs.ValidatorPerformance(ctx, &types.ValidatorPerformanceRequest{Validator: val2.String()})
+		s.Require().Error(err)
+	})
+
+	s.Run("validator performance - pass", func() {
+		res, err := qs.ValidatorPerformance(ctx, &types.ValidatorPerformanceRequest{Validator: val1.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), res.Performance.NumReportedPrices)
+		s.Require().Equal(sdkmath.LegacyNewDecWithPrec(1, 1), res.Performance.TotalDeviation)
+	})
+
+	s.Run("all validator performances - pass", func() {
+		res, err := qs.ValidatorPerformances(ctx, &types.ValidatorPerformancesRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Performances, 1)
+		s.Require().Equal(val1.String(), res.Performances[0].Validator)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidatorReportsGenesis() {
+	s.setPerformanceWindow(10)
+
+	for height := uint64(1); height <= 3; height++ {
+		ctx := s.ctx.WithBlockHeight(int64(height))
+		s.Require().NoError(s.oracleKeeper.RecordValidatorReports(ctx, []types.ValidatorReport{
+			report(val1, height, false, 1, 1, sdkmath.LegacyNewDecWithPrec(1, 1)),
+			report(val2, height, true, 0, 0, sdkmath.LegacyZeroDec()),
+		}))
+	}
+
+	gs := s.oracleKeeper.ExportGenesis(s.ctx)
+	s.Require().Len(gs.ValidatorReports, 6)
+
+	performances, err := s.oracleKeeper.GetAllValidatorPerformances(s.ctx)
+	s.Require().NoError(err)
+
+	// re-initialize the module from the exported genesis
+	s.SetupTest()
+	s.Require().NotPanics(func() {
+		s.oracleKeeper.InitGenesis(s.ctx, *gs)
+	})
+
+	reports, err := s.oracleKeeper.GetAllValidatorReports(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(gs.ValidatorReports, reports)
+
+	imported, err := s.oracleKeeper.GetAllValidatorPerformances(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(performances, imported)
+}
@@ -3,6 +3,8 @@ package oracle
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -16,6 +18,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	oraclemodulev1 "github.com/1119-Labs/slinky/api/slinky/oracle/module/v1"
 	marketmaptypes "github.com/1119-Labs/slinky/x/marketmap/types"
@@ -26,7 +29,7 @@ import (
 
 // ConsensusVersion is the x/oracle module's current version, as modules integrate and updates are made, this value determines what
 // version of the module is being run by the chain.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasName        = AppModule{}
@@ -118,6 +121,12 @@ func (am AppModule) RegisterServices(cfc module.Configurator) {
 	types.RegisterMsgServer(cfc.MsgServer(), keeper.NewMsgServer(am.k))
 	// register Query Service
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	// register in-place state migrations
+	m := keeper.NewMigrator(&am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to register x/oracle migration from version 1 to 2: %s", err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the oracle
@@ -167,6 +176,7 @@ func init() {
 	appmodule.Register(
 		&oraclemodulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetOracleHooks),
 	)
 }
 
@@ -212,3 +222,26 @@ func ProvideModule(in Inputs) Outputs {
 		Hooks:        marketmaptypes.MarketMapHooksWrapper{MarketMapHooks: oracleKeeper.Hooks()},
 	}
 }
+
+// InvokeSetOracleHooks sets the hooks provided by other modules on the module. The hooks are called in
+// the alphabetical order of the names of the modules that provide them.
+func InvokeSetOracleHooks(
+	keeper *keeper.Keeper,
+	hooks map[string]types.OracleHooksWrapper,
+) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	order := maps.Keys(hooks)
+	sort.Strings(order)
+
+	var multiHooks types.MultiOracleHooks
+	for _, modName := range order {
+		multiHooks = append(multiHooks, hooks[modName])
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import "fmt"
+
+const (
+	// AggregationStrategyMedian aggregates validator prices into their stake-weighted median.
+	AggregationStrategyMedian = "median"
+
+	// AggregationStrategyTrimmedMean aggregates validator prices into their stake-weighted mean, after the
+	// lowest and highest prices (by stake weight) are trimmed.
+	AggregationStrategyTrimmedMean = "trimmed_mean"
+
+	// AggregationStrategyMADMean aggregates validator prices into their stake-weighted mean, after prices
+	// that deviate from the median by more than a multiple of the median absolute deviation are rejected.
+	AggregationStrategyMADMean = "mad_mean"
+)
+
+// AggregationStrategies is the set of supported aggregation strategies.
+var AggregationStrategies = []string{
+	AggregationStrategyMedian,
+	AggregationStrategyTrimmedMean,
+	AggregationStrategyMADMean,
+}
+
+// ValidateAggregationStrategy checks that the given aggregation strategy is supported.
+func ValidateAggregationStrategy(strategy string) error {
+	for _, s := range AggregationStrategies {
+		if s == strategy {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported aggregation strategy %q, must be one of %v", strategy, AggregationStrategies)
+}
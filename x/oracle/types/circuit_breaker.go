@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+// NewCurrencyPairCircuitBreakerTrips returns a new CurrencyPairCircuitBreakerTrips given a CurrencyPair and its
+// consecutive circuit breaker rejections.
+func NewCurrencyPairCircuitBreakerTrips(cp slinkytypes.CurrencyPair, trips CircuitBreakerTrips) CurrencyPairCircuitBreakerTrips {
+	return CurrencyPairCircuitBreakerTrips{
+		CurrencyPair: cp,
+		Trips:        trips,
+	}
+}
+
+// ValidateBasic checks that the CurrencyPair is valid, and that at least one rejection is recorded.
+func (t *CurrencyPairCircuitBreakerTrips) ValidateBasic() error {
+	if err := t.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if t.Trips.Count == 0 {
+		return fmt.Errorf("circuit breaker trips for %s must have a positive count", t.CurrencyPair)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+// NewCurrencyPairPriceDispersion returns a new CurrencyPairPriceDispersion given a CurrencyPair and its dispersion.
+func NewCurrencyPairPriceDispersion(cp slinkytypes.CurrencyPair, dispersion PriceDispersion) CurrencyPairPriceDispersion {
+	return CurrencyPairPriceDispersion{
+		CurrencyPair: cp,
+		Dispersion:   dispersion,
+	}
+}
+
+// ValidateBasic checks that the CurrencyPair is valid, and that the dispersion's prices are ordered and its
+// power does not exceed the total power.
+func (d *CurrencyPairPriceDispersion) ValidateBasic() error {
+	if err := d.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	disp := d.Dispersion
+	if disp.Min.IsNil() || disp.Max.IsNil() || disp.LowerQuartile.IsNil() || disp.UpperQuartile.IsNil() ||
+		disp.Power.IsNil() || disp.TotalPower.IsNil() {
+		return fmt.Errorf("price dispersion for %s has unset fields", d.CurrencyPair)
+	}
+
+	if disp.Min.GT(disp.LowerQuartile) || disp.LowerQuartile.GT(disp.UpperQuartile) || disp.UpperQuartile.GT(disp.Max) {
+		return fmt.Errorf("price dispersion for %s is not ordered: min <= lower quartile <= upper quartile <= max", d.CurrencyPair)
+	}
+
+	if disp.Power.GT(disp.TotalPower) {
+		return fmt.Errorf("price dispersion for %s has power %s greater than total power %s", d.CurrencyPair, disp.Power, disp.TotalPower)
+	}
+
+	return nil
+}
@@ -41,3 +41,27 @@ func NewCurrencyPairAlreadyExistsError(cp slinkytypes.CurrencyPair) CurrencyPair
 func (e CurrencyPairAlreadyExistsError) Error() string {
 	return fmt.Sprintf("currency pair already exists: %s", e.cp)
 }
+
+func NewPriceHistoryNotExistError(cp slinkytypes.CurrencyPair) PriceHistoryNotExistError {
+	return PriceHistoryNotExistError{cp.String()}
+}
+
+type PriceHistoryNotExistError struct {
+	cp string
+}
+
+func (e PriceHistoryNotExistError) Error() string {
+	return fmt.Sprintf("no price history in window for CurrencyPair: %s", e.cp)
+}
+
+func NewPriceDispersionNotExistError(cp slinkytypes.CurrencyPair) PriceDispersionNotExistError {
+	return PriceDispersionNotExistError{cp.String()}
+}
+
+type PriceDispersionNotExistError struct {
+	cp string
+}
+
+func (e PriceDispersionNotExistError) Error() string {
+	return fmt.Sprintf("no price dispersion for CurrencyPair: %s", e.cp)
+}
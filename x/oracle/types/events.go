@@ -0,0 +1,16 @@
+package types
+
+// oracle module event types
+
+const (
+	EventTypePriceStale            = "price_stale"
+	EventTypePriceRecovered        = "price_recovered"
+	EventTypeCircuitBreakerTripped = "circuit_breaker_tripped"
+
+	AttributeKeyCurrencyPair      = "currency_pair"
+	AttributeKeyBlocksSinceUpdate = "blocks_since_update"
+	AttributeKeyLastUpdateHeight  = "last_update_height"
+	AttributeKeyPrice             = "price"
+	AttributeKeyReferencePrice    = "reference_price"
+	AttributeKeyAction            = "action"
+)
@@ -24,11 +24,12 @@ func (cpg *CurrencyPairGenesis) ValidateBasic() error {
 	return nil
 }
 
-// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses.
+// NewGenesisState returns a new genesis-state from a set of CurrencyPairGeneses, with default Params.
 func NewGenesisState(cpgs []CurrencyPairGenesis, nextID uint64) *GenesisState {
 	return &GenesisState{
 		CurrencyPairGenesis: cpgs,
 		NextId:              nextID,
+		Params:              DefaultParams(),
 	}
 }
 
@@ -38,8 +39,18 @@ func DefaultGenesisState() *GenesisState {
 }
 
 // Validate validates the currency-pair geneses that the Genesis-State is composed of
-// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated.
+// valid CurrencyPairGenesis, and that no ID for a currency-pair is repeated. It also
+// validates that every price history belongs to a currency-pair in the genesis, and that
+// no currency-pair has more than one price history, that every validator report is valid and
+// unique per height and validator, that every stale currency-pair is in the genesis and not
+// repeated, that every price dispersion is valid, belongs to a currency-pair in the genesis and is
+// not repeated, that the circuit breaker trips are valid, belong to a currency-pair in the genesis and
+// are not repeated, and that the Params are valid.
 func (gs *GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
 	ids := make(map[uint64]struct{})
 	cps := make(map[string]struct{})
 	for _, cpg := range gs.CurrencyPairGenesis {
@@ -70,6 +81,97 @@ func (gs *GenesisState) Validate() error {
 		cps[cpg.CurrencyPair.String()] = struct{}{}
 	}
 
+	histories := make(map[string]struct{})
+	for _, history := range gs.PriceHistory {
+		// validate the price history
+		if err := history.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check that the currency-pair is registered in genesis
+		if _, ok := cps[history.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("price history for unknown currency-pair: %v", history.CurrencyPair.String())
+		}
+
+		// check for repeated price histories
+		if _, ok := histories[history.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated price history for currency-pair: %v", history.CurrencyPair.String())
+		}
+
+		histories[history.CurrencyPair.String()] = struct{}{}
+	}
+
+	reports := make(map[string]struct{})
+	for _, report := range gs.ValidatorReports {
+		// validate the validator report
+		if err := report.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check for repeated reports for a validator at the same height
+		key := fmt.Sprintf("%d/%s", report.Height, report.Validator)
+		if _, ok := reports[key]; ok {
+			return fmt.Errorf("repeated validator report for validator %v at height %v", report.Validator, report.Height)
+		}
+
+		reports[key] = struct{}{}
+	}
+
+	stale := make(map[string]struct{})
+	for _, cp := range gs.StaleCurrencyPairs {
+		// check that the currency-pair is registered in genesis
+		if _, ok := cps[cp.String()]; !ok {
+			return fmt.Errorf("unknown stale currency-pair: %v", cp.String())
+		}
+
+		// check for repeated stale currency-pairs
+		if _, ok := stale[cp.String()]; ok {
+			return fmt.Errorf("repeated stale currency-pair: %v", cp.String())
+		}
+
+		stale[cp.String()] = struct{}{}
+	}
+
+	dispersions := make(map[string]struct{})
+	for _, dispersion := range gs.PriceDispersions {
+		// validate the price dispersion
+		if err := dispersion.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check that the currency-pair is registered in genesis
+		if _, ok := cps[dispersion.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("price dispersion for unknown currency-pair: %v", dispersion.CurrencyPair.String())
+		}
+
+		// check for repeated price dispersions
+		if _, ok := dispersions[dispersion.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated price dispersion for currency-pair: %v", dispersion.CurrencyPair.String())
+		}
+
+		dispersions[dispersion.CurrencyPair.String()] = struct{}{}
+	}
+
+	trips := make(map[string]struct{})
+	for _, trip := range gs.CircuitBreakerTrips {
+		// validate the circuit breaker trips
+		if err := trip.ValidateBasic(); err != nil {
+			return err
+		}
+
+		// check that the currency-pair is registered in genesis
+		if _, ok := cps[trip.CurrencyPair.String()]; !ok {
+			return fmt.Errorf("circuit breaker trips for unknown currency-pair: %v", trip.CurrencyPair.String())
+		}
+
+		// check for repeated circuit breaker trips
+		if _, ok := trips[trip.CurrencyPair.String()]; ok {
+			return fmt.Errorf("repeated circuit breaker trips for currency-pair: %v", trip.CurrencyPair.String())
+		}
+
+		trips[trip.CurrencyPair.String()] = struct{}{}
+	}
+
 	return nil
 }
 
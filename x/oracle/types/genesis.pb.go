@@ -89,6 +89,77 @@ func (m *QuotePrice) GetBlockHeight() uint64 {
 	return 0
 }
 
+// PriceDispersion describes the spread of the prices reported by validators for
+// a CurrencyPair in the block in which its QuotePrice was last updated. All
+// prices are represented in the same decimals as the QuotePrice.
+type PriceDispersion struct {
+	// Min is the lowest price reported by a validator.
+	Min cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min,proto3,customtype=cosmossdk.io/math.Int" json:"min"`
+	// Max is the highest price reported by a validator.
+	Max cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max,proto3,customtype=cosmossdk.io/math.Int" json:"max"`
+	// LowerQuartile is the stake-weighted 25th percentile of the reported
+	// prices.
+	LowerQuartile cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=lower_quartile,json=lowerQuartile,proto3,customtype=cosmossdk.io/math.Int" json:"lower_quartile"`
+	// UpperQuartile is the stake-weighted 75th percentile of the reported
+	// prices.
+	UpperQuartile cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=upper_quartile,json=upperQuartile,proto3,customtype=cosmossdk.io/math.Int" json:"upper_quartile"`
+	// NumValidators is the number of validators that reported a price.
+	NumValidators uint64 `protobuf:"varint,5,opt,name=num_validators,json=numValidators,proto3" json:"num_validators,omitempty"`
+	// Power is the total voting power of the validators that reported a price.
+	Power cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=power,proto3,customtype=cosmossdk.io/math.Int" json:"power"`
+	// TotalPower is the total voting power of the validator set.
+	TotalPower cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_power,json=totalPower,proto3,customtype=cosmossdk.io/math.Int" json:"total_power"`
+	// BlockHeight is the height of the block in which the prices were reported.
+	BlockHeight uint64 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *PriceDispersion) Reset()         { *m = PriceDispersion{} }
+func (m *PriceDispersion) String() string { return proto.CompactTextString(m) }
+func (*PriceDispersion) ProtoMessage()    {}
+func (*PriceDispersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{1}
+}
+func (m *PriceDispersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceDispersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceDispersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceDispersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceDispersion.Merge(m, src)
+}
+func (m *PriceDispersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceDispersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceDispersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceDispersion proto.InternalMessageInfo
+
+func (m *PriceDispersion) GetNumValidators() uint64 {
+	if m != nil {
+		return m.NumValidators
+	}
+	return 0
+}
+
+func (m *PriceDispersion) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
 // CurrencyPairState represents the stateful information tracked by the x/oracle
 // module per-currency-pair.
 type CurrencyPairState struct {
@@ -105,7 +176,7 @@ func (m *CurrencyPairState) Reset()         { *m = CurrencyPairState{} }
 func (m *CurrencyPairState) String() string { return proto.CompactTextString(m) }
 func (*CurrencyPairState) ProtoMessage()    {}
 func (*CurrencyPairState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{1}
+	return fileDescriptor_de36a97821ccc13b, []int{2}
 }
 func (m *CurrencyPairState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -174,7 +245,7 @@ func (m *CurrencyPairGenesis) Reset()         { *m = CurrencyPairGenesis{} }
 func (m *CurrencyPairGenesis) String() string { return proto.CompactTextString(m) }
 func (*CurrencyPairGenesis) ProtoMessage()    {}
 func (*CurrencyPairGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{2}
+	return fileDescriptor_de36a97821ccc13b, []int{3}
 }
 func (m *CurrencyPairGenesis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,6 +302,232 @@ func (m *CurrencyPairGenesis) GetId() uint64 {
 	return 0
 }
 
+// CircuitBreakerTrips is the number of consecutive prices for a CurrencyPair
+// that the circuit breaker rejected for moving in the same direction from its
+// reference price.
+type CircuitBreakerTrips struct {
+	// Upward is true if the rejected prices were above the reference price.
+	Upward bool `protobuf:"varint,1,opt,name=upward,proto3" json:"upward,omitempty"`
+	// Count is the number of consecutive rejected prices.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *CircuitBreakerTrips) Reset()         { *m = CircuitBreakerTrips{} }
+func (m *CircuitBreakerTrips) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerTrips) ProtoMessage()    {}
+func (*CircuitBreakerTrips) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{4}
+}
+func (m *CircuitBreakerTrips) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerTrips) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerTrips.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerTrips) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerTrips.Merge(m, src)
+}
+func (m *CircuitBreakerTrips) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerTrips) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerTrips.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerTrips proto.InternalMessageInfo
+
+func (m *CircuitBreakerTrips) GetUpward() bool {
+	if m != nil {
+		return m.Upward
+	}
+	return false
+}
+
+func (m *CircuitBreakerTrips) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// CurrencyPairCircuitBreakerTrips is the stored CircuitBreakerTrips of a
+// CurrencyPair.
+type CurrencyPairCircuitBreakerTrips struct {
+	// CurrencyPair is the pair that the trips belong to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Trips is the consecutive circuit breaker rejections of the CurrencyPair.
+	Trips CircuitBreakerTrips `protobuf:"bytes,2,opt,name=trips,proto3" json:"trips"`
+}
+
+func (m *CurrencyPairCircuitBreakerTrips) Reset()         { *m = CurrencyPairCircuitBreakerTrips{} }
+func (m *CurrencyPairCircuitBreakerTrips) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairCircuitBreakerTrips) ProtoMessage()    {}
+func (*CurrencyPairCircuitBreakerTrips) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{5}
+}
+func (m *CurrencyPairCircuitBreakerTrips) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairCircuitBreakerTrips) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairCircuitBreakerTrips.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairCircuitBreakerTrips) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairCircuitBreakerTrips.Merge(m, src)
+}
+func (m *CurrencyPairCircuitBreakerTrips) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairCircuitBreakerTrips) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairCircuitBreakerTrips.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairCircuitBreakerTrips proto.InternalMessageInfo
+
+func (m *CurrencyPairCircuitBreakerTrips) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairCircuitBreakerTrips) GetTrips() CircuitBreakerTrips {
+	if m != nil {
+		return m.Trips
+	}
+	return CircuitBreakerTrips{}
+}
+
+// CurrencyPairPriceDispersion is the stored PriceDispersion of a CurrencyPair.
+type CurrencyPairPriceDispersion struct {
+	// CurrencyPair is the pair that the dispersion belongs to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Dispersion is the dispersion of the validator prices that the
+	// CurrencyPair's price was aggregated from.
+	Dispersion PriceDispersion `protobuf:"bytes,2,opt,name=dispersion,proto3" json:"dispersion"`
+}
+
+func (m *CurrencyPairPriceDispersion) Reset()         { *m = CurrencyPairPriceDispersion{} }
+func (m *CurrencyPairPriceDispersion) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairPriceDispersion) ProtoMessage()    {}
+func (*CurrencyPairPriceDispersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{6}
+}
+func (m *CurrencyPairPriceDispersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairPriceDispersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairPriceDispersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairPriceDispersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairPriceDispersion.Merge(m, src)
+}
+func (m *CurrencyPairPriceDispersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairPriceDispersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairPriceDispersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairPriceDispersion proto.InternalMessageInfo
+
+func (m *CurrencyPairPriceDispersion) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairPriceDispersion) GetDispersion() PriceDispersion {
+	if m != nil {
+		return m.Dispersion
+	}
+	return PriceDispersion{}
+}
+
+// CurrencyPairPriceHistory is the bounded set of historical QuotePrices stored
+// for a CurrencyPair, ordered from oldest to newest.
+type CurrencyPairPriceHistory struct {
+	// CurrencyPair is the pair that the history belongs to.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// Prices is the list of historical QuotePrices for the CurrencyPair, ordered
+	// by ascending block height.
+	Prices []QuotePrice `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices"`
+}
+
+func (m *CurrencyPairPriceHistory) Reset()         { *m = CurrencyPairPriceHistory{} }
+func (m *CurrencyPairPriceHistory) String() string { return proto.CompactTextString(m) }
+func (*CurrencyPairPriceHistory) ProtoMessage()    {}
+func (*CurrencyPairPriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de36a97821ccc13b, []int{7}
+}
+func (m *CurrencyPairPriceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CurrencyPairPriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CurrencyPairPriceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CurrencyPairPriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyPairPriceHistory.Merge(m, src)
+}
+func (m *CurrencyPairPriceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *CurrencyPairPriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyPairPriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyPairPriceHistory proto.InternalMessageInfo
+
+func (m *CurrencyPairPriceHistory) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
+}
+
+func (m *CurrencyPairPriceHistory) GetPrices() []QuotePrice {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
 // GenesisState is the genesis-state for the x/oracle module, it takes a set of
 // predefined CurrencyPairGeneses
 type GenesisState struct {
@@ -240,13 +537,29 @@ type GenesisState struct {
 	CurrencyPairGenesis []CurrencyPairGenesis `protobuf:"bytes,1,rep,name=currency_pair_genesis,json=currencyPairGenesis,proto3" json:"currency_pair_genesis"`
 	// NextID is the next ID to be used for a CurrencyPair
 	NextId uint64 `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	// PriceHistory is the set of historical prices stored per CurrencyPair.
+	PriceHistory []CurrencyPairPriceHistory `protobuf:"bytes,3,rep,name=price_history,json=priceHistory,proto3" json:"price_history"`
+	// Params are the parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	// ValidatorReports is the set of validator reports within the performance
+	// window.
+	ValidatorReports []ValidatorReport `protobuf:"bytes,5,rep,name=validator_reports,json=validatorReports,proto3" json:"validator_reports"`
+	// StaleCurrencyPairs is the set of CurrencyPairs whose prices are currently
+	// stale.
+	StaleCurrencyPairs []types.CurrencyPair `protobuf:"bytes,6,rep,name=stale_currency_pairs,json=staleCurrencyPairs,proto3" json:"stale_currency_pairs"`
+	// PriceDispersions is the set of stored price dispersions of each
+	// CurrencyPair.
+	PriceDispersions []CurrencyPairPriceDispersion `protobuf:"bytes,7,rep,name=price_dispersions,json=priceDispersions,proto3" json:"price_dispersions"`
+	// CircuitBreakerTrips is the set of consecutive circuit breaker rejections
+	// of each CurrencyPair.
+	CircuitBreakerTrips []CurrencyPairCircuitBreakerTrips `protobuf:"bytes,8,rep,name=circuit_breaker_trips,json=circuitBreakerTrips,proto3" json:"circuit_breaker_trips"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_de36a97821ccc13b, []int{3}
+	return fileDescriptor_de36a97821ccc13b, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -289,49 +602,123 @@ func (m *GenesisState) GetNextId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPriceHistory() []CurrencyPairPriceHistory {
+	if m != nil {
+		return m.PriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetValidatorReports() []ValidatorReport {
+	if m != nil {
+		return m.ValidatorReports
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStaleCurrencyPairs() []types.CurrencyPair {
+	if m != nil {
+		return m.StaleCurrencyPairs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPriceDispersions() []CurrencyPairPriceDispersion {
+	if m != nil {
+		return m.PriceDispersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerTrips() []CurrencyPairCircuitBreakerTrips {
+	if m != nil {
+		return m.CircuitBreakerTrips
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuotePrice)(nil), "slinky.oracle.v1.QuotePrice")
+	proto.RegisterType((*PriceDispersion)(nil), "slinky.oracle.v1.PriceDispersion")
 	proto.RegisterType((*CurrencyPairState)(nil), "slinky.oracle.v1.CurrencyPairState")
 	proto.RegisterType((*CurrencyPairGenesis)(nil), "slinky.oracle.v1.CurrencyPairGenesis")
+	proto.RegisterType((*CircuitBreakerTrips)(nil), "slinky.oracle.v1.CircuitBreakerTrips")
+	proto.RegisterType((*CurrencyPairCircuitBreakerTrips)(nil), "slinky.oracle.v1.CurrencyPairCircuitBreakerTrips")
+	proto.RegisterType((*CurrencyPairPriceDispersion)(nil), "slinky.oracle.v1.CurrencyPairPriceDispersion")
+	proto.RegisterType((*CurrencyPairPriceHistory)(nil), "slinky.oracle.v1.CurrencyPairPriceHistory")
 	proto.RegisterType((*GenesisState)(nil), "slinky.oracle.v1.GenesisState")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/genesis.proto", fileDescriptor_de36a97821ccc13b) }
 
 var fileDescriptor_de36a97821ccc13b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0xeb, 0xae, 0x1b, 0xe0, 0x96, 0xc1, 0xd2, 0x4d, 0x94, 0x0a, 0x92, 0x52, 0x84, 0x54,
-	0x81, 0xe6, 0x28, 0xe5, 0x02, 0x47, 0x0a, 0x12, 0xab, 0x04, 0x52, 0x09, 0x9c, 0xb8, 0x44, 0x89,
-	0x63, 0x52, 0xab, 0x4d, 0x1c, 0xc5, 0xee, 0xb4, 0xbe, 0x01, 0xc7, 0x3d, 0x0c, 0x2f, 0xc0, 0xad,
-	0xc7, 0x89, 0x13, 0x70, 0x28, 0xa8, 0x7d, 0x11, 0x14, 0xdb, 0x29, 0x29, 0xdb, 0x81, 0x5b, 0x7e,
-	0xfe, 0xfd, 0xfb, 0x7e, 0xbe, 0x76, 0xa0, 0xc9, 0xa7, 0x34, 0x99, 0xcc, 0x6d, 0x96, 0xf9, 0x78,
-	0x4a, 0xec, 0x53, 0xc7, 0x8e, 0x48, 0x42, 0x38, 0xe5, 0x28, 0xcd, 0x98, 0x60, 0xc6, 0x6d, 0x95,
-	0x47, 0x2a, 0x8f, 0x4e, 0x9d, 0xf6, 0x61, 0xc4, 0x22, 0x26, 0x93, 0x76, 0xfe, 0xa5, 0xea, 0xda,
-	0x56, 0xc4, 0x58, 0x34, 0x25, 0xb6, 0x8c, 0x82, 0xd9, 0x27, 0x5b, 0xd0, 0x98, 0x70, 0xe1, 0xc7,
-	0xa9, 0x2e, 0xb8, 0x8b, 0x19, 0x8f, 0x19, 0xf7, 0x54, 0xa7, 0x0a, 0x74, 0xea, 0xa1, 0xd6, 0x20,
-	0xe6, 0x29, 0xe1, 0xb9, 0x04, 0x3c, 0xcb, 0x32, 0x92, 0xe0, 0xb9, 0x97, 0xfa, 0x34, 0x53, 0x45,
-	0xdd, 0xaf, 0x00, 0xc2, 0x77, 0x33, 0x26, 0xc8, 0x28, 0xa3, 0x98, 0x18, 0x2f, 0xe0, 0x6e, 0x9a,
-	0x7f, 0xb4, 0x40, 0x07, 0xf4, 0x6e, 0x0c, 0x9e, 0x2c, 0x96, 0x56, 0xe5, 0xe7, 0xd2, 0x3a, 0x52,
-	0x83, 0x79, 0x38, 0x41, 0x94, 0xd9, 0xb1, 0x2f, 0xc6, 0x68, 0x98, 0x88, 0x6f, 0x5f, 0x8e, 0xa1,
-	0xde, 0x38, 0x4c, 0x84, 0xab, 0x3a, 0x8d, 0xb7, 0xf0, 0x56, 0x30, 0x65, 0x78, 0xe2, 0x6d, 0xa4,
-	0xb6, 0xaa, 0x1d, 0xd0, 0xab, 0xf7, 0xdb, 0x48, 0xc1, 0xa0, 0x02, 0x06, 0x7d, 0x28, 0x2a, 0x06,
-	0xd7, 0xf3, 0x45, 0xe7, 0xbf, 0x2c, 0xe0, 0xee, 0xcb, 0xe6, 0x4d, 0xc6, 0x78, 0x00, 0x1b, 0x6a,
-	0xdc, 0x98, 0xd0, 0x68, 0x2c, 0x5a, 0x3b, 0x1d, 0xd0, 0xab, 0xb9, 0x75, 0x79, 0x76, 0x22, 0x8f,
-	0xba, 0x1c, 0x1e, 0xbc, 0xd4, 0x68, 0x23, 0x9f, 0x66, 0xef, 0x85, 0x2f, 0x88, 0xf1, 0xac, 0x4c,
-	0x52, 0xef, 0xdf, 0x43, 0xff, 0x3a, 0x8e, 0xfe, 0x62, 0x0f, 0x6a, 0x8b, 0xa5, 0x05, 0x0a, 0x80,
-	0x43, 0xb8, 0x9b, 0xb0, 0x04, 0x13, 0x29, 0xbb, 0xe6, 0xaa, 0xc0, 0xd8, 0x87, 0x55, 0x1a, 0xea,
-	0xed, 0x55, 0x1a, 0x76, 0x7f, 0x00, 0xd8, 0x2c, 0x6f, 0x7d, 0xad, 0xee, 0xd7, 0x38, 0x81, 0x37,
-	0xb7, 0x7c, 0xd6, 0xfb, 0xef, 0x17, 0xfb, 0xe5, 0x6d, 0xe4, 0xeb, 0xcb, 0xcd, 0x52, 0x40, 0xc5,
-	0x6d, 0xe0, 0xd2, 0x99, 0xe1, 0xc2, 0xe6, 0xd6, 0x24, 0x4f, 0xf1, 0x54, 0xff, 0x9b, 0xe7, 0xa0,
-	0x3c, 0x6e, 0xb4, 0xcd, 0xb6, 0x73, 0x99, 0xad, 0xb6, 0x61, 0xfb, 0x0c, 0x60, 0x43, 0xf3, 0x28,
-	0x33, 0x3d, 0x78, 0xb4, 0x2d, 0x45, 0xbf, 0xe6, 0x16, 0xe8, 0xec, 0xf4, 0xea, 0xfd, 0x47, 0x97,
-	0xc5, 0x5c, 0x61, 0x8d, 0x86, 0x6c, 0xe2, 0x2b, 0x5c, 0xbb, 0x03, 0xaf, 0x25, 0xe4, 0x4c, 0x78,
-	0x34, 0xd4, 0xae, 0xef, 0xe5, 0xe1, 0x30, 0x1c, 0xbc, 0x5a, 0xac, 0x4c, 0x70, 0xb1, 0x32, 0xc1,
-	0xef, 0x95, 0x09, 0xce, 0xd7, 0x66, 0xe5, 0x62, 0x6d, 0x56, 0xbe, 0xaf, 0xcd, 0xca, 0xc7, 0xc7,
-	0x11, 0x15, 0xe3, 0x59, 0x80, 0x30, 0x8b, 0x6d, 0xc7, 0x71, 0x9e, 0x1f, 0xbf, 0xf1, 0x03, 0x6e,
-	0xeb, 0x37, 0x7f, 0x56, 0xfc, 0x79, 0xd2, 0xee, 0x60, 0x4f, 0x3e, 0xb9, 0xa7, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xf3, 0xc5, 0xdd, 0xee, 0x97, 0x03, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0x5e, 0x67, 0x7f, 0x12, 0xce, 0x26, 0x69, 0x32, 0x9b, 0x80, 0x09, 0x74, 0x77, 0xbb, 0xa8,
+	0x52, 0x54, 0x88, 0x57, 0x1b, 0x24, 0x04, 0x48, 0x5c, 0x34, 0xa9, 0xd4, 0x44, 0x2a, 0x52, 0x6a,
+	0x52, 0x2e, 0xb8, 0x31, 0xb3, 0xde, 0xe9, 0xee, 0x68, 0x6d, 0x8f, 0x99, 0x19, 0xa7, 0xc9, 0x5b,
+	0x54, 0xf0, 0x02, 0x3c, 0x01, 0xe2, 0x82, 0x17, 0xe0, 0x2e, 0x97, 0x15, 0x57, 0xc0, 0x45, 0x40,
+	0xc9, 0x8b, 0x54, 0x9e, 0x99, 0x75, 0xbc, 0xf1, 0xb6, 0x4a, 0xa3, 0xdc, 0x79, 0xe6, 0xcc, 0xf9,
+	0xce, 0x77, 0x3e, 0x7f, 0x67, 0x6c, 0x68, 0x8a, 0x80, 0x46, 0xe3, 0x93, 0x2e, 0xe3, 0xd8, 0x0f,
+	0x48, 0xf7, 0xa8, 0xd7, 0x1d, 0x92, 0x88, 0x08, 0x2a, 0x9c, 0x98, 0x33, 0xc9, 0xd0, 0x8a, 0x8e,
+	0x3b, 0x3a, 0xee, 0x1c, 0xf5, 0x36, 0xd6, 0x86, 0x6c, 0xc8, 0x54, 0xb0, 0x9b, 0x3e, 0xe9, 0x73,
+	0x1b, 0xad, 0x21, 0x63, 0xc3, 0x80, 0x74, 0xd5, 0xaa, 0x9f, 0x3c, 0xef, 0x4a, 0x1a, 0x12, 0x21,
+	0x71, 0x18, 0x9b, 0x03, 0x1f, 0xfa, 0x4c, 0x84, 0x4c, 0x78, 0x3a, 0x53, 0x2f, 0x4c, 0xe8, 0x13,
+	0xc3, 0x41, 0x9e, 0xc4, 0x44, 0xa4, 0x14, 0xfc, 0x84, 0x73, 0x12, 0xf9, 0x27, 0x5e, 0x8c, 0x29,
+	0x37, 0x87, 0xee, 0x16, 0x88, 0xc6, 0x98, 0xe3, 0x70, 0x82, 0xf1, 0x59, 0x21, 0x7c, 0x84, 0x03,
+	0x3a, 0xc0, 0x92, 0x71, 0x2f, 0x26, 0xfc, 0x39, 0xe3, 0x21, 0x8e, 0x7c, 0xa2, 0x4f, 0x77, 0xfe,
+	0xb4, 0x00, 0x9e, 0x26, 0x4c, 0x92, 0x03, 0x4e, 0x7d, 0x82, 0x1e, 0x42, 0x35, 0x4e, 0x1f, 0x6c,
+	0xab, 0x6d, 0x6d, 0xbe, 0xb7, 0xf3, 0xe9, 0xe9, 0x59, 0xab, 0xf4, 0xef, 0x59, 0x6b, 0x5d, 0xb3,
+	0x14, 0x83, 0xb1, 0x43, 0x59, 0x37, 0xc4, 0x72, 0xe4, 0xec, 0x47, 0xf2, 0xaf, 0x3f, 0xb6, 0xc0,
+	0xd0, 0xdf, 0x8f, 0xa4, 0xab, 0x33, 0xd1, 0xb7, 0x70, 0xa7, 0x1f, 0x30, 0x7f, 0xec, 0x65, 0x7d,
+	0xdb, 0x73, 0x6d, 0x6b, 0xb3, 0xbe, 0xbd, 0xe1, 0x68, 0x65, 0x9c, 0x89, 0x32, 0xce, 0xe1, 0xe4,
+	0xc4, 0xce, 0x42, 0x5a, 0xe8, 0xe5, 0x7f, 0x2d, 0xcb, 0x5d, 0x56, 0xc9, 0x59, 0x04, 0xdd, 0x83,
+	0x45, 0x0d, 0x37, 0x22, 0x74, 0x38, 0x92, 0x76, 0xb9, 0x6d, 0x6d, 0x56, 0xdc, 0xba, 0xda, 0xdb,
+	0x53, 0x5b, 0x9d, 0x9f, 0x2b, 0x70, 0x47, 0xd1, 0x7f, 0x44, 0x45, 0x4c, 0xb8, 0xa0, 0x2c, 0x42,
+	0xdf, 0x40, 0x39, 0xa4, 0xd1, 0x4d, 0xda, 0x48, 0xf3, 0x54, 0x3a, 0x3e, 0xb6, 0xe7, 0x6e, 0x92,
+	0x8e, 0x8f, 0x91, 0x0b, 0xcb, 0x01, 0x7b, 0x41, 0xb8, 0xf7, 0x53, 0x82, 0xb9, 0xa4, 0x01, 0xb1,
+	0xcb, 0xef, 0x8e, 0xb4, 0xa4, 0x20, 0x9e, 0x1a, 0x84, 0x14, 0x33, 0x89, 0xe3, 0x3c, 0x66, 0xe5,
+	0x06, 0x98, 0x0a, 0x22, 0xc3, 0xbc, 0x0f, 0xcb, 0x51, 0x12, 0x7a, 0x99, 0x41, 0x84, 0x5d, 0x55,
+	0xf2, 0x2e, 0x45, 0x49, 0xf8, 0x7d, 0xb6, 0xa9, 0x5c, 0x91, 0x72, 0xb1, 0x6b, 0x37, 0x71, 0x45,
+	0x9a, 0x89, 0x9e, 0x40, 0x5d, 0x32, 0x89, 0x03, 0x4f, 0x03, 0xcd, 0xbf, 0x3b, 0x10, 0xa8, 0xfc,
+	0x03, 0x85, 0x76, 0xd5, 0x14, 0x0b, 0x45, 0x53, 0x08, 0x58, 0xdd, 0x35, 0xc3, 0x73, 0x80, 0x29,
+	0xff, 0x4e, 0x62, 0x49, 0xd0, 0x97, 0x79, 0x7b, 0xd7, 0xb7, 0x3f, 0x76, 0xae, 0xce, 0xb4, 0x73,
+	0x39, 0x0b, 0x3b, 0x95, 0xd3, 0xb3, 0x96, 0x35, 0x71, 0xf5, 0x1a, 0x54, 0x23, 0x16, 0xf9, 0x44,
+	0x59, 0xa2, 0xe2, 0xea, 0x05, 0x5a, 0x86, 0x39, 0x3a, 0x30, 0x96, 0x9c, 0xa3, 0x83, 0xce, 0x3f,
+	0x16, 0x34, 0xf2, 0x55, 0x1f, 0xeb, 0x1b, 0x04, 0xed, 0xc1, 0xd2, 0xd4, 0x24, 0x9b, 0xfa, 0x77,
+	0x27, 0xf5, 0xd5, 0xbc, 0xa7, 0xe5, 0xf3, 0xc9, 0x8a, 0x40, 0xc9, 0x5d, 0xf4, 0x73, 0x7b, 0xc8,
+	0x85, 0xc6, 0x14, 0x92, 0xa7, 0xfb, 0x99, 0xbb, 0x76, 0x3f, 0xab, 0x79, 0xb8, 0x83, 0xe9, 0xde,
+	0xca, 0xc5, 0xde, 0x2a, 0x59, 0x6f, 0xbb, 0xd0, 0xd8, 0xa5, 0xdc, 0x4f, 0xa8, 0xdc, 0xe1, 0x04,
+	0x8f, 0x09, 0x3f, 0xe4, 0x34, 0x16, 0xe8, 0x7d, 0xa8, 0x25, 0xf1, 0x0b, 0xcc, 0x07, 0xaa, 0xa7,
+	0x05, 0xd7, 0xac, 0x52, 0x50, 0x9f, 0x25, 0x91, 0x9c, 0x08, 0xa6, 0x16, 0x9d, 0xdf, 0x2c, 0x68,
+	0xe5, 0x7b, 0x9c, 0x85, 0x78, 0x7b, 0x62, 0x3d, 0x84, 0xaa, 0x4c, 0x21, 0x8d, 0x3c, 0xf7, 0x8b,
+	0xf2, 0xcc, 0xa8, 0x6f, 0x90, 0x74, 0x66, 0xe7, 0x77, 0x0b, 0x3e, 0xda, 0xbd, 0xaa, 0x58, 0xee,
+	0x9e, 0xb9, 0x3d, 0xb2, 0x8f, 0x01, 0x06, 0x19, 0xae, 0x61, 0x7c, 0xaf, 0xc8, 0xf8, 0x0a, 0x01,
+	0x03, 0x95, 0x4b, 0xed, 0xfc, 0x6a, 0x81, 0x5d, 0xa0, 0xbc, 0x47, 0x85, 0x64, 0xfc, 0xe4, 0x16,
+	0xf9, 0x7e, 0x0d, 0x35, 0xe5, 0xbd, 0x54, 0xdd, 0xf2, 0xb5, 0xcc, 0x57, 0x72, 0x4d, 0x46, 0xe7,
+	0x97, 0x2a, 0x2c, 0x9a, 0xd9, 0xd0, 0x83, 0xe9, 0xc1, 0xfa, 0xb4, 0xad, 0xcd, 0xb7, 0xd7, 0xb6,
+	0xda, 0xe5, 0x37, 0xbc, 0xb9, 0xe2, 0x98, 0x99, 0x22, 0x0d, 0xbf, 0x18, 0x42, 0x1f, 0xc0, 0x7c,
+	0x44, 0x8e, 0xa5, 0x47, 0x07, 0xc6, 0x90, 0xb5, 0x74, 0xb9, 0x3f, 0x40, 0xcf, 0x60, 0x49, 0x91,
+	0xf2, 0x46, 0x5a, 0x21, 0xbb, 0xac, 0x2a, 0x3e, 0x78, 0x7b, 0xc5, 0xbc, 0xa6, 0x13, 0x75, 0xe2,
+	0xbc, 0xce, 0x5f, 0x40, 0x4d, 0x7f, 0x95, 0xd5, 0x04, 0xd5, 0xb7, 0xed, 0x19, 0x6f, 0x52, 0xc5,
+	0x33, 0x65, 0xd4, 0x0a, 0x1d, 0xc2, 0xea, 0xe5, 0xe7, 0x9a, 0x93, 0x98, 0x71, 0x99, 0x5e, 0xca,
+	0xe5, 0xd9, 0x66, 0xc8, 0xee, 0x68, 0x57, 0x9d, 0x34, 0x58, 0x2b, 0x47, 0xd3, 0xdb, 0x02, 0x3d,
+	0x83, 0x35, 0x21, 0x71, 0x40, 0xbc, 0x29, 0x91, 0x85, 0x5d, 0x6b, 0x97, 0xaf, 0xfb, 0xf2, 0x91,
+	0x02, 0xc8, 0x07, 0x04, 0xfa, 0x11, 0x56, 0xb5, 0x76, 0x97, 0xee, 0x13, 0xf6, 0xbc, 0xc2, 0xdc,
+	0xba, 0x86, 0x7e, 0x05, 0x17, 0xaf, 0xc4, 0xd3, 0xdb, 0x02, 0x8d, 0x61, 0xdd, 0xd7, 0x23, 0xea,
+	0xf5, 0xf5, 0x8c, 0x7a, 0x7a, 0xa2, 0x17, 0x54, 0x95, 0xde, 0xdb, 0xab, 0xbc, 0x79, 0xba, 0x1b,
+	0xfe, 0x8c, 0xd0, 0xa3, 0xd3, 0xf3, 0xa6, 0xf5, 0xea, 0xbc, 0x69, 0xfd, 0x7f, 0xde, 0xb4, 0x5e,
+	0x5e, 0x34, 0x4b, 0xaf, 0x2e, 0x9a, 0xa5, 0xbf, 0x2f, 0x9a, 0xa5, 0x1f, 0x1e, 0x0c, 0xa9, 0x1c,
+	0x25, 0x7d, 0xc7, 0x67, 0x61, 0xb7, 0xd7, 0xeb, 0x7d, 0xb5, 0xf5, 0x04, 0xf7, 0x45, 0xd7, 0xfc,
+	0x68, 0x1d, 0x4f, 0x7e, 0xb5, 0x94, 0x7c, 0xfd, 0x9a, 0xfa, 0xbd, 0xf9, 0xfc, 0xf5, 0x00, 0x88,
+	0xc1, 0x89, 0xdd, 0x50, 0x0a, 0x00, 0x00,
 }
 
 func (m *QuotePrice) Marshal() (dAtA []byte, err error) {
@@ -380,7 +767,7 @@ func (m *QuotePrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CurrencyPairState) Marshal() (dAtA []byte, err error) {
+func (m *PriceDispersion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -390,68 +777,161 @@ func (m *CurrencyPairState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CurrencyPairState) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceDispersion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceDispersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+	if m.BlockHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x40
 	}
-	if m.Price != nil {
-		{
-			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
+	{
+		size := m.TotalPower.Size()
+		i -= size
+		if _, err := m.TotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *CurrencyPairGenesis) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Power.Size()
+		i -= size
+		if _, err := m.Power.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CurrencyPairGenesis) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+	i--
+	dAtA[i] = 0x32
+	if m.NumValidators != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NumValidators))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+	{
+		size := m.UpperQuartile.Size()
+		i -= size
+		if _, err := m.UpperQuartile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LowerQuartile.Size()
+		i -= size
+		if _, err := m.LowerQuartile.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Max.Size()
+		i -= size
+		if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Min.Size()
+		i -= size
+		if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairGenesis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairGenesis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x18
 	}
@@ -480,7 +960,7 @@ func (m *CurrencyPairGenesis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *CircuitBreakerTrips) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -490,130 +970,1412 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *CircuitBreakerTrips) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CircuitBreakerTrips) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NextId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.CurrencyPairGenesis) > 0 {
-		for iNdEx := len(m.CurrencyPairGenesis) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrencyPairGenesis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.Upward {
+		i--
+		if m.Upward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CurrencyPairCircuitBreakerTrips) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QuotePrice) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTimestamp)
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.BlockHeight))
-	}
-	return n
+
+func (m *CurrencyPairCircuitBreakerTrips) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CurrencyPairState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CurrencyPairCircuitBreakerTrips) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovGenesis(uint64(m.Nonce))
+	{
+		size, err := m.Trips.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrencyPairGenesis) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.CurrencyPairPrice != nil {
-		l = m.CurrencyPairPrice.Size()
-		n += 1 + l + sovGenesis(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovGenesis(uint64(m.Nonce))
-	}
-	if m.Id != 0 {
-		n += 1 + sovGenesis(uint64(m.Id))
+func (m *CurrencyPairPriceDispersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CurrencyPairPriceDispersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairPriceDispersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CurrencyPairGenesis) > 0 {
-		for _, e := range m.CurrencyPairGenesis {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size, err := m.Dispersion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.NextId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextId))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CurrencyPairPriceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairPriceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairPriceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CircuitBreakerTrips) > 0 {
+		for iNdEx := len(m.CircuitBreakerTrips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerTrips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PriceDispersions) > 0 {
+		for iNdEx := len(m.PriceDispersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceDispersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.StaleCurrencyPairs) > 0 {
+		for iNdEx := len(m.StaleCurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StaleCurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ValidatorReports) > 0 {
+		for iNdEx := len(m.ValidatorReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.PriceHistory) > 0 {
+		for iNdEx := len(m.PriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NextId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CurrencyPairGenesis) > 0 {
+		for iNdEx := len(m.CurrencyPairGenesis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairGenesis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuotePrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTimestamp)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *PriceDispersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Min.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Max.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LowerQuartile.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.UpperQuartile.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NumValidators != 0 {
+		n += 1 + sovGenesis(uint64(m.NumValidators))
+	}
+	l = m.Power.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalPower.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *CurrencyPairState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *CurrencyPairGenesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CurrencyPairPrice != nil {
+		l = m.CurrencyPairPrice.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *CircuitBreakerTrips) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Upward {
+		n += 2
+	}
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *CurrencyPairCircuitBreakerTrips) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Trips.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CurrencyPairPriceDispersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Dispersion.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *CurrencyPairPriceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairGenesis) > 0 {
+		for _, e := range m.CurrencyPairGenesis {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextId))
+	}
+	if len(m.PriceHistory) > 0 {
+		for _, e := range m.PriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorReports) > 0 {
+		for _, e := range m.ValidatorReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StaleCurrencyPairs) > 0 {
+		for _, e := range m.StaleCurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceDispersions) > 0 {
+		for _, e := range m.PriceDispersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerTrips) > 0 {
+		for _, e := range m.CircuitBreakerTrips {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QuotePrice) Unmarshal(dAtA []byte) error {
+func (m *QuotePrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotePrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceDispersion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceDispersion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerQuartile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerQuartile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperQuartile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperQuartile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidators", wireType)
+			}
+			m.NumValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrencyPairPrice == nil {
+				m.CurrencyPairPrice = &QuotePrice{}
+			}
+			if err := m.CurrencyPairPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerTrips) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerTrips: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerTrips: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upward = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairCircuitBreakerTrips) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrencyPairCircuitBreakerTrips: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrencyPairCircuitBreakerTrips: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trips", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trips.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrencyPairPriceDispersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -636,17 +2398,17 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuotePrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrencyPairPriceDispersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotePrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrencyPairPriceDispersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -656,29 +2418,28 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -705,29 +2466,10 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Dispersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -749,7 +2491,7 @@ func (m *QuotePrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
+func (m *CurrencyPairPriceHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,15 +2514,15 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairState: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrencyPairPriceHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrencyPairPriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -807,18 +2549,15 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -828,30 +2567,26 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, QuotePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -873,7 +2608,7 @@ func (m *CurrencyPairState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -896,15 +2631,15 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairGenesis: wiretype end group for non-group")
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairGenesis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairGenesis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -931,13 +2666,33 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrencyPairGenesis = append(m.CurrencyPairGenesis, CurrencyPairGenesis{})
+			if err := m.CurrencyPairGenesis[len(m.CurrencyPairGenesis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+			}
+			m.NextId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -964,18 +2719,16 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrencyPairPrice == nil {
-				m.CurrencyPairPrice = &QuotePrice{}
-			}
-			if err := m.CurrencyPairPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceHistory = append(m.PriceHistory, CurrencyPairPriceHistory{})
+			if err := m.PriceHistory[len(m.PriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -985,16 +2738,30 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.Id = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorReports", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1004,64 +2771,63 @@ func (m *CurrencyPairGenesis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.ValidatorReports = append(m.ValidatorReports, ValidatorReport{})
+			if err := m.ValidatorReports[len(m.ValidatorReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleCurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StaleCurrencyPairs = append(m.StaleCurrencyPairs, types.CurrencyPair{})
+			if err := m.StaleCurrencyPairs[len(m.StaleCurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairGenesis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDispersions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1088,16 +2854,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairGenesis = append(m.CurrencyPairGenesis, CurrencyPairGenesis{})
-			if err := m.CurrencyPairGenesis[len(m.CurrencyPairGenesis)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PriceDispersions = append(m.PriceDispersions, CurrencyPairPriceDispersion{})
+			if err := m.PriceDispersions[len(m.PriceDispersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerTrips", wireType)
 			}
-			m.NextId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1107,11 +2873,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerTrips = append(m.CircuitBreakerTrips, CurrencyPairCircuitBreakerTrips{})
+			if err := m.CircuitBreakerTrips[len(m.CircuitBreakerTrips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
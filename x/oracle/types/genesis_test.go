@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
@@ -129,3 +131,219 @@ func TestGenesisValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisValidationValidatorReports(t *testing.T) {
+	report := types.NewValidatorReport(sdk.ConsAddress("validator"), 1)
+
+	invalid := report
+	invalid.Validator = "invalid"
+
+	tcs := []struct {
+		name       string
+		reports    []types.ValidatorReport
+		expectPass bool
+	}{
+		{
+			"valid validator reports - pass",
+			[]types.ValidatorReport{report, types.NewValidatorReport(sdk.ConsAddress("validator"), 2)},
+			true,
+		},
+		{
+			"invalid validator report - fail",
+			[]types.ValidatorReport{invalid},
+			false,
+		},
+		{
+			"repeated validator report at the same height - fail",
+			[]types.ValidatorReport{report, report},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesisState()
+			gs.ValidatorReports = tc.reports
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationStaleCurrencyPairs(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+
+	tcs := []struct {
+		name       string
+		stale      []slinkytypes.CurrencyPair
+		expectPass bool
+	}{
+		{
+			"valid stale currency pairs - pass",
+			[]slinkytypes.CurrencyPair{cp},
+			true,
+		},
+		{
+			"unknown stale currency pair - fail",
+			[]slinkytypes.CurrencyPair{slinkytypes.NewCurrencyPair("ETH", "USD")},
+			false,
+		},
+		{
+			"repeated stale currency pair - fail",
+			[]slinkytypes.CurrencyPair{cp, cp},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState([]types.CurrencyPairGenesis{
+				{CurrencyPair: cp, Id: 0},
+			}, 1)
+			gs.StaleCurrencyPairs = tc.stale
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationPriceDispersions(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	dispersion := types.PriceDispersion{
+		Min:           sdkmath.NewInt(90),
+		Max:           sdkmath.NewInt(120),
+		LowerQuartile: sdkmath.NewInt(95),
+		UpperQuartile: sdkmath.NewInt(110),
+		NumValidators: 3,
+		Power:         sdkmath.NewInt(60),
+		TotalPower:    sdkmath.NewInt(100),
+		BlockHeight:   1,
+	}
+
+	unordered := dispersion
+	unordered.Min = sdkmath.NewInt(100)
+
+	excessPower := dispersion
+	excessPower.Power = sdkmath.NewInt(101)
+
+	tcs := []struct {
+		name        string
+		dispersions []types.CurrencyPairPriceDispersion
+		expectPass  bool
+	}{
+		{
+			"valid price dispersions - pass",
+			[]types.CurrencyPairPriceDispersion{types.NewCurrencyPairPriceDispersion(cp, dispersion)},
+			true,
+		},
+		{
+			"unset price dispersion - fail",
+			[]types.CurrencyPairPriceDispersion{types.NewCurrencyPairPriceDispersion(cp, types.PriceDispersion{})},
+			false,
+		},
+		{
+			"unordered price dispersion - fail",
+			[]types.CurrencyPairPriceDispersion{types.NewCurrencyPairPriceDispersion(cp, unordered)},
+			false,
+		},
+		{
+			"power greater than total power - fail",
+			[]types.CurrencyPairPriceDispersion{types.NewCurrencyPairPriceDispersion(cp, excessPower)},
+			false,
+		},
+		{
+			"price dispersion for unknown currency pair - fail",
+			[]types.CurrencyPairPriceDispersion{
+				types.NewCurrencyPairPriceDispersion(slinkytypes.NewCurrencyPair("ETH", "USD"), dispersion),
+			},
+			false,
+		},
+		{
+			"repeated price dispersion - fail",
+			[]types.CurrencyPairPriceDispersion{
+				types.NewCurrencyPairPriceDispersion(cp, dispersion),
+				types.NewCurrencyPairPriceDispersion(cp, dispersion),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState([]types.CurrencyPairGenesis{
+				{CurrencyPair: cp, Id: 0},
+			}, 1)
+			gs.PriceDispersions = tc.dispersions
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
+func TestGenesisValidationCircuitBreakerTrips(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("BTC", "USD")
+	trips := types.CircuitBreakerTrips{Upward: true, Count: 2}
+
+	tcs := []struct {
+		name       string
+		trips      []types.CurrencyPairCircuitBreakerTrips
+		expectPass bool
+	}{
+		{
+			"valid circuit breaker trips - pass",
+			[]types.CurrencyPairCircuitBreakerTrips{types.NewCurrencyPairCircuitBreakerTrips(cp, trips)},
+			true,
+		},
+		{
+			"zero count - fail",
+			[]types.CurrencyPairCircuitBreakerTrips{types.NewCurrencyPairCircuitBreakerTrips(cp, types.CircuitBreakerTrips{})},
+			false,
+		},
+		{
+			"circuit breaker trips for unknown currency pair - fail",
+			[]types.CurrencyPairCircuitBreakerTrips{
+				types.NewCurrencyPairCircuitBreakerTrips(slinkytypes.NewCurrencyPair("ETH", "USD"), trips),
+			},
+			false,
+		},
+		{
+			"repeated circuit breaker trips - fail",
+			[]types.CurrencyPairCircuitBreakerTrips{
+				types.NewCurrencyPairCircuitBreakerTrips(cp, trips),
+				types.NewCurrencyPairCircuitBreakerTrips(cp, trips),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState([]types.CurrencyPairGenesis{
+				{CurrencyPair: cp, Id: 0},
+			}, 1)
+			gs.CircuitBreakerTrips = tc.trips
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
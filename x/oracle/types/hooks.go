@@ -0,0 +1,45 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// OracleHooks is the interface that defines the hooks that can be integrated by other modules, e.g. to slash or jail
+// validators that do not run the oracle sidecar properly.
+//
+//go:generate mockery --name OracleHooks --output ./mocks/ --case underscore
+type OracleHooks interface {
+	// AfterValidatorPerformanceUpdated is called after a validator's performance over the performance window is
+	// updated with the validator's report for the latest block.
+	AfterValidatorPerformanceUpdated(ctx sdk.Context, validator sdk.ConsAddress, performance ValidatorPerformance) error
+}
+
+var _ OracleHooks = &MultiOracleHooks{}
+
+// MultiOracleHooks defines an array of OracleHooks which can be executed in sequence.
+type MultiOracleHooks []OracleHooks
+
+// AfterValidatorPerformanceUpdated calls all AfterValidatorPerformanceUpdated hooks registered to the MultiOracleHooks.
+func (oh MultiOracleHooks) AfterValidatorPerformanceUpdated(
+	ctx sdk.Context,
+	validator sdk.ConsAddress,
+	performance ValidatorPerformance,
+) error {
+	for i := range oh {
+		if err := oh[i].AfterValidatorPerformanceUpdated(ctx, validator, performance); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OracleHooksWrapper is a wrapper for modules to inject OracleHooks using depinject.
+type OracleHooksWrapper struct{ OracleHooks }
+
+var _ OracleHooks = &NoopOracleHooks{}
+
+// NoopOracleHooks defines oracle hooks that are a no-op.
+type NoopOracleHooks struct{}
+
+func (n *NoopOracleHooks) AfterValidatorPerformanceUpdated(_ sdk.Context, _ sdk.ConsAddress, _ ValidatorPerformance) error {
+	return nil
+}
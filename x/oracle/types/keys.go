@@ -33,6 +33,41 @@ var (
 	// NumCPsKeyPrefix is the key-prefix under which the number CPs is stored.
 	NumCPsKeyPrefix = collections.NewPrefix(5)
 
+	// PriceHistoryKeyPrefix is the key-prefix under which historical QuotePrices are stored,
+	// keyed by (currency-pair, block height).
+	PriceHistoryKeyPrefix = collections.NewPrefix(6)
+
+	// PriceHistoryCountKeyPrefix is the key-prefix under which the number of historical
+	// QuotePrices stored per currency-pair is tracked.
+	PriceHistoryCountKeyPrefix = collections.NewPrefix(7)
+
+	// ParamsKeyPrefix is the key-prefix under which the module's Params are stored.
+	ParamsKeyPrefix = collections.NewPrefix(8)
+
+	// StalePricesKeyPrefix is the key-prefix under which the set of currency-pairs whose
+	// prices are currently stale is stored.
+	StalePricesKeyPrefix = collections.NewPrefix(9)
+
+	// ValidatorReportsKeyPrefix is the key-prefix under which the validator reports within the
+	// performance window are stored, keyed by (block height, validator consensus address).
+	ValidatorReportsKeyPrefix = collections.NewPrefix(10)
+
+	// ValidatorPerformanceKeyPrefix is the key-prefix under which each validator's performance,
+	// accumulated over the reports within the performance window, is stored.
+	ValidatorPerformanceKeyPrefix = collections.NewPrefix(11)
+
+	// PriceDispersionKeyPrefix is the key-prefix under which the dispersion of the validator prices
+	// that each currency-pair's latest price was aggregated from is stored.
+	PriceDispersionKeyPrefix = collections.NewPrefix(12)
+
+	// ShadowCurrencyPairsKeyPrefix is the key-prefix under which the set of currency-pairs whose
+	// markets are in the shadow state of their lifecycle in x/marketmap is stored.
+	ShadowCurrencyPairsKeyPrefix = collections.NewPrefix(13)
+
+	// CircuitBreakerTripsKeyPrefix is the key-prefix under which the consecutive circuit breaker
+	// rejections of each currency-pair are stored.
+	CircuitBreakerTripsKeyPrefix = collections.NewPrefix(14)
+
 	// CounterCodec is the collections.KeyCodec value used for the counter values.
 	CounterCodec = codec.KeyToValueCodec[uint64](codec.NewUint64Key[uint64]())
 )
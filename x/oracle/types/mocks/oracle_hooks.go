@@ -0,0 +1,48 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	oracletypes "github.com/1119-Labs/slinky/x/oracle/types"
+
+	types "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleHooks is an autogenerated mock type for the OracleHooks type
+type OracleHooks struct {
+	mock.Mock
+}
+
+// AfterValidatorPerformanceUpdated provides a mock function with given fields: ctx, validator, performance
+func (_m *OracleHooks) AfterValidatorPerformanceUpdated(ctx types.Context, validator types.ConsAddress, performance oracletypes.ValidatorPerformance) error {
+	ret := _m.Called(ctx, validator, performance)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AfterValidatorPerformanceUpdated")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, oracletypes.ValidatorPerformance) error); ok {
+		r0 = rf(ctx, validator, performance)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewOracleHooks creates a new instance of OracleHooks. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOracleHooks(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OracleHooks {
+	mock := &OracleHooks{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
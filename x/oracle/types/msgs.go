@@ -9,6 +9,7 @@ import (
 var (
 	_ sdk.Msg = &MsgAddCurrencyPairs{}
 	_ sdk.Msg = &MsgRemoveCurrencyPairs{}
+	_ sdk.Msg = &MsgUpdateParams{}
 )
 
 // NewMsgAddCurrencyPairs returns a new message from a set of currency-pairs and an authority.
@@ -65,3 +66,23 @@ func (m *MsgRemoveCurrencyPairs) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateParams returns a new message to update the x/oracle module's Params.
+func NewMsgUpdateParams(authority string, params Params) MsgUpdateParams {
+	return MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic determines whether the information in the message is valid, specifically
+// whether the authority is a valid acc-address, and that the Params are valid.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	// validate authority address
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return err
+	}
+
+	return m.Params.ValidateBasic()
+}
@@ -105,3 +105,38 @@ func TestValidateBasicMsgRemoveCurrencyPairs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBasicMsgUpdateParams(t *testing.T) {
+	tcs := []struct {
+		name       string
+		msg        types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			"if the authority is not an acc-address - fail",
+			types.NewMsgUpdateParams("abc", types.DefaultParams()),
+			false,
+		},
+		{
+			"if the params are invalid - fail",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.Params{}),
+			false,
+		},
+		{
+			"if the params + authority are valid - pass",
+			types.NewMsgUpdateParams(sdk.AccAddress("abc").String(), types.DefaultParams()),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if !tc.expectPass {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultMaxPriceHistory is the default number of historical QuotePrices retained per CurrencyPair.
+const DefaultMaxPriceHistory uint64 = 100
+
+// MaxMaxPriceHistory is the upper bound of the MaxPriceHistory parameter. It bounds the storage retained per
+// CurrencyPair, as well as the number of historical QuotePrices BeginBlocker removes per CurrencyPair in a single block
+// once the parameter is lowered.
+const MaxMaxPriceHistory uint64 = 10_000
+
+// DefaultMaxPriceStaleness is the default number of consecutive blocks a CurrencyPair's price may go without an
+// update before it is considered stale.
+const DefaultMaxPriceStaleness uint64 = 10
+
+// DefaultMaxPriceChange is the default maximum fractional change between a newly aggregated price and a
+// CurrencyPair's reference price, i.e. the circuit breaker is disabled by default.
+var DefaultMaxPriceChange = math.LegacyZeroDec()
+
+// DefaultPerformanceWindow is the default number of blocks over which each validator's oracle performance is
+// accumulated.
+const DefaultPerformanceWindow uint64 = 100
+
+// MaxPerformanceWindow is the upper bound of the PerformanceWindow parameter. A report is retained per validator for
+// every block in the window.
+const MaxPerformanceWindow uint64 = 10_000
+
+// DefaultAggregationStrategy is the default strategy used to aggregate validator prices, i.e. the stake-weighted
+// median.
+const DefaultAggregationStrategy = AggregationStrategyMedian
+
+// DefaultMaxConsecutiveRejections is the default number of consecutive prices for a CurrencyPair that the circuit
+// breaker may reject for moving in the same direction before it accepts prices continuing the move.
+const DefaultMaxConsecutiveRejections uint64 = 5
+
+// DefaultVotePowerThreshold is the default fraction of the total voting power that must have reported a price
+// for a currency-pair in order for an aggregated price to be written to state, i.e. a 2/3+ supermajority.
+var DefaultVotePowerThreshold = math.LegacyNewDecWithPrec(667, 3)
+
+// DefaultParams returns default oracle parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultVotePowerThreshold,
+		DefaultMaxPriceHistory,
+		DefaultMaxPriceStaleness,
+		DefaultMaxPriceChange,
+		false,
+		0,
+		DefaultPerformanceWindow,
+		DefaultAggregationStrategy,
+		DefaultMaxConsecutiveRejections,
+	)
+}
+
+// NewParams returns a new Params instance.
+func NewParams(
+	votePowerThreshold math.LegacyDec,
+	maxPriceHistory, maxPriceStaleness uint64,
+	maxPriceChange math.LegacyDec,
+	clampPriceChanges bool,
+	priceChangeWindow uint64,
+	performanceWindow uint64,
+	aggregationStrategy string,
+	maxConsecutiveRejections uint64,
+) Params {
+	return Params{
+		VotePowerThreshold:       votePowerThreshold,
+		MaxPriceHistory:          maxPriceHistory,
+		MaxPriceStaleness:        maxPriceStaleness,
+		MaxPriceChange:           maxPriceChange,
+		ClampPriceChanges:        clampPriceChanges,
+		PriceChangeWindow:        priceChangeWindow,
+		PerformanceWindow:        performanceWindow,
+		AggregationStrategy:      aggregationStrategy,
+		MaxConsecutiveRejections: maxConsecutiveRejections,
+	}
+}
+
+// ValidateBasic performs stateless validation of the Params.
+func (p *Params) ValidateBasic() error {
+	if p.VotePowerThreshold.IsNil() {
+		return fmt.Errorf("vote power threshold cannot be nil")
+	}
+
+	if !p.VotePowerThreshold.IsPositive() || p.VotePowerThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote power threshold must be in (0, 1]: %s", p.VotePowerThreshold)
+	}
+
+	if p.MaxPriceHistory > MaxMaxPriceHistory {
+		return fmt.Errorf("max price history cannot exceed %d: %d", MaxMaxPriceHistory, p.MaxPriceHistory)
+	}
+
+	if err := ValidateMaxPriceChange(p.MaxPriceChange); err != nil {
+		return err
+	}
+
+	// the circuit breaker's reference price can only be averaged over retained history
+	if p.PriceChangeWindow > p.MaxPriceHistory {
+		return fmt.Errorf("price change window %d cannot exceed max price history %d", p.PriceChangeWindow, p.MaxPriceHistory)
+	}
+
+	if p.PerformanceWindow > MaxPerformanceWindow {
+		return fmt.Errorf("performance window cannot exceed %d: %d", MaxPerformanceWindow, p.PerformanceWindow)
+	}
+
+	return ValidateAggregationStrategy(p.AggregationStrategy)
+}
+
+// ValidateMaxPriceChange checks that the given maximum fractional price change is non-nil and non-negative.
+func ValidateMaxPriceChange(maxPriceChange math.LegacyDec) error {
+	if maxPriceChange.IsNil() {
+		return fmt.Errorf("max price change cannot be nil")
+	}
+
+	if maxPriceChange.IsNegative() {
+		return fmt.Errorf("max price change cannot be negative: %s", maxPriceChange)
+	}
+
+	return nil
+}
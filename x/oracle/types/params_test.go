@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func TestValidateBasicParams(t *testing.T) {
+	tcs := []struct {
+		name       string
+		params     types.Params
+		expectPass bool
+	}{
+		{
+			"default params - pass",
+			types.DefaultParams(),
+			true,
+		},
+		{
+			"nil vote power threshold - fail",
+			types.Params{MaxPriceHistory: 10},
+			false,
+		},
+		{
+			"zero vote power threshold - fail",
+			types.NewParams(math.LegacyZeroDec(), 10, 0, math.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"vote power threshold above one - fail",
+			types.NewParams(math.LegacyNewDecWithPrec(11, 1), 10, 0, math.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"nil max price change - fail",
+			types.Params{VotePowerThreshold: math.LegacyOneDec()},
+			false,
+		},
+		{
+			"negative max price change - fail",
+			types.NewParams(math.LegacyOneDec(), 10, 0, math.LegacyNewDec(-1), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"circuit breaker enabled - pass",
+			types.NewParams(math.LegacyOneDec(), 10, 10, math.LegacyNewDecWithPrec(1, 1), true, 5, 0, types.DefaultAggregationStrategy, 0),
+			true,
+		},
+		{
+			"trimmed mean aggregation strategy - pass",
+			types.NewParams(math.LegacyOneDec(), 10, 10, math.LegacyZeroDec(), false, 0, 0, types.AggregationStrategyTrimmedMean, 0),
+			true,
+		},
+		{
+			"empty aggregation strategy - fail",
+			types.NewParams(math.LegacyOneDec(), 10, 10, math.LegacyZeroDec(), false, 0, 0, "", 0),
+			false,
+		},
+		{
+			"unsupported aggregation strategy - fail",
+			types.NewParams(math.LegacyOneDec(), 10, 10, math.LegacyZeroDec(), false, 0, 0, "mode", 0),
+			false,
+		},
+		{
+			"max price history at bound - pass",
+			types.NewParams(math.LegacyOneDec(), types.MaxMaxPriceHistory, 0, math.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			true,
+		},
+		{
+			"max price history above bound - fail",
+			types.NewParams(math.LegacyOneDec(), types.MaxMaxPriceHistory+1, 0, math.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"price change window larger than the price history - fail",
+			types.NewParams(math.LegacyOneDec(), 10, 10, math.LegacyNewDecWithPrec(1, 1), true, 11, 0, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"price change window with price history disabled - fail",
+			types.NewParams(math.LegacyOneDec(), 0, 10, math.LegacyNewDecWithPrec(1, 1), true, 1, 0, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"performance window at bound - pass",
+			types.NewParams(math.LegacyOneDec(), 10, 0, math.LegacyZeroDec(), false, 0, types.MaxPerformanceWindow, types.DefaultAggregationStrategy, 0),
+			true,
+		},
+		{
+			"performance window above bound - fail",
+			types.NewParams(math.LegacyOneDec(), 10, 0, math.LegacyZeroDec(), false, 0, types.MaxPerformanceWindow+1, types.DefaultAggregationStrategy, 0),
+			false,
+		},
+		{
+			"vote power threshold of one and all optional features disabled - pass",
+			types.NewParams(math.LegacyOneDec(), 0, 0, math.LegacyZeroDec(), false, 0, 0, types.DefaultAggregationStrategy, 0),
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.ValidateBasic()
+			if !tc.expectPass {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+)
+
+// NewCurrencyPairPriceHistory returns a new CurrencyPairPriceHistory given a CurrencyPair and its historical prices.
+func NewCurrencyPairPriceHistory(cp slinkytypes.CurrencyPair, prices []QuotePrice) CurrencyPairPriceHistory {
+	return CurrencyPairPriceHistory{
+		CurrencyPair: cp,
+		Prices:       prices,
+	}
+}
+
+// ValidateBasic checks that the CurrencyPair is valid, that every historical QuotePrice is valid, and that the
+// prices are ordered by strictly ascending block height.
+func (h *CurrencyPairPriceHistory) ValidateBasic() error {
+	if err := h.CurrencyPair.ValidateBasic(); err != nil {
+		return err
+	}
+
+	for i, qp := range h.Prices {
+		if err := qp.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if i > 0 && qp.BlockHeight <= h.Prices[i-1].BlockHeight {
+			return fmt.Errorf("price history for %s is not ordered by ascending block height at index %d", h.CurrencyPair, i)
+		}
+	}
+
+	return nil
+}
+
+// ComputeTWAP computes the time-weighted average of the given prices over the window [start, end]. The prices must be
+// ordered by ascending position, where point returns the position of a QuotePrice along the window's axis (i.e. its block
+// height or its block timestamp). Each price is weighted by the portion of the window during which it was the latest
+// price. The latest price at or before start is carried into the window. If every contributing price has zero weight
+// (i.e. the only price lies exactly at end), that price is returned. The number of prices that contributed to the
+// average is returned alongside it.
+func ComputeTWAP(prices []QuotePrice, start, end int64, point func(QuotePrice) int64) (math.Int, uint64, error) {
+	if start > end {
+		return math.Int{}, 0, fmt.Errorf("invalid window: start %d is after end %d", start, end)
+	}
+
+	// find the first price that contributes to the window, this is the latest price at or before start
+	// if one exists, otherwise the first price inside the window.
+	first := -1
+	for i, qp := range prices {
+		p := point(qp)
+		if p > start {
+			if first == -1 && p <= end {
+				first = i
+			}
+			break
+		}
+
+		first = i
+	}
+
+	if first == -1 {
+		return math.Int{}, 0, fmt.Errorf("no prices in window [%d, %d]", start, end)
+	}
+
+	var (
+		weightedSum = math.ZeroInt()
+		totalWeight = math.ZeroInt()
+		samples     uint64
+		latest      math.Int
+	)
+	for i := first; i < len(prices); i++ {
+		segStart := max(point(prices[i]), start)
+		if segStart > end {
+			break
+		}
+
+		segEnd := end
+		if i+1 < len(prices) {
+			segEnd = min(point(prices[i+1]), end)
+		}
+
+		weight := math.NewInt(segEnd - segStart)
+		weightedSum = weightedSum.Add(prices[i].Price.Mul(weight))
+		totalWeight = totalWeight.Add(weight)
+		latest = prices[i].Price
+		samples++
+	}
+
+	if totalWeight.IsZero() {
+		return latest, samples, nil
+	}
+
+	return weightedSum.Quo(totalWeight), samples, nil
+}
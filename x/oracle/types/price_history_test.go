@@ -0,0 +1,180 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	slinkytypes "github.com/1119-Labs/slinky/pkg/types"
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func heightPoint(qp types.QuotePrice) int64 {
+	return int64(qp.BlockHeight) //nolint:gosec
+}
+
+func quotePrices(heightsAndPrices ...int64) []types.QuotePrice {
+	prices := make([]types.QuotePrice, 0, len(heightsAndPrices)/2)
+	for i := 0; i < len(heightsAndPrices); i += 2 {
+		prices = append(prices, types.QuotePrice{
+			BlockHeight: uint64(heightsAndPrices[i]), //nolint:gosec
+			Price:       math.NewInt(heightsAndPrices[i+1]),
+		})
+	}
+	return prices
+}
+
+func TestComputeTWAP(t *testing.T) {
+	tcs := []struct {
+		name          string
+		prices        []types.QuotePrice
+		start, end    int64
+		expected      math.Int
+		expectedCount uint64
+		expectPass    bool
+	}{
+		{
+			"no prices - fail",
+			nil,
+			0, 10,
+			math.Int{},
+			0,
+			false,
+		},
+		{
+			"start after end - fail",
+			quotePrices(1, 100),
+			10, 0,
+			math.Int{},
+			0,
+			false,
+		},
+		{
+			"all prices after the window - fail",
+			quotePrices(11, 100),
+			0, 10,
+			math.Int{},
+			0,
+			false,
+		},
+		{
+			"single price before the window is carried in - pass",
+			quotePrices(1, 100),
+			5, 10,
+			math.NewInt(100),
+			1,
+			true,
+		},
+		{
+			"prices weighted by duration - pass",
+			quotePrices(0, 100, 6, 200),
+			0, 8,
+			math.NewInt((100*6 + 200*2) / 8),
+			2,
+			true,
+		},
+		{
+			"latest price before start is carried in, older prices ignored - pass",
+			quotePrices(0, 1, 2, 100, 6, 200),
+			4, 8,
+			math.NewInt(150),
+			2,
+			true,
+		},
+		{
+			"window starts before the first price - pass",
+			quotePrices(4, 100, 6, 200),
+			0, 8,
+			math.NewInt(150),
+			2,
+			true,
+		},
+		{
+			"prices after the window are ignored - pass",
+			quotePrices(0, 100, 8, 200, 9, 1000),
+			0, 8,
+			math.NewInt(100),
+			2,
+			true,
+		},
+		{
+			"single price exactly at end is returned - pass",
+			quotePrices(8, 100),
+			0, 8,
+			math.NewInt(100),
+			1,
+			true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, count, err := types.ComputeTWAP(tc.prices, tc.start, tc.end, heightPoint)
+			if !tc.expectPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, twap)
+			require.Equal(t, tc.expectedCount, count)
+		})
+	}
+}
+
+func TestGenesisPriceHistoryValidation(t *testing.T) {
+	cp := slinkytypes.NewCurrencyPair("AA", "BB")
+	cpgs := []types.CurrencyPairGenesis{{CurrencyPair: cp, Id: 0}}
+
+	tcs := []struct {
+		name       string
+		history    []types.CurrencyPairPriceHistory
+		expectPass bool
+	}{
+		{
+			"valid history - pass",
+			[]types.CurrencyPairPriceHistory{types.NewCurrencyPairPriceHistory(cp, quotePrices(1, 100, 2, 200))},
+			true,
+		},
+		{
+			"history for unknown currency-pair - fail",
+			[]types.CurrencyPairPriceHistory{
+				types.NewCurrencyPairPriceHistory(slinkytypes.NewCurrencyPair("CC", "DD"), quotePrices(1, 100)),
+			},
+			false,
+		},
+		{
+			"repeated history - fail",
+			[]types.CurrencyPairPriceHistory{
+				types.NewCurrencyPairPriceHistory(cp, quotePrices(1, 100)),
+				types.NewCurrencyPairPriceHistory(cp, quotePrices(2, 100)),
+			},
+			false,
+		},
+		{
+			"unordered history - fail",
+			[]types.CurrencyPairPriceHistory{types.NewCurrencyPairPriceHistory(cp, quotePrices(2, 100, 1, 200))},
+			false,
+		},
+		{
+			"negative price - fail",
+			[]types.CurrencyPairPriceHistory{types.NewCurrencyPairPriceHistory(cp, quotePrices(1, -100))},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.NewGenesisState(cpgs, 1)
+			gs.PriceHistory = tc.history
+
+			err := gs.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
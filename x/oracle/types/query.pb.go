@@ -5,24 +5,30 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/1119-Labs/slinky/pkg/types"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -173,6 +179,20 @@ type GetPriceResponse struct {
 	Decimals uint64 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
 	// ID represents the identifier for the CurrencyPair.
 	Id uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// BlocksSinceUpdate is the number of consecutive blocks that the
+	// CurrencyPair's price has gone without an update (zero if no price exists).
+	BlocksSinceUpdate uint64 `protobuf:"varint,5,opt,name=blocks_since_update,json=blocksSinceUpdate,proto3" json:"blocks_since_update,omitempty"`
+	// Stale is true if BlocksSinceUpdate exceeds the module's MaxPriceStaleness
+	// parameter.
+	Stale bool `protobuf:"varint,6,opt,name=stale,proto3" json:"stale,omitempty"`
+	// Dispersion describes the spread of the validator prices that the
+	// quote-price was aggregated from (nil if it is not tracked for the
+	// quote-price).
+	Dispersion *PriceDispersion `protobuf:"bytes,7,opt,name=dispersion,proto3" json:"dispersion,omitempty"`
+	// Shadow is true if the CurrencyPair's market is in the shadow state of its
+	// lifecycle in x/marketmap, i.e. its price is aggregated and stored but must
+	// not yet be used by consumers.
+	Shadow bool `protobuf:"varint,8,opt,name=shadow,proto3" json:"shadow,omitempty"`
 }
 
 func (m *GetPriceResponse) Reset()         { *m = GetPriceResponse{} }
@@ -236,6 +256,34 @@ func (m *GetPriceResponse) GetId() uint64 {
 	return 0
 }
 
+func (m *GetPriceResponse) GetBlocksSinceUpdate() uint64 {
+	if m != nil {
+		return m.BlocksSinceUpdate
+	}
+	return 0
+}
+
+func (m *GetPriceResponse) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
+func (m *GetPriceResponse) GetDispersion() *PriceDispersion {
+	if m != nil {
+		return m.Dispersion
+	}
+	return nil
+}
+
+func (m *GetPriceResponse) GetShadow() bool {
+	if m != nil {
+		return m.Shadow
+	}
+	return false
+}
+
 // GetPricesRequest takes an identifier for the CurrencyPair
 // in the format base/quote.
 type GetPricesRequest struct {
@@ -550,865 +598,2936 @@ func (m *GetCurrencyPairMappingListResponse) GetMappings() []CurrencyPairMapping
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "slinky.oracle.v1.GetAllCurrencyPairsRequest")
-	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "slinky.oracle.v1.GetAllCurrencyPairsResponse")
-	proto.RegisterType((*GetPriceRequest)(nil), "slinky.oracle.v1.GetPriceRequest")
-	proto.RegisterType((*GetPriceResponse)(nil), "slinky.oracle.v1.GetPriceResponse")
-	proto.RegisterType((*GetPricesRequest)(nil), "slinky.oracle.v1.GetPricesRequest")
-	proto.RegisterType((*GetPricesResponse)(nil), "slinky.oracle.v1.GetPricesResponse")
-	proto.RegisterType((*GetCurrencyPairMappingRequest)(nil), "slinky.oracle.v1.GetCurrencyPairMappingRequest")
-	proto.RegisterType((*GetCurrencyPairMappingResponse)(nil), "slinky.oracle.v1.GetCurrencyPairMappingResponse")
-	proto.RegisterMapType((map[uint64]types.CurrencyPair)(nil), "slinky.oracle.v1.GetCurrencyPairMappingResponse.CurrencyPairMappingEntry")
-	proto.RegisterType((*GetCurrencyPairMappingListRequest)(nil), "slinky.oracle.v1.GetCurrencyPairMappingListRequest")
-	proto.RegisterType((*CurrencyPairMapping)(nil), "slinky.oracle.v1.CurrencyPairMapping")
-	proto.RegisterType((*GetCurrencyPairMappingListResponse)(nil), "slinky.oracle.v1.GetCurrencyPairMappingListResponse")
+// GetPriceHistoryRequest is the GetPriceHistory request type.
+type GetPriceHistoryRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
 }
 
-func init() { proto.RegisterFile("slinky/oracle/v1/query.proto", fileDescriptor_ba8e832073f3a7b0) }
-
-var fileDescriptor_ba8e832073f3a7b0 = []byte{
-	// 748 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x5b,
-	0x14, 0xef, 0x2d, 0x2d, 0x81, 0xf3, 0x1e, 0xff, 0x2e, 0xbc, 0x97, 0xa6, 0x94, 0x01, 0xa6, 0xef,
-	0x25, 0x88, 0x32, 0x63, 0xa9, 0x0b, 0x70, 0x61, 0x14, 0x35, 0x15, 0x83, 0x09, 0x34, 0x71, 0xa3,
-	0x8b, 0x66, 0x98, 0xde, 0xd4, 0x1b, 0xa6, 0x73, 0x87, 0xb9, 0xb7, 0x8d, 0x75, 0xa9, 0x6b, 0x8d,
-	0x09, 0x6b, 0xbf, 0x83, 0x9f, 0xc1, 0x15, 0x4b, 0x12, 0x37, 0xae, 0x8c, 0x01, 0x3f, 0x88, 0xe9,
-	0xbd, 0x77, 0x4a, 0x4b, 0xa7, 0xb5, 0xb8, 0x9b, 0x99, 0xdf, 0x39, 0xbf, 0xf3, 0x3b, 0x7f, 0x07,
-	0x72, 0xdc, 0xa3, 0xfe, 0x51, 0xcb, 0x66, 0xa1, 0xe3, 0x7a, 0xc4, 0x6e, 0x16, 0xec, 0xe3, 0x06,
-	0x09, 0x5b, 0x56, 0x10, 0x32, 0xc1, 0xf0, 0xac, 0x42, 0x2d, 0x85, 0x5a, 0xcd, 0x42, 0x76, 0xa1,
-	0xc6, 0x6a, 0x4c, 0x82, 0x76, 0xfb, 0x49, 0xd9, 0x65, 0x73, 0x35, 0xc6, 0x6a, 0x1e, 0xb1, 0x9d,
-	0x80, 0xda, 0x8e, 0xef, 0x33, 0xe1, 0x08, 0xca, 0x7c, 0xae, 0x51, 0xa3, 0x2f, 0x46, 0x8d, 0xf8,
-	0x84, 0xd3, 0x08, 0xcf, 0x6b, 0x5c, 0xb4, 0x02, 0xc2, 0xdb, 0xb0, 0xdb, 0x08, 0x43, 0xe2, 0xbb,
-	0xad, 0x4a, 0xe0, 0xd0, 0x50, 0x19, 0x99, 0x39, 0xc8, 0x96, 0x88, 0x78, 0xe0, 0x79, 0x0f, 0x35,
-	0xb8, 0xef, 0xd0, 0x90, 0x97, 0xc9, 0x71, 0x83, 0x70, 0x61, 0x52, 0x58, 0x8c, 0x45, 0x79, 0xc0,
-	0x7c, 0x4e, 0xf0, 0x53, 0x98, 0xee, 0xe1, 0xe4, 0x19, 0xb4, 0x32, 0xb6, 0xf6, 0xd7, 0xe6, 0x92,
-	0xa5, 0x13, 0x94, 0xa1, 0xad, 0x66, 0xc1, 0xea, 0xf6, 0xdf, 0x49, 0x9d, 0x7e, 0x5f, 0x4e, 0x94,
-	0xa7, 0xdc, 0x6e, 0x4e, 0xf3, 0x25, 0xcc, 0x94, 0x88, 0xd8, 0x0f, 0xa9, 0x4b, 0x74, 0x74, 0xfc,
-	0x04, 0xa6, 0x7a, 0xe8, 0x33, 0x68, 0x05, 0x8d, 0xca, 0xfe, 0x77, 0x37, 0xbb, 0xf9, 0x01, 0xc1,
-	0xec, 0x25, 0xbb, 0x56, 0xbf, 0x05, 0xe9, 0xa0, 0xfd, 0x41, 0xd3, 0xe6, 0xac, 0xab, 0x5d, 0xb1,
-	0x0e, 0x1a, 0x4c, 0x10, 0xe9, 0x24, 0x59, 0x51, 0x59, 0x39, 0xe0, 0x05, 0x48, 0xfb, 0xcc, 0x77,
-	0x49, 0x26, 0xb9, 0x82, 0xd6, 0x52, 0x65, 0xf5, 0x82, 0xb3, 0x30, 0x51, 0x25, 0x2e, 0xad, 0x3b,
-	0x1e, 0xcf, 0x8c, 0x49, 0xa0, 0xf3, 0x8e, 0xa7, 0x21, 0x49, 0xab, 0x99, 0x94, 0xfc, 0x9a, 0xa4,
-	0x55, 0xf3, 0xde, 0xa5, 0x9e, 0xa8, 0xd8, 0x78, 0x1d, 0xe6, 0x7a, 0xd2, 0xad, 0xd0, 0xaa, 0x2a,
-	0xe8, 0x64, 0x79, 0xa6, 0x3b, 0x9b, 0xdd, 0x2a, 0x37, 0x9f, 0xc3, 0x5c, 0x97, 0xbf, 0x4e, 0xe8,
-	0x3e, 0x8c, 0x4b, 0x7d, 0x51, 0x1b, 0xcc, 0xfe, 0x8c, 0xae, 0x16, 0x41, 0x57, 0x4b, 0xfb, 0x99,
-	0xcb, 0xb0, 0x54, 0x22, 0xa2, 0xbb, 0x9c, 0xcf, 0x9c, 0x20, 0xa0, 0x7e, 0x2d, 0x1a, 0x88, 0xf7,
-	0x49, 0x30, 0x06, 0x59, 0x68, 0x15, 0xef, 0x10, 0xfc, 0xd3, 0x9b, 0x47, 0x5d, 0x59, 0x68, 0x55,
-	0xbb, 0xb1, 0xaa, 0x86, 0x30, 0x5a, 0x31, 0xd8, 0x63, 0x5f, 0x84, 0x2d, 0x2d, 0x7e, 0xde, 0xed,
-	0xc7, 0xb3, 0x04, 0x32, 0x83, 0xdc, 0xf0, 0x2c, 0x8c, 0x1d, 0x91, 0x96, 0x6c, 0x7b, 0xaa, 0xdc,
-	0x7e, 0xc4, 0x45, 0x48, 0x37, 0x1d, 0xaf, 0xa1, 0x1a, 0xfa, 0xbb, 0x09, 0x2b, 0x2b, 0xdb, 0xbb,
-	0xc9, 0x2d, 0x64, 0xe6, 0x61, 0x35, 0x5e, 0xfc, 0x1e, 0xe5, 0x22, 0x2a, 0x1a, 0x83, 0xf9, 0x18,
-	0x0b, 0x3d, 0x13, 0x28, 0x9a, 0x89, 0xfe, 0x71, 0x4f, 0xfe, 0xe9, 0xb8, 0xd7, 0xc1, 0x1c, 0xa6,
-	0x4a, 0x37, 0xaa, 0x04, 0x13, 0xba, 0x33, 0xd1, 0xc0, 0xfc, 0xdf, 0xdf, 0x9a, 0x18, 0x12, 0x1d,
-	0xb2, 0xe3, 0xbc, 0x79, 0x32, 0x0e, 0xe9, 0x83, 0xf6, 0x79, 0xc3, 0x9f, 0x10, 0xcc, 0xc7, 0x1c,
-	0x0c, 0x7c, 0x2b, 0xb6, 0xe7, 0x03, 0xae, 0x4e, 0x76, 0x63, 0x44, 0x6b, 0x95, 0x87, 0x79, 0xe3,
-	0xed, 0xd7, 0x9f, 0x27, 0xc9, 0x3c, 0x5e, 0xb5, 0x63, 0x0e, 0xa2, 0xa8, 0x38, 0x9e, 0x57, 0x11,
-	0xd4, 0x3d, 0x22, 0x21, 0xc7, 0x4d, 0x98, 0x88, 0x36, 0x00, 0xaf, 0x0e, 0xdb, 0x0e, 0x25, 0x64,
-	0x84, 0x05, 0x32, 0xf3, 0x32, 0xfa, 0x12, 0x5e, 0x8c, 0x8f, 0xae, 0x0e, 0xc6, 0x1b, 0x98, 0xec,
-	0xac, 0x2b, 0x1e, 0xc2, 0xda, 0x29, 0x41, 0x7e, 0xa8, 0x8d, 0x0e, 0xfd, 0x9f, 0x0c, 0x6d, 0xe0,
-	0xdc, 0x90, 0xd0, 0x1c, 0x7f, 0x46, 0xf0, 0x6f, 0xfc, 0x34, 0x60, 0x7b, 0xf4, 0x55, 0x54, 0xb2,
-	0x6e, 0x5f, 0x77, 0x77, 0xcd, 0xa2, 0xd4, 0xb8, 0x81, 0x6f, 0xc6, 0x6b, 0x8c, 0x3d, 0x14, 0xf8,
-	0x0b, 0x92, 0x7f, 0xa5, 0x01, 0x03, 0x8c, 0x8b, 0xa3, 0xaa, 0xe8, 0x5a, 0xc2, 0xec, 0x9d, 0xeb,
-	0x39, 0x69, 0xf9, 0xdb, 0x52, 0x7e, 0x11, 0x17, 0x6c, 0x97, 0xf9, 0x3e, 0x71, 0x45, 0x47, 0xff,
-	0xe6, 0x60, 0xfd, 0x15, 0x8f, 0x72, 0xb1, 0xf3, 0xe8, 0xf4, 0xdc, 0x40, 0x67, 0xe7, 0x06, 0xfa,
-	0x71, 0x6e, 0xa0, 0x8f, 0x17, 0x46, 0xe2, 0xec, 0xc2, 0x48, 0x7c, 0xbb, 0x30, 0x12, 0x2f, 0xd6,
-	0x6b, 0x54, 0xbc, 0x6a, 0x1c, 0x5a, 0x2e, 0xab, 0xdb, 0x85, 0x42, 0x61, 0x7b, 0x63, 0xcf, 0x39,
-	0xe4, 0x51, 0x7d, 0x5e, 0x47, 0x11, 0xe4, 0xba, 0x1f, 0x8e, 0xcb, 0xdf, 0x74, 0xf1, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xf3, 0x1c, 0x80, 0x11, 0x51, 0x08, 0x00, 0x00,
+func (m *GetPriceHistoryRequest) Reset()         { *m = GetPriceHistoryRequest{} }
+func (m *GetPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryRequest) ProtoMessage()    {}
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{11}
+}
+func (m *GetPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryRequest.Merge(m, src)
+}
+func (m *GetPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GetPriceHistoryRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
-	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
-	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
-	// that CurrencyPair.
-	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
-	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
-	// Get the mapping of currency pair ID -> currency pair. This is useful for
-	// indexers that have access to the ID of a currency pair, but no way to get
-	// the underlying currency pair from it.
-	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
-	// Get the mapping of currency pair ID <-> currency pair as a list. This is
-	// useful for indexers that have access to the ID of a currency pair, but no
-	// way to get the underlying currency pair from it.
-	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+func (m *GetPriceHistoryRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return types.CurrencyPair{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// GetPriceHistoryResponse is the GetPriceHistory response type.
+type GetPriceHistoryResponse struct {
+	// prices is the list of historical QuotePrices for the CurrencyPair, ordered
+	// by ascending block height.
+	Prices []QuotePrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *GetPriceHistoryResponse) Reset()         { *m = GetPriceHistoryResponse{} }
+func (m *GetPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryResponse) ProtoMessage()    {}
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{12}
 }
-
-func (c *queryClient) GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error) {
-	out := new(GetAllCurrencyPairsResponse)
-	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetAllCurrencyPairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryResponse.Merge(m, src)
+}
+func (m *GetPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
-	out := new(GetPriceResponse)
-	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPriceHistoryResponse) GetPrices() []QuotePrice {
+	if m != nil {
+		return m.Prices
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error) {
-	out := new(GetPricesResponse)
-	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+// GetTWAPRequest is the GetTWAP request type. Exactly one of block_window or
+// time_window must be set.
+type GetTWAPRequest struct {
+	// CurrencyPair represents the pair that the user wishes to query.
+	CurrencyPair types.CurrencyPair `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair"`
+	// block_window is the number of blocks (ending at the current block) to
+	// average over.
+	BlockWindow uint64 `protobuf:"varint,2,opt,name=block_window,json=blockWindow,proto3" json:"block_window,omitempty"`
+	// time_window is the duration (ending at the current block time) to average
+	// over.
+	TimeWindow time.Duration `protobuf:"bytes,3,opt,name=time_window,json=timeWindow,proto3,stdduration" json:"time_window"`
+}
+
+func (m *GetTWAPRequest) Reset()         { *m = GetTWAPRequest{} }
+func (m *GetTWAPRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPRequest) ProtoMessage()    {}
+func (*GetTWAPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{13}
+}
+func (m *GetTWAPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTWAPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetTWAPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPRequest.Merge(m, src)
+}
+func (m *GetTWAPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTWAPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
-	out := new(GetCurrencyPairMappingResponse)
-	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetCurrencyPairMapping", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTWAPRequest proto.InternalMessageInfo
+
+func (m *GetTWAPRequest) GetCurrencyPair() types.CurrencyPair {
+	if m != nil {
+		return m.CurrencyPair
 	}
-	return out, nil
+	return types.CurrencyPair{}
 }
 
-func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error) {
-	out := new(GetCurrencyPairMappingListResponse)
-	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetCurrencyPairMappingList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTWAPRequest) GetBlockWindow() uint64 {
+	if m != nil {
+		return m.BlockWindow
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get all the currency pairs the x/oracle module is tracking price-data for.
-	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
-	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
-	// that CurrencyPair.
-	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
-	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
-	// Get the mapping of currency pair ID -> currency pair. This is useful for
-	// indexers that have access to the ID of a currency pair, but no way to get
-	// the underlying currency pair from it.
-	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
-	// Get the mapping of currency pair ID <-> currency pair as a list. This is
-	// useful for indexers that have access to the ID of a currency pair, but no
-	// way to get the underlying currency pair from it.
-	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+func (m *GetTWAPRequest) GetTimeWindow() time.Duration {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// GetTWAPResponse is the GetTWAP response type.
+type GetTWAPResponse struct {
+	// price is the time-weighted average price over the requested window.
+	Price cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	// decimals represents the number of decimals that the price is represented
+	// in.
+	Decimals uint64 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// num_samples is the number of historical prices that contributed to the
+	// average.
+	NumSamples uint64 `protobuf:"varint,3,opt,name=num_samples,json=numSamples,proto3" json:"num_samples,omitempty"`
 }
 
-func (*UnimplementedQueryServer) GetAllCurrencyPairs(ctx context.Context, req *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCurrencyPairs not implemented")
+func (m *GetTWAPResponse) Reset()         { *m = GetTWAPResponse{} }
+func (m *GetTWAPResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTWAPResponse) ProtoMessage()    {}
+func (*GetTWAPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{14}
 }
-func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequest) (*GetPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+func (m *GetTWAPResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
+func (m *GetTWAPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTWAPResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
+func (m *GetTWAPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTWAPResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetCurrencyPairMappingList(ctx context.Context, req *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
+func (m *GetTWAPResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *GetTWAPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTWAPResponse.DiscardUnknown(m)
 }
 
-func _Query_GetAllCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCurrencyPairsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllCurrencyPairs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.oracle.v1.Query/GetAllCurrencyPairs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllCurrencyPairs(ctx, req.(*GetAllCurrencyPairsRequest))
+var xxx_messageInfo_GetTWAPResponse proto.InternalMessageInfo
+
+func (m *GetTWAPResponse) GetDecimals() uint64 {
+	if m != nil {
+		return m.Decimals
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.oracle.v1.Query/GetPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPrice(ctx, req.(*GetPriceRequest))
+func (m *GetTWAPResponse) GetNumSamples() uint64 {
+	if m != nil {
+		return m.NumSamples
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.oracle.v1.Query/GetPrices",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPrices(ctx, req.(*GetPricesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ParamsRequest is the request type for the Query/Params RPC method.
+type ParamsRequest struct {
 }
 
-func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrencyPairMappingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCurrencyPairMapping(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.oracle.v1.Query/GetCurrencyPairMapping",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCurrencyPairMapping(ctx, req.(*GetCurrencyPairMappingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{15}
 }
-
-func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrencyPairMappingListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/slinky.oracle.v1.Query/GetCurrencyPairMappingList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, req.(*GetCurrencyPairMappingListRequest))
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "slinky.oracle.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetAllCurrencyPairs",
-			Handler:    _Query_GetAllCurrencyPairs_Handler,
-		},
-		{
-			MethodName: "GetPrice",
-			Handler:    _Query_GetPrice_Handler,
-		},
-		{
-			MethodName: "GetPrices",
-			Handler:    _Query_GetPrices_Handler,
-		},
-		{
-			MethodName: "GetCurrencyPairMapping",
-			Handler:    _Query_GetCurrencyPairMapping_Handler,
-		},
-		{
-			MethodName: "GetCurrencyPairMappingList",
-			Handler:    _Query_GetCurrencyPairMappingList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "slinky/oracle/v1/query.proto",
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+// ParamsResponse is the response type for the Query/Params RPC method.
+type ParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{16}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
 }
-
-func (m *GetAllCurrencyPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
 }
 
-func (m *GetAllCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *GetAllCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorPerformanceRequest is the request type for the
+// Query/ValidatorPerformance RPC method.
+type ValidatorPerformanceRequest struct {
+	// validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 }
 
-func (m *GetAllCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ValidatorPerformanceRequest) Reset()         { *m = ValidatorPerformanceRequest{} }
+func (m *ValidatorPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceRequest) ProtoMessage()    {}
+func (*ValidatorPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{17}
+}
+func (m *ValidatorPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceRequest.Merge(m, src)
+}
+func (m *ValidatorPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceRequest.DiscardUnknown(m)
 }
 
-func (m *GetPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorPerformanceRequest proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceRequest) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorPerformanceResponse is the response type for the
+// Query/ValidatorPerformance RPC method.
+type ValidatorPerformanceResponse struct {
+	Performance ValidatorPerformance `protobuf:"bytes,1,opt,name=performance,proto3" json:"performance"`
 }
 
-func (m *GetPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+func (m *ValidatorPerformanceResponse) Reset()         { *m = ValidatorPerformanceResponse{} }
+func (m *ValidatorPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceResponse) ProtoMessage()    {}
+func (*ValidatorPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{18}
+}
+func (m *ValidatorPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceResponse.Merge(m, src)
+}
+func (m *ValidatorPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceResponse.DiscardUnknown(m)
 }
 
-func (m *GetPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorPerformanceResponse proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceResponse) GetPerformance() ValidatorPerformance {
+	if m != nil {
+		return m.Performance
 	}
-	return dAtA[:n], nil
+	return ValidatorPerformance{}
 }
 
-func (m *GetPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorPerformancesRequest is the request type for the
+// Query/ValidatorPerformances RPC method.
+type ValidatorPerformancesRequest struct {
 }
 
-func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Price != nil {
-		{
-			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ValidatorPerformancesRequest) Reset()         { *m = ValidatorPerformancesRequest{} }
+func (m *ValidatorPerformancesRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformancesRequest) ProtoMessage()    {}
+func (*ValidatorPerformancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{19}
+}
+func (m *ValidatorPerformancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ValidatorPerformancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformancesRequest.Merge(m, src)
 }
-
-func (m *GetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorPerformancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformancesRequest.DiscardUnknown(m)
 }
 
-func (m *GetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CurrencyPairIds[iNdEx])
-			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+var xxx_messageInfo_ValidatorPerformancesRequest proto.InternalMessageInfo
+
+// ValidatorPerformancesResponse is the response type for the
+// Query/ValidatorPerformances RPC method.
+type ValidatorPerformancesResponse struct {
+	Performances []ValidatorPerformance `protobuf:"bytes,1,rep,name=performances,proto3" json:"performances"`
+}
+
+func (m *ValidatorPerformancesResponse) Reset()         { *m = ValidatorPerformancesResponse{} }
+func (m *ValidatorPerformancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformancesResponse) ProtoMessage()    {}
+func (*ValidatorPerformancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba8e832073f3a7b0, []int{20}
+}
+func (m *ValidatorPerformancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ValidatorPerformancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformancesResponse.Merge(m, src)
+}
+func (m *ValidatorPerformancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformancesResponse.DiscardUnknown(m)
 }
 
-func (m *GetPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorPerformancesResponse proto.InternalMessageInfo
+
+func (m *ValidatorPerformancesResponse) GetPerformances() []ValidatorPerformance {
+	if m != nil {
+		return m.Performances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*GetAllCurrencyPairsRequest)(nil), "slinky.oracle.v1.GetAllCurrencyPairsRequest")
+	proto.RegisterType((*GetAllCurrencyPairsResponse)(nil), "slinky.oracle.v1.GetAllCurrencyPairsResponse")
+	proto.RegisterType((*GetPriceRequest)(nil), "slinky.oracle.v1.GetPriceRequest")
+	proto.RegisterType((*GetPriceResponse)(nil), "slinky.oracle.v1.GetPriceResponse")
+	proto.RegisterType((*GetPricesRequest)(nil), "slinky.oracle.v1.GetPricesRequest")
+	proto.RegisterType((*GetPricesResponse)(nil), "slinky.oracle.v1.GetPricesResponse")
+	proto.RegisterType((*GetCurrencyPairMappingRequest)(nil), "slinky.oracle.v1.GetCurrencyPairMappingRequest")
+	proto.RegisterType((*GetCurrencyPairMappingResponse)(nil), "slinky.oracle.v1.GetCurrencyPairMappingResponse")
+	proto.RegisterMapType((map[uint64]types.CurrencyPair)(nil), "slinky.oracle.v1.GetCurrencyPairMappingResponse.CurrencyPairMappingEntry")
+	proto.RegisterType((*GetCurrencyPairMappingListRequest)(nil), "slinky.oracle.v1.GetCurrencyPairMappingListRequest")
+	proto.RegisterType((*CurrencyPairMapping)(nil), "slinky.oracle.v1.CurrencyPairMapping")
+	proto.RegisterType((*GetCurrencyPairMappingListResponse)(nil), "slinky.oracle.v1.GetCurrencyPairMappingListResponse")
+	proto.RegisterType((*GetPriceHistoryRequest)(nil), "slinky.oracle.v1.GetPriceHistoryRequest")
+	proto.RegisterType((*GetPriceHistoryResponse)(nil), "slinky.oracle.v1.GetPriceHistoryResponse")
+	proto.RegisterType((*GetTWAPRequest)(nil), "slinky.oracle.v1.GetTWAPRequest")
+	proto.RegisterType((*GetTWAPResponse)(nil), "slinky.oracle.v1.GetTWAPResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "slinky.oracle.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "slinky.oracle.v1.ParamsResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "slinky.oracle.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "slinky.oracle.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorPerformancesRequest)(nil), "slinky.oracle.v1.ValidatorPerformancesRequest")
+	proto.RegisterType((*ValidatorPerformancesResponse)(nil), "slinky.oracle.v1.ValidatorPerformancesResponse")
 }
 
-func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("slinky/oracle/v1/query.proto", fileDescriptor_ba8e832073f3a7b0) }
+
+var fileDescriptor_ba8e832073f3a7b0 = []byte{
+	// 1324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0x3f, 0x9a, 0xbc, 0xb4, 0x49, 0x3b, 0x69, 0xfb, 0x75, 0x5d, 0xc7, 0xb1, 0x37,
+	0xdf, 0x42, 0xfa, 0x23, 0xbb, 0x75, 0x83, 0x50, 0xdb, 0x03, 0x90, 0x34, 0x28, 0x2d, 0x2a, 0x28,
+	0x75, 0x29, 0x95, 0x40, 0xc2, 0xda, 0xec, 0x4e, 0x9d, 0x51, 0x76, 0x77, 0xb6, 0x3b, 0xe3, 0x14,
+	0x73, 0x84, 0x33, 0x12, 0xa8, 0x17, 0x2e, 0x5c, 0x39, 0x70, 0xe2, 0x50, 0xfe, 0x01, 0xb8, 0xf4,
+	0x58, 0x95, 0x0b, 0xe2, 0x50, 0x50, 0xca, 0x1f, 0x82, 0x76, 0x7e, 0x38, 0xeb, 0x78, 0xed, 0x6e,
+	0x50, 0x6f, 0x9e, 0x7d, 0xef, 0x7d, 0x3e, 0x9f, 0x37, 0xf3, 0xe6, 0xbd, 0x31, 0x94, 0x99, 0x4f,
+	0xc2, 0x9d, 0x8e, 0x4d, 0x63, 0xc7, 0xf5, 0xb1, 0xbd, 0x5b, 0xb7, 0x1f, 0xb6, 0x71, 0xdc, 0xb1,
+	0xa2, 0x98, 0x72, 0x8a, 0x8e, 0x4b, 0xab, 0x25, 0xad, 0xd6, 0x6e, 0xbd, 0x74, 0xb2, 0x45, 0x5b,
+	0x54, 0x18, 0xed, 0xe4, 0x97, 0xf4, 0x2b, 0x95, 0x5b, 0x94, 0xb6, 0x7c, 0x6c, 0x3b, 0x11, 0xb1,
+	0x9d, 0x30, 0xa4, 0xdc, 0xe1, 0x84, 0x86, 0x4c, 0x59, 0x2b, 0xca, 0x2a, 0x56, 0x5b, 0xed, 0x07,
+	0xb6, 0xd7, 0x8e, 0x85, 0x83, 0xb2, 0x9f, 0x71, 0x29, 0x0b, 0x28, 0x6b, 0x4a, 0x58, 0xb9, 0xd0,
+	0xa1, 0x7d, 0xf2, 0x5a, 0x38, 0xc4, 0x8c, 0x68, 0xfb, 0xa2, 0xb2, 0xf3, 0x4e, 0x84, 0x59, 0x62,
+	0x76, 0xdb, 0x71, 0x8c, 0x43, 0xb7, 0xd3, 0x8c, 0x1c, 0x12, 0x2b, 0xa7, 0xf9, 0x3e, 0x90, 0xc8,
+	0x89, 0x9d, 0x40, 0x63, 0x5c, 0xea, 0x33, 0xef, 0x3a, 0x3e, 0xf1, 0x1c, 0x4e, 0xe3, 0x66, 0x84,
+	0xe3, 0x07, 0x34, 0x0e, 0x9c, 0xd0, 0xc5, 0xd2, 0xdb, 0x2c, 0x43, 0x69, 0x03, 0xf3, 0x55, 0xdf,
+	0xbf, 0xa1, 0x98, 0x36, 0x1d, 0x12, 0xb3, 0x06, 0x7e, 0xd8, 0xc6, 0x8c, 0x9b, 0x04, 0xce, 0x66,
+	0x5a, 0x59, 0x44, 0x43, 0x86, 0xd1, 0x07, 0x30, 0xd3, 0x23, 0x90, 0x15, 0x8d, 0xea, 0xe8, 0xd2,
+	0xf4, 0x95, 0x79, 0x4b, 0x6d, 0xb4, 0xc8, 0xc3, 0xda, 0xad, 0x5b, 0xe9, 0xf8, 0xb5, 0xb1, 0xa7,
+	0x2f, 0x16, 0x46, 0x1a, 0xc7, 0xdc, 0x34, 0xa6, 0xf9, 0x19, 0xcc, 0x6e, 0x60, 0xbe, 0x19, 0x13,
+	0x17, 0x2b, 0x76, 0x74, 0x13, 0x8e, 0xf5, 0xc0, 0x17, 0x8d, 0xaa, 0x91, 0x17, 0xfd, 0x68, 0x1a,
+	0xdd, 0xfc, 0xa5, 0x00, 0xc7, 0xf7, 0xd1, 0x95, 0xfa, 0xab, 0x30, 0x1e, 0x25, 0x1f, 0x14, 0x6c,
+	0xd9, 0x3a, 0x58, 0x1d, 0xd6, 0x9d, 0x36, 0xe5, 0x58, 0x04, 0x09, 0x54, 0xa3, 0x21, 0x03, 0xd0,
+	0x49, 0x18, 0x0f, 0x69, 0xe8, 0xe2, 0x62, 0xa1, 0x6a, 0x2c, 0x8d, 0x35, 0xe4, 0x02, 0x95, 0x60,
+	0xd2, 0xc3, 0x2e, 0x09, 0x1c, 0x9f, 0x15, 0x47, 0x85, 0xa1, 0xbb, 0x46, 0x33, 0x50, 0x20, 0x5e,
+	0x71, 0x4c, 0x7c, 0x2d, 0x10, 0x0f, 0x59, 0x30, 0xb7, 0xe5, 0x53, 0x77, 0x87, 0x35, 0x19, 0x09,
+	0x5d, 0xdc, 0x6c, 0x47, 0x9e, 0xc3, 0x71, 0x71, 0x5c, 0x38, 0x9c, 0x90, 0xa6, 0xbb, 0x89, 0xe5,
+	0x9e, 0x30, 0x24, 0x8c, 0x8c, 0x3b, 0x3e, 0x2e, 0x4e, 0x54, 0x8d, 0xa5, 0xc9, 0x86, 0x5c, 0xa0,
+	0x0d, 0x00, 0x8f, 0xb0, 0x08, 0xc7, 0x8c, 0xd0, 0xb0, 0x78, 0x44, 0xa4, 0x51, 0xeb, 0x4f, 0x43,
+	0x64, 0xb0, 0xde, 0x75, 0x54, 0xb9, 0xa4, 0x42, 0xd1, 0x69, 0x98, 0x60, 0xdb, 0x8e, 0x47, 0x1f,
+	0x15, 0x27, 0x05, 0xbe, 0x5a, 0x99, 0xef, 0xec, 0x6f, 0x9b, 0xae, 0x09, 0x74, 0x01, 0x4e, 0xf4,
+	0x9c, 0x4a, 0x93, 0x78, 0xf2, 0xdc, 0xa7, 0x1a, 0xb3, 0xe9, 0x4d, 0xbf, 0xe5, 0x31, 0xf3, 0x1e,
+	0x9c, 0x48, 0xc5, 0xab, 0x7d, 0x7f, 0x0f, 0x26, 0xc4, 0x36, 0xea, 0x6a, 0x31, 0xfb, 0x15, 0x1f,
+	0x3c, 0x2b, 0x75, 0xa8, 0x2a, 0xce, 0x5c, 0x80, 0xf9, 0x0d, 0xcc, 0xd3, 0xa7, 0xfe, 0xa1, 0x13,
+	0x45, 0x24, 0x6c, 0xe9, 0xba, 0xfd, 0xa6, 0x00, 0x95, 0x41, 0x1e, 0x4a, 0xc5, 0xd7, 0x06, 0x9c,
+	0xea, 0xcd, 0x23, 0x90, 0x1e, 0x4a, 0xd5, 0xad, 0x4c, 0x55, 0x43, 0x10, 0xad, 0x0c, 0xdb, 0xfb,
+	0x21, 0x8f, 0x3b, 0x4a, 0xfc, 0x9c, 0xdb, 0x6f, 0x2f, 0x61, 0x28, 0x0e, 0x0a, 0x43, 0xc7, 0x61,
+	0x74, 0x07, 0x77, 0x44, 0x75, 0x8e, 0x35, 0x92, 0x9f, 0x68, 0x05, 0xc6, 0x77, 0x1d, 0xbf, 0x2d,
+	0xeb, 0xee, 0x55, 0x17, 0xa1, 0x21, 0x7d, 0xaf, 0x17, 0xae, 0x1a, 0xe6, 0x22, 0xd4, 0xb2, 0xc5,
+	0xdf, 0x26, 0x8c, 0xeb, 0x4d, 0xa3, 0x30, 0x97, 0xe1, 0xa1, 0x4a, 0xd7, 0xe8, 0x96, 0x6e, 0xdf,
+	0xad, 0x2c, 0xfc, 0xd7, 0x5b, 0x19, 0x80, 0x39, 0x4c, 0x95, 0x3a, 0xa8, 0x0d, 0x98, 0x54, 0x27,
+	0xa3, 0x0b, 0xe6, 0x5c, 0xff, 0xd1, 0x64, 0x80, 0x28, 0xca, 0x6e, 0xb0, 0xb9, 0x05, 0xa7, 0x75,
+	0x5d, 0xdd, 0x24, 0x8c, 0xd3, 0xb8, 0xf3, 0xfa, 0x1b, 0xcd, 0x3d, 0xf8, 0x5f, 0x1f, 0x87, 0xca,
+	0xe3, 0xfa, 0x81, 0xb2, 0x7f, 0x75, 0xbf, 0xd9, 0x2f, 0xf8, 0xdf, 0x0c, 0x98, 0xd9, 0xc0, 0xfc,
+	0xe3, 0xfb, 0xab, 0x9b, 0xaf, 0x5d, 0x33, 0xaa, 0xc1, 0x51, 0xd1, 0x70, 0x9a, 0x8f, 0x48, 0x98,
+	0xb4, 0x00, 0xd9, 0xd4, 0xa6, 0xc5, 0xb7, 0xfb, 0xe2, 0x13, 0x5a, 0x87, 0x69, 0x4e, 0x02, 0xac,
+	0x3d, 0x46, 0x05, 0xd5, 0x19, 0x4b, 0x0e, 0x42, 0x4b, 0x0f, 0x42, 0x6b, 0x5d, 0x0d, 0xc2, 0xb5,
+	0xc9, 0x84, 0xe6, 0xfb, 0xbf, 0x92, 0x2e, 0x93, 0xc4, 0x49, 0x14, 0xf3, 0x3b, 0x03, 0x66, 0xbb,
+	0x59, 0xa8, 0x5d, 0x59, 0x4d, 0x37, 0xe1, 0xa9, 0xb5, 0x8b, 0x49, 0xe0, 0x9f, 0x2f, 0x16, 0x4e,
+	0xc9, 0xb1, 0xc9, 0xbc, 0x1d, 0x8b, 0x50, 0x3b, 0x70, 0xf8, 0xb6, 0x75, 0x2b, 0xe4, 0xcf, 0x9f,
+	0x2c, 0x83, 0x34, 0x24, 0x2b, 0xdd, 0x8d, 0xd3, 0x7d, 0xb7, 0x70, 0xa0, 0xef, 0x2e, 0xc0, 0x74,
+	0xd8, 0x0e, 0x9a, 0xcc, 0x09, 0x22, 0x1f, 0xeb, 0xb6, 0x0c, 0x61, 0x3b, 0xb8, 0x2b, 0xbf, 0x98,
+	0xb3, 0x70, 0x6c, 0x53, 0x4c, 0x4f, 0x7d, 0x0b, 0x6e, 0xc2, 0x8c, 0xfe, 0xa0, 0x24, 0xbe, 0x0d,
+	0x13, 0x72, 0xc0, 0xaa, 0x2d, 0x2e, 0x66, 0x74, 0x58, 0x61, 0xef, 0x1e, 0x9a, 0x58, 0x99, 0x9f,
+	0xc3, 0xd9, 0x4f, 0xf4, 0xe4, 0xdd, 0xdc, 0x1f, 0xbc, 0xfa, 0x00, 0xdf, 0x85, 0xa9, 0xee, 0x60,
+	0x56, 0xd9, 0xd7, 0x9e, 0x3f, 0x59, 0x9e, 0x57, 0x09, 0xde, 0x48, 0xb8, 0x43, 0xd6, 0x66, 0xab,
+	0x9e, 0x17, 0x63, 0xc6, 0xee, 0xf2, 0x38, 0x69, 0x36, 0xfb, 0x31, 0x66, 0x08, 0xe5, 0x6c, 0x7c,
+	0xa5, 0xfb, 0x23, 0x98, 0x4e, 0xcd, 0x7b, 0x25, 0xfe, 0x8d, 0x7e, 0xf1, 0x59, 0x20, 0x2a, 0x95,
+	0x34, 0x80, 0x59, 0xc9, 0xe6, 0xeb, 0xee, 0xdc, 0x43, 0x98, 0x1f, 0x60, 0x57, 0x82, 0x36, 0xe1,
+	0x68, 0x0a, 0x4f, 0xdf, 0x83, 0xc3, 0x29, 0xea, 0x41, 0xb8, 0xb2, 0x37, 0x0d, 0xe3, 0x77, 0x92,
+	0x07, 0x1e, 0xfa, 0xc1, 0x80, 0xb9, 0x8c, 0xa7, 0x0a, 0xba, 0x94, 0xd9, 0xc6, 0x07, 0xbc, 0x77,
+	0x4a, 0xcb, 0x39, 0xbd, 0x65, 0x42, 0xe6, 0xf9, 0xaf, 0x7e, 0xff, 0xe7, 0x71, 0x61, 0x11, 0xd5,
+	0xec, 0x8c, 0x77, 0x1d, 0x6f, 0x3a, 0xbe, 0xdf, 0xe4, 0xc4, 0xdd, 0xc1, 0x31, 0x43, 0xbb, 0x30,
+	0xa9, 0x1b, 0x03, 0xaa, 0x0d, 0x1b, 0x78, 0x52, 0x48, 0x8e, 0x99, 0x68, 0x2e, 0x0a, 0xf6, 0x79,
+	0x74, 0x36, 0x9b, 0x5d, 0x5e, 0x8e, 0x2f, 0x61, 0x4a, 0x07, 0x32, 0x34, 0x04, 0xb5, 0xbb, 0x05,
+	0x8b, 0x43, 0x7d, 0x14, 0xf5, 0xff, 0x05, 0x75, 0x05, 0x95, 0x87, 0x50, 0x33, 0xf4, 0xb3, 0x21,
+	0x3a, 0x6e, 0xd6, 0x50, 0xb1, 0xf3, 0x4f, 0x57, 0x29, 0xeb, 0xf2, 0x61, 0xc7, 0xb1, 0xb9, 0x22,
+	0x34, 0x2e, 0xa3, 0x8b, 0xd9, 0x1a, 0x33, 0x67, 0x3f, 0xfa, 0xd5, 0x80, 0x52, 0x36, 0x6e, 0x32,
+	0x93, 0xd0, 0x4a, 0x5e, 0x15, 0xa9, 0xb9, 0x5a, 0x7a, 0xeb, 0x70, 0x41, 0x4a, 0xfe, 0x35, 0x21,
+	0x7f, 0x05, 0xd5, 0x6d, 0x97, 0x86, 0x21, 0x76, 0x79, 0x57, 0xff, 0x95, 0xc1, 0xfa, 0x9b, 0x7e,
+	0xa2, 0xf2, 0xb1, 0x01, 0xb3, 0x07, 0xa6, 0x10, 0x5a, 0x1a, 0x7c, 0xac, 0xbd, 0xc3, 0xb0, 0x74,
+	0x3e, 0x87, 0xa7, 0xd2, 0x78, 0x51, 0x68, 0x3c, 0x87, 0x16, 0x87, 0x94, 0x41, 0x73, 0x5b, 0x29,
+	0x88, 0xe1, 0x88, 0x6a, 0xfe, 0xa8, 0x9a, 0x49, 0x91, 0x9a, 0x6e, 0xa5, 0xda, 0x10, 0x0f, 0x45,
+	0x6e, 0x0a, 0xf2, 0x32, 0x2a, 0x65, 0x93, 0xf3, 0x47, 0x4e, 0x84, 0x02, 0x98, 0x90, 0xad, 0x19,
+	0x2d, 0x0c, 0x6a, 0xda, 0x9a, 0xb1, 0x3a, 0xd8, 0x41, 0x11, 0x56, 0x05, 0x61, 0x09, 0x15, 0xed,
+	0x01, 0x7f, 0xc0, 0xd0, 0x8f, 0x06, 0x9c, 0xcc, 0xea, 0x5d, 0x68, 0x39, 0x5f, 0x8f, 0xd3, 0x5a,
+	0xac, 0xbc, 0xee, 0x4a, 0x99, 0x2d, 0x94, 0x9d, 0x47, 0x6f, 0xda, 0xf9, 0xfe, 0xfb, 0xa1, 0x9f,
+	0x0c, 0x38, 0x95, 0x85, 0xc8, 0x50, 0x4e, 0xea, 0xee, 0xb6, 0xd9, 0xb9, 0xfd, 0x95, 0xd6, 0xcb,
+	0x42, 0xeb, 0x05, 0xb4, 0x94, 0x53, 0x2b, 0x5b, 0x5b, 0x7f, 0xba, 0x57, 0x31, 0x9e, 0xed, 0x55,
+	0x8c, 0xbf, 0xf7, 0x2a, 0xc6, 0xb7, 0x2f, 0x2b, 0x23, 0xcf, 0x5e, 0x56, 0x46, 0xfe, 0x78, 0x59,
+	0x19, 0xf9, 0xf4, 0x42, 0x8b, 0xf0, 0xed, 0xf6, 0x96, 0xe5, 0xd2, 0xc0, 0xae, 0xd7, 0xeb, 0xd7,
+	0x96, 0x6f, 0x3b, 0x5b, 0x4c, 0xe3, 0x7e, 0xa1, 0x91, 0xc5, 0x4b, 0x68, 0x6b, 0x42, 0xbc, 0x52,
+	0x56, 0xfe, 0x1d, 0x00, 0x0a, 0x9a, 0xa4, 0xdb, 0x22, 0x10, 0x00, 0x00,
 }
 
-func (m *GetCurrencyPairMappingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get all the currency pairs the x/oracle module is tracking price-data for.
+	GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error)
+	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
+	// that CurrencyPair.
+	GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error)
+	GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error)
+	// Get the mapping of currency pair ID -> currency pair. This is useful for
+	// indexers that have access to the ID of a currency pair, but no way to get
+	// the underlying currency pair from it.
+	GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error)
+	// Get the mapping of currency pair ID <-> currency pair as a list. This is
+	// useful for indexers that have access to the ID of a currency pair, but no
+	// way to get the underlying currency pair from it.
+	GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error)
+	// Given a CurrencyPair return the historical QuotePrices stored for it,
+	// ordered from oldest to newest.
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window (either a number of blocks or a
+	// duration), return the time-weighted average price over that window.
+	GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Get the oracle performance of a validator over the performance window.
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	// Get the oracle performance of every validator with reports in the
+	// performance window.
+	ValidatorPerformances(ctx context.Context, in *ValidatorPerformancesRequest, opts ...grpc.CallOption) (*ValidatorPerformancesResponse, error)
 }
 
-func (m *GetCurrencyPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *GetCurrencyPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *GetCurrencyPairMappingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllCurrencyPairs(ctx context.Context, in *GetAllCurrencyPairsRequest, opts ...grpc.CallOption) (*GetAllCurrencyPairsResponse, error) {
+	out := new(GetAllCurrencyPairsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetAllCurrencyPairs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetCurrencyPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrencyPairMapping) > 0 {
-		for k := range m.CurrencyPairMapping {
-			v := m.CurrencyPairMapping[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintQuery(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetPrice(ctx context.Context, in *GetPriceRequest, opts ...grpc.CallOption) (*GetPriceResponse, error) {
+	out := new(GetPriceResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPrices(ctx context.Context, in *GetPricesRequest, opts ...grpc.CallOption) (*GetPricesResponse, error) {
+	out := new(GetPricesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPrices", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCurrencyPairMapping(ctx context.Context, in *GetCurrencyPairMappingRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingResponse, error) {
+	out := new(GetCurrencyPairMappingResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetCurrencyPairMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetCurrencyPairMappingList(ctx context.Context, in *GetCurrencyPairMappingListRequest, opts ...grpc.CallOption) (*GetCurrencyPairMappingListResponse, error) {
+	out := new(GetCurrencyPairMappingListResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetCurrencyPairMappingList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CurrencyPairMapping) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetPriceHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *CurrencyPairMapping) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTWAP(ctx context.Context, in *GetTWAPRequest, opts ...grpc.CallOption) (*GetTWAPResponse, error) {
+	out := new(GetTWAPResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/GetTWAP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *CurrencyPairMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/ValidatorPerformance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValidatorPerformances(ctx context.Context, in *ValidatorPerformancesRequest, opts ...grpc.CallOption) (*ValidatorPerformancesResponse, error) {
+	out := new(ValidatorPerformancesResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Query/ValidatorPerformances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetCurrencyPairMappingListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Mappings) > 0 {
-		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get all the currency pairs the x/oracle module is tracking price-data for.
+	GetAllCurrencyPairs(context.Context, *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error)
+	// Given a CurrencyPair (or its identifier) return the latest QuotePrice for
+	// that CurrencyPair.
+	GetPrice(context.Context, *GetPriceRequest) (*GetPriceResponse, error)
+	GetPrices(context.Context, *GetPricesRequest) (*GetPricesResponse, error)
+	// Get the mapping of currency pair ID -> currency pair. This is useful for
+	// indexers that have access to the ID of a currency pair, but no way to get
+	// the underlying currency pair from it.
+	GetCurrencyPairMapping(context.Context, *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error)
+	// Get the mapping of currency pair ID <-> currency pair as a list. This is
+	// useful for indexers that have access to the ID of a currency pair, but no
+	// way to get the underlying currency pair from it.
+	GetCurrencyPairMappingList(context.Context, *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error)
+	// Given a CurrencyPair return the historical QuotePrices stored for it,
+	// ordered from oldest to newest.
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Given a CurrencyPair and a window (either a number of blocks or a
+	// duration), return the time-weighted average price over that window.
+	GetTWAP(context.Context, *GetTWAPRequest) (*GetTWAPResponse, error)
+	// Params returns the current x/oracle module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Get the oracle performance of a validator over the performance window.
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	// Get the oracle performance of every validator with reports in the
+	// performance window.
+	ValidatorPerformances(context.Context, *ValidatorPerformancesRequest) (*ValidatorPerformancesResponse, error)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GetAllCurrencyPairsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *GetAllCurrencyPairsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairs) > 0 {
-		for _, e := range m.CurrencyPairs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (*UnimplementedQueryServer) GetAllCurrencyPairs(ctx context.Context, req *GetAllCurrencyPairsRequest) (*GetAllCurrencyPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCurrencyPairs not implemented")
+}
+func (*UnimplementedQueryServer) GetPrice(ctx context.Context, req *GetPriceRequest) (*GetPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrice not implemented")
+}
+func (*UnimplementedQueryServer) GetPrices(ctx context.Context, req *GetPricesRequest) (*GetPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrices not implemented")
+}
+func (*UnimplementedQueryServer) GetCurrencyPairMapping(ctx context.Context, req *GetCurrencyPairMappingRequest) (*GetCurrencyPairMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMapping not implemented")
+}
+func (*UnimplementedQueryServer) GetCurrencyPairMappingList(ctx context.Context, req *GetCurrencyPairMappingListRequest) (*GetCurrencyPairMappingListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyPairMappingList not implemented")
+}
+func (*UnimplementedQueryServer) GetPriceHistory(ctx context.Context, req *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) GetTWAP(ctx context.Context, req *GetTWAPRequest) (*GetTWAPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTWAP not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformance(ctx context.Context, req *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformance not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorPerformances(ctx context.Context, req *ValidatorPerformancesRequest) (*ValidatorPerformancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPerformances not implemented")
 }
 
-func (m *GetPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *GetPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_GetAllCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCurrencyPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllCurrencyPairs(ctx, in)
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovQuery(uint64(m.Decimals))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetAllCurrencyPairs",
 	}
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllCurrencyPairs(ctx, req.(*GetAllCurrencyPairsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairIds) > 0 {
-		for _, s := range m.CurrencyPairIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrice(ctx, in)
 	}
-	return n
-}
-
-func (m *GetPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetPrice",
 	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrice(ctx, req.(*GetPriceRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPrices(ctx, req.(*GetPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetCurrencyPairMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CurrencyPairMapping) > 0 {
-		for k, v := range m.CurrencyPairMapping {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
-			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairMapping(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetCurrencyPairMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairMapping(ctx, req.(*GetCurrencyPairMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingListRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetCurrencyPairMappingList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencyPairMappingListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetCurrencyPairMappingList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCurrencyPairMappingList(ctx, req.(*GetCurrencyPairMappingListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CurrencyPairMapping) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(QueryServer).GetPriceHistory(ctx, in)
 	}
-	l = m.CurrencyPair.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCurrencyPairMappingListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTWAP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTWAPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
+	if interceptor == nil {
+		return srv.(QueryServer).GetTWAP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/GetTWAP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTWAP(ctx, req.(*GetTWAPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPerformances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPerformances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Query/ValidatorPerformances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPerformances(ctx, req.(*ValidatorPerformancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "slinky.oracle.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAllCurrencyPairs",
+			Handler:    _Query_GetAllCurrencyPairs_Handler,
+		},
+		{
+			MethodName: "GetPrice",
+			Handler:    _Query_GetPrice_Handler,
+		},
+		{
+			MethodName: "GetPrices",
+			Handler:    _Query_GetPrices_Handler,
+		},
+		{
+			MethodName: "GetCurrencyPairMapping",
+			Handler:    _Query_GetCurrencyPairMapping_Handler,
+		},
+		{
+			MethodName: "GetCurrencyPairMappingList",
+			Handler:    _Query_GetCurrencyPairMappingList_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Query_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetTWAP",
+			Handler:    _Query_GetTWAP_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _Query_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformances",
+			Handler:    _Query_ValidatorPerformances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "slinky/oracle/v1/query.proto",
+}
+
+func (m *GetAllCurrencyPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCurrencyPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if len(m.Mappings) > 0 {
-		for _, e := range m.Mappings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAllCurrencyPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAllCurrencyPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAllCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for iNdEx := len(m.CurrencyPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrencyPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shadow {
+		i--
+		if m.Shadow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Dispersion != nil {
+		{
+			size, err := m.Dispersion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlocksSinceUpdate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksSinceUpdate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Price != nil {
+		{
+			size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for iNdEx := len(m.CurrencyPairIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrencyPairIds[iNdEx])
+			copy(dAtA[i:], m.CurrencyPairIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrencyPairIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrencyPairMapping) > 0 {
+		for k := range m.CurrencyPairMapping {
+			v := m.CurrencyPairMapping[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintQuery(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CurrencyPairMapping) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CurrencyPairMapping) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CurrencyPairMapping) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCurrencyPairMappingListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCurrencyPairMappingListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCurrencyPairMappingListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for iNdEx := len(m.Mappings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mappings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTWAPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeWindow):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.BlockWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CurrencyPair.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTWAPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTWAPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTWAPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumSamples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSamples))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for iNdEx := len(m.Performances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Performances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetAllCurrencyPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetAllCurrencyPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairs) > 0 {
+		for _, e := range m.CurrencyPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.BlocksSinceUpdate != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksSinceUpdate))
+	}
+	if m.Stale {
+		n += 2
+	}
+	if m.Dispersion != nil {
+		l = m.Dispersion.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Shadow {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairIds) > 0 {
+		for _, s := range m.CurrencyPairIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCurrencyPairMappingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCurrencyPairMappingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrencyPairMapping) > 0 {
+		for k, v := range m.CurrencyPairMapping {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + sovQuery(uint64(k)) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetCurrencyPairMappingListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CurrencyPairMapping) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetCurrencyPairMappingListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mappings) > 0 {
+		for _, e := range m.Mappings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTWAPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CurrencyPair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockWindow != 0 {
+		n += 1 + sovQuery(uint64(m.BlockWindow))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GetTWAPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	if m.NumSamples != 0 {
+		n += 1 + sovQuery(uint64(m.NumSamples))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Performance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ValidatorPerformancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Performances) > 0 {
+		for _, e := range m.Performances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
+			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Price == nil {
+				m.Price = &QuotePrice{}
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksSinceUpdate", wireType)
+			}
+			m.BlocksSinceUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksSinceUpdate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispersion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispersion == nil {
+				m.Dispersion = &PriceDispersion{}
+			}
+			if err := m.Dispersion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shadow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shadow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, GetPriceResponse{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairMapping", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CurrencyPairMapping == nil {
+				m.CurrencyPairMapping = make(map[uint64]types.CurrencyPair)
+			}
+			var mapkey uint64
+			mapvalue := &types.CurrencyPair{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.CurrencyPair{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CurrencyPairMapping[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1431,10 +3550,10 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1458,7 +3577,7 @@ func (m *GetAllCurrencyPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1481,15 +3600,34 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CurrencyPairMapping: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAllCurrencyPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CurrencyPairMapping: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1516,8 +3654,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairs = append(m.CurrencyPairs, types.CurrencyPair{})
-			if err := m.CurrencyPairs[len(m.CurrencyPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1542,7 +3679,7 @@ func (m *GetAllCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1565,15 +3702,15 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1600,7 +3737,8 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mappings = append(m.Mappings, CurrencyPairMapping{})
+			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1625,7 +3763,7 @@ func (m *GetPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *GetPriceHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1648,15 +3786,15 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPriceHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1683,56 +3821,65 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Price == nil {
-				m.Price = &QuotePrice{}
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Id = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1742,11 +3889,26 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, QuotePrice{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1768,7 +3930,7 @@ func (m *GetPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1791,17 +3953,17 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1811,23 +3973,76 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockWindow", wireType)
+			}
+			m.BlockWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrencyPairIds = append(m.CurrencyPairIds, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1850,7 +4065,7 @@ func (m *GetPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTWAPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,17 +4088,17 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTWAPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTWAPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1893,26 +4108,64 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, GetPriceResponse{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSamples", wireType)
+			}
+			m.NumSamples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSamples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1934,7 +4187,7 @@ func (m *GetPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1957,10 +4210,10 @@ func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1984,7 +4237,7 @@ func (m *GetCurrencyPairMappingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2007,15 +4260,15 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPairMapping", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2042,91 +4295,9 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrencyPairMapping == nil {
-				m.CurrencyPairMapping = make(map[uint64]types.CurrencyPair)
-			}
-			var mapkey uint64
-			mapvalue := &types.CurrencyPair{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.CurrencyPair{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CurrencyPairMapping[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2149,7 +4320,7 @@ func (m *GetCurrencyPairMappingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2172,12 +4343,44 @@ func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2199,7 +4402,7 @@ func (m *GetCurrencyPairMappingListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,34 +4425,15 @@ func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CurrencyPairMapping: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrencyPairMapping: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2276,7 +4460,7 @@ func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrencyPair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2301,7 +4485,7 @@ func (m *CurrencyPairMapping) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorPerformancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2324,15 +4508,65 @@ func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorPerformancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCurrencyPairMappingListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorPerformancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2359,8 +4593,8 @@ func (m *GetCurrencyPairMappingListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mappings = append(m.Mappings, CurrencyPairMapping{})
-			if err := m.Mappings[len(m.Mappings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Performances = append(m.Performances, ValidatorPerformance{})
+			if err := m.Performances[len(m.Performances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
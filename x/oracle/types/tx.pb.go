@@ -225,46 +225,147 @@ func (m *MsgRemoveCurrencyPairsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveCurrencyPairsResponse proto.InternalMessageInfo
 
+// MsgUpdateParams defines the Msg/UpdateParams request type. It contains the
+// new parameters for the x/oracle module.
+type MsgUpdateParams struct {
+	// authority is the address of the account that is authorized to update the
+	// x/oracle's Params
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params defines the new parameters for the x/oracle module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cd987ee4c7d5236, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddCurrencyPairs)(nil), "slinky.oracle.v1.MsgAddCurrencyPairs")
 	proto.RegisterType((*MsgAddCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgAddCurrencyPairsResponse")
 	proto.RegisterType((*MsgRemoveCurrencyPairs)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairs")
 	proto.RegisterType((*MsgRemoveCurrencyPairsResponse)(nil), "slinky.oracle.v1.MsgRemoveCurrencyPairsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "slinky.oracle.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "slinky.oracle.v1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("slinky/oracle/v1/tx.proto", fileDescriptor_1cd987ee4c7d5236) }
 
 var fileDescriptor_1cd987ee4c7d5236 = []byte{
-	// 457 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2c, 0xce, 0xc9, 0xcc,
-	0xcb, 0xae, 0xd4, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x48, 0xe9, 0x41, 0xa4, 0xf4, 0xca, 0x0c, 0xa5,
-	0xe4, 0x30, 0x14, 0xa7, 0xa7, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0x43, 0x74, 0x48, 0x49, 0x26, 0xe7,
-	0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x1c, 0xc2, 0xd3, 0xcf,
-	0x2d, 0x4e, 0x07, 0xe9, 0xcb, 0x2d, 0x4e, 0x87, 0x4a, 0x08, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb,
-	0x83, 0x49, 0xa8, 0x90, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0xc4, 0x0c, 0x10, 0x0b, 0x2a, 0xaa, 0x0c,
-	0xb5, 0xbc, 0xa4, 0xb2, 0x20, 0xb5, 0x18, 0x64, 0x46, 0x72, 0x69, 0x51, 0x51, 0x6a, 0x5e, 0x72,
-	0x65, 0x7c, 0x41, 0x62, 0x66, 0x11, 0x44, 0x91, 0xd2, 0x55, 0x46, 0x2e, 0x61, 0xdf, 0xe2, 0x74,
-	0xc7, 0x94, 0x14, 0x67, 0xa8, 0x6c, 0x40, 0x62, 0x66, 0x51, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62,
-	0x69, 0x49, 0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4,
-	0xa5, 0x2d, 0xba, 0x22, 0x50, 0x37, 0x3a, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14,
-	0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x79, 0x71, 0xf1, 0xa1, 0x58, 0x53, 0x2c, 0xc1, 0xa4,
-	0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xab, 0x07, 0x0d, 0x1c, 0xb0, 0x6b, 0xf4, 0xca, 0x0c, 0xf5, 0x90,
-	0xed, 0x73, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x88, 0x37, 0x19, 0xd9, 0x0d, 0x56, 0x56, 0x2f,
-	0x16, 0xc8, 0x33, 0x34, 0x3d, 0xdf, 0xa0, 0x85, 0x30, 0xbf, 0xeb, 0xf9, 0x06, 0x2d, 0x98, 0xbf,
-	0x2a, 0x60, 0xc1, 0x8a, 0xc5, 0xfd, 0x4a, 0xb2, 0x5c, 0xd2, 0x58, 0x84, 0x83, 0x52, 0x8b, 0x0b,
-	0xf2, 0xf3, 0x8a, 0x53, 0x95, 0x76, 0x30, 0x72, 0x89, 0xf9, 0x16, 0xa7, 0x07, 0xa5, 0xe6, 0xe6,
-	0x97, 0xa5, 0x52, 0xc7, 0xe7, 0x5a, 0x5c, 0x82, 0x28, 0x3e, 0x8f, 0xcf, 0x4c, 0x81, 0x78, 0x9e,
-	0x33, 0x88, 0x1f, 0xd9, 0x5f, 0x9e, 0x29, 0x24, 0xf9, 0x2c, 0x38, 0xb5, 0x04, 0xd5, 0x67, 0x0a,
-	0x5c, 0x72, 0xd8, 0x5d, 0x0e, 0xf3, 0x9c, 0xd1, 0x4b, 0x46, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1,
-	0x0c, 0x2e, 0x01, 0x8c, 0x78, 0x55, 0xd5, 0x43, 0x4f, 0xa4, 0x7a, 0x58, 0xc2, 0x49, 0x4a, 0x97,
-	0x28, 0x65, 0x30, 0x1b, 0x85, 0x0a, 0xb9, 0x84, 0xb1, 0x05, 0xa5, 0x06, 0x56, 0x53, 0xb0, 0xa8,
-	0x94, 0x32, 0x20, 0x56, 0x25, 0xcc, 0x4a, 0x29, 0xd6, 0x86, 0xe7, 0x1b, 0xb4, 0x18, 0x9d, 0x5c,
-	0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x2b, 0x3d, 0xb3, 0x24, 0xa3,
-	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xdf, 0xd0, 0xd0, 0xd0, 0x52, 0xd7, 0x27, 0x31, 0xa9, 0x58,
-	0x1f, 0x3d, 0x84, 0xc1, 0xc9, 0x31, 0x89, 0x0d, 0x9c, 0x19, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x17, 0x7f, 0x22, 0x41, 0xdd, 0x03, 0x00, 0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xbb, 0xba, 0xd0, 0x59, 0x75, 0x77, 0xb3, 0x8b, 0x9b, 0x46, 0x9a, 0xd6, 0x8a,
+	0x50, 0x03, 0x4d, 0x4c, 0x85, 0x82, 0xbd, 0x6d, 0xf5, 0xa2, 0x58, 0x58, 0xb2, 0x78, 0x11, 0xa1,
+	0xa4, 0xc9, 0x30, 0x0d, 0x6e, 0x32, 0x71, 0x26, 0x2d, 0xdb, 0x9b, 0x78, 0xf4, 0xe4, 0x47, 0xf0,
+	0x23, 0xf4, 0xe0, 0x41, 0x3f, 0x81, 0x7b, 0x5c, 0x04, 0xc1, 0x93, 0x48, 0x7b, 0xa8, 0x1f, 0x43,
+	0x9a, 0x4c, 0x76, 0xd3, 0x76, 0xc0, 0x55, 0xbc, 0x84, 0xcc, 0x7b, 0xff, 0x79, 0xef, 0xff, 0xcb,
+	0x9b, 0x09, 0x2c, 0xb2, 0x63, 0x3f, 0x7c, 0x35, 0x32, 0x09, 0x75, 0xdc, 0x63, 0x64, 0x0e, 0x2d,
+	0x33, 0x3e, 0x31, 0x22, 0x4a, 0x62, 0x22, 0x6f, 0xa7, 0x29, 0x23, 0x4d, 0x19, 0x43, 0x4b, 0xd5,
+	0x56, 0xc4, 0x18, 0x85, 0x88, 0xf9, 0x2c, 0xdd, 0xa1, 0x16, 0x5d, 0xc2, 0x02, 0xc2, 0xba, 0xc9,
+	0xca, 0x4c, 0x17, 0x3c, 0xb5, 0x9f, 0xae, 0xcc, 0x80, 0xe1, 0xf9, 0xbe, 0x80, 0x61, 0x9e, 0xd8,
+	0x71, 0x02, 0x3f, 0x24, 0x66, 0xf2, 0xe4, 0xa1, 0x3d, 0x4c, 0x30, 0x49, 0x6b, 0xcc, 0xdf, 0x78,
+	0xf4, 0x0e, 0x6f, 0x1e, 0x8f, 0x22, 0xc4, 0xe6, 0x35, 0xdc, 0x01, 0xa5, 0x28, 0x74, 0x47, 0xdd,
+	0xc8, 0xf1, 0x29, 0x17, 0x95, 0x56, 0x1c, 0x46, 0x0e, 0x75, 0x02, 0xee, 0xa2, 0xfa, 0x0d, 0xc0,
+	0xdd, 0x0e, 0xc3, 0x07, 0x9e, 0xf7, 0x88, 0x6f, 0x3e, 0x74, 0x7c, 0xca, 0xe4, 0x26, 0x2c, 0x38,
+	0x83, 0xb8, 0x4f, 0xa8, 0x1f, 0x8f, 0x14, 0x50, 0x01, 0xb5, 0x42, 0x5b, 0xf9, 0xfa, 0xb1, 0xbe,
+	0xc7, 0x11, 0x0e, 0x3c, 0x8f, 0x22, 0xc6, 0x8e, 0x62, 0xea, 0x87, 0xd8, 0xbe, 0x90, 0xca, 0x4f,
+	0xe1, 0x8d, 0x05, 0x17, 0x4c, 0x59, 0xab, 0xac, 0xd7, 0x36, 0x1b, 0x25, 0x83, 0x7f, 0xbb, 0xc4,
+	0xac, 0x31, 0xb4, 0x8c, 0x7c, 0xbf, 0xf6, 0x95, 0xd3, 0x1f, 0x65, 0xc9, 0xbe, 0xee, 0xe6, 0x3d,
+	0xb4, 0x5a, 0xbf, 0x3e, 0x94, 0xa5, 0xb7, 0xb3, 0xb1, 0x7e, 0x51, 0xff, 0xdd, 0x6c, 0xac, 0x67,
+	0xd8, 0x27, 0x19, 0x93, 0xc0, 0x7f, 0xb5, 0x04, 0x6f, 0x09, 0xc2, 0x36, 0x62, 0x11, 0x09, 0x19,
+	0xaa, 0x7e, 0x02, 0xf0, 0x66, 0x87, 0x61, 0x1b, 0x05, 0x64, 0x88, 0xfe, 0x0f, 0xb9, 0x0e, 0x77,
+	0x16, 0xc8, 0xbb, 0xbe, 0x97, 0xc2, 0x17, 0xec, 0xad, 0x3c, 0xd7, 0x13, 0xef, 0xaf, 0xc8, 0x8e,
+	0x50, 0xbc, 0x48, 0x56, 0x81, 0x9a, 0xd8, 0xf9, 0x39, 0xdc, 0x67, 0x00, 0xb7, 0x3a, 0x0c, 0x3f,
+	0x8f, 0x3c, 0x27, 0x46, 0x87, 0xc9, 0xb4, 0xff, 0x99, 0xaa, 0x09, 0x37, 0xd2, 0xf3, 0xa2, 0xac,
+	0x55, 0x40, 0x6d, 0xb3, 0xa1, 0x18, 0xcb, 0x77, 0xc0, 0x48, 0x3b, 0xf0, 0x11, 0x72, 0x75, 0xab,
+	0x29, 0x26, 0x2c, 0x0b, 0x08, 0xf3, 0x3e, 0xab, 0x45, 0xb8, 0xbf, 0x14, 0xca, 0xb0, 0x1a, 0x5f,
+	0xd6, 0xe0, 0x7a, 0x87, 0x61, 0xb9, 0x0f, 0xb7, 0x57, 0x8e, 0xeb, 0xdd, 0x55, 0x5b, 0x82, 0xf1,
+	0xab, 0xf5, 0x4b, 0xc9, 0xb2, 0x8e, 0xf2, 0x6b, 0xb8, 0x2b, 0x3a, 0x21, 0x35, 0x61, 0x15, 0x81,
+	0x52, 0xbd, 0x7f, 0x59, 0xe5, 0x79, 0xcb, 0x97, 0xf0, 0xda, 0xc2, 0xdc, 0x6e, 0x0b, 0x2b, 0xe4,
+	0x25, 0xea, 0xbd, 0x3f, 0x4a, 0xb2, 0xea, 0xea, 0xd5, 0x37, 0xb3, 0xb1, 0x0e, 0xda, 0x8f, 0x4f,
+	0x27, 0x1a, 0x38, 0x9b, 0x68, 0xe0, 0xe7, 0x44, 0x03, 0xef, 0xa7, 0x9a, 0x74, 0x36, 0xd5, 0xa4,
+	0xef, 0x53, 0x4d, 0x7a, 0xa1, 0x63, 0x3f, 0xee, 0x0f, 0x7a, 0x86, 0x4b, 0x02, 0xd3, 0xb2, 0xac,
+	0x87, 0xf5, 0x67, 0x4e, 0x8f, 0x99, 0xcb, 0x43, 0x4b, 0xee, 0x70, 0x6f, 0x23, 0xf9, 0x83, 0x3c,
+	0xf8, 0x3d, 0x00, 0x2c, 0x05, 0x4a, 0x1c, 0x31, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,6 +388,9 @@ type MsgClient interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurrencyPairs, opts ...grpc.CallOption) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters. It can only be executed by the module authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -315,6 +419,15 @@ func (c *msgClient) RemoveCurrencyPairs(ctx context.Context, in *MsgRemoveCurren
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/slinky.oracle.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddCurrencyPairs will be used only by governance to update the set of
@@ -325,6 +438,9 @@ type MsgServer interface {
 	// given set of currency-pairs from the module's state. Thus these
 	// CurrencyPairs will no longer have price-data available from this module.
 	RemoveCurrencyPairs(context.Context, *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error)
+	// UpdateParams defines a method for updating the x/oracle module
+	// parameters. It can only be executed by the module authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -337,6 +453,9 @@ func (*UnimplementedMsgServer) AddCurrencyPairs(ctx context.Context, req *MsgAdd
 func (*UnimplementedMsgServer) RemoveCurrencyPairs(ctx context.Context, req *MsgRemoveCurrencyPairs) (*MsgRemoveCurrencyPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCurrencyPairs not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -378,6 +497,24 @@ func _Msg_RemoveCurrencyPairs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/slinky.oracle.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "slinky.oracle.v1.Msg",
@@ -391,6 +528,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCurrencyPairs",
 			Handler:    _Msg_RemoveCurrencyPairs_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "slinky/oracle/v1/tx.proto",
@@ -525,6 +666,69 @@ func (m *MsgRemoveCurrencyPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -592,6 +796,30 @@ func (m *MsgRemoveCurrencyPairsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -928,6 +1156,171 @@ func (m *MsgRemoveCurrencyPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorReport returns a new, empty ValidatorReport for the given validator at the given height.
+func NewValidatorReport(validator sdk.ConsAddress, height uint64) ValidatorReport {
+	return ValidatorReport{
+		Validator: validator.String(),
+		Height:    height,
+		Deviation: math.LegacyZeroDec(),
+	}
+}
+
+// ValidateBasic checks that the validator address is valid, and that the deviation is non-negative.
+func (r *ValidatorReport) ValidateBasic() error {
+	if _, err := sdk.ConsAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid validator address %s: %w", r.Validator, err)
+	}
+
+	if r.Deviation.IsNil() || r.Deviation.IsNegative() {
+		return fmt.Errorf("deviation must be non-negative for validator %s at height %d", r.Validator, r.Height)
+	}
+
+	if r.Absent && (r.MissingPrices != 0 || r.ReportedPrices != 0) {
+		return fmt.Errorf("absent report for validator %s at height %d cannot contain prices", r.Validator, r.Height)
+	}
+
+	return nil
+}
+
+// NewValidatorPerformance returns a new, empty ValidatorPerformance for the given validator.
+func NewValidatorPerformance(validator string) ValidatorPerformance {
+	return ValidatorPerformance{
+		Validator:      validator,
+		TotalDeviation: math.LegacyZeroDec(),
+	}
+}
+
+// Add accumulates the given ValidatorReport into the ValidatorPerformance.
+func (p *ValidatorPerformance) Add(r ValidatorReport) {
+	p.NumBlocks++
+	if r.Absent {
+		p.NumAbsent++
+	}
+	p.NumMissingPrices += r.MissingPrices
+	p.NumReportedPrices += r.ReportedPrices
+	p.TotalDeviation = p.TotalDeviation.Add(r.Deviation)
+}
+
+// Sub removes the given ValidatorReport (which must have previously been added) from the ValidatorPerformance.
+func (p *ValidatorPerformance) Sub(r ValidatorReport) {
+	p.NumBlocks--
+	if r.Absent {
+		p.NumAbsent--
+	}
+	p.NumMissingPrices -= r.MissingPrices
+	p.NumReportedPrices -= r.ReportedPrices
+	p.TotalDeviation = p.TotalDeviation.Sub(r.Deviation)
+}
+
+// AbsentRate returns the fraction of blocks within the window for which the validator's vote was absent.
+func (p ValidatorPerformance) AbsentRate() math.LegacyDec {
+	if p.NumBlocks == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDec(int64(p.NumAbsent)).QuoInt64(int64(p.NumBlocks)) //nolint:gosec
+}
+
+// MissRate returns the fraction of prices within the window that the validator was expected to report, but did not,
+// i.e. absent blocks are not counted.
+func (p ValidatorPerformance) MissRate() math.LegacyDec {
+	expected := p.NumMissingPrices + p.NumReportedPrices
+	if expected == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return math.LegacyNewDec(int64(p.NumMissingPrices)).QuoInt64(int64(expected)) //nolint:gosec
+}
+
+// AverageDeviation returns the average deviation of the validator's reported prices from the final aggregated prices
+// within the window.
+func (p ValidatorPerformance) AverageDeviation() math.LegacyDec {
+	if p.NumReportedPrices == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	return p.TotalDeviation.QuoInt64(int64(p.NumReportedPrices)) //nolint:gosec
+}
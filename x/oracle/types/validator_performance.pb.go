@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slinky/oracle/v1/validator_performance.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ValidatorReport is the oracle report of a single validator for a single
+// block, as derived from the validator's vote extension.
+type ValidatorReport struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// Height is the block height at which the prices aggregated from the
+	// validator's vote extension were written to state.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// Absent is true if the validator's vote was not included in the commit for
+	// the block.
+	Absent bool `protobuf:"varint,3,opt,name=absent,proto3" json:"absent,omitempty"`
+	// MissingPrices is the number of currency-pairs for which the validator did
+	// not report a price.
+	MissingPrices uint64 `protobuf:"varint,4,opt,name=missing_prices,json=missingPrices,proto3" json:"missing_prices,omitempty"`
+	// ReportedPrices is the number of currency-pairs for which the validator
+	// reported a price.
+	ReportedPrices uint64 `protobuf:"varint,5,opt,name=reported_prices,json=reportedPrices,proto3" json:"reported_prices,omitempty"`
+	// Deviation is the sum, over every reported price, of the absolute
+	// fractional deviation of the reported price from the final aggregated
+	// price. The deviation of a single price is capped at 1.
+	Deviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=deviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation"`
+}
+
+func (m *ValidatorReport) Reset()         { *m = ValidatorReport{} }
+func (m *ValidatorReport) String() string { return proto.CompactTextString(m) }
+func (*ValidatorReport) ProtoMessage()    {}
+func (*ValidatorReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f41aecfbd96b05e, []int{0}
+}
+func (m *ValidatorReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorReport.Merge(m, src)
+}
+func (m *ValidatorReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorReport proto.InternalMessageInfo
+
+func (m *ValidatorReport) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorReport) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorReport) GetAbsent() bool {
+	if m != nil {
+		return m.Absent
+	}
+	return false
+}
+
+func (m *ValidatorReport) GetMissingPrices() uint64 {
+	if m != nil {
+		return m.MissingPrices
+	}
+	return 0
+}
+
+func (m *ValidatorReport) GetReportedPrices() uint64 {
+	if m != nil {
+		return m.ReportedPrices
+	}
+	return 0
+}
+
+// ValidatorPerformance is the oracle performance of a single validator,
+// accumulated over the ValidatorReports within the module's performance
+// window.
+type ValidatorPerformance struct {
+	// Validator is the consensus address of the validator.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// NumBlocks is the number of blocks within the window for which the
+	// validator was part of the commit.
+	NumBlocks uint64 `protobuf:"varint,2,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// NumAbsent is the number of blocks within the window for which the
+	// validator's vote was absent.
+	NumAbsent uint64 `protobuf:"varint,3,opt,name=num_absent,json=numAbsent,proto3" json:"num_absent,omitempty"`
+	// NumMissingPrices is the number of prices the validator did not report
+	// within the window.
+	NumMissingPrices uint64 `protobuf:"varint,4,opt,name=num_missing_prices,json=numMissingPrices,proto3" json:"num_missing_prices,omitempty"`
+	// NumReportedPrices is the number of prices the validator reported within
+	// the window.
+	NumReportedPrices uint64 `protobuf:"varint,5,opt,name=num_reported_prices,json=numReportedPrices,proto3" json:"num_reported_prices,omitempty"`
+	// TotalDeviation is the sum of the deviations of the validator's reported
+	// prices from the final aggregated prices within the window.
+	TotalDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=total_deviation,json=totalDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_deviation"`
+}
+
+func (m *ValidatorPerformance) Reset()         { *m = ValidatorPerformance{} }
+func (m *ValidatorPerformance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformance) ProtoMessage()    {}
+func (*ValidatorPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f41aecfbd96b05e, []int{1}
+}
+func (m *ValidatorPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformance.Merge(m, src)
+}
+func (m *ValidatorPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformance proto.InternalMessageInfo
+
+func (m *ValidatorPerformance) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ValidatorPerformance) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetNumAbsent() uint64 {
+	if m != nil {
+		return m.NumAbsent
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetNumMissingPrices() uint64 {
+	if m != nil {
+		return m.NumMissingPrices
+	}
+	return 0
+}
+
+func (m *ValidatorPerformance) GetNumReportedPrices() uint64 {
+	if m != nil {
+		return m.NumReportedPrices
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorReport)(nil), "slinky.oracle.v1.ValidatorReport")
+	proto.RegisterType((*ValidatorPerformance)(nil), "slinky.oracle.v1.ValidatorPerformance")
+}
+
+func init() {
+	proto.RegisterFile("slinky/oracle/v1/validator_performance.proto", fileDescriptor_8f41aecfbd96b05e)
+}
+
+var fileDescriptor_8f41aecfbd96b05e = []byte{
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0x32, 0x2a, 0x6a, 0x89, 0x76, 0x84, 0x09, 0x85, 0xa1, 0x65, 0x65, 0x12, 0xa2,
+	0x42, 0x6b, 0xa2, 0x88, 0x13, 0x27, 0xb4, 0xd2, 0xe3, 0x10, 0x53, 0x90, 0x38, 0xec, 0x12, 0x39,
+	0x8e, 0x49, 0xad, 0xc6, 0x76, 0xe4, 0xcf, 0xa9, 0xe8, 0x5b, 0xf0, 0x0e, 0xbc, 0xc2, 0x1e, 0x62,
+	0x37, 0xa6, 0x9d, 0x10, 0x87, 0x09, 0xb5, 0x2f, 0x82, 0x6a, 0x27, 0x2b, 0x93, 0x7a, 0x63, 0xb7,
+	0x7c, 0xff, 0xff, 0xff, 0xfb, 0x9c, 0xef, 0x97, 0x18, 0x1d, 0x43, 0xc1, 0xc4, 0x6c, 0x11, 0x4a,
+	0x85, 0x49, 0x41, 0xc3, 0x79, 0x14, 0xce, 0x71, 0xc1, 0x32, 0xac, 0xa5, 0x4a, 0x4a, 0xaa, 0xbe,
+	0x4a, 0xc5, 0xb1, 0x20, 0x34, 0x28, 0x95, 0xd4, 0xd2, 0xdd, 0xb5, 0xe9, 0xc0, 0xa6, 0x83, 0x79,
+	0xb4, 0xbf, 0x97, 0xcb, 0x5c, 0x1a, 0x33, 0x5c, 0x3f, 0xd9, 0xdc, 0xfe, 0x73, 0x22, 0x81, 0x4b,
+	0x48, 0xac, 0x61, 0x0b, 0x6b, 0x1d, 0xfd, 0x68, 0xa3, 0xfe, 0x97, 0xe6, 0x88, 0x98, 0x96, 0x52,
+	0x69, 0xf7, 0x3d, 0xea, 0xde, 0x9e, 0xea, 0x39, 0x03, 0x67, 0xd8, 0x1d, 0xbf, 0xbc, 0xbe, 0x18,
+	0x1d, 0xd4, 0x8d, 0x1f, 0xa4, 0x00, 0x2a, 0xa0, 0x82, 0x93, 0x2c, 0x53, 0x14, 0xe0, 0xb3, 0x56,
+	0x4c, 0xe4, 0xf1, 0xa6, 0xc7, 0x7d, 0x86, 0x3a, 0x53, 0xca, 0xf2, 0xa9, 0xf6, 0xda, 0x03, 0x67,
+	0xb8, 0x13, 0xd7, 0xd5, 0x5a, 0xc7, 0x29, 0x50, 0xa1, 0xbd, 0x07, 0x03, 0x67, 0xf8, 0x28, 0xae,
+	0x2b, 0xf7, 0x15, 0xea, 0x71, 0x06, 0xc0, 0x44, 0x9e, 0x94, 0x8a, 0x11, 0x0a, 0xde, 0x8e, 0xe9,
+	0x7b, 0x5c, 0xab, 0x67, 0x46, 0x74, 0x5f, 0xa3, 0xbe, 0x32, 0x6f, 0x48, 0xb3, 0x26, 0xf7, 0xd0,
+	0xe4, 0x7a, 0x8d, 0x5c, 0x07, 0x3f, 0xa1, 0x6e, 0x46, 0xe7, 0x0c, 0x6b, 0x26, 0x85, 0xd7, 0x31,
+	0x0b, 0x44, 0x97, 0x37, 0x87, 0xad, 0xdf, 0x37, 0x87, 0x2f, 0xec, 0x12, 0x90, 0xcd, 0x02, 0x26,
+	0x43, 0x8e, 0xf5, 0x34, 0x38, 0xa5, 0x39, 0x26, 0x8b, 0x09, 0x25, 0xd7, 0x17, 0x23, 0x54, 0xef,
+	0x38, 0xa1, 0x24, 0xde, 0xcc, 0x38, 0xfa, 0xd9, 0x46, 0x7b, 0xb7, 0x94, 0xce, 0x36, 0xdf, 0xe1,
+	0xff, 0x51, 0x1d, 0x20, 0x24, 0x2a, 0x9e, 0xa4, 0x85, 0x24, 0x33, 0xa8, 0x71, 0x75, 0x45, 0xc5,
+	0xc7, 0x46, 0x68, 0xec, 0x7f, 0xa8, 0x59, 0xfb, 0xc4, 0x82, 0x3b, 0x46, 0xee, 0xda, 0xde, 0x0a,
+	0x6f, 0x57, 0x54, 0xfc, 0xe3, 0x1d, 0x7e, 0x01, 0x7a, 0xba, 0x4e, 0x6f, 0x67, 0xf8, 0x44, 0x54,
+	0x3c, 0xbe, 0x8b, 0xf1, 0x1c, 0xf5, 0xb5, 0xd4, 0xb8, 0x48, 0xee, 0x01, 0x66, 0xcf, 0x4c, 0x9a,
+	0x34, 0x83, 0xc6, 0x93, 0xcb, 0xa5, 0xef, 0x5c, 0x2d, 0x7d, 0xe7, 0xcf, 0xd2, 0x77, 0xbe, 0xaf,
+	0xfc, 0xd6, 0xd5, 0xca, 0x6f, 0xfd, 0x5a, 0xf9, 0xad, 0xf3, 0x37, 0x39, 0xd3, 0xd3, 0x2a, 0x0d,
+	0x88, 0xe4, 0x61, 0x14, 0x45, 0xef, 0x46, 0xa7, 0x38, 0x85, 0xb0, 0xbe, 0x17, 0xdf, 0x9a, 0x9b,
+	0xa1, 0x17, 0x25, 0x85, 0xb4, 0x63, 0x7e, 0xe2, 0xb7, 0x7f, 0x07, 0x00, 0x6a, 0x20, 0x91, 0x6b,
+	0x37, 0x03, 0x00, 0x00,
+}
+
+func (m *ValidatorReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ReportedPrices != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.ReportedPrices))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MissingPrices != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.MissingPrices))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Absent {
+		i--
+		if m.Absent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalDeviation.Size()
+		i -= size
+		if _, err := m.TotalDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.NumReportedPrices != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.NumReportedPrices))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumMissingPrices != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.NumMissingPrices))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NumAbsent != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.NumAbsent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintValidatorPerformance(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorPerformance(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.Height))
+	}
+	if m.Absent {
+		n += 2
+	}
+	if m.MissingPrices != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.MissingPrices))
+	}
+	if m.ReportedPrices != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.ReportedPrices))
+	}
+	l = m.Deviation.Size()
+	n += 1 + l + sovValidatorPerformance(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovValidatorPerformance(uint64(l))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.NumBlocks))
+	}
+	if m.NumAbsent != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.NumAbsent))
+	}
+	if m.NumMissingPrices != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.NumMissingPrices))
+	}
+	if m.NumReportedPrices != 0 {
+		n += 1 + sovValidatorPerformance(uint64(m.NumReportedPrices))
+	}
+	l = m.TotalDeviation.Size()
+	n += 1 + l + sovValidatorPerformance(uint64(l))
+	return n
+}
+
+func sovValidatorPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorPerformance(x uint64) (n int) {
+	return sovValidatorPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Absent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Absent = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPrices", wireType)
+			}
+			m.MissingPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportedPrices", wireType)
+			}
+			m.ReportedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportedPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumAbsent", wireType)
+			}
+			m.NumAbsent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumAbsent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissingPrices", wireType)
+			}
+			m.NumMissingPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMissingPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReportedPrices", wireType)
+			}
+			m.NumReportedPrices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReportedPrices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthValidatorPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorPerformance = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,121 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/1119-Labs/slinky/x/oracle/types"
+)
+
+func TestValidatorReportValidateBasic(t *testing.T) {
+	validator := sdk.ConsAddress("validator")
+
+	tcs := []struct {
+		name       string
+		report     func() types.ValidatorReport
+		expectPass bool
+	}{
+		{
+			"valid report - pass",
+			func() types.ValidatorReport {
+				r := types.NewValidatorReport(validator, 1)
+				r.MissingPrices = 1
+				r.ReportedPrices = 2
+				r.Deviation = math.LegacyNewDecWithPrec(1, 2)
+				return r
+			},
+			true,
+		},
+		{
+			"valid absent report - pass",
+			func() types.ValidatorReport {
+				r := types.NewValidatorReport(validator, 1)
+				r.Absent = true
+				return r
+			},
+			true,
+		},
+		{
+			"invalid validator address - fail",
+			func() types.ValidatorReport {
+				r := types.NewValidatorReport(validator, 1)
+				r.Validator = "invalid"
+				return r
+			},
+			false,
+		},
+		{
+			"nil deviation - fail",
+			func() types.ValidatorReport {
+				r := types.NewValidatorReport(validator, 1)
+				r.Deviation = math.LegacyDec{}
+				return r
+			},
+			false,
+		},
+		{
+			"negative deviation - fail",
+			func() types.ValidatorReport {
+				r := types.NewValidatorReport(validator, 1)
+				r.Deviation = math.LegacyNewDec(-1)
+				return r
+			},
+			false,
+		},
+		{
+			"absent report with prices - fail",
+			func() types.ValidatorReport {
+				r := types.NewValidatorReport(validator, 1)
+				r.Absent = true
+				r.ReportedPrices = 1
+				return r
+			},
+			false,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			r := tc.report()
+			err := r.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatorPerformance(t *testing.T) {
+	validator := sdk.ConsAddress("validator")
+
+	reported := types.NewValidatorReport(validator, 1)
+	reported.MissingPrices = 1
+	reported.ReportedPrices = 3
+	reported.Deviation = math.LegacyNewDecWithPrec(3, 2)
+
+	absent := types.NewValidatorReport(validator, 2)
+	absent.Absent = true
+
+	performance := types.NewValidatorPerformance(validator.String())
+	require.Equal(t, math.LegacyZeroDec(), performance.AbsentRate())
+	require.Equal(t, math.LegacyZeroDec(), performance.MissRate())
+	require.Equal(t, math.LegacyZeroDec(), performance.AverageDeviation())
+
+	performance.Add(reported)
+	performance.Add(absent)
+	require.Equal(t, uint64(2), performance.NumBlocks)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), performance.AbsentRate())
+	require.Equal(t, math.LegacyNewDecWithPrec(25, 2), performance.MissRate())
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 2), performance.AverageDeviation())
+
+	performance.Sub(reported)
+	require.Equal(t, uint64(1), performance.NumBlocks)
+	require.Equal(t, math.LegacyOneDec(), performance.AbsentRate())
+	require.Equal(t, math.LegacyZeroDec(), performance.MissRate())
+	require.True(t, performance.TotalDeviation.IsZero())
+}